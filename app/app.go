@@ -0,0 +1,597 @@
+// Package app 路由服务的启动流程，main 和集成测试通过 Options 替换路由仓库和服务的实现
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/asim/go-micro/plugins/registry/consul/v3"
+	"github.com/asim/go-micro/v3"
+	"github.com/asim/go-micro/v3/registry"
+	"github.com/asim/go-micro/v3/server"
+	"github.com/asim/go-micro/v3/transport"
+	"github.com/urfave/cli/v2"
+	"github.com/zxnlx/common"
+	routeclient "github.com/zxnlx/route/client"
+	"github.com/zxnlx/route/debugserver"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/domain/repository/kvstore"
+	service2 "github.com/zxnlx/route/domain/service"
+	"github.com/zxnlx/route/gateway"
+	"github.com/zxnlx/route/handler"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/k8strace"
+	"github.com/zxnlx/route/lock"
+	"github.com/zxnlx/route/logsample"
+	"github.com/zxnlx/route/metrics"
+	"github.com/zxnlx/route/notify"
+	"github.com/zxnlx/route/proto/health"
+	"github.com/zxnlx/route/proto/route"
+	"github.com/zxnlx/route/rpccodec"
+	"github.com/zxnlx/route/secrets"
+	"github.com/zxnlx/route/seed"
+	"github.com/zxnlx/route/storage"
+	"github.com/zxnlx/route/tlsconfig"
+	"github.com/zxnlx/route/webhook"
+	"github.com/zxnlx/route/wrapper"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"strconv"
+	"time"
+)
+
+var (
+	serviceHost = "host.docker.internal"
+	servicePort = "8087"
+	// HTTP 网关端口
+	gatewayPort = "8088"
+
+	// 注册中心配置
+	consulHost       = serviceHost
+	consulPort int64 = 8500
+
+	// --seed 启动时加载示例路由，--seed-ingress-nginx 同时为 kind 集群安装 ingress-nginx
+	seedMode         bool
+	seedIngressNginx bool
+)
+
+// 注册中心
+func initRegistry() registry.Registry {
+	return consul.NewRegistry(func(options *registry.Options) {
+		options.Addrs = []string{
+			consulHost + ":" + strconv.FormatInt(consulPort, 10),
+		}
+	})
+}
+
+// 密钥从 Secret 读取时需要 k8s client
+func initConfig(opts Options, clientSet kubernetes.Interface, dynamicClient dynamic.Interface) (*storage.Storage, *service2.RouteConfig, *wrapper.RateLimiter, *notify.Config, *wrapper.RequestLogger, *gateway.Config, *tlsconfig.Config, *rpccodec.Config, *webhook.Config, *debugserver.Config, secrets.Provider) {
+	// 配置中心
+	config, err := common.GetConsulConfig(consulHost, consulPort, "/base/micro/config")
+	if err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+
+	// 路由服务配置，没有配置时使用默认值
+	routeConfig := &service2.RouteConfig{}
+	if err := config.Get("route").Scan(routeConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	// 限流，配置变更时实时生效
+	rateLimitConfig := wrapper.RateLimitConfig{}
+	if err := config.Get("route", "rate_limit").Scan(&rateLimitConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	rateLimiter := wrapper.NewRateLimiter(rateLimitConfig)
+	go rateLimiter.Watch(config, "route", "rate_limit")
+	// 通知渠道，未配置时不发送
+	notifyConfig := &notify.Config{}
+	if err := config.Get("route", "notify").Scan(notifyConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	// 请求日志，配置变更时实时生效
+	loggingConfig := wrapper.LoggingConfig{}
+	if err := config.Get("route", "logging").Scan(&loggingConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	requestLogger := wrapper.NewRequestLogger(loggingConfig)
+	go requestLogger.Watch(config, "route", "logging")
+	// 高频日志采样和重复错误聚合，配置变更时实时生效
+	samplingConfig := logsample.Config{}
+	if err := config.Get("route", "log_sampling").Scan(&samplingConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	logsample.Default.SetConfig(samplingConfig)
+	go logsample.Default.Watch(config, "route", "log_sampling")
+	go logsample.Default.Run(context.Background())
+	// k8s API 请求追踪，排查 API server 限流时临时开启
+	traceConfig := k8strace.Config{}
+	if err := config.Get("route", "k8s_trace").Scan(&traceConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	k8strace.Default.SetConfig(traceConfig)
+	go k8strace.Default.Watch(config, "route", "k8s_trace")
+	// 错误消息的默认语言，请求可以通过 Accept-Language 指定
+	i18nConfig := i18n.Config{}
+	if err := config.Get("route", "i18n").Scan(&i18nConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	i18n.Default.SetConfig(i18nConfig)
+	go i18n.Default.Watch(config, "route", "i18n")
+	// HTTP 网关，未配置 OIDC 时不做认证
+	gatewayConfig := &gateway.Config{}
+	if err := config.Get("route", "gateway").Scan(gatewayConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	// gRPC 端口的 TLS，未开启时使用明文
+	tlsConfig := &tlsconfig.Config{}
+	if err := config.Get("route", "tls").Scan(tlsConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	// gRPC 端口的消息大小限制，未配置时为 16MB
+	serverConfig := &rpccodec.Config{}
+	if err := config.Get("route", "server").Scan(serverConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+
+	// Ingress 准入 webhook，拒绝绕过路由服务的修改
+	webhookConfig := &webhook.Config{}
+	if err := config.Get("route", "ingress_webhook").Scan(webhookConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+
+	// 调试端口，pprof 和运行时状态，默认关闭
+	debugConfig := &debugserver.Config{}
+	if err := config.Get("route", "debug").Scan(debugConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+
+	// 密钥后端，MySQL 账号和 webhook 签名密钥可以从 Vault 或 Secret 读取
+	secretsConfig := secrets.Config{}
+	if err := config.Get("route", "secrets").Scan(&secretsConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	secretsProvider, err := secrets.New(secretsConfig, clientSet)
+	if err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+
+	// 敏感字段加密，未配置加密密钥时明文保存
+	encrypter, err := secrets.NewEncrypter(secretsProvider, secretsConfig)
+	if err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	repository.SetEncrypter(encrypter)
+
+	// 存储后端，kubernetes 和 redis 后端不需要 mysql
+	storageConfig := kvstore.Config{}
+	if err := config.Get("route", "storage").Scan(&storageConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	if err := storage.CheckBackend(storageConfig.Backend); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	if storageConfig.Backend == kvstore.BackendKubernetes {
+		store, err := storage.NewKubernetesStorage(clientSet, dynamicClient, storageConfig)
+		if err != nil {
+			common.Fatal(err)
+			return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+		}
+		return store, routeConfig, rateLimiter, notifyConfig, requestLogger, gatewayConfig, tlsConfig, serverConfig, webhookConfig, debugConfig, secretsProvider
+	}
+	if storageConfig.Backend == kvstore.BackendRedis {
+		store, err := storage.NewRedisStorage(storageConfig)
+		if err != nil {
+			common.Fatal(err)
+			return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+		}
+		return store, routeConfig, rateLimiter, notifyConfig, requestLogger, gatewayConfig, tlsConfig, serverConfig, webhookConfig, debugConfig, secretsProvider
+	}
+
+	var dialector gorm.Dialector
+	if secretsConfig.Mysql != "" {
+		// 地址和账号都从密钥后端读取，不读取配置中心的 mysql 节点，轮换后新连接自动使用新账号
+		dsn, err := secrets.MysqlDSN(secretsProvider, secretsConfig)
+		if err != nil {
+			common.Fatal(err)
+			return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+		}
+		sqlDB, err := secrets.OpenMysql(secretsProvider, secretsConfig, dsn)
+		if err != nil {
+			common.Fatal(err)
+			return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+		}
+		dialector = mysql.New(mysql.Config{Conn: sqlDB})
+	} else {
+		mysqlConf, err := common.GetMysqlFormConsul(config, "mysql")
+		if err != nil {
+			common.Fatal(err)
+			return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+		}
+		// 连接mysql
+		dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local", mysqlConf.User, mysqlConf.Pwd, mysqlConf.Host, mysqlConf.Port, mysqlConf.Database)
+		dialector = mysql.Open(dsn)
+		common.Info(dsn)
+	}
+	// 数据库日志和慢查询
+	loggerConfig := repository.LoggerConfig{}
+	if err := config.Get("route", "db_log").Scan(&loggerConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	db, err := gorm.Open(dialector, &gorm.Config{Logger: repository.NewLogger(loggerConfig)})
+	if err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	// 只读副本，列表和统计查询读副本，未配置时都使用主库
+	replicaConfig := repository.ReplicaConfig{}
+	if err := config.Get("route", "replicas").Scan(&replicaConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	if err := repository.UseReplicas(db, replicaConfig); err != nil {
+		common.Fatal(err)
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	return &storage.Storage{DB: db, NewRouteRepository: opts.NewRouteRepository}, routeConfig, rateLimiter, notifyConfig, requestLogger, gatewayConfig, tlsConfig, serverConfig, webhookConfig, debugConfig, secretsProvider
+}
+
+func initK8s() (*kubernetes.Clientset, dynamic.Interface) {
+	//k8s
+	//var k8sConfig *string
+	//k8sConfig = flag.String("kubeconfig", "", "/Users/lqy007700/Data/config")
+	//flag.Parse()
+	//common.Info(*k8sConfig)
+
+	//config, err := clientcmd.BuildConfigFromFlags("", "/Users/lqy007700/Data/config")
+	config, err := clientcmd.BuildConfigFromFlags("", "/root/.kube/config")
+	if err != nil {
+		common.Fatal(err)
+		return nil, nil
+	}
+	//
+	//config, err := rest.InClusterConfig()
+	//if err != nil {
+	//	return
+	//}
+	// 请求追踪在读取配置中心后开启
+	config.Wrap(k8strace.Default.WrapTransport)
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		common.Fatal(err)
+		return nil, nil
+	}
+	// Gateway API 等 CRD 资源使用 dynamic client
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		common.Fatal(err)
+		return nil, nil
+	}
+	return clientset, dynamicClient
+}
+
+// 分布式锁，未开启时返回 nil
+func initLocker(config service2.DistributedLockConfig) service2.RouteLocker {
+	if !config.Enabled {
+		return nil
+	}
+	prefix := config.Prefix
+	if prefix == "" {
+		prefix = "route/locks/"
+	}
+	waitTime := time.Duration(config.WaitSeconds) * time.Second
+	if waitTime <= 0 {
+		waitTime = 10 * time.Second
+	}
+	locker, err := lock.NewConsulLocker(consulHost+":"+strconv.FormatInt(consulPort, 10), prefix, waitTime)
+	if err != nil {
+		common.Fatal(err)
+		return nil
+	}
+	return locker
+}
+
+// Run 启动服务，直到收到退出信号，Options 中为空的构造函数使用默认实现
+func Run(opts Options) {
+	opts = opts.withDefaults()
+	c := initRegistry()
+	clientSet, dynamicClient := initK8s()
+
+	repos, routeConfig, rateLimiter, notifyConfig, requestLogger, gatewayConfig, tlsConfig, serverConfig, webhookConfig, debugConfig, secretsProvider := initConfig(opts, clientSet, dynamicClient)
+
+	// 日志
+	// ./filebeat -e -c filebeat.yml
+
+	// 角色在创建服务前初始化，鉴权 wrapper 需要使用
+	apiKeyRepository := repos.APIKeyRepository()
+	roleBindingDataService := service2.NewRoleBindingDataService(repos.RoleBindingRepository(), repos.RouteRepository(), apiKeyRepository, repos.NamespaceDefaultRepository(), routeConfig.RBAC, routeConfig.Environments)
+	apiKeyDataService := service2.NewAPIKeyDataService(apiKeyRepository, repos.RouteRepository(), routeConfig.Environments)
+	// 开启双向 TLS 时客户端证书作为调用方身份，握手时记录
+	peers := tlsconfig.NewPeers()
+
+	service := micro.NewService(
+		// 接受 gzip 压缩的请求，限制单条消息的大小
+		micro.Server(server.NewServer(append(rpccodec.ServerOptions(*serverConfig), func(options *server.Options) {
+			options.Advertise = serviceHost + ":" + servicePort
+		})...)),
+		micro.Name("go.micro.service.route"),
+		micro.Version("latest"),
+		micro.Metadata(map[string]string{routeclient.ClusterMetadata: routeConfig.ClusterName}),
+		micro.Registry(c),
+		micro.Address(":"+servicePort),
+		// 限流，保护 mysql 和 k8s api server
+		micro.WrapHandler(metrics.NewHandlerWrapper(), wrapper.NewLocaleWrapper(i18n.Default), requestLogger.NewLoggingWrapper(), rateLimiter.NewGlobalRateLimitWrapper(), wrapper.NewAuthorizationWrapper(roleBindingDataService, apiKeyDataService, wrapper.NewAssertion(gatewayConfig.Assertion, secretsProvider), peers), rateLimiter.NewCallerRateLimitWrapper(), wrapper.NewMessageSizeWrapper(serverConfig.Limit()), wrapper.NewBackpressureWrapper()),
+		micro.Flags(
+			&cli.BoolFlag{Name: "seed", Usage: "启动时加载示例路由"},
+			&cli.BoolFlag{Name: "seed-ingress-nginx", Usage: "加载示例路由前为 kind 集群安装 ingress-nginx"},
+		),
+		micro.Action(func(c *cli.Context) error {
+			seedMode = c.Bool("seed") || c.Bool("seed-ingress-nginx")
+			seedIngressNginx = c.Bool("seed-ingress-nginx")
+			return nil
+		}),
+	)
+
+	// 证书从 Secret 读取时需要 k8s client，在创建服务后设置传输层
+	serverTLS, err := tlsconfig.Load(*tlsConfig, clientSet)
+	if err != nil {
+		common.Fatal(err)
+		return
+	}
+	if serverTLS != nil {
+		peers.Track(serverTLS)
+		service.Init(micro.Transport(transport.NewHTTPTransport(transport.Secure(true), transport.TLSConfig(serverTLS))))
+	}
+
+	service.Init()
+
+	// 执行一遍
+	//err := repository.NewRouteRepository(db).InitTable()
+	//if err != nil {
+	//	common.Fatal(err)
+	//	return
+	//}
+	//err = repository.NewNamespaceDefaultRepository(db).InitTable()
+	//if err != nil {
+	//	common.Fatal(err)
+	//	return
+	//}
+	//err = repository.NewApplicationRepository(db).InitTable()
+	//if err != nil {
+	//	common.Fatal(err)
+	//	return
+	//}
+	//err = repository.NewEventRepository(db).InitTable()
+	//if err != nil {
+	//	common.Fatal(err)
+	//	return
+	//}
+	//err = repository.NewFreezeWindowRepository(db).InitTable()
+	//if err != nil {
+	//	common.Fatal(err)
+	//	return
+	//}
+	//err = repository.NewOutboxRepository(db).InitTable()
+	//if err != nil {
+	//	common.Fatal(err)
+	//	return
+	//}
+	//err = repository.NewAnnotationTemplateRepository(db).InitTable()
+	//if err != nil {
+	//	common.Fatal(err)
+	//	return
+	//}
+	//err = repository.NewRoleBindingRepository(db).InitTable()
+	//if err != nil {
+	//	common.Fatal(err)
+	//	return
+	//}
+	//err = repository.NewAPIKeyRepository(db).InitTable()
+	//if err != nil {
+	//	common.Fatal(err)
+	//	return
+	//}
+	//err = repository.NewQuotaRepository(db).InitTable()
+	//if err != nil {
+	//	common.Fatal(err)
+	//	return
+	//}
+	//err = repository.NewUsageSnapshotRepository(db).InitTable()
+	//if err != nil {
+	//	common.Fatal(err)
+	//	return
+	//}
+	//err = repository.NewReservedHostRepository(db).InitTable()
+	//if err != nil {
+	//	common.Fatal(err)
+	//	return
+	//}
+
+	eventDataService := service2.NewEventDataService(repos.EventRepository())
+	annotationTemplateRepository := repos.AnnotationTemplateRepository()
+	quotaRepository := repos.QuotaRepository()
+	locker := initLocker(routeConfig.DistributedLock)
+	dataService := opts.NewRouteDataService(repos.RouteRepository(), annotationTemplateRepository, quotaRepository, clientSet, dynamicClient, routeConfig, locker)
+	if err := dataService.InstallRouteMutators(routeConfig.Mutators); err != nil {
+		common.Fatal(err)
+		return
+	}
+	usageReportDataService := service2.NewUsageReportDataService(repos.UsageSnapshotRepository(), repos.RouteRepository())
+	namespaceDefaultDataService := service2.NewNamespaceDefaultDataService(repos.NamespaceDefaultRepository())
+	reservedHostDataService := service2.NewReservedHostDataService(repos.ReservedHostRepository(), repos.RouteRepository(), roleBindingDataService)
+	//域名规则和保留域名在校验阶段检查，修改、晋级、导入也会经过
+	if err := dataService.AddRouteHook(service2.StageValidate, func(op *service2.RouteOperation) error {
+		return namespaceDefaultDataService.CheckHost(op.Info)
+	}); err != nil {
+		common.Fatal(err)
+		return
+	}
+	if err := dataService.AddRouteHook(service2.StageValidate, reservedHostDataService.CheckRoute); err != nil {
+		common.Fatal(err)
+		return
+	}
+	applicationDataService := service2.NewApplicationDataService(repos.ApplicationRepository(), dataService)
+	// 自检报告中展示的配置，通知渠道和数据库连接包含密钥不展示
+	diagnosticsDataService := service2.NewDiagnosticsDataService(repos.DB, c, service.Server(), dataService, func() map[string]interface{} {
+		return map[string]interface{}{
+			"route":              routeConfig,
+			"route.rate_limit":   rateLimiter.Config(),
+			"route.logging":      requestLogger.Config(),
+			"route.log_sampling": logsample.Default.Config(),
+			"route.k8s_trace":    k8strace.Default.Config(),
+			"route.i18n":         i18n.Default.Config(),
+		}
+	})
+	err = route.RegisterRouteHandler(service.Server(), &handler.RouteHandler{
+		RouteDataService:              dataService,
+		NamespaceDefaultDataService:   namespaceDefaultDataService,
+		ApplicationDataService:        applicationDataService,
+		EventDataService:              eventDataService,
+		FreezeWindowDataService:       service2.NewFreezeWindowDataService(repos.FreezeWindowRepository()),
+		ReservedHostDataService:       reservedHostDataService,
+		AnnotationTemplateDataService: service2.NewAnnotationTemplateDataService(annotationTemplateRepository),
+		RoleBindingDataService:        roleBindingDataService,
+		APIKeyDataService:             apiKeyDataService,
+		QuotaDataService:              service2.NewQuotaDataService(quotaRepository, repos.RouteRepository()),
+		UsageReportDataService:        usageReportDataService,
+		DiagnosticsDataService:        diagnosticsDataService,
+	})
+	if err != nil {
+		common.Fatal(err)
+		return
+	}
+
+	// 示例路由
+	if seedMode {
+		if err := seed.NewSeeder(dataService, clientSet, dynamicClient).Run(context.Background(), seedIngressNginx); err != nil {
+			common.Fatal(err)
+			return
+		}
+	}
+
+	// 启动自检，注册完成后执行，失败只记录日志不阻止启动
+	service.Init(micro.AfterStart(func() error {
+		report := diagnosticsDataService.Diagnose(context.Background())
+		data, err := json.Marshal(report)
+		if err != nil {
+			common.Error(err)
+			return nil
+		}
+		if !report.Ok {
+			common.Error("启动自检未通过：" + string(data))
+			return nil
+		}
+		common.Info("启动自检通过：" + string(data))
+		return nil
+	}))
+
+	// 健康检查，service 为空时检查全部依赖
+	healthCheckers := map[string]service2.HealthChecker{
+		"kubernetes": func(ctx context.Context) error {
+			return clientSet.Discovery().RESTClient().Get().AbsPath("/readyz").Do(ctx).Error()
+		},
+	}
+	if repos.DB != nil {
+		healthCheckers["mysql"] = func(ctx context.Context) error {
+			sqlDB, err := repos.DB.DB()
+			if err != nil {
+				return err
+			}
+			return sqlDB.PingContext(ctx)
+		}
+	}
+	healthDataService := service2.NewHealthDataService(healthCheckers)
+	err = health.RegisterHealthHandler(service.Server(), &handler.HealthHandler{HealthDataService: healthDataService})
+	if err != nil {
+		common.Fatal(err)
+		return
+	}
+
+	// 事件随路由变更写入 outbox，由 relay 发布到通知渠道
+	go service2.NewOutboxRelay(repos.OutboxRepository(), notify.NewDispatcher(*notifyConfig, secretsProvider)).Run(context.Background())
+
+	// 继续中断的删除，启动时先执行一次
+	go dataService.RunDeleteReconciler(context.Background())
+
+	// 自动重试写入失败的路由，超过次数后进入死信列表
+	go dataService.RunApplyRetrier(context.Background())
+
+	// 启动时检查数据库和集群是否一致，重启后尽早发现漂移
+	// 开启分布式锁时只在拿到领导权的副本上执行，其他副本等待，领导副本退出后由新的领导副本执行
+	// 未开启分布式锁时按单副本部署处理，直接执行
+	if routeConfig.ConsistencyCheck.Enabled {
+		go func() {
+			if elector, ok := locker.(service2.LeaderElector); ok {
+				if _, err := elector.Lead(context.Background(), "consistency-check-leader"); err != nil {
+					common.Error(err)
+					return
+				}
+			}
+			report, err := dataService.CheckConsistency(routeConfig.ConsistencyCheck)
+			if err != nil {
+				common.Error(err)
+				return
+			}
+			data, _ := json.Marshal(report)
+			common.Info("启动一致性检查：" + string(data))
+		}()
+	}
+
+	// 每小时记录用量快照，用量报表按天读取
+	go usageReportDataService.Run(context.Background())
+
+	// 按保留策略清理历史事件、归档和快照
+	go service2.NewRetentionJob(repos.RetentionRepository(), routeConfig.Retention).Run(context.Background())
+
+	// HTTP 网关
+	go func() {
+		if err := gateway.NewGateway(dataService, eventDataService, service.Client(), "go.micro.service.route", *gatewayConfig, secretsProvider).Run(":" + gatewayPort); err != nil {
+			common.Fatal(err)
+		}
+	}()
+
+	if webhookConfig.Enabled {
+		go func() {
+			if err := webhook.NewServer(dataService, *webhookConfig).Run(clientSet); err != nil {
+				common.Fatal(err)
+			}
+		}()
+	}
+
+	if debugConfig.Enabled {
+		go func() {
+			if err := debugserver.NewServer(dataService, *debugConfig, secretsProvider).Run(); err != nil {
+				common.Fatal(err)
+			}
+		}()
+	}
+
+	err = service.Run()
+	if err != nil {
+		common.Fatal(err)
+		return
+	}
+}
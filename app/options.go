@@ -0,0 +1,35 @@
+package app
+
+import (
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/domain/service"
+	"gorm.io/gorm"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Options 路由仓库和服务的构造函数，集成测试可以替换为 mocks 包中的实现
+type Options struct {
+	// NewRouteRepository 只用于 MySQL 存储，kubernetes 和 redis 存储使用各自的仓库
+	NewRouteRepository  func(db *gorm.DB) repository.IRouteRepository
+	NewRouteDataService func(routeRepository repository.IRouteRepository, annotationTemplateRepository repository.IAnnotationTemplateRepository, quotaRepository repository.IQuotaRepository, clientSet kubernetes.Interface, dynamicClient dynamic.Interface, config *service.RouteConfig, locker service.RouteLocker) service.IRouteDataService
+}
+
+// DefaultOptions 使用 MySQL 仓库和默认的路由服务
+func DefaultOptions() Options {
+	return Options{
+		NewRouteRepository:  repository.NewRouteRepository,
+		NewRouteDataService: service.NewRouteDataService,
+	}
+}
+
+func (o Options) withDefaults() Options {
+	defaults := DefaultOptions()
+	if o.NewRouteRepository == nil {
+		o.NewRouteRepository = defaults.NewRouteRepository
+	}
+	if o.NewRouteDataService == nil {
+		o.NewRouteDataService = defaults.NewRouteDataService
+	}
+	return o
+}
@@ -0,0 +1,183 @@
+package client
+
+import (
+	"github.com/zxnlx/route/proto/route"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"strings"
+	"time"
+)
+
+// RouteBuilder 链式构造 RouteInfo，每一步立即校验，记录第一个错误并在 Build 时返回
+//
+//	info, err := client.NewRoute("web").Namespace("prod").Host("a.com").PathPrefix("/api", "api", 8080).TLS("letsencrypt").Build()
+type RouteBuilder struct {
+	info *route.RouteInfo
+	err  error
+}
+
+// NewRoute 指定路由名称开始构造
+func NewRoute(name string) *RouteBuilder {
+	b := &RouteBuilder{info: &route.RouteInfo{RouteName: name}}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		b.fail("路由名称 " + name + " 不合法：" + strings.Join(errs, "；"))
+	}
+	return b
+}
+
+func (b *RouteBuilder) fail(detail string) *RouteBuilder {
+	if b.err == nil {
+		b.err = invalid(detail)
+	}
+	return b
+}
+
+func (b *RouteBuilder) Namespace(namespace string) *RouteBuilder {
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return b.fail("命名空间 " + namespace + " 不合法：" + strings.Join(errs, "；"))
+	}
+	b.info.RouteNamespace = namespace
+	return b
+}
+
+// Host 支持 *.a.com 形式的通配符域名
+func (b *RouteBuilder) Host(host string) *RouteBuilder {
+	if errs := validation.IsDNS1123Subdomain(strings.TrimPrefix(host, "*.")); len(errs) > 0 {
+		return b.fail("域名 " + host + " 不合法：" + strings.Join(errs, "；"))
+	}
+	b.info.RouteHost = host
+	return b
+}
+
+// PathPrefix 添加前缀匹配的路径
+func (b *RouteBuilder) PathPrefix(path string, service string, port int32) *RouteBuilder {
+	return b.Path(&route.RoutePath{RoutePathName: path, RouteBackendService: service, RouteBackendServicePort: port})
+}
+
+// Path 添加完整的路径配置，用于请求头匹配、镜像、健康检查等
+func (b *RouteBuilder) Path(path *route.RoutePath) *RouteBuilder {
+	if err := ValidatePath(path); err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	for _, v := range b.info.RoutePath {
+		if v.RoutePathName == path.RoutePathName && len(v.RouteHeaderMatch) == 0 && len(path.RouteHeaderMatch) == 0 && len(v.RouteMethod) == 0 && len(path.RouteMethod) == 0 {
+			return b.fail("路径 " + path.RoutePathName + " 重复")
+		}
+	}
+	b.info.RoutePath = append(b.info.RoutePath, path)
+	return b
+}
+
+// Class Ingress class，默认 nginx
+func (b *RouteBuilder) Class(class string) *RouteBuilder {
+	b.info.RouteClass = class
+	return b
+}
+
+// TLS 使用 cert-manager 的 ClusterIssuer 签发证书并开启 https
+func (b *RouteBuilder) TLS(issuer string) *RouteBuilder {
+	if issuer == "" {
+		return b.fail("证书签发者不能为空")
+	}
+	b.info.RouteTlsIssuer = issuer
+	return b
+}
+
+func (b *RouteBuilder) Annotation(key string, value string) *RouteBuilder {
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return b.fail("注解 " + key + " 不合法：" + strings.Join(errs, "；"))
+	}
+	if b.info.RouteAnnotations == nil {
+		b.info.RouteAnnotations = map[string]string{}
+	}
+	b.info.RouteAnnotations[key] = value
+	return b
+}
+
+// GatewayAPI 使用 HTTPRoute 实现，gateway 格式为 namespace/name
+func (b *RouteBuilder) GatewayAPI(gateway string) *RouteBuilder {
+	if gateway == "" {
+		return b.fail("Gateway 不能为空")
+	}
+	b.info.RouteAdapter = "gateway-api"
+	b.info.RouteGateway = gateway
+	return b
+}
+
+func (b *RouteBuilder) ResponseHeader(name string, value string) *RouteBuilder {
+	if errs := validation.IsHTTPHeaderName(name); len(errs) > 0 {
+		return b.fail("响应头 " + name + " 不合法：" + strings.Join(errs, "；"))
+	}
+	if b.info.RouteResponseHeaderSet == nil {
+		b.info.RouteResponseHeaderSet = map[string]string{}
+	}
+	b.info.RouteResponseHeaderSet[name] = value
+	return b
+}
+
+func (b *RouteBuilder) RequestHeader(name string, value string) *RouteBuilder {
+	if errs := validation.IsHTTPHeaderName(name); len(errs) > 0 {
+		return b.fail("请求头 " + name + " 不合法：" + strings.Join(errs, "；"))
+	}
+	if b.info.RouteRequestHeaderSet == nil {
+		b.info.RouteRequestHeaderSet = map[string]string{}
+	}
+	b.info.RouteRequestHeaderSet[name] = value
+	return b
+}
+
+// Owner 负责团队和联系方式，团队会写入标签
+func (b *RouteBuilder) Owner(team string, contact string) *RouteBuilder {
+	if errs := validation.IsValidLabelValue(team); len(errs) > 0 {
+		return b.fail("负责团队 " + team + " 不合法：" + strings.Join(errs, "；"))
+	}
+	b.info.RouteOwnerTeam = team
+	b.info.RouteContact = contact
+	return b
+}
+
+func (b *RouteBuilder) CostCenter(costCenter string) *RouteBuilder {
+	if errs := validation.IsValidLabelValue(costCenter); len(errs) > 0 {
+		return b.fail("成本中心 " + costCenter + " 不合法：" + strings.Join(errs, "；"))
+	}
+	b.info.RouteCostCenter = costCenter
+	return b
+}
+
+func (b *RouteBuilder) Application(id int64) *RouteBuilder {
+	b.info.RouteApplicationId = id
+	return b
+}
+
+// WaitForReady 创建、更新后等待就绪再返回
+func (b *RouteBuilder) WaitForReady(timeout time.Duration) *RouteBuilder {
+	b.info.RouteWaitForReady = true
+	b.info.RouteWaitTimeout = int64(timeout / time.Second)
+	return b
+}
+
+// FieldManager 服务端应用使用的 field manager，force 为 true 时冲突字段强制接管
+func (b *RouteBuilder) FieldManager(name string, force bool) *RouteBuilder {
+	if name == "" || len(name) > 128 {
+		return b.fail("field manager 长度需要在 1 到 128 之间")
+	}
+	b.info.RouteFieldManager = name
+	b.info.RouteApplyConflicts = "fail"
+	if force {
+		b.info.RouteApplyConflicts = "force"
+	}
+	return b
+}
+
+// Build 返回构造结果，整体再做一次 Validate
+func (b *RouteBuilder) Build() (*route.RouteInfo, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if err := Validate(b.info); err != nil {
+		return nil, err
+	}
+	return b.info, nil
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	microclient "github.com/asim/go-micro/v3/client"
+	"github.com/zxnlx/route/proto/route"
+	"time"
+)
+
+// ServiceName 路由服务注册的服务名
+const ServiceName = "go.micro.service.route"
+
+// Client 对生成的 RouteService 做了一层封装：调用前校验、错误转换为 *Error、等待和遍历工具
+type Client struct {
+	service route.RouteService
+}
+
+// New 使用 go-micro client 创建，一般传入 service.Client()
+func New(c microclient.Client) *Client {
+	return &Client{service: route.NewRouteService(ServiceName, c)}
+}
+
+// NewFromService 使用已有的 RouteService，便于测试时替换
+func NewFromService(service route.RouteService) *Client {
+	return &Client{service: service}
+}
+
+// Service 未封装的接口直接使用生成的客户端
+func (c *Client) Service() route.RouteService {
+	return c.service
+}
+
+// AddRoute 校验后创建路由，返回路由ID
+func (c *Client) AddRoute(ctx context.Context, info *route.RouteInfo, opts ...microclient.CallOption) (int64, error) {
+	if err := Validate(info); err != nil {
+		return 0, err
+	}
+	rsp, err := c.service.AddRoute(ctx, info, opts...)
+	if err != nil {
+		return 0, wrapError(err)
+	}
+	return rsp.Id, nil
+}
+
+// UpdateRoute 校验后更新路由，规格没有变化时返回 false
+func (c *Client) UpdateRoute(ctx context.Context, info *route.RouteInfo, opts ...microclient.CallOption) (bool, error) {
+	if err := Validate(info); err != nil {
+		return false, err
+	}
+	rsp, err := c.service.UpdateRoute(ctx, info, opts...)
+	if err != nil {
+		return false, wrapError(err)
+	}
+	return !rsp.Unchanged, nil
+}
+
+// DeleteRoute 按删除策略删除路由，policy 为空时使用默认策略
+func (c *Client) DeleteRoute(ctx context.Context, id int64, policy string, opts ...microclient.CallOption) error {
+	_, err := c.service.DeleteRoute(ctx, &route.RouteId{Id: id, DeletePolicy: policy}, opts...)
+	return wrapError(err)
+}
+
+// FindRoute 根据ID查询路由
+func (c *Client) FindRoute(ctx context.Context, id int64, opts ...microclient.CallOption) (*route.RouteInfo, error) {
+	info, err := c.service.FindRouteByID(ctx, &route.RouteId{Id: id}, opts...)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return info, nil
+}
+
+// LintRoute 检查路由规格，返回警告，不写入
+func (c *Client) LintRoute(ctx context.Context, info *route.RouteInfo, opts ...microclient.CallOption) ([]*route.LintWarning, error) {
+	rsp, err := c.service.LintRoute(ctx, info, opts...)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return rsp.Warnings, nil
+}
+
+// ListEvents 查询路由事件，按时间倒序
+func (c *Client) ListEvents(ctx context.Context, req *route.ListEventsRequest, opts ...microclient.CallOption) ([]*route.EventInfo, error) {
+	rsp, err := c.service.ListEvents(ctx, req, opts...)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return rsp.EventInfo, nil
+}
+
+// ListIngressClasses 查询集群中的 IngressClass 名称
+func (c *Client) ListIngressClasses(ctx context.Context, opts ...microclient.CallOption) ([]string, error) {
+	rsp, err := c.service.GetClusterCapabilities(ctx, &route.ClusterRequest{}, opts...)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return rsp.IngressClasses, nil
+}
+
+// DiffRoute 对比期望规格和线上资源
+func (c *Client) DiffRoute(ctx context.Context, id int64, opts ...microclient.CallOption) (*route.RouteDiff, error) {
+	diff, err := c.service.DiffRoute(ctx, &route.RouteId{Id: id}, opts...)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return diff, nil
+}
+
+// WaitForApply 轮询路由的写入状态，直到 since 之后有一次写入结果，写入失败时返回错误
+func (c *Client) WaitForApply(ctx context.Context, id int64, since time.Time, interval time.Duration) (*route.RouteInfo, error) {
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		info, err := c.FindRoute(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if info.RouteLastApplyTime >= since.Unix() {
+			if info.RouteLastError != "" {
+				return info, &Error{Code: 500, Detail: info.RouteLastError, kind: ErrApplyFailed}
+			}
+			return info, nil
+		}
+		select {
+		case <-ctx.Done():
+			return info, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
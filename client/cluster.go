@@ -0,0 +1,39 @@
+package client
+
+import (
+	microclient "github.com/asim/go-micro/v3/client"
+	"github.com/asim/go-micro/v3/registry"
+	"github.com/asim/go-micro/v3/selector"
+)
+
+// ClusterMetadata 服务注册时在节点元数据中写入所在集群的名称
+const ClusterMetadata = "cluster"
+
+// WithCluster 每个集群部署一套服务时，只调用指定集群的实例
+// 所有实例都没有集群名称时不过滤，兼容只部署一套的情况
+func WithCluster(cluster string) microclient.CallOption {
+	return microclient.WithSelectOption(selector.WithFilter(func(old []*registry.Service) []*registry.Service {
+		services := []*registry.Service{}
+		labeled := false
+		for _, service := range old {
+			nodes := []*registry.Node{}
+			for _, node := range service.Nodes {
+				if node.Metadata[ClusterMetadata] != "" {
+					labeled = true
+				}
+				if node.Metadata[ClusterMetadata] == cluster {
+					nodes = append(nodes, node)
+				}
+			}
+			if len(nodes) > 0 {
+				copied := *service
+				copied.Nodes = nodes
+				services = append(services, &copied)
+			}
+		}
+		if !labeled {
+			return old
+		}
+		return services
+	}))
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"errors"
+	microerrors "github.com/asim/go-micro/v3/errors"
+	"strings"
+)
+
+// 错误类型，使用 errors.Is 判断
+var (
+	ErrInvalid     = errors.New("route: invalid request")
+	ErrNotFound    = errors.New("route: not found")
+	ErrConflict    = errors.New("route: already exists")
+	ErrFrozen      = errors.New("route: change freeze")
+	ErrRateLimited = errors.New("route: rate limited")
+	ErrApplyFailed = errors.New("route: apply failed")
+	// ErrPermissionDenied 服务的 service account 缺少操作 k8s 资源的权限
+	ErrPermissionDenied = errors.New("route: permission denied")
+	ErrUnknown          = errors.New("route: unknown error")
+)
+
+// Error 服务端或本地校验返回的错误
+type Error struct {
+	Code   int32
+	Detail string
+	kind   error
+}
+
+func (e *Error) Error() string {
+	return e.Detail
+}
+
+// Unwrap 支持 errors.Is(err, client.ErrNotFound)
+func (e *Error) Unwrap() error {
+	return e.kind
+}
+
+// 服务端大部分错误没有设置错误码，按错误码和错误信息归类
+func wrapError(err error) error {
+	if err == nil {
+		return nil
+	}
+	e := &Error{Code: 500, Detail: err.Error(), kind: ErrUnknown}
+	if me := microerrors.FromError(err); me != nil {
+		if me.Code != 0 {
+			e.Code = me.Code
+		}
+		if me.Detail != "" {
+			e.Detail = me.Detail
+		}
+	}
+	switch {
+	case e.Code == 429:
+		e.kind = ErrRateLimited
+	case e.Code == 404 || strings.Contains(e.Detail, "record not found"):
+		e.kind = ErrNotFound
+	case e.Code == 409 || strings.Contains(e.Detail, "已经存在") || strings.Contains(e.Detail, "already exists"):
+		e.kind = ErrConflict
+	case strings.Contains(e.Detail, "变更冻结窗口"):
+		e.kind = ErrFrozen
+	case e.Code == 403 || strings.HasPrefix(e.Detail, "权限不足"):
+		e.kind = ErrPermissionDenied
+	case e.Code == 400:
+		e.kind = ErrInvalid
+	}
+	return e
+}
+
+func invalid(detail string) error {
+	return &Error{Code: 400, Detail: detail, kind: ErrInvalid}
+}
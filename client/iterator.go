@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"github.com/zxnlx/route/proto/route"
+)
+
+// RouteIterator 遍历路由
+//
+//	it := c.Routes(ctx)
+//	for it.Next() {
+//		info := it.Route()
+//	}
+//	if err := it.Err(); err != nil {}
+type RouteIterator struct {
+	ctx     context.Context
+	client  *Client
+	routes  []*route.RouteInfo
+	index   int
+	fetched bool
+	token   string
+	err     error
+}
+
+// 每次请求的条数
+const routePageSize = 500
+
+// Routes 遍历所有路由，按游标分页请求，第一次调用 Next 时才发起请求
+func (c *Client) Routes(ctx context.Context) *RouteIterator {
+	return &RouteIterator{ctx: ctx, client: c, index: -1}
+}
+
+// Next 移动到下一个路由，没有更多或出错时返回 false
+func (it *RouteIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	for it.index >= len(it.routes) {
+		//已经是最后一页
+		if it.fetched && it.token == "" {
+			return false
+		}
+		rsp, err := it.client.service.FindAllRoute(it.ctx, &route.FindAll{PageSize: routePageSize, PageToken: it.token})
+		if err != nil {
+			it.err = wrapError(err)
+			return false
+		}
+		it.fetched = true
+		it.token = rsp.NextPageToken
+		it.routes = rsp.RouteInfo
+		it.index = 0
+	}
+	return true
+}
+
+// Route 当前路由
+func (it *RouteIterator) Route() *route.RouteInfo {
+	if it.index < 0 || it.index >= len(it.routes) {
+		return nil
+	}
+	return it.routes[it.index]
+}
+
+// Err 遍历过程中的错误
+func (it *RouteIterator) Err() error {
+	return it.err
+}
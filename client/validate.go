@@ -0,0 +1,78 @@
+package client
+
+import (
+	"github.com/zxnlx/route/proto/route"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"strconv"
+	"strings"
+)
+
+// Validate 调用前的本地校验，只检查 k8s 一定会拒绝的格式问题，业务规则仍由服务端校验
+func Validate(info *route.RouteInfo) error {
+	if info == nil {
+		return invalid("路由不能为空")
+	}
+	if err := ValidateName(info.RouteName); err != nil {
+		return err
+	}
+	if err := ValidateNamespace(info.RouteNamespace); err != nil {
+		return err
+	}
+	if info.RouteHost != "" {
+		if err := ValidateHost(info.RouteHost); err != nil {
+			return err
+		}
+	}
+	if len(info.RoutePath) == 0 {
+		return invalid("路由 " + info.RouteName + " 至少需要一个路径")
+	}
+	for _, v := range info.RoutePath {
+		if err := ValidatePath(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateName 路由名称需要符合 DNS-1123 子域名
+func ValidateName(name string) error {
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return invalid("路由名称 " + name + " 不合法：" + strings.Join(errs, "；"))
+	}
+	return nil
+}
+
+// ValidateNamespace 命名空间需要符合 DNS-1123 标签
+func ValidateNamespace(namespace string) error {
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return invalid("命名空间 " + namespace + " 不合法：" + strings.Join(errs, "；"))
+	}
+	return nil
+}
+
+// ValidateHost 域名支持 *. 开头的通配符
+func ValidateHost(host string) error {
+	if errs := validation.IsDNS1123Subdomain(strings.TrimPrefix(host, "*.")); len(errs) > 0 {
+		return invalid("域名 " + host + " 不合法：" + strings.Join(errs, "；"))
+	}
+	return nil
+}
+
+// ValidatePath 校验路径和后端
+func ValidatePath(path *route.RoutePath) error {
+	if !strings.HasPrefix(path.RoutePathName, "/") {
+		return invalid("路径 " + path.RoutePathName + " 必须以 / 开头")
+	}
+	if errs := validation.IsDNS1035Label(path.RouteBackendService); len(errs) > 0 {
+		return invalid("路径 " + path.RoutePathName + " 的后端服务 " + path.RouteBackendService + " 不合法：" + strings.Join(errs, "；"))
+	}
+	if errs := validation.IsValidPortNum(int(path.RouteBackendServicePort)); len(errs) > 0 {
+		return invalid("路径 " + path.RoutePathName + " 的后端端口 " + strconv.Itoa(int(path.RouteBackendServicePort)) + " 不合法")
+	}
+	if path.RouteMirrorService != "" {
+		if errs := validation.IsValidPortNum(int(path.RouteMirrorServicePort)); len(errs) > 0 {
+			return invalid("路径 " + path.RoutePathName + " 的镜像端口 " + strconv.Itoa(int(path.RouteMirrorServicePort)) + " 不合法")
+		}
+	}
+	return nil
+}
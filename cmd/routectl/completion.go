@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/urfave/cli/v2"
+	"os"
+	"sort"
+	"strings"
+)
+
+const bashCompletion = `_routectl_complete() {
+  local cur words
+  COMPREPLY=()
+  cur="${COMP_WORDS[COMP_CWORD]}"
+  words=("${COMP_WORDS[@]:0:$COMP_CWORD}")
+  if [[ "$cur" == "-"* ]]; then
+    opts=$("${words[@]}" "$cur" --generate-bash-completion 2>/dev/null)
+  else
+    opts=$("${words[@]}" --generate-bash-completion 2>/dev/null)
+  fi
+  COMPREPLY=($(compgen -W "${opts}" -- "${cur}"))
+}
+complete -o bashdefault -o default -F _routectl_complete routectl
+`
+
+const zshCompletion = `#compdef routectl
+
+_routectl() {
+  local -a opts
+  local cur
+  cur=${words[-1]}
+  if [[ "$cur" == "-"* ]]; then
+    opts=("${(@f)$(${words[@]:0:#words[@]-1} ${cur} --generate-bash-completion 2>/dev/null)}")
+  else
+    opts=("${(@f)$(${words[@]:0:#words[@]-1} --generate-bash-completion 2>/dev/null)}")
+  fi
+  if [[ "${opts[1]}" != "" ]]; then
+    _describe 'values' opts
+  else
+    _files
+  fi
+}
+
+compdef _routectl routectl
+`
+
+func completionCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "completion",
+		Usage:     "输出补全脚本，如 source <(routectl completion bash)",
+		ArgsUsage: "bash|zsh",
+		Action: func(c *cli.Context) error {
+			switch c.Args().First() {
+			case "bash":
+				fmt.Print(bashCompletion)
+			case "zsh":
+				fmt.Print(zshCompletion)
+			default:
+				return errors.New("只支持 bash 和 zsh")
+			}
+			return nil
+		},
+	}
+}
+
+// 补全 --namespace 的值和路由名称，路由列表从服务查询，出错时不输出
+func completeRoutes(c *cli.Context) {
+	previous := ""
+	//最后一个参数是 --generate-bash-completion
+	if len(os.Args) >= 3 {
+		previous = os.Args[len(os.Args)-2]
+	}
+	isNamespace := previous == "-n" || previous == "--namespace"
+	if strings.HasPrefix(previous, "-") && !isNamespace {
+		cli.DefaultCompleteWithFlags(c.Command)(c)
+		return
+	}
+	routeClient, ctx := newClient(c)
+	namespaces := map[string]bool{}
+	names := map[string]bool{}
+	it := routeClient.Routes(ctx)
+	for it.Next() {
+		info := it.Route()
+		namespaces[info.RouteNamespace] = true
+		if namespace := c.String("namespace"); namespace == "" || namespace == info.RouteNamespace {
+			names[info.RouteName] = true
+		}
+	}
+	if it.Err() != nil {
+		return
+	}
+	candidates := names
+	if isNamespace {
+		candidates = namespaces
+	}
+	list := make([]string, 0, len(candidates))
+	for k := range candidates {
+		list = append(list, k)
+	}
+	sort.Strings(list)
+	for _, v := range list {
+		fmt.Fprintln(c.App.Writer, v)
+	}
+}
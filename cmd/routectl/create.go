@@ -0,0 +1,65 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/urfave/cli/v2"
+	"github.com/zxnlx/route/proto/route"
+	"io/ioutil"
+	"os"
+	"sigs.k8s.io/yaml"
+	"time"
+)
+
+func createCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "create",
+		Usage: "创建路由，从文件读取或按提示逐项填写",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "file", Aliases: []string{"f"}, Usage: "路由规格 YAML，字段名和 proto 一致"},
+			&cli.BoolFlag{Name: "interactive", Aliases: []string{"i"}, Usage: "交互式填写"},
+			&cli.BoolFlag{Name: "wait", Usage: "等待写入 k8s 完成"},
+		},
+		Action: func(c *cli.Context) error {
+			routeClient, ctx := newClient(c)
+			var info *route.RouteInfo
+			switch {
+			case c.Bool("interactive"):
+				w := newWizard(os.Stdin, os.Stdout, routeClient)
+				var err error
+				if info, err = w.run(ctx); err != nil {
+					return err
+				}
+				if info == nil {
+					fmt.Println("已取消")
+					return nil
+				}
+			case c.String("file") != "":
+				data, err := ioutil.ReadFile(c.String("file"))
+				if err != nil {
+					return err
+				}
+				info = &route.RouteInfo{}
+				if err := yaml.Unmarshal(data, info); err != nil {
+					return errors.New("解析 " + c.String("file") + " 失败：" + err.Error())
+				}
+			default:
+				return errors.New("需要指定 --file 或 --interactive")
+			}
+			since := time.Now()
+			id, err := routeClient.AddRoute(ctx, info)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("路由 %s/%s 已创建，ID 为 %d\n", info.RouteNamespace, info.RouteName, id)
+			if !c.Bool("wait") {
+				return nil
+			}
+			if _, err := routeClient.WaitForApply(ctx, id, since, 0); err != nil {
+				return err
+			}
+			fmt.Println("已写入 k8s")
+			return nil
+		},
+	}
+}
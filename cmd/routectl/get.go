@@ -0,0 +1,77 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/urfave/cli/v2"
+	"github.com/zxnlx/route/proto/route"
+	"os"
+	"sigs.k8s.io/yaml"
+	"sort"
+	"text/tabwriter"
+)
+
+func getCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "get",
+		Usage:     "查看路由，不指定名称时列出命名空间下的所有路由",
+		ArgsUsage: "[名称]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "namespace", Aliases: []string{"n"}, Usage: "命名空间，为空时列出所有命名空间"},
+		},
+		BashComplete: completeRoutes,
+		Action: func(c *cli.Context) error {
+			routeClient, ctx := newClient(c)
+			namespace := c.String("namespace")
+			name := c.Args().First()
+			if name != "" && namespace == "" {
+				return errors.New("查看单个路由需要指定 --namespace")
+			}
+			routes := []*route.RouteInfo{}
+			it := routeClient.Routes(ctx)
+			for it.Next() {
+				info := it.Route()
+				if namespace != "" && info.RouteNamespace != namespace || name != "" && info.RouteName != name {
+					continue
+				}
+				routes = append(routes, info)
+			}
+			if err := it.Err(); err != nil {
+				return err
+			}
+			if name != "" {
+				if len(routes) == 0 {
+					return errors.New("路由 " + namespace + "/" + name + " 不存在")
+				}
+				data, err := yaml.Marshal(routes[0])
+				if err != nil {
+					return err
+				}
+				_, err = os.Stdout.Write(data)
+				return err
+			}
+			sort.Slice(routes, func(i, j int) bool {
+				if routes[i].RouteNamespace != routes[j].RouteNamespace {
+					return routes[i].RouteNamespace < routes[j].RouteNamespace
+				}
+				return routes[i].RouteName < routes[j].RouteName
+			})
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "NAMESPACE\tNAME\tHOST\tCLASS\tREVISION\tSTATUS")
+			for _, v := range routes {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n", v.RouteNamespace, v.RouteName, v.RouteHost, v.RouteClass, v.RouteRevision, routeStatus(v))
+			}
+			return w.Flush()
+		},
+	}
+}
+
+func routeStatus(info *route.RouteInfo) string {
+	switch {
+	case info.RouteDisabled:
+		return "Disabled"
+	case info.RouteConsecutiveFailures > 0:
+		return fmt.Sprintf("Failing(%d)", info.RouteConsecutiveFailures)
+	}
+	return "Ready"
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	microclient "github.com/asim/go-micro/v3/client"
+	"github.com/urfave/cli/v2"
+	"github.com/zxnlx/route/client"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// 目标集群：--cluster 优先，其次是 kubeconfig 当前 context 对应的集群，没有 kubeconfig 时不限制
+func targetCluster(c *cli.Context) string {
+	if cluster := c.String("cluster"); cluster != "" {
+		return cluster
+	}
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = c.String("kubeconfig")
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: c.String("context")}
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides).RawConfig()
+	if err != nil {
+		return ""
+	}
+	name := config.CurrentContext
+	if overrides.CurrentContext != "" {
+		name = overrides.CurrentContext
+	}
+	if kubeContext, ok := config.Contexts[name]; ok {
+		return kubeContext.Cluster
+	}
+	return ""
+}
+
+// clusterClient 每次调用都只选择目标集群的服务实例
+type clusterClient struct {
+	microclient.Client
+	cluster string
+}
+
+func (c *clusterClient) Call(ctx context.Context, req microclient.Request, rsp interface{}, opts ...microclient.CallOption) error {
+	return c.Client.Call(ctx, req, rsp, append(opts, client.WithCluster(c.cluster))...)
+}
+
+func withCluster(cluster string) microclient.Wrapper {
+	return func(c microclient.Client) microclient.Client {
+		return &clusterClient{Client: c, cluster: cluster}
+	}
+}
@@ -0,0 +1,73 @@
+// routectl 路由服务的命令行工具
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/asim/go-micro/plugins/registry/consul/v3"
+	microclient "github.com/asim/go-micro/v3/client"
+	"github.com/asim/go-micro/v3/metadata"
+	"github.com/asim/go-micro/v3/registry"
+	"github.com/urfave/cli/v2"
+	"github.com/zxnlx/route/client"
+	"github.com/zxnlx/route/rpccodec"
+	"os"
+	"time"
+)
+
+func main() {
+	app := &cli.App{
+		Name:  "routectl",
+		Usage: "管理路由",
+		// 补全脚本通过 --generate-bash-completion 调用
+		EnableBashCompletion: true,
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "registry", Usage: "consul 地址", Value: "127.0.0.1:8500", EnvVars: []string{"ROUTECTL_REGISTRY"}},
+			&cli.StringFlag{Name: "api-key", Usage: "API 密钥，按密钥的角色和范围鉴权", EnvVars: []string{"ROUTECTL_API_KEY"}},
+			&cli.DurationFlag{Name: "timeout", Usage: "单次调用的超时时间", Value: 30 * time.Second},
+			&cli.StringFlag{Name: "kubeconfig", Usage: "kubeconfig 路径，默认使用 KUBECONFIG 或 ~/.kube/config"},
+			&cli.StringFlag{Name: "context", Usage: "kubeconfig 中的 context，默认使用当前 context"},
+			&cli.StringFlag{Name: "cluster", Usage: "目标集群，和服务配置的 cluster_name 对应，默认使用 context 的集群"},
+			&cli.BoolFlag{Name: "gzip", Usage: "压缩请求和响应，路由较多时减少传输量"},
+			&cli.StringFlag{Name: "lang", Usage: "错误消息的语言，zh 或 en，默认使用服务配置的语言", EnvVars: []string{"ROUTECTL_LANG"}},
+		},
+		Commands: []*cli.Command{
+			getCommand(),
+			createCommand(),
+			watchCommand(),
+			topCommand(),
+			completionCommand(),
+		},
+	}
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, "错误：", err)
+		os.Exit(1)
+	}
+}
+
+// 通过 consul 发现路由服务，按 kubeconfig 选择集群，带上 API 密钥
+func newClient(c *cli.Context) (*client.Client, context.Context) {
+	opts := []microclient.Option{
+		microclient.Registry(consul.NewRegistry(registry.Addrs(c.String("registry")))),
+		microclient.RequestTimeout(c.Duration("timeout")),
+	}
+	if cluster := targetCluster(c); cluster != "" {
+		opts = append(opts, microclient.Wrap(withCluster(cluster)))
+	}
+	if c.Bool("gzip") {
+		opts = append(opts, rpccodec.ClientOptions(0)...)
+	}
+	micro := microclient.NewClient(opts...)
+	ctx := c.Context
+	md := metadata.Metadata{}
+	if key := c.String("api-key"); key != "" {
+		md["X-Api-Key"] = key
+	}
+	if lang := c.String("lang"); lang != "" {
+		md["Accept-Language"] = lang
+	}
+	if len(md) > 0 {
+		ctx = metadata.NewContext(ctx, md)
+	}
+	return client.New(micro), ctx
+}
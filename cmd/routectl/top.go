@@ -0,0 +1,130 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/prometheus/common/expfmt"
+	"github.com/urfave/cli/v2"
+	"github.com/zxnlx/route/metrics"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+)
+
+// 每个路由写入 k8s 的失败次数
+const applyFailuresMetric = metrics.Namespace + "_apply_failures_total"
+
+type topRow struct {
+	namespace string
+	class     string
+	routes    int
+	disabled  int
+	failing   int
+	failures  float64
+}
+
+func topCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "top",
+		Usage: "按命名空间和 Ingress class 汇总路由数量和写入失败次数",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "metrics-url", Usage: "网关的指标地址，如 http://route-gateway:8080/metrics，为空时不显示累计失败次数", EnvVars: []string{"ROUTECTL_METRICS_URL"}},
+		},
+		Action: func(c *cli.Context) error {
+			routeClient, ctx := newClient(c)
+			var failures map[string]float64
+			if url := c.String("metrics-url"); url != "" {
+				var err error
+				if failures, err = scrapeApplyFailures(url); err != nil {
+					return err
+				}
+			}
+			rows := map[string]*topRow{}
+			it := routeClient.Routes(ctx)
+			for it.Next() {
+				info := it.Route()
+				class := info.RouteClass
+				if class == "" {
+					class = "<default>"
+				}
+				key := info.RouteNamespace + "\x00" + class
+				row, ok := rows[key]
+				if !ok {
+					row = &topRow{namespace: info.RouteNamespace, class: class}
+					rows[key] = row
+				}
+				row.routes++
+				if info.RouteDisabled {
+					row.disabled++
+				}
+				if info.RouteConsecutiveFailures > 0 {
+					row.failing++
+				}
+				row.failures += failures[info.RouteNamespace+"/"+info.RouteName]
+			}
+			if err := it.Err(); err != nil {
+				return err
+			}
+			list := make([]*topRow, 0, len(rows))
+			for _, v := range rows {
+				list = append(list, v)
+			}
+			//失败多的排在前面
+			sort.Slice(list, func(i, j int) bool {
+				if list[i].failing != list[j].failing {
+					return list[i].failing > list[j].failing
+				}
+				if list[i].namespace != list[j].namespace {
+					return list[i].namespace < list[j].namespace
+				}
+				return list[i].class < list[j].class
+			})
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "NAMESPACE\tCLASS\tROUTES\tDISABLED\tFAILING\tAPPLY FAILURES")
+			for _, v := range list {
+				total := "-"
+				if failures != nil {
+					total = strconv.FormatFloat(v.failures, 'f', 0, 64)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\n", v.namespace, v.class, v.routes, v.disabled, v.failing, total)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+// 读取写入失败次数，按 命名空间/名称 汇总；多个实例时只包含被访问到的实例
+func scrapeApplyFailures(url string) (map[string]float64, error) {
+	rsp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return nil, errors.New("读取指标失败：" + rsp.Status)
+	}
+	families, err := (&expfmt.TextParser{}).TextToMetricFamilies(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	failures := map[string]float64{}
+	family, ok := families[applyFailuresMetric]
+	if !ok {
+		return failures, nil
+	}
+	for _, m := range family.GetMetric() {
+		var namespace, name string
+		for _, l := range m.GetLabel() {
+			switch l.GetName() {
+			case "route_namespace":
+				namespace = l.GetValue()
+			case "route_name":
+				name = l.GetValue()
+			}
+		}
+		failures[namespace+"/"+name] += m.GetCounter().GetValue()
+	}
+	return failures, nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"github.com/urfave/cli/v2"
+	"github.com/zxnlx/route/proto/route"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+func watchCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "watch",
+		Usage: "持续输出路由事件",
+		Flags: []cli.Flag{
+			&cli.Int64Flag{Name: "route-id", Usage: "只看指定路由"},
+			&cli.StringFlag{Name: "type", Usage: "只看指定类型，如 apply_failed"},
+			&cli.DurationFlag{Name: "since", Usage: "先输出最近一段时间的事件，如 10m"},
+			&cli.DurationFlag{Name: "interval", Usage: "轮询间隔", Value: 2 * time.Second},
+		},
+		Action: func(c *cli.Context) error {
+			routeClient, ctx := newClient(c)
+			since := time.Now()
+			if d := c.Duration("since"); d > 0 {
+				since = since.Add(-d)
+			}
+			req := &route.ListEventsRequest{RouteId: c.Int64("route-id"), Type: c.String("type"), Since: since.Unix()}
+			names := map[int64]string{}
+			routeName := func(id int64) string {
+				if name, ok := names[id]; ok {
+					return name
+				}
+				//路由可能已删除，只查询一次
+				names[id] = strconv.FormatInt(id, 10)
+				if info, err := routeClient.FindRoute(ctx, id); err == nil {
+					names[id] = info.RouteNamespace + "/" + info.RouteName
+				}
+				return names[id]
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "TIME\tROUTE\tTYPE\tMESSAGE")
+			_ = w.Flush()
+			var lastID int64
+			ticker := time.NewTicker(c.Duration("interval"))
+			defer ticker.Stop()
+			for {
+				events, err := routeClient.ListEvents(ctx, req)
+				if err != nil {
+					return err
+				}
+				//按时间倒序返回，since 包含边界，按 ID 去重
+				sort.Slice(events, func(i, j int) bool { return events[i].Id < events[j].Id })
+				for _, v := range events {
+					if v.Id <= lastID {
+						continue
+					}
+					lastID = v.Id
+					if v.CreatedAt > req.Since {
+						req.Since = v.CreatedAt
+					}
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", time.Unix(v.CreatedAt, 0).Format("2006-01-02 15:04:05"), routeName(v.RouteId), v.EventType, v.EventMessage)
+				}
+				_ = w.Flush()
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+				}
+			}
+		},
+	}
+}
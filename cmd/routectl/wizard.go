@@ -0,0 +1,208 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/zxnlx/route/client"
+	"github.com/zxnlx/route/proto/route"
+	"io"
+	"sigs.k8s.io/yaml"
+	"strconv"
+	"strings"
+)
+
+// wizard 交互式创建路由，每一项输入后立即校验，不合法时重新输入
+type wizard struct {
+	in     *bufio.Reader
+	out    io.Writer
+	client *client.Client
+}
+
+func newWizard(in io.Reader, out io.Writer, routeClient *client.Client) *wizard {
+	return &wizard{in: bufio.NewReader(in), out: out, client: routeClient}
+}
+
+// 返回 nil 表示用户取消
+func (w *wizard) run(ctx context.Context) (*route.RouteInfo, error) {
+	info := &route.RouteInfo{}
+	var err error
+	if info.RouteNamespace, err = w.ask("命名空间", "", client.ValidateNamespace); err != nil {
+		return nil, err
+	}
+	if info.RouteHost, err = w.ask("域名", "", client.ValidateHost); err != nil {
+		return nil, err
+	}
+	if info.RouteName, err = w.ask("路由名称", defaultRouteName(info.RouteHost), client.ValidateName); err != nil {
+		return nil, err
+	}
+	if info.RoutePath, err = w.askPaths(); err != nil {
+		return nil, err
+	}
+	if info.RouteClass, err = w.askClass(ctx); err != nil {
+		return nil, err
+	}
+	if info.RouteTlsIssuer, err = w.ask("证书签发 ClusterIssuer，留空不启用 TLS", "", nil); err != nil {
+		return nil, err
+	}
+	if err := client.Validate(info); err != nil {
+		return nil, err
+	}
+	data, err := yaml.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintln(w.out, "\n---")
+	fmt.Fprint(w.out, string(data))
+	fmt.Fprintln(w.out, "---")
+	warnings, err := w.client.LintRoute(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range warnings {
+		fmt.Fprintf(w.out, "[%s] %s：%s\n", v.Severity, v.Field, v.Message)
+	}
+	ok, err := w.confirm("确认创建")
+	if err != nil || !ok {
+		return nil, err
+	}
+	return info, nil
+}
+
+// 读取一行，输入结束时返回 io.EOF
+func (w *wizard) readLine() (string, error) {
+	line, err := w.in.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// 提示输入，直接回车使用默认值
+func (w *wizard) ask(label string, def string, validate func(string) error) (string, error) {
+	for {
+		if def != "" {
+			fmt.Fprintf(w.out, "%s [%s]: ", label, def)
+		} else {
+			fmt.Fprintf(w.out, "%s: ", label)
+		}
+		v, err := w.readLine()
+		if err != nil {
+			return "", err
+		}
+		if v == "" {
+			v = def
+		}
+		if validate == nil {
+			return v, nil
+		}
+		if err := validate(v); err != nil {
+			fmt.Fprintln(w.out, "  "+err.Error())
+			continue
+		}
+		return v, nil
+	}
+}
+
+// 逐个输入路径，路径留空时结束，至少一个
+func (w *wizard) askPaths() ([]*route.RoutePath, error) {
+	paths := []*route.RoutePath{}
+	for {
+		def := ""
+		if len(paths) == 0 {
+			def = "/"
+		}
+		name, err := w.ask("路径"+strconv.Itoa(len(paths)+1)+"，留空结束", def, func(v string) error {
+			if v == "" && len(paths) > 0 {
+				return nil
+			}
+			if !strings.HasPrefix(v, "/") {
+				return errors.New("路径必须以 / 开头")
+			}
+			for _, p := range paths {
+				if p.RoutePathName == v {
+					return errors.New("路径 " + v + " 已添加")
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			return paths, nil
+		}
+		path := &route.RoutePath{RoutePathName: name}
+		if _, err := w.ask("  后端 Service", "", func(v string) error {
+			path.RouteBackendService = v
+			path.RouteBackendServicePort = 80
+			return client.ValidatePath(path)
+		}); err != nil {
+			return nil, err
+		}
+		if _, err := w.ask("  后端端口", "80", func(v string) error {
+			port, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				return errors.New("端口必须是数字")
+			}
+			path.RouteBackendServicePort = int32(port)
+			return client.ValidatePath(path)
+		}); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+}
+
+// 从集群查询可用的 IngressClass，按序号或名称选择，留空使用服务的默认 class
+func (w *wizard) askClass(ctx context.Context) (string, error) {
+	classes, err := w.client.ListIngressClasses(ctx)
+	if err != nil {
+		fmt.Fprintln(w.out, "查询 IngressClass 失败："+err.Error())
+	}
+	for i, v := range classes {
+		fmt.Fprintf(w.out, "  %d) %s\n", i+1, v)
+	}
+	v, err := w.ask("Ingress class，留空使用默认", "", func(v string) error {
+		if v == "" || len(classes) == 0 {
+			return nil
+		}
+		if n, err := strconv.Atoi(v); err == nil {
+			if n < 1 || n > len(classes) {
+				return errors.New("序号超出范围")
+			}
+			return nil
+		}
+		for _, c := range classes {
+			if c == v {
+				return nil
+			}
+		}
+		return errors.New("集群中没有 IngressClass " + v)
+	})
+	if err != nil {
+		return "", err
+	}
+	if n, err := strconv.Atoi(v); err == nil && n >= 1 && n <= len(classes) {
+		return classes[n-1], nil
+	}
+	return v, nil
+}
+
+func (w *wizard) confirm(label string) (bool, error) {
+	v, err := w.ask(label+"？[y/N]", "", nil)
+	if err != nil {
+		return false, err
+	}
+	return strings.EqualFold(v, "y") || strings.EqualFold(v, "yes"), nil
+}
+
+// 域名转换为默认的路由名称，如 api.example.com 转换为 api-example-com
+func defaultRouteName(host string) string {
+	name := strings.ReplaceAll(strings.TrimPrefix(host, "*."), ".", "-")
+	if client.ValidateName(name) != nil {
+		return ""
+	}
+	return name
+}
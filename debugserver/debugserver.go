@@ -0,0 +1,164 @@
+// Package debugserver 调试端口，提供 net/http/pprof 和运行时状态，线上卡住时不需要重新编译即可排查
+// 默认只监听本机，监听其他地址时必须配置令牌
+package debugserver
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/service"
+	"github.com/zxnlx/route/secrets"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// Config 调试端口配置，从配置中心的 route.debug 节点读取，默认关闭
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// Addr 监听地址，默认 127.0.0.1:6060，需要通过 kubectl port-forward 访问
+	Addr string `json:"addr"`
+	// Token 不为空时请求需要带上 Authorization: Bearer <token>，Addr 不是本机地址时必须配置
+	Token string `json:"token"`
+	// TokenRef 令牌在密钥后端中的引用，格式 path#field，优先于 token
+	TokenRef string `json:"token_ref"`
+}
+
+func (c Config) addr() string {
+	if c.Addr == "" {
+		return "127.0.0.1:6060"
+	}
+	return c.Addr
+}
+
+func (c Config) check() error {
+	if c.Token != "" || c.TokenRef != "" {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(c.addr())
+	if err != nil {
+		return err
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return errors.New("调试端口监听 " + c.addr() + " 时必须配置 token 或 token_ref")
+}
+
+// Server 调试端口
+type Server struct {
+	RouteDataService service.IRouteDataService
+	Config           Config
+	// Secrets 读取 TokenRef，未配置时为空
+	Secrets secrets.Provider
+	started time.Time
+}
+
+// NewServer 创建
+func NewServer(routeDataService service.IRouteDataService, config Config, provider secrets.Provider) *Server {
+	return &Server{RouteDataService: routeDataService, Config: config, Secrets: provider, started: time.Now()}
+}
+
+// Run 启动 HTTP 服务，阻塞直到出错
+func (s *Server) Run() error {
+	if err := s.Config.check(); err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("/debug/stats", s.stats)
+	common.Info("调试端口监听 " + s.Config.addr())
+	return http.ListenAndServe(s.Config.addr(), s.authenticate(mux))
+}
+
+// 配置了令牌时校验 Authorization 头，令牌轮换后按密钥后端的缓存周期生效
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := s.Config.Token
+		if s.Config.TokenRef != "" {
+			var err error
+			if token, err = secrets.Resolve(r.Context(), s.Secrets, s.Config.TokenRef); err != nil {
+				common.Error(err)
+				http.Error(w, "读取调试端口令牌失败", http.StatusInternalServerError)
+				return
+			}
+			//配置了令牌引用但读到空值时拒绝，不能退化为不校验
+			if token == "" {
+				common.Error("调试端口令牌 " + s.Config.TokenRef + " 为空")
+				http.Error(w, "调试端口令牌为空", http.StatusInternalServerError)
+				return
+			}
+		}
+		if token != "" {
+			got := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Stats 运行时和服务内部的状态
+type Stats struct {
+	GoVersion     string  `json:"go_version"`
+	UptimeSeconds float64 `json:"uptime_seconds"`
+	GOMAXPROCS    int     `json:"gomaxprocs"`
+	NumCPU        int     `json:"num_cpu"`
+	Goroutines    int     `json:"goroutines"`
+	// HeapAlloc 等内存字段的单位为字节
+	HeapAlloc    uint64              `json:"heap_alloc"`
+	HeapInuse    uint64              `json:"heap_inuse"`
+	HeapObjects  uint64              `json:"heap_objects"`
+	Sys          uint64              `json:"sys"`
+	NumGC        uint32              `json:"num_gc"`
+	LastGC       int64               `json:"last_gc"`
+	GCPauseTotal time.Duration       `json:"gc_pause_total"`
+	Route        *service.DebugStats `json:"route"`
+}
+
+// GET /debug/stats 协程、内存、写入队列和缓存的状态，协程栈使用 /debug/pprof/goroutine?debug=2
+func (s *Server) stats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	stats := &Stats{
+		GoVersion:     runtime.Version(),
+		UptimeSeconds: time.Since(s.started).Seconds(),
+		GOMAXPROCS:    runtime.GOMAXPROCS(0),
+		NumCPU:        runtime.NumCPU(),
+		Goroutines:    runtime.NumGoroutine(),
+		HeapAlloc:     mem.HeapAlloc,
+		HeapInuse:     mem.HeapInuse,
+		HeapObjects:   mem.HeapObjects,
+		Sys:           mem.Sys,
+		NumGC:         mem.NumGC,
+		GCPauseTotal:  time.Duration(mem.PauseTotalNs),
+		Route:         s.RouteDataService.DebugStats(),
+	}
+	if mem.LastGC > 0 {
+		stats.LastGC = time.Unix(0, int64(mem.LastGC)).Unix()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(stats); err != nil {
+		common.Error(err)
+	}
+}
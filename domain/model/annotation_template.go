@@ -0,0 +1,15 @@
+package model
+
+// AnnotationTemplate 管理员维护的注解模板，渲染 Ingress 时替换变量后加入注解，路由自身的注解优先
+type AnnotationTemplate struct {
+	ID           int64  `gorm:"primary_key;not_null;auto_increment"`
+	TemplateName string `json:"template_name"`
+	//生效的命名空间，支持通配符，为空时对所有命名空间生效
+	TemplateNamespaces []string `gorm:"serializer:json" json:"template_namespaces"`
+	//生效的 Ingress class，为空时不限制
+	TemplateClass string `json:"template_class"`
+	//注解的值支持 {{.RouteName}}、{{.Namespace}}、{{.Env}} 等变量
+	TemplateAnnotations map[string]string `gorm:"serializer:json" json:"template_annotations"`
+	//多个模板设置同一注解时优先级大的生效
+	TemplatePriority int64 `json:"template_priority"`
+}
@@ -0,0 +1,20 @@
+package model
+
+// APIKey 供无法使用 OIDC 的调用方（如 CI 流水线）使用，只保存密钥的哈希
+type APIKey struct {
+	ID      int64  `gorm:"primary_key;not_null;auto_increment"`
+	KeyName string `json:"key_name"`
+	//密钥前缀，用于查找和展示
+	KeyPrefix string `gorm:"uniqueIndex;size:32" json:"key_prefix"`
+	KeyHash   string `gorm:"size:64" json:"-"`
+	//支持通配符，为空时对所有命名空间生效
+	KeyNamespaces []string `gorm:"serializer:json" json:"key_namespaces"`
+	//read、write、admin
+	KeyVerbs []string `gorm:"serializer:json" json:"key_verbs"`
+	//过期时间（unix 秒），0 表示不过期
+	KeyExpiresAt  int64  `json:"key_expires_at"`
+	KeyRevokedAt  int64  `json:"key_revoked_at"`
+	KeyLastUsedAt int64  `json:"key_last_used_at"`
+	KeyCreatedBy  string `json:"key_created_by"`
+	KeyCreatedAt  int64  `gorm:"autoCreateTime" json:"key_created_at"`
+}
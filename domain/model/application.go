@@ -0,0 +1,9 @@
+package model
+
+// Application 应用，同一产品下的多个路由归为一组
+type Application struct {
+	ID                     int64  `gorm:"primary_key;not_null;auto_increment"`
+	ApplicationName        string `json:"application_name"`
+	ApplicationNamespace   string `json:"application_namespace"`
+	ApplicationDescription string `json:"application_description"`
+}
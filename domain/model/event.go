@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// Event 路由事件，记录应用成功/失败、漂移、清理等内部事件
+type Event struct {
+	ID           int64     `gorm:"primary_key;not_null;auto_increment"`
+	RouteID      int64     `gorm:"index" json:"route_id"`
+	EventType    string    `gorm:"index;size:64" json:"event_type"`
+	EventMessage string    `gorm:"type:text" json:"event_message"`
+	CreatedAt    time.Time `gorm:"index" json:"-"`
+}
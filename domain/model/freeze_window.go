@@ -0,0 +1,14 @@
+package model
+
+// FreezeWindow 变更冻结窗口，每周重复，如 Fri 18:00 到 Mon 08:00
+type FreezeWindow struct {
+	ID         int64  `gorm:"primary_key;not_null;auto_increment"`
+	FreezeName string `json:"freeze_name"`
+	//生效的命名空间，支持通配符，为空时对所有命名空间生效
+	FreezeNamespaces []string `gorm:"serializer:json" json:"freeze_namespaces"`
+	FreezeStart      string   `json:"freeze_start"`
+	FreezeEnd        string   `json:"freeze_end"`
+	//时区，为空时使用服务所在时区
+	FreezeTimezone string `json:"freeze_timezone"`
+	FreezeReason   string `json:"freeze_reason"`
+}
@@ -0,0 +1,12 @@
+package model
+
+// NamespaceDefault 命名空间下创建路由时继承的默认配置
+type NamespaceDefault struct {
+	ID                 int64             `gorm:"primary_key;not_null;auto_increment"`
+	Namespace          string            `gorm:"uniqueIndex;size:64;not null" json:"namespace"`
+	DefaultClass       string            `json:"default_class"`
+	DefaultAnnotations map[string]string `gorm:"serializer:json" json:"default_annotations"`
+	DefaultTlsIssuer   string            `json:"default_tls_issuer"`
+	// AllowedHostsPattern 允许使用的域名正则，需要匹配整个域名
+	AllowedHostsPattern string `json:"allowed_hosts_pattern"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// OutboxMessage 待发布的事件，与路由变更在同一事务中写入，由 relay 发布到通知渠道
+type OutboxMessage struct {
+	ID        int64  `gorm:"primary_key;not_null;auto_increment"`
+	EventType string `gorm:"size:64"`
+	//notify.Event 的 JSON
+	Payload  string `gorm:"type:text"`
+	Attempts int64
+	//下次发布时间，失败后按次数退避
+	NextAttemptAt time.Time `gorm:"index"`
+	//为空表示未发布
+	PublishedAt *time.Time `gorm:"index"`
+	LastError   string     `gorm:"type:text"`
+	//已经发送成功的通知渠道，重试时跳过
+	DeliveredChannels []string `gorm:"serializer:json;type:text"`
+	//超过最大发布次数后不再重试，保留记录供排查
+	DeadLetter bool `gorm:"index"`
+	CreatedAt  time.Time
+}
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// Quota 命名空间的路由配额，由管理员设置，为 0 的项不限制
+// QuotaNamespace 为 * 时是默认配额，对没有单独设置的命名空间生效
+type Quota struct {
+	ID                   int64     `gorm:"primary_key;not_null;auto_increment"`
+	QuotaNamespace       string    `gorm:"uniqueIndex;size:64;not null" json:"quota_namespace"`
+	QuotaMaxRoutes       int64     `json:"quota_max_routes"`
+	QuotaMaxHosts        int64     `json:"quota_max_hosts"`
+	QuotaMaxRoutesPerDay int64     `json:"quota_max_routes_per_day"`
+	QuotaUpdatedBy       string    `json:"quota_updated_by"`
+	UpdatedAt            time.Time `json:"-"`
+}
+
+// QuotaCounter 命名空间每天创建的路由数，删除路由后不减少
+type QuotaCounter struct {
+	ID               int64  `gorm:"primary_key;not_null;auto_increment"`
+	CounterNamespace string `gorm:"uniqueIndex:idx_quota_counter;size:64;not null" json:"counter_namespace"`
+	//格式 2006-01-02
+	CounterDay     string `gorm:"uniqueIndex:idx_quota_counter;size:10;not null" json:"counter_day"`
+	CounterCreated int64  `json:"counter_created"`
+}
@@ -0,0 +1,12 @@
+package model
+
+// ReservedHost 保留域名，只有指定的命名空间可以直接使用
+type ReservedHost struct {
+	ID int64 `gorm:"primary_key;not_null;auto_increment"`
+	//域名，*. 开头时匹配所有子域名
+	ReservedHost string `gorm:"uniqueIndex;size:255;not null" json:"reserved_host"`
+	//可以直接使用的命名空间，支持通配符
+	ReservedNamespaces []string `gorm:"serializer:json" json:"reserved_namespaces"`
+	ReservedReason     string   `json:"reserved_reason"`
+	ReservedCreatedBy  string   `json:"reserved_created_by"`
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// RoleBinding 调用方的角色，namespace-admin 和限定命名空间的 viewer、editor 只在对应命名空间生效
+type RoleBinding struct {
+	ID             int64  `gorm:"primary_key;not_null;auto_increment"`
+	BindingSubject string `gorm:"index" json:"binding_subject"`
+	BindingRole    string `json:"binding_role"`
+	//支持通配符，为空时对所有命名空间生效
+	BindingNamespace string    `json:"binding_namespace"`
+	BindingCreatedBy string    `json:"binding_created_by"`
+	CreatedAt        time.Time `json:"-"`
+}
@@ -1,9 +1,61 @@
 package model
 
+import "time"
+
 type Route struct {
-	ID             int64       `gorm:"primary_key;not_null;auto_increment"`
-	RouteName      string      `json:"route_name"`
-	RouteNamespace string      `json:"route_namespace"`
-	RouteHost      string      `json:"route_host"`
-	RoutePath      []RoutePath `gorm:"ForeignKey:RouteID" json:"route_path"`
+	ID               int64             `gorm:"primary_key;not_null;auto_increment"`
+	RouteName        string            `gorm:"size:253;uniqueIndex:idx_route_namespace_name,priority:2" json:"route_name"`
+	RouteNamespace   string            `gorm:"size:63;uniqueIndex:idx_route_namespace_name,priority:1" json:"route_namespace"`
+	RouteHost        string            `json:"route_host"`
+	RoutePath        []RoutePath       `gorm:"ForeignKey:RouteID" json:"route_path"`
+	RouteClass       string            `json:"route_class"`
+	RouteAnnotations map[string]string `gorm:"serializer:json" json:"route_annotations"`
+	RouteTlsIssuer   string            `json:"route_tls_issuer"`
+	//所属应用
+	RouteApplicationID int64 `json:"route_application_id"`
+	//禁用后从k8s中移除，数据库保留
+	RouteDisabled bool `json:"route_disabled"`
+	//路由实现方式，为空时使用 Ingress
+	RouteAdapter string `json:"route_adapter"`
+	RouteGateway string `json:"route_gateway"`
+	//响应头
+	RouteResponseHeaderSet    map[string]string `gorm:"serializer:json" json:"route_response_header_set"`
+	RouteResponseHeaderRemove []string          `gorm:"serializer:json" json:"route_response_header_remove"`
+	//请求头，可能包含后端的认证信息，加密保存
+	RouteRequestHeaderAdd    map[string]string `gorm:"serializer:encrypted;type:text" json:"route_request_header_add"`
+	RouteRequestHeaderSet    map[string]string `gorm:"serializer:encrypted;type:text" json:"route_request_header_set"`
+	RouteRequestHeaderRemove []string          `gorm:"serializer:json" json:"route_request_header_remove"`
+	//版本号，每次更新到k8s递增
+	RouteRevision int64 `json:"route_revision"`
+	//创建人、最后修改人
+	RouteCreatedBy string `json:"route_created_by"`
+	RouteUpdatedBy string `json:"route_updated_by"`
+	//负责团队、联系方式、成本中心
+	RouteOwnerTeam  string `json:"route_owner_team"`
+	RouteContact    string `json:"route_contact"`
+	RouteCostCenter string `json:"route_cost_center"`
+	//响应压缩、WebSocket、HTTP/2 后端
+	RouteCompression             bool  `json:"route_compression"`
+	RouteWebsocket               bool  `json:"route_websocket"`
+	RouteWebsocketTimeoutSeconds int32 `json:"route_websocket_timeout_seconds"`
+	RouteBackendHTTP2            bool  `json:"route_backend_http2"`
+	//外部认证，地址中可能包含凭据，加密保存
+	RouteAuthURL             string   `gorm:"serializer:encrypted;type:text" json:"route_auth_url"`
+	RouteAuthSignin          string   `json:"route_auth_signin"`
+	RouteAuthResponseHeaders []string `gorm:"serializer:json" json:"route_auth_response_headers"`
+	//最后一次写入k8s的规格哈希
+	RouteSpecHash string `gorm:"size:64" json:"route_spec_hash"`
+	//最近一次写入k8s的时间（unix 秒）、耗时、错误和连续失败次数
+	RouteLastApplyTime       int64  `json:"route_last_apply_time"`
+	RouteLastApplyDurationMs int64  `json:"route_last_apply_duration_ms"`
+	RouteLastError           string `gorm:"type:text" json:"route_last_error"`
+	RouteConsecutiveFailures int64  `json:"route_consecutive_failures"`
+	//写入失败后下次自动重试的时间（unix 秒），超过重试次数后进入死信列表
+	RouteNextRetryAt int64 `gorm:"index" json:"route_next_retry_at"`
+	RouteDeadLetter  bool  `gorm:"index" json:"route_dead_letter"`
+	//删除中，k8s中的资源删除后再删除记录，中断的删除按记录的策略继续
+	RouteDeleting     bool      `gorm:"index" json:"-"`
+	RouteDeletePolicy string    `gorm:"size:16" json:"-"`
+	CreatedAt         time.Time `json:"-"`
+	UpdatedAt         time.Time `json:"-"`
 }
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// RouteArchive 交还手动管理的路由，保留释放前的完整规格便于追溯
+type RouteArchive struct {
+	ID             int64  `gorm:"primary_key;not_null;auto_increment"`
+	RouteID        int64  `gorm:"index" json:"route_id"`
+	RouteNamespace string `json:"route_namespace"`
+	RouteName      string `json:"route_name"`
+	//释放前的路由规格 JSON，包含敏感字段，加密保存
+	RouteSpec  string    `gorm:"serializer:encrypted;type:mediumtext" json:"route_spec"`
+	ArchivedBy string    `json:"archived_by"`
+	CreatedAt  time.Time `json:"-"`
+}
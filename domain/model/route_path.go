@@ -1,9 +1,23 @@
 package model
 
 type RoutePath struct {
-	ID                      int64  `gorm:"primary_key;not_null;auto_increment"`
-	RouteID                 int64  `json:"route_id"`
-	RoutePathName           string `json:"route_path_name"`
-	RouteBackendService     string `json:"route_backend_service"`
-	RouteBackendServicePort int32  `json:"route_backend_service_port"`
+	ID                      int64              `gorm:"primary_key;not_null;auto_increment"`
+	RouteID                 int64              `json:"route_id"`
+	RoutePathName           string             `json:"route_path_name"`
+	RouteBackendService     string             `json:"route_backend_service"`
+	RouteBackendServicePort int32              `json:"route_backend_service_port"`
+	RouteHeaderMatch        []RouteHeaderMatch `gorm:"serializer:json" json:"route_header_match"`
+	RouteMethod             []string           `gorm:"serializer:json" json:"route_method"`
+	RouteMirrorService      string             `json:"route_mirror_service"`
+	RouteMirrorServicePort  int32              `json:"route_mirror_service_port"`
+	//后端健康检查
+	RouteHealthcheckPath            string `json:"route_healthcheck_path"`
+	RouteHealthcheckIntervalSeconds int32  `json:"route_healthcheck_interval_seconds"`
+}
+
+// RouteHeaderMatch 请求头匹配条件
+type RouteHeaderMatch struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Type  string `json:"type"`
 }
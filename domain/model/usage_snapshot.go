@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// UsageSnapshot 每天按命名空间和负责团队记录的用量，用于按时间段出用量报表
+type UsageSnapshot struct {
+	ID int64 `gorm:"primary_key;not_null;auto_increment"`
+	//格式 2006-01-02
+	SnapshotDay             string    `gorm:"uniqueIndex:idx_usage_snapshot;size:10;not null" json:"snapshot_day"`
+	SnapshotNamespace       string    `gorm:"uniqueIndex:idx_usage_snapshot;size:64;not null" json:"snapshot_namespace"`
+	SnapshotTeam            string    `gorm:"uniqueIndex:idx_usage_snapshot;size:64;not null" json:"snapshot_team"`
+	SnapshotRoutes          int64     `json:"snapshot_routes"`
+	SnapshotHosts           int64     `json:"snapshot_hosts"`
+	SnapshotTlsCertificates int64     `json:"snapshot_tls_certificates"`
+	CreatedAt               time.Time `json:"-"`
+}
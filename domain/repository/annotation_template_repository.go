@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"github.com/zxnlx/route/domain/model"
+	"gorm.io/gorm"
+)
+
+// IAnnotationTemplateRepository 注解模板需要实现的接口
+type IAnnotationTemplateRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+	// FindAnnotationTemplateByID 根据ID查找数据
+	FindAnnotationTemplateByID(int64) (*model.AnnotationTemplate, error)
+	// CreateAnnotationTemplate 创建一条数据
+	CreateAnnotationTemplate(*model.AnnotationTemplate) (int64, error)
+	// DeleteAnnotationTemplateByID 根据ID删除一条数据
+	DeleteAnnotationTemplateByID(int64) error
+	// UpdateAnnotationTemplate 修改更新数据
+	UpdateAnnotationTemplate(*model.AnnotationTemplate) error
+	// FindAll 查找所有数据
+	FindAll() ([]model.AnnotationTemplate, error)
+}
+
+// NewAnnotationTemplateRepository 创建annotationTemplateRepository
+func NewAnnotationTemplateRepository(db *gorm.DB) IAnnotationTemplateRepository {
+	return &AnnotationTemplateRepository{db: db}
+}
+
+type AnnotationTemplateRepository struct {
+	db *gorm.DB
+}
+
+func (u *AnnotationTemplateRepository) InitTable() error {
+	return u.db.AutoMigrate(&model.AnnotationTemplate{})
+}
+
+// FindAnnotationTemplateByID 根据ID查找
+func (u *AnnotationTemplateRepository) FindAnnotationTemplateByID(id int64) (annotationTemplate *model.AnnotationTemplate, err error) {
+	annotationTemplate = &model.AnnotationTemplate{}
+	return annotationTemplate, u.db.First(annotationTemplate, id).Error
+}
+
+// CreateAnnotationTemplate 创建
+func (u *AnnotationTemplateRepository) CreateAnnotationTemplate(annotationTemplate *model.AnnotationTemplate) (int64, error) {
+	return annotationTemplate.ID, u.db.Create(annotationTemplate).Error
+}
+
+// DeleteAnnotationTemplateByID 根据ID删除
+func (u *AnnotationTemplateRepository) DeleteAnnotationTemplateByID(id int64) error {
+	return u.db.Where("id = ?", id).Delete(&model.AnnotationTemplate{}).Error
+}
+
+// UpdateAnnotationTemplate 更新
+func (u *AnnotationTemplateRepository) UpdateAnnotationTemplate(annotationTemplate *model.AnnotationTemplate) error {
+	return u.db.Model(annotationTemplate).Updates(annotationTemplate).Error
+}
+
+// FindAll 获取结果集
+func (u *AnnotationTemplateRepository) FindAll() (annotationTemplateAll []model.AnnotationTemplate, err error) {
+	return annotationTemplateAll, u.db.Find(&annotationTemplateAll).Error
+}
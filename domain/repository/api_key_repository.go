@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"github.com/zxnlx/route/domain/model"
+	"gorm.io/gorm"
+)
+
+// IAPIKeyRepository API 密钥需要实现的接口
+type IAPIKeyRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+	// FindAPIKeyByID 根据ID查找数据
+	FindAPIKeyByID(int64) (*model.APIKey, error)
+	// FindAPIKeyByPrefix 根据密钥前缀查找数据
+	FindAPIKeyByPrefix(string) (*model.APIKey, error)
+	// CreateAPIKey 创建一条数据
+	CreateAPIKey(*model.APIKey) (int64, error)
+	// RevokeAPIKey 记录吊销时间
+	RevokeAPIKey(int64, int64) error
+	// UpdateLastUsed 记录最后使用时间
+	UpdateLastUsed(int64, int64) error
+	// FindAll 查找所有数据
+	FindAll() ([]model.APIKey, error)
+}
+
+// NewAPIKeyRepository 创建apiKeyRepository
+func NewAPIKeyRepository(db *gorm.DB) IAPIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+type APIKeyRepository struct {
+	db *gorm.DB
+}
+
+func (u *APIKeyRepository) InitTable() error {
+	return u.db.AutoMigrate(&model.APIKey{})
+}
+
+// FindAPIKeyByID 根据ID查找
+func (u *APIKeyRepository) FindAPIKeyByID(id int64) (apiKey *model.APIKey, err error) {
+	apiKey = &model.APIKey{}
+	return apiKey, u.db.First(apiKey, id).Error
+}
+
+// FindAPIKeyByPrefix 根据密钥前缀查找
+func (u *APIKeyRepository) FindAPIKeyByPrefix(prefix string) (apiKey *model.APIKey, err error) {
+	apiKey = &model.APIKey{}
+	return apiKey, u.db.Where("key_prefix = ?", prefix).First(apiKey).Error
+}
+
+// CreateAPIKey 创建
+func (u *APIKeyRepository) CreateAPIKey(apiKey *model.APIKey) (int64, error) {
+	return apiKey.ID, u.db.Create(apiKey).Error
+}
+
+// RevokeAPIKey 吊销
+func (u *APIKeyRepository) RevokeAPIKey(id int64, revokedAt int64) error {
+	return u.db.Model(&model.APIKey{}).Where("id = ?", id).Update("key_revoked_at", revokedAt).Error
+}
+
+// UpdateLastUsed 更新最后使用时间
+func (u *APIKeyRepository) UpdateLastUsed(id int64, lastUsedAt int64) error {
+	return u.db.Model(&model.APIKey{}).Where("id = ?", id).Update("key_last_used_at", lastUsedAt).Error
+}
+
+// FindAll 获取结果集
+func (u *APIKeyRepository) FindAll() (apiKeyAll []model.APIKey, err error) {
+	return apiKeyAll, u.db.Find(&apiKeyAll).Error
+}
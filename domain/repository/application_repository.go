@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"github.com/zxnlx/route/domain/model"
+	"gorm.io/gorm"
+)
+
+// IApplicationRepository 应用需要实现的接口
+type IApplicationRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+	// FindApplicationByID 根据ID查找数据
+	FindApplicationByID(int64) (*model.Application, error)
+	// CreateApplication 创建一条数据
+	CreateApplication(*model.Application) (int64, error)
+	// DeleteApplicationByID 根据ID删除一条数据
+	DeleteApplicationByID(int64) error
+	// UpdateApplication 修改更新数据
+	UpdateApplication(*model.Application) error
+	// FindAll 查找所有数据
+	FindAll() ([]model.Application, error)
+}
+
+// NewApplicationRepository 创建applicationRepository
+func NewApplicationRepository(db *gorm.DB) IApplicationRepository {
+	return &ApplicationRepository{db: db}
+}
+
+type ApplicationRepository struct {
+	db *gorm.DB
+}
+
+func (u *ApplicationRepository) InitTable() error {
+	return u.db.AutoMigrate(&model.Application{})
+}
+
+// FindApplicationByID 根据ID查找
+func (u *ApplicationRepository) FindApplicationByID(id int64) (application *model.Application, err error) {
+	application = &model.Application{}
+	return application, u.db.First(application, id).Error
+}
+
+// CreateApplication 创建
+func (u *ApplicationRepository) CreateApplication(application *model.Application) (int64, error) {
+	return application.ID, u.db.Create(application).Error
+}
+
+// DeleteApplicationByID 根据ID删除
+func (u *ApplicationRepository) DeleteApplicationByID(id int64) error {
+	return u.db.Where("id = ?", id).Delete(&model.Application{}).Error
+}
+
+// UpdateApplication 更新
+func (u *ApplicationRepository) UpdateApplication(application *model.Application) error {
+	return u.db.Model(application).Updates(application).Error
+}
+
+// FindAll 获取结果集，从只读副本读取
+func (u *ApplicationRepository) FindAll() (applicationAll []model.Application, err error) {
+	return applicationAll, readReplica(u.db).Find(&applicationAll).Error
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/zxnlx/route/secrets"
+	"gorm.io/gorm/schema"
+	"reflect"
+)
+
+func init() {
+	schema.RegisterSerializer("encrypted", EncryptedSerializer{})
+}
+
+var encrypter *secrets.Encrypter
+
+// SetEncrypter 开启敏感字段加密，为 nil 时明文保存
+func SetEncrypter(e *secrets.Encrypter) {
+	encrypter = e
+}
+
+// CurrentEncrypter 当前的加密器，未开启加密时为 nil，供不经过 gorm 的存储后端使用
+func CurrentEncrypter() *secrets.Encrypter {
+	return encrypter
+}
+
+// EncryptedSerializer 敏感字段的序列化，字符串直接保存，其余类型保存为 JSON，开启加密时再用 AES-GCM 加密
+// 读取时兼容开启加密前的明文数据
+type EncryptedSerializer struct{}
+
+func (EncryptedSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	fieldValue := reflect.New(field.FieldType)
+	if dbValue != nil {
+		var data []byte
+		switch v := dbValue.(type) {
+		case []byte:
+			data = v
+		case string:
+			data = []byte(v)
+		default:
+			return fmt.Errorf("字段 %s 的值类型 %T 不支持", field.Name, dbValue)
+		}
+		if secrets.IsEncrypted(string(data)) {
+			if encrypter == nil {
+				return errors.New("字段 " + field.Name + " 已加密，未配置加密密钥")
+			}
+			plaintext, err := encrypter.Decrypt(ctx, string(data))
+			if err != nil {
+				return err
+			}
+			data = plaintext
+		}
+		if len(data) > 0 {
+			if field.FieldType.Kind() == reflect.String {
+				fieldValue.Elem().SetString(string(data))
+			} else if err := json.Unmarshal(data, fieldValue.Interface()); err != nil {
+				return err
+			}
+		}
+	}
+	field.ReflectValueOf(ctx, dst).Set(fieldValue.Elem())
+	return nil
+}
+
+func (EncryptedSerializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	var data []byte
+	if s, ok := fieldValue.(string); ok {
+		data = []byte(s)
+	} else {
+		var err error
+		if data, err = json.Marshal(fieldValue); err != nil {
+			return nil, err
+		}
+	}
+	if encrypter == nil || len(data) == 0 {
+		return string(data), nil
+	}
+	return encrypter.Encrypt(ctx, data)
+}
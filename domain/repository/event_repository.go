@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"github.com/zxnlx/route/domain/model"
+	"gorm.io/gorm"
+	"time"
+)
+
+// IEventRepository 事件需要实现的接口
+type IEventRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+	// CreateEvent 创建一条数据
+	CreateEvent(*model.Event) (int64, error)
+	// FindEvents 按路由、类型、起始时间过滤，零值表示不过滤
+	FindEvents(int64, string, time.Time) ([]model.Event, error)
+	// FindEventsAfter 按 ID 升序返回 ID 大于指定值的事件，最多 limit 条
+	FindEventsAfter(int64, int) ([]model.Event, error)
+	// FindLatestEventID 最新事件的 ID，没有事件时为 0
+	FindLatestEventID() (int64, error)
+}
+
+// NewEventRepository 创建eventRepository
+func NewEventRepository(db *gorm.DB) IEventRepository {
+	return &EventRepository{db: db}
+}
+
+type EventRepository struct {
+	db *gorm.DB
+}
+
+func (u *EventRepository) InitTable() error {
+	return u.db.AutoMigrate(&model.Event{})
+}
+
+// CreateEvent 创建
+func (u *EventRepository) CreateEvent(event *model.Event) (int64, error) {
+	return event.ID, u.db.Create(event).Error
+}
+
+// FindEvents 按时间倒序返回，从只读副本读取
+func (u *EventRepository) FindEvents(routeID int64, eventType string, since time.Time) (eventAll []model.Event, err error) {
+	db := readReplica(u.db)
+	if routeID != 0 {
+		db = db.Where("route_id = ?", routeID)
+	}
+	if eventType != "" {
+		db = db.Where("event_type = ?", eventType)
+	}
+	if !since.IsZero() {
+		db = db.Where("created_at >= ?", since)
+	}
+	return eventAll, db.Order("created_at desc").Find(&eventAll).Error
+}
+
+// FindEventsAfter 从主库读取，避免只读副本延迟导致推送时漏掉事件
+func (u *EventRepository) FindEventsAfter(afterID int64, limit int) (eventAll []model.Event, err error) {
+	return eventAll, u.db.Where("id > ?", afterID).Order("id").Limit(limit).Find(&eventAll).Error
+}
+
+// FindLatestEventID 从主库读取
+func (u *EventRepository) FindLatestEventID() (int64, error) {
+	var id int64
+	return id, u.db.Model(&model.Event{}).Select("COALESCE(MAX(id), 0)").Scan(&id).Error
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"github.com/zxnlx/route/domain/model"
+	"gorm.io/gorm"
+)
+
+// IFreezeWindowRepository 冻结窗口需要实现的接口
+type IFreezeWindowRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+	// FindFreezeWindowByID 根据ID查找数据
+	FindFreezeWindowByID(int64) (*model.FreezeWindow, error)
+	// CreateFreezeWindow 创建一条数据
+	CreateFreezeWindow(*model.FreezeWindow) (int64, error)
+	// DeleteFreezeWindowByID 根据ID删除一条数据
+	DeleteFreezeWindowByID(int64) error
+	// UpdateFreezeWindow 修改更新数据
+	UpdateFreezeWindow(*model.FreezeWindow) error
+	// FindAll 查找所有数据
+	FindAll() ([]model.FreezeWindow, error)
+}
+
+// NewFreezeWindowRepository 创建freezeWindowRepository
+func NewFreezeWindowRepository(db *gorm.DB) IFreezeWindowRepository {
+	return &FreezeWindowRepository{db: db}
+}
+
+type FreezeWindowRepository struct {
+	db *gorm.DB
+}
+
+func (u *FreezeWindowRepository) InitTable() error {
+	return u.db.AutoMigrate(&model.FreezeWindow{})
+}
+
+// FindFreezeWindowByID 根据ID查找
+func (u *FreezeWindowRepository) FindFreezeWindowByID(id int64) (freezeWindow *model.FreezeWindow, err error) {
+	freezeWindow = &model.FreezeWindow{}
+	return freezeWindow, u.db.First(freezeWindow, id).Error
+}
+
+// CreateFreezeWindow 创建
+func (u *FreezeWindowRepository) CreateFreezeWindow(freezeWindow *model.FreezeWindow) (int64, error) {
+	return freezeWindow.ID, u.db.Create(freezeWindow).Error
+}
+
+// DeleteFreezeWindowByID 根据ID删除
+func (u *FreezeWindowRepository) DeleteFreezeWindowByID(id int64) error {
+	return u.db.Where("id = ?", id).Delete(&model.FreezeWindow{}).Error
+}
+
+// UpdateFreezeWindow 更新
+func (u *FreezeWindowRepository) UpdateFreezeWindow(freezeWindow *model.FreezeWindow) error {
+	return u.db.Model(freezeWindow).Updates(freezeWindow).Error
+}
+
+// FindAll 获取结果集
+func (u *FreezeWindowRepository) FindAll() (freezeWindowAll []model.FreezeWindow, err error) {
+	return freezeWindowAll, u.db.Find(&freezeWindowAll).Error
+}
@@ -0,0 +1,72 @@
+package kvstore
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/secrets"
+	"reflect"
+)
+
+// 按字段名编码为 JSON，不使用 json 标签，json:"-" 的字段也会保存
+func marshalRow(row interface{}) ([]byte, error) {
+	v := reflect.ValueOf(row).Elem()
+	fields := make(map[string]interface{}, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		if field := v.Type().Field(i); field.PkgPath == "" {
+			fields[field.Name] = v.Field(i).Interface()
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// 已经删除的字段忽略
+func unmarshalRow(raw []byte, row interface{}) error {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return err
+	}
+	v := reflect.ValueOf(row).Elem()
+	for name, data := range fields {
+		field := v.FieldByName(name)
+		if !field.IsValid() || !field.CanSet() {
+			continue
+		}
+		if err := json.Unmarshal(data, field.Addr().Interface()); err != nil {
+			return errors.New("字段 " + name + "：" + err.Error())
+		}
+	}
+	return nil
+}
+
+func encodeRow(row interface{}) (string, error) {
+	raw, err := marshalRow(row)
+	if err != nil {
+		return "", err
+	}
+	if encrypter := repository.CurrentEncrypter(); encrypter != nil {
+		return encrypter.Encrypt(context.TODO(), raw)
+	}
+	return base64.StdEncoding.EncodeToString(raw), nil
+}
+
+// 兼容开启加密前保存的数据
+func decodeRow(data string, row interface{}) error {
+	var raw []byte
+	var err error
+	if secrets.IsEncrypted(data) {
+		encrypter := repository.CurrentEncrypter()
+		if encrypter == nil {
+			return errors.New("存储的数据已加密，未配置加密密钥")
+		}
+		raw, err = encrypter.Decrypt(context.TODO(), data)
+	} else {
+		raw, err = base64.StdEncoding.DecodeString(data)
+	}
+	if err != nil {
+		return err
+	}
+	return unmarshalRow(raw, row)
+}
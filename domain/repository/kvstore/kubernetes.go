@@ -0,0 +1,226 @@
+package kvstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/zxnlx/route/domain/model"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"strconv"
+	"strings"
+)
+
+// 存储后端
+const (
+	BackendMysql      = "mysql"
+	BackendKubernetes = "kubernetes"
+	BackendRedis      = "redis"
+)
+
+// Config 存储后端配置，默认使用 mysql；小规模部署可以使用 kubernetes 或 redis，不需要 mysql
+type Config struct {
+	Backend string `json:"backend"`
+	// Namespace kubernetes 后端保存数据的命名空间，默认 default
+	Namespace string `json:"namespace"`
+	// Redis redis 后端的连接
+	Redis RedisConfig `json:"redis"`
+}
+
+const (
+	tableLabel = "route.zxnlx.io/table"
+	idLabel    = "route.zxnlx.io/id"
+	// 每个 ConfigMap 保存的行数，按ID分段，ConfigMap 不能超过 1MiB
+	rowsPerConfigMap = 32
+)
+
+var routeResource = schema.GroupVersionResource{Group: "route.zxnlx.io", Version: "v1alpha1", Resource: "routes"}
+
+// KubernetesPersister 路由保存为 Route 自定义资源，其他数据表按ID分段，每段的行保存在同一个 ConfigMap 中，都放在同一个命名空间中
+// 每行的完整数据编码为 JSON 后保存，开启加密时整体加密；Route 的 spec 另外保存不含敏感字段的规格，便于 kubectl 查看
+// Route CRD 不在运行时创建，部署前执行 kubectl apply -f deploy/route-crd.yaml
+type KubernetesPersister struct {
+	clientSet     kubernetes.Interface
+	dynamicClient dynamic.Interface
+	namespace     string
+}
+
+// NewKubernetesPersister 创建，Route CRD 未安装时返回错误
+func NewKubernetesPersister(clientSet kubernetes.Interface, dynamicClient dynamic.Interface, namespace string) (*KubernetesPersister, error) {
+	if namespace == "" {
+		return nil, errors.New("存储命名空间不能为空")
+	}
+	p := &KubernetesPersister{clientSet: clientSet, dynamicClient: dynamicClient, namespace: namespace}
+	_, err := dynamicClient.Resource(routeResource).Namespace(namespace).List(context.TODO(), metav1.ListOptions{Limit: 1})
+	if k8serrors.IsNotFound(err) {
+		return nil, errors.New("Route CRD 未安装，请先执行 kubectl apply -f deploy/route-crd.yaml")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func objectName(table string, id int64) string {
+	return strings.ReplaceAll(table, "_", "-") + "-" + strconv.FormatInt(id, 10)
+}
+
+func rowLabels(table string, id int64) map[string]string {
+	return map[string]string{tableLabel: table, idLabel: strconv.FormatInt(id, 10)}
+}
+
+// 行所在的 ConfigMap，同一段的行保存在一起，段内的行都删除后删除 ConfigMap
+func segmentName(table string, id int64) string {
+	return strings.ReplaceAll(table, "_", "-") + "-rows-" + strconv.FormatInt(id/rowsPerConfigMap, 10)
+}
+
+func (p *KubernetesPersister) Load(table string, newRow func() interface{}, fn func(id int64, row interface{})) error {
+	options := metav1.ListOptions{LabelSelector: tableLabel + "=" + table}
+	load := func(labels map[string]string, data string) error {
+		id, err := strconv.ParseInt(labels[idLabel], 10, 64)
+		if err != nil {
+			return err
+		}
+		row := newRow()
+		if err := decodeRow(data, row); err != nil {
+			return err
+		}
+		fn(id, row)
+		return nil
+	}
+	if table == "route" {
+		list, err := p.dynamicClient.Resource(routeResource).Namespace(p.namespace).List(context.TODO(), options)
+		if err != nil {
+			return err
+		}
+		for _, v := range list.Items {
+			data, _, _ := unstructured.NestedString(v.Object, "data")
+			if err := load(v.GetLabels(), data); err != nil {
+				return errors.New("读取 Route " + v.GetName() + " 失败：" + err.Error())
+			}
+		}
+		return nil
+	}
+	list, err := p.clientSet.CoreV1().ConfigMaps(p.namespace).List(context.TODO(), options)
+	if err != nil {
+		return err
+	}
+	for _, v := range list.Items {
+		for key, data := range v.Data {
+			if err := load(map[string]string{idLabel: key}, data); err != nil {
+				return errors.New("读取 ConfigMap " + v.Name + " 中的 " + key + " 失败：" + err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func (p *KubernetesPersister) Save(table string, id int64, row interface{}) error {
+	data, err := encodeRow(row)
+	if err != nil {
+		return err
+	}
+	if table == "route" {
+		return p.saveRoute(id, row.(*model.Route), data)
+	}
+	return p.updateSegment(table, id, func(rows map[string]string) {
+		rows[strconv.FormatInt(id, 10)] = data
+	})
+}
+
+// 读取行所在的分段修改后写回，冲突时重试，分段为空时删除
+func (p *KubernetesPersister) updateSegment(table string, id int64, fn func(rows map[string]string)) error {
+	client := p.clientSet.CoreV1().ConfigMaps(p.namespace)
+	name := segmentName(table, id)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := client.Get(context.TODO(), name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: p.namespace, Labels: map[string]string{tableLabel: table}},
+				Data:       map[string]string{},
+			}
+			fn(configMap.Data)
+			if len(configMap.Data) == 0 {
+				return nil
+			}
+			_, err = client.Create(context.TODO(), configMap, metav1.CreateOptions{})
+			//其他写入同时创建了分段，重新读取后修改
+			if k8serrors.IsAlreadyExists(err) {
+				return k8serrors.NewConflict(corev1.Resource("configmaps"), name, err)
+			}
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if current.Data == nil {
+			current.Data = map[string]string{}
+		}
+		fn(current.Data)
+		if len(current.Data) == 0 {
+			err = client.Delete(context.TODO(), name, metav1.DeleteOptions{Preconditions: &metav1.Preconditions{ResourceVersion: &current.ResourceVersion}})
+			if k8serrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		_, err = client.Update(context.TODO(), current, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// spec 中不保存加密字段
+func (p *KubernetesPersister) saveRoute(id int64, route *model.Route, data string) error {
+	view := *route
+	view.RouteRequestHeaderAdd = nil
+	view.RouteRequestHeaderSet = nil
+	view.RouteAuthURL = ""
+	raw, err := json.Marshal(view)
+	if err != nil {
+		return err
+	}
+	spec := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &spec); err != nil {
+		return err
+	}
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": routeResource.Group + "/" + routeResource.Version,
+		"kind":       "Route",
+		"spec":       spec,
+		"data":       data,
+	}}
+	obj.SetName(objectName("route", id))
+	obj.SetNamespace(p.namespace)
+	obj.SetLabels(rowLabels("route", id))
+	client := p.dynamicClient.Resource(routeResource).Namespace(p.namespace)
+	current, err := client.Get(context.TODO(), obj.GetName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = client.Create(context.TODO(), obj, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	obj.SetResourceVersion(current.GetResourceVersion())
+	_, err = client.Update(context.TODO(), obj, metav1.UpdateOptions{})
+	return err
+}
+
+func (p *KubernetesPersister) Delete(table string, id int64) error {
+	if table != "route" {
+		return p.updateSegment(table, id, func(rows map[string]string) {
+			delete(rows, strconv.FormatInt(id, 10))
+		})
+	}
+	err := p.dynamicClient.Resource(routeResource).Namespace(p.namespace).Delete(context.TODO(), objectName(table, id), metav1.DeleteOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
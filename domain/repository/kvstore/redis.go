@@ -0,0 +1,80 @@
+package kvstore
+
+import (
+	"context"
+	"errors"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+)
+
+const defaultRedisKeyPrefix = "route:"
+
+// RedisConfig redis 后端配置
+type RedisConfig struct {
+	Addr     string `json:"addr"`
+	Password string `json:"password"`
+	DB       int    `json:"db"`
+	// KeyPrefix 数据表 key 的前缀，默认 route:
+	KeyPrefix string `json:"key_prefix"`
+	// Persistence 开启 redis 的 AOF 持久化，关闭时数据只在 redis 内存中，重启后丢失，适合演示环境
+	Persistence bool `json:"persistence"`
+}
+
+// RedisPersister 每张数据表保存为一个 hash，field 为ID，value 为编码后的行，开启加密时整体加密
+type RedisPersister struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisPersister 创建，连接失败或无法开启持久化时返回错误
+func NewRedisPersister(config RedisConfig) (*RedisPersister, error) {
+	if config.Addr == "" {
+		return nil, errors.New("redis 地址不能为空")
+	}
+	prefix := config.KeyPrefix
+	if prefix == "" {
+		prefix = defaultRedisKeyPrefix
+	}
+	client := redis.NewClient(&redis.Options{Addr: config.Addr, Password: config.Password, DB: config.DB})
+	if err := client.Ping(context.TODO()).Err(); err != nil {
+		return nil, err
+	}
+	//托管的 redis 通常禁用 CONFIG 命令，需要在服务端开启持久化
+	if config.Persistence {
+		if err := client.ConfigSet(context.TODO(), "appendonly", "yes").Err(); err != nil {
+			return nil, errors.New("开启 redis 持久化失败：" + err.Error())
+		}
+	}
+	return &RedisPersister{client: client, prefix: prefix}, nil
+}
+
+func (p *RedisPersister) Load(table string, newRow func() interface{}, fn func(id int64, row interface{})) error {
+	rows, err := p.client.HGetAll(context.TODO(), p.prefix+table).Result()
+	if err != nil {
+		return err
+	}
+	for field, data := range rows {
+		id, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return err
+		}
+		row := newRow()
+		if err := decodeRow(data, row); err != nil {
+			return errors.New("读取 " + p.prefix + table + " 中的 " + field + " 失败：" + err.Error())
+		}
+		fn(id, row)
+	}
+	return nil
+}
+
+func (p *RedisPersister) Save(table string, id int64, row interface{}) error {
+	data, err := encodeRow(row)
+	if err != nil {
+		return err
+	}
+	return p.client.HSet(context.TODO(), p.prefix+table, strconv.FormatInt(id, 10), data).Err()
+}
+
+func (p *RedisPersister) Delete(table string, id int64) error {
+	return p.client.HDel(context.TODO(), p.prefix+table, strconv.FormatInt(id, 10)).Err()
+}
@@ -0,0 +1,1194 @@
+package kvstore
+
+import (
+	"errors"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/i18n"
+	"gorm.io/gorm"
+	"sort"
+	"time"
+)
+
+// NewRouteRepository 创建routeRepository
+func NewRouteRepository(store *Store) repository.IRouteRepository {
+	return &RouteRepository{store: store}
+}
+
+// RouteRepository 路由仓库
+type RouteRepository struct {
+	store *Store
+	//事务中不为空，记录修改前的内容
+	undo *undoLog
+}
+
+var _ repository.IRouteRepository = (*RouteRepository)(nil)
+
+func (u *RouteRepository) InitTable() error {
+	return nil
+}
+
+func (u *RouteRepository) FindRouteByID(routeID int64) (*model.Route, error) {
+	route := &model.Route{}
+	return route, u.store.get("route", routeID, route)
+}
+
+// 和数据库的 idx_route_namespace_name 一致，同一命名空间下名称不能重复，调用方持有 routeMu
+func (u *RouteRepository) checkUniqueName(namespace string, name string, id int64) error {
+	current, err := u.FindRouteByName(namespace, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if current.ID != id {
+		return i18n.NewError(i18n.CodeRouteAlreadyExists, "路由 "+name+" 已经存在")
+	}
+	return nil
+}
+
+func (u *RouteRepository) CreateRoute(route *model.Route) (int64, error) {
+	u.store.routeMu.Lock()
+	defer u.store.routeMu.Unlock()
+	return u.createRoute(route)
+}
+
+func (u *RouteRepository) createRoute(route *model.Route) (int64, error) {
+	if err := u.checkUniqueName(route.RouteNamespace, route.RouteName, 0); err != nil {
+		return 0, err
+	}
+	u.store.mu.Lock()
+	route.ID = u.store.newID()
+	for i := range route.RoutePath {
+		route.RoutePath[i].ID = u.store.newID()
+		route.RoutePath[i].RouteID = route.ID
+	}
+	u.store.mu.Unlock()
+	route.CreatedAt = time.Now()
+	route.UpdatedAt = route.CreatedAt
+	return route.ID, u.put("route", route.ID, route)
+}
+
+func (u *RouteRepository) CreateRoutes(routes []*model.Route, batchSize int) error {
+	for _, v := range routes {
+		if _, err := u.CreateRoute(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *RouteRepository) DeleteRoutesByID(ids []int64) error {
+	for _, id := range ids {
+		if err := u.DeleteRouteByID(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpsertRoute 按名称查找后写入，和数据库一样保留ID、版本号和创建人，查找和写入之间不会有其他创建
+func (u *RouteRepository) UpsertRoute(route *model.Route) (int64, bool, error) {
+	u.store.routeMu.Lock()
+	defer u.store.routeMu.Unlock()
+	current, err := u.FindRouteByName(route.RouteNamespace, route.RouteName)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		id, err := u.createRoute(route)
+		return id, err == nil, err
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	route.ID = current.ID
+	route.RouteRevision = current.RouteRevision
+	route.RouteCreatedBy = current.RouteCreatedBy
+	route.RouteDeleting = current.RouteDeleting
+	route.RouteDeletePolicy = current.RouteDeletePolicy
+	route.CreatedAt = current.CreatedAt
+	u.store.mu.Lock()
+	for i := range route.RoutePath {
+		route.RoutePath[i].ID = u.store.newID()
+		route.RoutePath[i].RouteID = route.ID
+	}
+	u.store.mu.Unlock()
+	route.UpdatedAt = time.Now()
+	return route.ID, false, u.put("route", route.ID, route)
+}
+
+func (u *RouteRepository) DeleteRouteByID(routeID int64) error {
+	return u.delete("route", routeID)
+}
+
+func (u *RouteRepository) UpdateRoute(route *model.Route) error {
+	u.store.routeMu.Lock()
+	defer u.store.routeMu.Unlock()
+	//零值字段不覆盖，修改命名空间或名称时按修改后的值检查重复
+	current, err := u.FindRouteByID(route.ID)
+	if err != nil {
+		return err
+	}
+	namespace, name := current.RouteNamespace, current.RouteName
+	if route.RouteNamespace != "" {
+		namespace = route.RouteNamespace
+	}
+	if route.RouteName != "" {
+		name = route.RouteName
+	}
+	if err := u.checkUniqueName(namespace, name, route.ID); err != nil {
+		return err
+	}
+	u.store.mu.Lock()
+	for i := range route.RoutePath {
+		if route.RoutePath[i].ID == 0 {
+			route.RoutePath[i].ID = u.store.newID()
+		}
+		route.RoutePath[i].RouteID = route.ID
+	}
+	u.store.mu.Unlock()
+	route.UpdatedAt = time.Now()
+	if err := u.update("route", route.ID, route); err != nil {
+		return err
+	}
+	//与数据库实现一致，可以关闭或清空的字段总是写入
+	current, err = u.FindRouteByID(route.ID)
+	if err != nil {
+		return err
+	}
+	current.RouteCompression = route.RouteCompression
+	current.RouteWebsocket = route.RouteWebsocket
+	current.RouteWebsocketTimeoutSeconds = route.RouteWebsocketTimeoutSeconds
+	current.RouteBackendHTTP2 = route.RouteBackendHTTP2
+	current.RouteAuthURL = route.RouteAuthURL
+	current.RouteAuthSignin = route.RouteAuthSignin
+	current.RouteAuthResponseHeaders = route.RouteAuthResponseHeaders
+	return u.put("route", route.ID, current)
+}
+
+func (u *RouteRepository) find(match func(*model.Route) bool) ([]model.Route, error) {
+	var result []model.Route
+	err := u.store.each("route", func() interface{} { return &model.Route{} }, func(row interface{}) bool {
+		if route := row.(*model.Route); match(route) {
+			result = append(result, *route)
+		}
+		return true
+	})
+	return result, err
+}
+
+func (u *RouteRepository) FindAll() ([]model.Route, error) {
+	return u.find(func(*model.Route) bool { return true })
+}
+
+func (u *RouteRepository) FindRouteByApplicationID(applicationID int64) ([]model.Route, error) {
+	return u.find(func(route *model.Route) bool { return route.RouteApplicationID == applicationID })
+}
+
+func (u *RouteRepository) FindRouteByName(namespace string, name string) (*model.Route, error) {
+	routes, err := u.find(func(route *model.Route) bool {
+		return route.RouteNamespace == namespace && route.RouteName == name
+	})
+	if err != nil {
+		return &model.Route{}, err
+	}
+	if len(routes) == 0 {
+		return &model.Route{}, gorm.ErrRecordNotFound
+	}
+	return &routes[0], nil
+}
+
+func (u *RouteRepository) UpdateRouteDisabled(routeID int64, disabled bool) error {
+	route, err := u.FindRouteByID(routeID)
+	if err != nil {
+		return nil
+	}
+	route.RouteDisabled = disabled
+	return u.put("route", routeID, route)
+}
+
+func (u *RouteRepository) MarkRouteDeleting(routeID int64, policy string) error {
+	route, err := u.FindRouteByID(routeID)
+	if err != nil {
+		return nil
+	}
+	route.RouteDeleting = true
+	route.RouteDeletePolicy = policy
+	return u.put("route", routeID, route)
+}
+
+func (u *RouteRepository) FindDeletingRoutes() ([]model.Route, error) {
+	return u.find(func(route *model.Route) bool { return route.RouteDeleting })
+}
+
+func (u *RouteRepository) UpdateRouteApplyStatus(routeID int64, applyTime time.Time, duration time.Duration, applyErr error) (int64, error) {
+	route, err := u.FindRouteByID(routeID)
+	if err != nil {
+		return 0, nil
+	}
+	route.RouteLastApplyTime = applyTime.Unix()
+	route.RouteLastApplyDurationMs = duration.Milliseconds()
+	route.RouteLastError = ""
+	route.RouteConsecutiveFailures = 0
+	route.RouteNextRetryAt = 0
+	route.RouteDeadLetter = false
+	if applyErr != nil {
+		route.RouteLastError = applyErr.Error()
+		route.RouteConsecutiveFailures++
+	}
+	return route.RouteConsecutiveFailures, u.put("route", routeID, route)
+}
+
+func (u *RouteRepository) ScheduleRouteRetry(routeID int64, nextRetryAt int64, deadLetter bool) error {
+	route, err := u.FindRouteByID(routeID)
+	if err != nil {
+		return nil
+	}
+	route.RouteNextRetryAt = nextRetryAt
+	route.RouteDeadLetter = deadLetter
+	return u.put("route", routeID, route)
+}
+
+func (u *RouteRepository) FindRoutesDueForRetry(now time.Time, limit int) ([]model.Route, error) {
+	routes, err := u.find(func(route *model.Route) bool {
+		return route.RouteNextRetryAt > 0 && route.RouteNextRetryAt <= now.Unix() && !route.RouteDeadLetter
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(routes, func(i, j int) bool { return routes[i].RouteNextRetryAt < routes[j].RouteNextRetryAt })
+	if len(routes) > limit {
+		routes = routes[:limit]
+	}
+	return routes, nil
+}
+
+func (u *RouteRepository) FindFailedRoutes(includeRetrying bool) ([]model.Route, error) {
+	routes, err := u.find(func(route *model.Route) bool {
+		return route.RouteDeadLetter || includeRetrying && route.RouteNextRetryAt > 0
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].RouteNamespace+"/"+routes[i].RouteName < routes[j].RouteNamespace+"/"+routes[j].RouteName
+	})
+	return routes, nil
+}
+
+func (u *RouteRepository) CreateOutbox(event *model.Event, message *model.OutboxMessage) error {
+	u.store.mu.Lock()
+	event.ID = u.store.newID()
+	message.ID = u.store.newID()
+	u.store.mu.Unlock()
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+	if err := u.put("event", event.ID, event); err != nil {
+		return err
+	}
+	message.CreatedAt = time.Now()
+	return u.put("outbox", message.ID, message)
+}
+
+func (u *RouteRepository) ArchiveRoute(archive *model.RouteArchive) error {
+	u.store.mu.Lock()
+	archive.ID = u.store.newID()
+	u.store.mu.Unlock()
+	archive.CreatedAt = time.Now()
+	if err := u.put("route_archive", archive.ID, archive); err != nil {
+		return err
+	}
+	return u.delete("route", archive.RouteID)
+}
+
+// Transaction 事务之间串行执行，出错时把修改过的行恢复为修改前的内容
+// 事务外的读取可以看到未完成的修改，和 mysql 的读已提交不同
+func (u *RouteRepository) Transaction(fn func(repository.IRouteRepository) error) error {
+	if u.undo != nil {
+		return fn(u)
+	}
+	u.store.txMu.Lock()
+	defer u.store.txMu.Unlock()
+	tx := &RouteRepository{store: u.store, undo: &undoLog{touched: map[string]bool{}}}
+	if err := fn(tx); err != nil {
+		if rollbackErr := u.store.rollback(tx.undo); rollbackErr != nil {
+			return errors.New(err.Error() + "，回滚失败：" + rollbackErr.Error())
+		}
+		return err
+	}
+	return nil
+}
+
+func (u *RouteRepository) put(name string, id int64, row interface{}) error {
+	if err := u.store.record(u.undo, name, id); err != nil {
+		return err
+	}
+	return u.store.put(name, id, row)
+}
+
+func (u *RouteRepository) update(name string, id int64, row interface{}) error {
+	if err := u.store.record(u.undo, name, id); err != nil {
+		return err
+	}
+	return u.store.update(name, id, row)
+}
+
+func (u *RouteRepository) delete(name string, id int64) error {
+	if err := u.store.record(u.undo, name, id); err != nil {
+		return err
+	}
+	return u.store.delete(name, id)
+}
+
+// ReencryptAll 重新写入路由和归档，持久化时用当前主密钥加密，只保存在内存中时只返回数量
+func (u *RouteRepository) ReencryptAll() (int64, int64, error) {
+	var routes, archives int64
+	var err error
+	if err := u.store.each("route", func() interface{} { return &model.Route{} }, func(row interface{}) bool {
+		route := row.(*model.Route)
+		if err = u.put("route", route.ID, route); err != nil {
+			return false
+		}
+		routes++
+		return true
+	}); err != nil {
+		return 0, 0, err
+	}
+	if err != nil {
+		return routes, 0, err
+	}
+	if err := u.store.each("route_archive", func() interface{} { return &model.RouteArchive{} }, func(row interface{}) bool {
+		archive := row.(*model.RouteArchive)
+		if err = u.put("route_archive", archive.ID, archive); err != nil {
+			return false
+		}
+		archives++
+		return true
+	}); err != nil {
+		return routes, 0, err
+	}
+	return routes, archives, err
+}
+
+func (u *RouteRepository) Count(filter repository.RouteFilter) (int64, error) {
+	routes, err := u.find(func(route *model.Route) bool { return matchFilter(filter, route) })
+	return int64(len(routes)), err
+}
+
+// 取出全部后在内存中排序，总是返回全部字段
+func (u *RouteRepository) FindPage(filter repository.RouteFilter, orderBy string, after *repository.RouteCursor, limit int, fields []string) ([]model.Route, error) {
+	order := repository.RouteCursor{OrderBy: orderBy}
+	routes, err := u.find(func(route *model.Route) bool {
+		return matchFilter(filter, route) && (after == nil || !after.Less(route))
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return order.After(&routes[j]).Less(&routes[i]) && routes[i].ID != routes[j].ID
+	})
+	if limit > 0 && len(routes) > limit {
+		routes = routes[:limit]
+	}
+	return routes, nil
+}
+
+func matchFilter(filter repository.RouteFilter, route *model.Route) bool {
+	return (filter.Namespace == "" || route.RouteNamespace == filter.Namespace) &&
+		(filter.Host == "" || route.RouteHost == filter.Host) &&
+		(filter.ApplicationID == 0 || route.RouteApplicationID == filter.ApplicationID) &&
+		(filter.Adapter == "" || route.RouteAdapter == filter.Adapter) &&
+		(filter.Disabled == nil || route.RouteDisabled == *filter.Disabled)
+}
+
+func (u *RouteRepository) ExistsByName(namespace string, name string) (bool, error) {
+	_, err := u.FindRouteByName(namespace, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (u *RouteRepository) AggregateByNamespace() ([]repository.NamespaceAggregate, error) {
+	routes, err := u.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	aggregates := map[string]*repository.NamespaceAggregate{}
+	hosts := map[string]map[string]bool{}
+	for _, v := range routes {
+		aggregate, ok := aggregates[v.RouteNamespace]
+		if !ok {
+			aggregate = &repository.NamespaceAggregate{Namespace: v.RouteNamespace}
+			aggregates[v.RouteNamespace] = aggregate
+			hosts[v.RouteNamespace] = map[string]bool{}
+		}
+		aggregate.Routes++
+		if v.RouteDisabled {
+			aggregate.Disabled++
+		}
+		if v.RouteHost != "" && !hosts[v.RouteNamespace][v.RouteHost] {
+			hosts[v.RouteNamespace][v.RouteHost] = true
+			aggregate.Hosts++
+		}
+	}
+	result := make([]repository.NamespaceAggregate, 0, len(aggregates))
+	for _, v := range aggregates {
+		result = append(result, *v)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Namespace < result[j].Namespace })
+	return result, nil
+}
+
+// NewNamespaceDefaultRepository 创建namespaceDefaultRepository
+func NewNamespaceDefaultRepository(store *Store) repository.INamespaceDefaultRepository {
+	return &NamespaceDefaultRepository{store: store}
+}
+
+// NamespaceDefaultRepository 命名空间默认配置仓库
+type NamespaceDefaultRepository struct {
+	store *Store
+}
+
+var _ repository.INamespaceDefaultRepository = (*NamespaceDefaultRepository)(nil)
+
+func (u *NamespaceDefaultRepository) InitTable() error {
+	return nil
+}
+
+func (u *NamespaceDefaultRepository) FindNamespaceDefaultByID(id int64) (*model.NamespaceDefault, error) {
+	namespaceDefault := &model.NamespaceDefault{}
+	return namespaceDefault, u.store.get("namespace_default", id, namespaceDefault)
+}
+
+func (u *NamespaceDefaultRepository) FindNamespaceDefaultByNamespace(namespace string) (*model.NamespaceDefault, error) {
+	all, err := u.FindAll()
+	if err != nil {
+		return &model.NamespaceDefault{}, err
+	}
+	for i := range all {
+		if all[i].Namespace == namespace {
+			return &all[i], nil
+		}
+	}
+	return &model.NamespaceDefault{}, gorm.ErrRecordNotFound
+}
+
+func (u *NamespaceDefaultRepository) CreateNamespaceDefault(namespaceDefault *model.NamespaceDefault) (int64, error) {
+	u.store.mu.Lock()
+	namespaceDefault.ID = u.store.newID()
+	u.store.mu.Unlock()
+	return namespaceDefault.ID, u.store.put("namespace_default", namespaceDefault.ID, namespaceDefault)
+}
+
+func (u *NamespaceDefaultRepository) DeleteNamespaceDefaultByID(id int64) error {
+	return u.store.delete("namespace_default", id)
+}
+
+func (u *NamespaceDefaultRepository) UpdateNamespaceDefault(namespaceDefault *model.NamespaceDefault) error {
+	return u.store.update("namespace_default", namespaceDefault.ID, namespaceDefault)
+}
+
+func (u *NamespaceDefaultRepository) FindAll() ([]model.NamespaceDefault, error) {
+	var result []model.NamespaceDefault
+	err := u.store.each("namespace_default", func() interface{} { return &model.NamespaceDefault{} }, func(row interface{}) bool {
+		result = append(result, *row.(*model.NamespaceDefault))
+		return true
+	})
+	return result, err
+}
+
+// NewApplicationRepository 创建applicationRepository
+func NewApplicationRepository(store *Store) repository.IApplicationRepository {
+	return &ApplicationRepository{store: store}
+}
+
+// ApplicationRepository 应用仓库
+type ApplicationRepository struct {
+	store *Store
+}
+
+var _ repository.IApplicationRepository = (*ApplicationRepository)(nil)
+
+func (u *ApplicationRepository) InitTable() error {
+	return nil
+}
+
+func (u *ApplicationRepository) FindApplicationByID(id int64) (*model.Application, error) {
+	application := &model.Application{}
+	return application, u.store.get("application", id, application)
+}
+
+func (u *ApplicationRepository) CreateApplication(application *model.Application) (int64, error) {
+	u.store.mu.Lock()
+	application.ID = u.store.newID()
+	u.store.mu.Unlock()
+	return application.ID, u.store.put("application", application.ID, application)
+}
+
+func (u *ApplicationRepository) DeleteApplicationByID(id int64) error {
+	return u.store.delete("application", id)
+}
+
+func (u *ApplicationRepository) UpdateApplication(application *model.Application) error {
+	return u.store.update("application", application.ID, application)
+}
+
+func (u *ApplicationRepository) FindAll() ([]model.Application, error) {
+	var result []model.Application
+	err := u.store.each("application", func() interface{} { return &model.Application{} }, func(row interface{}) bool {
+		result = append(result, *row.(*model.Application))
+		return true
+	})
+	return result, err
+}
+
+// NewEventRepository 创建eventRepository
+func NewEventRepository(store *Store) repository.IEventRepository {
+	return &EventRepository{store: store}
+}
+
+// EventRepository 事件仓库
+type EventRepository struct {
+	store *Store
+}
+
+var _ repository.IEventRepository = (*EventRepository)(nil)
+
+func (u *EventRepository) InitTable() error {
+	return nil
+}
+
+func (u *EventRepository) CreateEvent(event *model.Event) (int64, error) {
+	u.store.mu.Lock()
+	event.ID = u.store.newID()
+	u.store.mu.Unlock()
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+	return event.ID, u.store.put("event", event.ID, event)
+}
+
+// FindEvents 按时间倒序返回
+func (u *EventRepository) FindEvents(routeID int64, eventType string, since time.Time) ([]model.Event, error) {
+	var result []model.Event
+	err := u.store.each("event", func() interface{} { return &model.Event{} }, func(row interface{}) bool {
+		event := row.(*model.Event)
+		if (routeID == 0 || event.RouteID == routeID) && (eventType == "" || event.EventType == eventType) && (since.IsZero() || !event.CreatedAt.Before(since)) {
+			result = append(result, *event)
+		}
+		return true
+	})
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+	return result, err
+}
+
+// FindEventsAfter 按 ID 升序返回
+func (u *EventRepository) FindEventsAfter(afterID int64, limit int) ([]model.Event, error) {
+	var result []model.Event
+	err := u.store.each("event", func() interface{} { return &model.Event{} }, func(row interface{}) bool {
+		if event := row.(*model.Event); event.ID > afterID {
+			result = append(result, *event)
+		}
+		return true
+	})
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, err
+}
+
+func (u *EventRepository) FindLatestEventID() (int64, error) {
+	var id int64
+	err := u.store.each("event", func() interface{} { return &model.Event{} }, func(row interface{}) bool {
+		if event := row.(*model.Event); event.ID > id {
+			id = event.ID
+		}
+		return true
+	})
+	return id, err
+}
+
+// NewFreezeWindowRepository 创建freezeWindowRepository
+func NewFreezeWindowRepository(store *Store) repository.IFreezeWindowRepository {
+	return &FreezeWindowRepository{store: store}
+}
+
+// FreezeWindowRepository 冻结窗口仓库
+type FreezeWindowRepository struct {
+	store *Store
+}
+
+var _ repository.IFreezeWindowRepository = (*FreezeWindowRepository)(nil)
+
+func (u *FreezeWindowRepository) InitTable() error {
+	return nil
+}
+
+func (u *FreezeWindowRepository) FindFreezeWindowByID(id int64) (*model.FreezeWindow, error) {
+	freezeWindow := &model.FreezeWindow{}
+	return freezeWindow, u.store.get("freeze_window", id, freezeWindow)
+}
+
+func (u *FreezeWindowRepository) CreateFreezeWindow(freezeWindow *model.FreezeWindow) (int64, error) {
+	u.store.mu.Lock()
+	freezeWindow.ID = u.store.newID()
+	u.store.mu.Unlock()
+	return freezeWindow.ID, u.store.put("freeze_window", freezeWindow.ID, freezeWindow)
+}
+
+func (u *FreezeWindowRepository) DeleteFreezeWindowByID(id int64) error {
+	return u.store.delete("freeze_window", id)
+}
+
+func (u *FreezeWindowRepository) UpdateFreezeWindow(freezeWindow *model.FreezeWindow) error {
+	return u.store.update("freeze_window", freezeWindow.ID, freezeWindow)
+}
+
+func (u *FreezeWindowRepository) FindAll() ([]model.FreezeWindow, error) {
+	var result []model.FreezeWindow
+	err := u.store.each("freeze_window", func() interface{} { return &model.FreezeWindow{} }, func(row interface{}) bool {
+		result = append(result, *row.(*model.FreezeWindow))
+		return true
+	})
+	return result, err
+}
+
+// NewReservedHostRepository 创建reservedHostRepository
+func NewReservedHostRepository(store *Store) repository.IReservedHostRepository {
+	return &ReservedHostRepository{store: store}
+}
+
+// ReservedHostRepository 保留域名仓库
+type ReservedHostRepository struct {
+	store *Store
+}
+
+var _ repository.IReservedHostRepository = (*ReservedHostRepository)(nil)
+
+func (u *ReservedHostRepository) InitTable() error {
+	return nil
+}
+
+func (u *ReservedHostRepository) FindReservedHostByID(id int64) (*model.ReservedHost, error) {
+	reservedHost := &model.ReservedHost{}
+	return reservedHost, u.store.get("reserved_host", id, reservedHost)
+}
+
+func (u *ReservedHostRepository) CreateReservedHost(reservedHost *model.ReservedHost) (int64, error) {
+	u.store.mu.Lock()
+	reservedHost.ID = u.store.newID()
+	u.store.mu.Unlock()
+	return reservedHost.ID, u.store.put("reserved_host", reservedHost.ID, reservedHost)
+}
+
+func (u *ReservedHostRepository) DeleteReservedHostByID(id int64) error {
+	return u.store.delete("reserved_host", id)
+}
+
+func (u *ReservedHostRepository) UpdateReservedHost(reservedHost *model.ReservedHost) error {
+	return u.store.update("reserved_host", reservedHost.ID, reservedHost)
+}
+
+func (u *ReservedHostRepository) FindAll() ([]model.ReservedHost, error) {
+	var result []model.ReservedHost
+	err := u.store.each("reserved_host", func() interface{} { return &model.ReservedHost{} }, func(row interface{}) bool {
+		result = append(result, *row.(*model.ReservedHost))
+		return true
+	})
+	return result, err
+}
+
+// NewAnnotationTemplateRepository 创建annotationTemplateRepository
+func NewAnnotationTemplateRepository(store *Store) repository.IAnnotationTemplateRepository {
+	return &AnnotationTemplateRepository{store: store}
+}
+
+// AnnotationTemplateRepository 注解模板仓库
+type AnnotationTemplateRepository struct {
+	store *Store
+}
+
+var _ repository.IAnnotationTemplateRepository = (*AnnotationTemplateRepository)(nil)
+
+func (u *AnnotationTemplateRepository) InitTable() error {
+	return nil
+}
+
+func (u *AnnotationTemplateRepository) FindAnnotationTemplateByID(id int64) (*model.AnnotationTemplate, error) {
+	annotationTemplate := &model.AnnotationTemplate{}
+	return annotationTemplate, u.store.get("annotation_template", id, annotationTemplate)
+}
+
+func (u *AnnotationTemplateRepository) CreateAnnotationTemplate(annotationTemplate *model.AnnotationTemplate) (int64, error) {
+	u.store.mu.Lock()
+	annotationTemplate.ID = u.store.newID()
+	u.store.mu.Unlock()
+	return annotationTemplate.ID, u.store.put("annotation_template", annotationTemplate.ID, annotationTemplate)
+}
+
+func (u *AnnotationTemplateRepository) DeleteAnnotationTemplateByID(id int64) error {
+	return u.store.delete("annotation_template", id)
+}
+
+func (u *AnnotationTemplateRepository) UpdateAnnotationTemplate(annotationTemplate *model.AnnotationTemplate) error {
+	return u.store.update("annotation_template", annotationTemplate.ID, annotationTemplate)
+}
+
+func (u *AnnotationTemplateRepository) FindAll() ([]model.AnnotationTemplate, error) {
+	var result []model.AnnotationTemplate
+	err := u.store.each("annotation_template", func() interface{} { return &model.AnnotationTemplate{} }, func(row interface{}) bool {
+		result = append(result, *row.(*model.AnnotationTemplate))
+		return true
+	})
+	return result, err
+}
+
+// NewRoleBindingRepository 创建roleBindingRepository
+func NewRoleBindingRepository(store *Store) repository.IRoleBindingRepository {
+	return &RoleBindingRepository{store: store}
+}
+
+// RoleBindingRepository 角色绑定仓库
+type RoleBindingRepository struct {
+	store *Store
+}
+
+var _ repository.IRoleBindingRepository = (*RoleBindingRepository)(nil)
+
+func (u *RoleBindingRepository) InitTable() error {
+	return nil
+}
+
+func (u *RoleBindingRepository) CreateRoleBinding(roleBinding *model.RoleBinding) (int64, error) {
+	u.store.mu.Lock()
+	roleBinding.ID = u.store.newID()
+	u.store.mu.Unlock()
+	return roleBinding.ID, u.store.put("role_binding", roleBinding.ID, roleBinding)
+}
+
+func (u *RoleBindingRepository) DeleteRoleBindingByID(id int64) error {
+	return u.store.delete("role_binding", id)
+}
+
+func (u *RoleBindingRepository) FindBySubject(subject string) ([]model.RoleBinding, error) {
+	var result []model.RoleBinding
+	err := u.store.each("role_binding", func() interface{} { return &model.RoleBinding{} }, func(row interface{}) bool {
+		if v := row.(*model.RoleBinding); v.BindingSubject == subject {
+			result = append(result, *v)
+		}
+		return true
+	})
+	return result, err
+}
+
+func (u *RoleBindingRepository) FindAll() ([]model.RoleBinding, error) {
+	var result []model.RoleBinding
+	err := u.store.each("role_binding", func() interface{} { return &model.RoleBinding{} }, func(row interface{}) bool {
+		result = append(result, *row.(*model.RoleBinding))
+		return true
+	})
+	return result, err
+}
+
+// NewAPIKeyRepository 创建apiKeyRepository
+func NewAPIKeyRepository(store *Store) repository.IAPIKeyRepository {
+	return &APIKeyRepository{store: store}
+}
+
+// APIKeyRepository API 密钥仓库
+type APIKeyRepository struct {
+	store *Store
+}
+
+var _ repository.IAPIKeyRepository = (*APIKeyRepository)(nil)
+
+func (u *APIKeyRepository) InitTable() error {
+	return nil
+}
+
+func (u *APIKeyRepository) FindAPIKeyByID(id int64) (*model.APIKey, error) {
+	apiKey := &model.APIKey{}
+	return apiKey, u.store.get("api_key", id, apiKey)
+}
+
+func (u *APIKeyRepository) FindAPIKeyByPrefix(prefix string) (*model.APIKey, error) {
+	var found *model.APIKey
+	err := u.store.each("api_key", func() interface{} { return &model.APIKey{} }, func(row interface{}) bool {
+		if v := row.(*model.APIKey); v.KeyPrefix == prefix {
+			found = v
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return found, nil
+}
+
+func (u *APIKeyRepository) CreateAPIKey(apiKey *model.APIKey) (int64, error) {
+	u.store.mu.Lock()
+	apiKey.ID = u.store.newID()
+	u.store.mu.Unlock()
+	return apiKey.ID, u.store.put("api_key", apiKey.ID, apiKey)
+}
+
+func (u *APIKeyRepository) RevokeAPIKey(id int64, revokedAt int64) error {
+	apiKey, err := u.FindAPIKeyByID(id)
+	if err != nil {
+		return err
+	}
+	apiKey.KeyRevokedAt = revokedAt
+	return u.store.update("api_key", id, apiKey)
+}
+
+func (u *APIKeyRepository) UpdateLastUsed(id int64, lastUsedAt int64) error {
+	apiKey, err := u.FindAPIKeyByID(id)
+	if err != nil {
+		return err
+	}
+	apiKey.KeyLastUsedAt = lastUsedAt
+	return u.store.update("api_key", id, apiKey)
+}
+
+func (u *APIKeyRepository) FindAll() ([]model.APIKey, error) {
+	var result []model.APIKey
+	err := u.store.each("api_key", func() interface{} { return &model.APIKey{} }, func(row interface{}) bool {
+		result = append(result, *row.(*model.APIKey))
+		return true
+	})
+	return result, err
+}
+
+// NewOutboxRepository 创建outboxRepository
+func NewOutboxRepository(store *Store) repository.IOutboxRepository {
+	return &OutboxRepository{store: store}
+}
+
+// OutboxRepository 待发布消息仓库
+type OutboxRepository struct {
+	store *Store
+}
+
+var _ repository.IOutboxRepository = (*OutboxRepository)(nil)
+
+func (u *OutboxRepository) InitTable() error {
+	return nil
+}
+
+// ClaimPendingOutbox 只支持单副本部署，没有其他副本竞争，推迟下次发布时间即可
+func (u *OutboxRepository) ClaimPendingOutbox(now time.Time, lease time.Duration, limit int) ([]model.OutboxMessage, error) {
+	var result []model.OutboxMessage
+	err := u.store.each("outbox", func() interface{} { return &model.OutboxMessage{} }, func(row interface{}) bool {
+		message := row.(*model.OutboxMessage)
+		if message.PublishedAt == nil && !message.DeadLetter && !message.NextAttemptAt.After(now) {
+			result = append(result, *message)
+		}
+		return len(result) < limit
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range result {
+		claimed := result[i]
+		claimed.NextAttemptAt = now.Add(lease)
+		if err := u.store.put("outbox", claimed.ID, &claimed); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (u *OutboxRepository) MarkOutboxPublished(id int64, publishedAt time.Time) error {
+	message := &model.OutboxMessage{}
+	if err := u.store.get("outbox", id, message); err != nil {
+		return nil
+	}
+	message.PublishedAt = &publishedAt
+	message.LastError = ""
+	return u.store.put("outbox", id, message)
+}
+
+func (u *OutboxRepository) MarkOutboxFailed(id int64, lastError string, delivered []string, nextAttemptAt time.Time) error {
+	message := &model.OutboxMessage{}
+	if err := u.store.get("outbox", id, message); err != nil {
+		return nil
+	}
+	message.Attempts++
+	message.LastError = lastError
+	message.DeliveredChannels = delivered
+	message.NextAttemptAt = nextAttemptAt
+	return u.store.put("outbox", id, message)
+}
+
+func (u *OutboxRepository) MarkOutboxDeadLetter(id int64, lastError string, delivered []string) error {
+	message := &model.OutboxMessage{}
+	if err := u.store.get("outbox", id, message); err != nil {
+		return nil
+	}
+	message.Attempts++
+	message.LastError = lastError
+	message.DeliveredChannels = delivered
+	message.DeadLetter = true
+	return u.store.put("outbox", id, message)
+}
+
+// NewQuotaRepository 创建quotaRepository
+func NewQuotaRepository(store *Store) repository.IQuotaRepository {
+	return &QuotaRepository{store: store}
+}
+
+// QuotaRepository 配额仓库
+type QuotaRepository struct {
+	store *Store
+}
+
+var _ repository.IQuotaRepository = (*QuotaRepository)(nil)
+
+func (u *QuotaRepository) InitTable() error {
+	return nil
+}
+
+func (u *QuotaRepository) SaveQuota(quota *model.Quota) error {
+	existing, err := u.FindQuota(quota.QuotaNamespace)
+	switch {
+	case err == nil:
+		quota.ID = existing.ID
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		u.store.mu.Lock()
+		quota.ID = u.store.newID()
+		u.store.mu.Unlock()
+	default:
+		return err
+	}
+	quota.UpdatedAt = time.Now()
+	return u.store.put("quota", quota.ID, quota)
+}
+
+func (u *QuotaRepository) DeleteQuota(namespace string) error {
+	quota, err := u.FindQuota(namespace)
+	if err != nil {
+		return nil
+	}
+	return u.store.delete("quota", quota.ID)
+}
+
+func (u *QuotaRepository) FindQuota(namespace string) (*model.Quota, error) {
+	var found *model.Quota
+	err := u.store.each("quota", func() interface{} { return &model.Quota{} }, func(row interface{}) bool {
+		if v := row.(*model.Quota); v.QuotaNamespace == namespace {
+			found = v
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return found, nil
+}
+
+func (u *QuotaRepository) FindAll() ([]model.Quota, error) {
+	var result []model.Quota
+	err := u.store.each("quota", func() interface{} { return &model.Quota{} }, func(row interface{}) bool {
+		result = append(result, *row.(*model.Quota))
+		return true
+	})
+	return result, err
+}
+
+func (u *QuotaRepository) findCounter(namespace string, day string) (*model.QuotaCounter, error) {
+	var found *model.QuotaCounter
+	err := u.store.each("quota_counter", func() interface{} { return &model.QuotaCounter{} }, func(row interface{}) bool {
+		if v := row.(*model.QuotaCounter); v.CounterNamespace == namespace && v.CounterDay == day {
+			found = v
+			return false
+		}
+		return true
+	})
+	return found, err
+}
+
+func (u *QuotaRepository) IncrementCreated(namespace string, day string) error {
+	counter, err := u.findCounter(namespace, day)
+	if err != nil {
+		return err
+	}
+	if counter == nil {
+		u.store.mu.Lock()
+		counter = &model.QuotaCounter{ID: u.store.newID(), CounterNamespace: namespace, CounterDay: day}
+		u.store.mu.Unlock()
+	}
+	counter.CounterCreated++
+	return u.store.put("quota_counter", counter.ID, counter)
+}
+
+func (u *QuotaRepository) FindCreated(namespace string, day string) (int64, error) {
+	counter, err := u.findCounter(namespace, day)
+	if err != nil || counter == nil {
+		return 0, err
+	}
+	return counter.CounterCreated, nil
+}
+
+// NewUsageSnapshotRepository 创建usageSnapshotRepository
+func NewUsageSnapshotRepository(store *Store) repository.IUsageSnapshotRepository {
+	return &UsageSnapshotRepository{store: store}
+}
+
+// UsageSnapshotRepository 用量快照仓库
+type UsageSnapshotRepository struct {
+	store *Store
+}
+
+var _ repository.IUsageSnapshotRepository = (*UsageSnapshotRepository)(nil)
+
+func (u *UsageSnapshotRepository) InitTable() error {
+	return nil
+}
+
+func (u *UsageSnapshotRepository) SaveSnapshots(day string, snapshots []model.UsageSnapshot) error {
+	stale := []int64{}
+	if err := u.store.each("usage_snapshot", func() interface{} { return &model.UsageSnapshot{} }, func(row interface{}) bool {
+		if v := row.(*model.UsageSnapshot); v.SnapshotDay == day {
+			stale = append(stale, v.ID)
+		}
+		return true
+	}); err != nil {
+		return err
+	}
+	for _, id := range stale {
+		if err := u.store.delete("usage_snapshot", id); err != nil {
+			return err
+		}
+	}
+	for i := range snapshots {
+		u.store.mu.Lock()
+		snapshots[i].ID = u.store.newID()
+		u.store.mu.Unlock()
+		snapshots[i].CreatedAt = time.Now()
+		if err := u.store.put("usage_snapshot", snapshots[i].ID, &snapshots[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *UsageSnapshotRepository) FindSnapshots(from string, to string) ([]model.UsageSnapshot, error) {
+	var result []model.UsageSnapshot
+	err := u.store.each("usage_snapshot", func() interface{} { return &model.UsageSnapshot{} }, func(row interface{}) bool {
+		if v := row.(*model.UsageSnapshot); v.SnapshotDay >= from && v.SnapshotDay <= to {
+			result = append(result, *v)
+		}
+		return true
+	})
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].SnapshotDay != result[j].SnapshotDay {
+			return result[i].SnapshotDay < result[j].SnapshotDay
+		}
+		if result[i].SnapshotNamespace != result[j].SnapshotNamespace {
+			return result[i].SnapshotNamespace < result[j].SnapshotNamespace
+		}
+		return result[i].SnapshotTeam < result[j].SnapshotTeam
+	})
+	return result, err
+}
+
+// NewRetentionRepository 创建retentionRepository
+func NewRetentionRepository(store *Store) repository.IRetentionRepository {
+	return &RetentionRepository{store: store}
+}
+
+// RetentionRepository 历史数据清理，没有冷表，不支持归档
+type RetentionRepository struct {
+	store *Store
+}
+
+var _ repository.IRetentionRepository = (*RetentionRepository)(nil)
+
+func (u *RetentionRepository) Prune(table string, before time.Time, keepRows int64, archive bool) (int64, error) {
+	if archive {
+		return 0, errors.New("当前存储后端不支持归档到冷表：" + table)
+	}
+	//返回ID、写入时间以及能否清理
+	var name string
+	var rowInfo func(row interface{}) (int64, time.Time, bool)
+	switch table {
+	case repository.RetentionEvents:
+		name = "event"
+		rowInfo = func(row interface{}) (int64, time.Time, bool) {
+			event := row.(*model.Event)
+			return event.ID, event.CreatedAt, true
+		}
+	case repository.RetentionOutbox:
+		name = "outbox"
+		rowInfo = func(row interface{}) (int64, time.Time, bool) {
+			message := row.(*model.OutboxMessage)
+			return message.ID, message.CreatedAt, message.PublishedAt != nil
+		}
+	case repository.RetentionArchives:
+		name = "route_archive"
+		rowInfo = func(row interface{}) (int64, time.Time, bool) {
+			archive := row.(*model.RouteArchive)
+			return archive.ID, archive.CreatedAt, true
+		}
+	case repository.RetentionUsageSnapshots:
+		name = "usage_snapshot"
+		rowInfo = func(row interface{}) (int64, time.Time, bool) {
+			snapshot := row.(*model.UsageSnapshot)
+			return snapshot.ID, snapshot.CreatedAt, true
+		}
+	default:
+		return 0, errors.New("不支持清理的表：" + table)
+	}
+	type candidate struct {
+		id        int64
+		createdAt time.Time
+	}
+	var candidates []candidate
+	err := u.store.each(name, tables[name], func(row interface{}) bool {
+		if id, createdAt, ok := rowInfo(row); ok {
+			candidates = append(candidates, candidate{id: id, createdAt: createdAt})
+		}
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+	//按ID从新到旧，保留最新的 keepRows 行
+	var pruned int64
+	for i := len(candidates) - 1; i >= 0; i-- {
+		newer := int64(len(candidates) - 1 - i)
+		expired := !before.IsZero() && candidates[i].createdAt.Before(before)
+		if !expired && (keepRows <= 0 || newer < keepRows) {
+			continue
+		}
+		if err := u.store.delete(name, candidates[i].id); err != nil {
+			return pruned, err
+		}
+		pruned++
+	}
+	return pruned, nil
+}
+
+func (u *RouteRepository) Version() (int64, time.Time, error) {
+	routes, err := u.FindAll()
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	var updatedAt time.Time
+	for _, v := range routes {
+		if v.UpdatedAt.After(updatedAt) {
+			updatedAt = v.UpdatedAt
+		}
+	}
+	return int64(len(routes)), updatedAt, nil
+}
@@ -0,0 +1,261 @@
+// Package kvstore 不依赖 mysql 的仓库实现，数据表保存在内存中，可以通过 Persister 持久化到集群或 redis
+package kvstore
+
+import (
+	"bytes"
+	"encoding/gob"
+	"github.com/zxnlx/route/domain/model"
+	"gorm.io/gorm"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// Persister 持久化数据表，每次写入后同步保存，启动时全部加载到内存
+type Persister interface {
+	// Load 读取一张表的所有行
+	Load(table string, newRow func() interface{}, fn func(id int64, row interface{})) error
+	Save(table string, id int64, row interface{}) error
+	Delete(table string, id int64) error
+}
+
+// 各仓库使用的数据表
+var tables = map[string]func() interface{}{
+	"route":               func() interface{} { return &model.Route{} },
+	"route_archive":       func() interface{} { return &model.RouteArchive{} },
+	"namespace_default":   func() interface{} { return &model.NamespaceDefault{} },
+	"application":         func() interface{} { return &model.Application{} },
+	"event":               func() interface{} { return &model.Event{} },
+	"freeze_window":       func() interface{} { return &model.FreezeWindow{} },
+	"outbox":              func() interface{} { return &model.OutboxMessage{} },
+	"annotation_template": func() interface{} { return &model.AnnotationTemplate{} },
+	"role_binding":        func() interface{} { return &model.RoleBinding{} },
+	"api_key":             func() interface{} { return &model.APIKey{} },
+	"quota":               func() interface{} { return &model.Quota{} },
+	"quota_counter":       func() interface{} { return &model.QuotaCounter{} },
+	"usage_snapshot":      func() interface{} { return &model.UsageSnapshot{} },
+	"reserved_host":       func() interface{} { return &model.ReservedHost{} },
+}
+
+// Store 数据表，实现各个仓库接口
+// 读写都会深拷贝，调用方修改返回值不会影响存储的数据
+type Store struct {
+	mu sync.Mutex
+	//事务串行执行
+	txMu sync.Mutex
+	//路由按命名空间和名称唯一，查找和写入在同一把锁内
+	routeMu   sync.Mutex
+	nextID    int64
+	tables    map[string]map[int64]interface{}
+	persister Persister
+}
+
+// NewMemoryStore 只保存在内存中，用于端到端测试
+func NewMemoryStore() *Store {
+	return &Store{tables: map[string]map[int64]interface{}{}}
+}
+
+// NewStore 从 persister 加载所有数据表，之后的写入同步保存，ID 从已有的最大值继续分配
+// 写入在同一把锁内完成，只支持单副本部署
+func NewStore(persister Persister) (*Store, error) {
+	s := &Store{tables: map[string]map[int64]interface{}{}, persister: persister}
+	for name, newRow := range tables {
+		t := s.table(name)
+		err := persister.Load(name, newRow, func(id int64, row interface{}) {
+			t[id] = row
+			if id > s.nextID {
+				s.nextID = id
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func deepCopy(dst interface{}, src interface{}) error {
+	buf := &bytes.Buffer{}
+	if err := gob.NewEncoder(buf).Encode(src); err != nil {
+		return err
+	}
+	return gob.NewDecoder(buf).Decode(dst)
+}
+
+func (s *Store) table(name string) map[int64]interface{} {
+	t, ok := s.tables[name]
+	if !ok {
+		t = map[int64]interface{}{}
+		s.tables[name] = t
+	}
+	return t
+}
+
+func (s *Store) newID() int64 {
+	s.nextID++
+	return s.nextID
+}
+
+func (s *Store) get(name string, id int64, dst interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	row, ok := s.table(name)[id]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	return deepCopy(dst, row)
+}
+
+func (s *Store) put(name string, id int64, row interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := reflect.New(reflect.TypeOf(row).Elem()).Interface()
+	if err := deepCopy(stored, row); err != nil {
+		return err
+	}
+	if s.persister != nil {
+		if err := s.persister.Save(name, id, stored); err != nil {
+			return err
+		}
+	}
+	s.table(name)[id] = stored
+	return nil
+}
+
+// 与 gorm Updates 一致，零值字段不覆盖
+func (s *Store) update(name string, id int64, row interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, ok := s.table(name)[id]
+	if !ok {
+		return nil
+	}
+	src := reflect.New(reflect.TypeOf(row).Elem())
+	if err := deepCopy(src.Interface(), row); err != nil {
+		return err
+	}
+	updated := reflect.New(reflect.TypeOf(current).Elem())
+	if err := deepCopy(updated.Interface(), current); err != nil {
+		return err
+	}
+	dst := updated.Elem()
+	for i := 0; i < src.Elem().NumField(); i++ {
+		if field := src.Elem().Field(i); !field.IsZero() {
+			dst.Field(i).Set(field)
+		}
+	}
+	if s.persister != nil {
+		if err := s.persister.Save(name, id, updated.Interface()); err != nil {
+			return err
+		}
+	}
+	s.table(name)[id] = updated.Interface()
+	return nil
+}
+
+// 持久化失败时保留内存中的数据，可以重试
+func (s *Store) delete(name string, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.persister != nil {
+		if err := s.persister.Delete(name, id); err != nil {
+			return err
+		}
+	}
+	delete(s.table(name), id)
+	return nil
+}
+
+// undoLog 记录事务中修改的行在修改前的内容，出错时按相反顺序恢复
+type undoLog struct {
+	entries []undoEntry
+	touched map[string]bool
+}
+
+type undoEntry struct {
+	table   string
+	id      int64
+	row     interface{}
+	existed bool
+}
+
+// 每行只记录第一次修改前的内容
+func (s *Store) record(log *undoLog, name string, id int64) error {
+	if log == nil {
+		return nil
+	}
+	key := name + "/" + strconv.FormatInt(id, 10)
+	if log.touched[key] {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry := undoEntry{table: name, id: id}
+	if row, ok := s.table(name)[id]; ok {
+		entry.row = reflect.New(reflect.TypeOf(row).Elem()).Interface()
+		if err := deepCopy(entry.row, row); err != nil {
+			return err
+		}
+		entry.existed = true
+	}
+	log.touched[key] = true
+	log.entries = append(log.entries, entry)
+	return nil
+}
+
+// 恢复时同样写入 persister，返回第一个错误，其余的行继续恢复
+func (s *Store) rollback(log *undoLog) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var first error
+	for i := len(log.entries) - 1; i >= 0; i-- {
+		entry := log.entries[i]
+		var err error
+		if entry.existed {
+			if s.persister != nil {
+				err = s.persister.Save(entry.table, entry.id, entry.row)
+			}
+			if err == nil {
+				s.table(entry.table)[entry.id] = entry.row
+			}
+		} else {
+			if s.persister != nil {
+				err = s.persister.Delete(entry.table, entry.id)
+			}
+			if err == nil {
+				delete(s.table(entry.table), entry.id)
+			}
+		}
+		if err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// 按ID顺序遍历，fn 拿到的是拷贝
+func (s *Store) each(name string, newRow func() interface{}, fn func(row interface{}) bool) error {
+	s.mu.Lock()
+	ids := make([]int64, 0, len(s.table(name)))
+	for id := range s.table(name) {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	rows := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		row := newRow()
+		if err := deepCopy(row, s.table(name)[id]); err != nil {
+			s.mu.Unlock()
+			return err
+		}
+		rows = append(rows, row)
+	}
+	s.mu.Unlock()
+	for _, row := range rows {
+		if !fn(row) {
+			break
+		}
+	}
+	return nil
+}
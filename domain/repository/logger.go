@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/metrics"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// LoggerConfig 数据库日志配置，从配置中心的 route.db_log 节点读取
+type LoggerConfig struct {
+	// Level 为 silent、error、warn、info，默认 warn，info 时记录所有 SQL
+	Level string `json:"level"`
+	// SlowThresholdMs 慢查询阈值，默认 200，为负数时不检测
+	SlowThresholdMs int64 `json:"slow_threshold_ms"`
+	// LogNotFound 是否把记录不存在当作错误记录
+	LogNotFound bool `json:"log_not_found"`
+}
+
+func (c LoggerConfig) level() logger.LogLevel {
+	switch c.Level {
+	case "silent":
+		return logger.Silent
+	case "error":
+		return logger.Error
+	case "info":
+		return logger.Info
+	}
+	return logger.Warn
+}
+
+func (c LoggerConfig) slowThreshold() time.Duration {
+	if c.SlowThresholdMs == 0 {
+		return 200 * time.Millisecond
+	}
+	return time.Duration(c.SlowThresholdMs) * time.Millisecond
+}
+
+// NewLogger 把 gorm 的日志写入服务日志，并按仓库方法记录查询耗时和慢查询数
+func NewLogger(config LoggerConfig) logger.Interface {
+	return &gormLogger{config: config, level: config.level()}
+}
+
+type gormLogger struct {
+	config LoggerConfig
+	level  logger.LogLevel
+}
+
+func (l *gormLogger) LogMode(level logger.LogLevel) logger.Interface {
+	copied := *l
+	copied.level = level
+	return &copied
+}
+
+func (l *gormLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Info {
+		common.Infof(msg, data...)
+	}
+}
+
+func (l *gormLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Warn {
+		common.Warnf(msg, data...)
+	}
+}
+
+func (l *gormLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Error {
+		common.Errorf(msg, data...)
+	}
+}
+
+// Trace 每条 SQL 执行后调用，指标不受日志级别影响
+func (l *gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	elapsed := time.Since(begin)
+	call := repositoryCall()
+	threshold := l.config.slowThreshold()
+	slow := l.config.SlowThresholdMs >= 0 && elapsed > threshold
+	if errors.Is(err, gorm.ErrRecordNotFound) && !l.config.LogNotFound {
+		err = nil
+	}
+	metrics.ObserveQuery(call, elapsed, slow, err)
+	switch {
+	case err != nil && l.level >= logger.Error:
+		sql, rows := fc()
+		common.Errorf("%s 查询失败：%v，耗时 %s，行数 %d，SQL：%s", call, err, elapsed, rows, sql)
+	case slow && l.level >= logger.Warn:
+		sql, rows := fc()
+		common.Warnf("%s 慢查询，耗时 %s 超过 %s，行数 %d，SQL：%s", call, elapsed, threshold, rows, sql)
+	case l.level >= logger.Info:
+		sql, rows := fc()
+		common.Infof("%s 耗时 %s，行数 %d，SQL：%s", call, elapsed, rows, sql)
+	}
+}
+
+// 调用栈中第一个仓库方法，如 RouteRepository.FindAll，不在仓库中发起的查询为 unknown
+func repositoryCall() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if i := strings.Index(frame.Function, "/domain/repository."); i >= 0 {
+			name := frame.Function[i+len("/domain/repository."):]
+			name = strings.NewReplacer("(*", "", ")", "").Replace(name)
+			//事务等闭包去掉 .func1 后缀
+			if j := strings.Index(name, ".func"); j >= 0 {
+				name = name[:j]
+			}
+			if !strings.HasPrefix(name, "gormLogger") && !strings.HasPrefix(name, "repositoryCall") {
+				return name
+			}
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
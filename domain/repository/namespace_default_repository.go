@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"github.com/zxnlx/route/domain/model"
+	"gorm.io/gorm"
+)
+
+// INamespaceDefaultRepository 命名空间默认配置需要实现的接口
+type INamespaceDefaultRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+	// FindNamespaceDefaultByID 根据ID查找数据
+	FindNamespaceDefaultByID(int64) (*model.NamespaceDefault, error)
+	// FindNamespaceDefaultByNamespace 根据命名空间查找数据
+	FindNamespaceDefaultByNamespace(string) (*model.NamespaceDefault, error)
+	// CreateNamespaceDefault 创建一条数据
+	CreateNamespaceDefault(*model.NamespaceDefault) (int64, error)
+	// DeleteNamespaceDefaultByID 根据ID删除一条数据
+	DeleteNamespaceDefaultByID(int64) error
+	// UpdateNamespaceDefault 修改更新数据
+	UpdateNamespaceDefault(*model.NamespaceDefault) error
+	// FindAll 查找所有数据
+	FindAll() ([]model.NamespaceDefault, error)
+}
+
+// NewNamespaceDefaultRepository 创建namespaceDefaultRepository
+func NewNamespaceDefaultRepository(db *gorm.DB) INamespaceDefaultRepository {
+	return &NamespaceDefaultRepository{db: db}
+}
+
+type NamespaceDefaultRepository struct {
+	db *gorm.DB
+}
+
+func (u *NamespaceDefaultRepository) InitTable() error {
+	return u.db.AutoMigrate(&model.NamespaceDefault{})
+}
+
+// FindNamespaceDefaultByID 根据ID查找
+func (u *NamespaceDefaultRepository) FindNamespaceDefaultByID(id int64) (namespaceDefault *model.NamespaceDefault, err error) {
+	namespaceDefault = &model.NamespaceDefault{}
+	return namespaceDefault, u.db.First(namespaceDefault, id).Error
+}
+
+// FindNamespaceDefaultByNamespace 根据命名空间查找
+func (u *NamespaceDefaultRepository) FindNamespaceDefaultByNamespace(namespace string) (namespaceDefault *model.NamespaceDefault, err error) {
+	namespaceDefault = &model.NamespaceDefault{}
+	return namespaceDefault, u.db.Where("namespace = ?", namespace).First(namespaceDefault).Error
+}
+
+// CreateNamespaceDefault 创建
+func (u *NamespaceDefaultRepository) CreateNamespaceDefault(namespaceDefault *model.NamespaceDefault) (int64, error) {
+	return namespaceDefault.ID, u.db.Create(namespaceDefault).Error
+}
+
+// DeleteNamespaceDefaultByID 根据ID删除
+func (u *NamespaceDefaultRepository) DeleteNamespaceDefaultByID(id int64) error {
+	return u.db.Where("id = ?", id).Delete(&model.NamespaceDefault{}).Error
+}
+
+// UpdateNamespaceDefault 更新
+func (u *NamespaceDefaultRepository) UpdateNamespaceDefault(namespaceDefault *model.NamespaceDefault) error {
+	return u.db.Model(namespaceDefault).Updates(namespaceDefault).Error
+}
+
+// FindAll 获取结果集
+func (u *NamespaceDefaultRepository) FindAll() (namespaceDefaultAll []model.NamespaceDefault, err error) {
+	return namespaceDefaultAll, u.db.Find(&namespaceDefaultAll).Error
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"encoding/json"
+	"github.com/zxnlx/route/domain/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// IOutboxRepository outbox 需要实现的接口，写入由 IRouteRepository 在事务中完成
+type IOutboxRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+	// ClaimPendingOutbox 领取到期未发布的消息，领取后下次发布时间推迟 lease，其他副本不会重复领取
+	ClaimPendingOutbox(time.Time, time.Duration, int) ([]model.OutboxMessage, error)
+	// MarkOutboxPublished 标记已发布
+	MarkOutboxPublished(int64, time.Time) error
+	// MarkOutboxFailed 记录失败、已发送成功的渠道，并设置下次发布时间
+	MarkOutboxFailed(int64, string, []string, time.Time) error
+	// MarkOutboxDeadLetter 超过最大发布次数，不再重试
+	MarkOutboxDeadLetter(int64, string, []string) error
+}
+
+// NewOutboxRepository 创建outboxRepository
+func NewOutboxRepository(db *gorm.DB) IOutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+type OutboxRepository struct {
+	db *gorm.DB
+}
+
+func (u *OutboxRepository) InitTable() error {
+	return u.db.AutoMigrate(&model.OutboxMessage{})
+}
+
+// ClaimPendingOutbox 按写入顺序领取，SKIP LOCKED 跳过其他副本正在领取的行
+func (u *OutboxRepository) ClaimPendingOutbox(now time.Time, lease time.Duration, limit int) (outboxAll []model.OutboxMessage, err error) {
+	err = u.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("published_at IS NULL AND dead_letter = ? AND next_attempt_at <= ?", false, now).
+			Order("id").Limit(limit).Find(&outboxAll).Error; err != nil {
+			return err
+		}
+		if len(outboxAll) == 0 {
+			return nil
+		}
+		ids := make([]int64, 0, len(outboxAll))
+		for _, v := range outboxAll {
+			ids = append(ids, v.ID)
+		}
+		return tx.Model(&model.OutboxMessage{}).Where("id IN ?", ids).Update("next_attempt_at", now.Add(lease)).Error
+	})
+	return outboxAll, err
+}
+
+// MarkOutboxPublished 标记已发布
+func (u *OutboxRepository) MarkOutboxPublished(id int64, publishedAt time.Time) error {
+	return u.db.Model(&model.OutboxMessage{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"published_at": publishedAt,
+		"last_error":   "",
+	}).Error
+}
+
+// MarkOutboxFailed 失败次数加一
+func (u *OutboxRepository) MarkOutboxFailed(id int64, lastError string, delivered []string, nextAttemptAt time.Time) error {
+	return u.db.Model(&model.OutboxMessage{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"attempts":           gorm.Expr("attempts + 1"),
+		"last_error":         lastError,
+		"delivered_channels": channelsJSON(delivered),
+		"next_attempt_at":    nextAttemptAt,
+	}).Error
+}
+
+// MarkOutboxDeadLetter 失败次数加一并停止重试
+func (u *OutboxRepository) MarkOutboxDeadLetter(id int64, lastError string, delivered []string) error {
+	return u.db.Model(&model.OutboxMessage{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"attempts":           gorm.Expr("attempts + 1"),
+		"last_error":         lastError,
+		"delivered_channels": channelsJSON(delivered),
+		"dead_letter":        true,
+	}).Error
+}
+
+// map 更新不经过模型的 serializer，需要自己序列化
+func channelsJSON(channels []string) string {
+	if channels == nil {
+		channels = []string{}
+	}
+	data, _ := json.Marshal(channels)
+	return string(data)
+}
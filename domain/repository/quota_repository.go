@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"github.com/zxnlx/route/domain/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IQuotaRepository 配额需要实现的接口
+type IQuotaRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+	// SaveQuota 按命名空间创建或更新配额
+	SaveQuota(*model.Quota) error
+	// DeleteQuota 删除命名空间的配额
+	DeleteQuota(string) error
+	// FindQuota 查找命名空间的配额
+	FindQuota(string) (*model.Quota, error)
+	// FindAll 查找所有配额
+	FindAll() ([]model.Quota, error)
+	// IncrementCreated 命名空间当天创建的路由数加一
+	IncrementCreated(string, string) error
+	// FindCreated 命名空间当天创建的路由数
+	FindCreated(string, string) (int64, error)
+}
+
+// NewQuotaRepository 创建quotaRepository
+func NewQuotaRepository(db *gorm.DB) IQuotaRepository {
+	return &QuotaRepository{db: db}
+}
+
+type QuotaRepository struct {
+	db *gorm.DB
+}
+
+func (u *QuotaRepository) InitTable() error {
+	return u.db.AutoMigrate(&model.Quota{}, &model.QuotaCounter{})
+}
+
+// SaveQuota 命名空间已有配额时覆盖
+func (u *QuotaRepository) SaveQuota(quota *model.Quota) error {
+	return u.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "quota_namespace"}},
+		DoUpdates: clause.AssignmentColumns([]string{"quota_max_routes", "quota_max_hosts", "quota_max_routes_per_day", "quota_updated_by", "updated_at"}),
+	}).Create(quota).Error
+}
+
+// DeleteQuota 删除
+func (u *QuotaRepository) DeleteQuota(namespace string) error {
+	return u.db.Where("quota_namespace = ?", namespace).Delete(&model.Quota{}).Error
+}
+
+// FindQuota 根据命名空间查找
+func (u *QuotaRepository) FindQuota(namespace string) (*model.Quota, error) {
+	quota := &model.Quota{}
+	return quota, u.db.Where("quota_namespace = ?", namespace).First(quota).Error
+}
+
+// FindAll 获取结果集
+func (u *QuotaRepository) FindAll() (quotaAll []model.Quota, err error) {
+	return quotaAll, u.db.Find(&quotaAll).Error
+}
+
+// IncrementCreated 多个副本同时创建时由数据库保证计数准确
+func (u *QuotaRepository) IncrementCreated(namespace string, day string) error {
+	return u.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "counter_namespace"}, {Name: "counter_day"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"counter_created": gorm.Expr("counter_created + 1")}),
+	}).Create(&model.QuotaCounter{CounterNamespace: namespace, CounterDay: day, CounterCreated: 1}).Error
+}
+
+// FindCreated 没有记录时为 0
+func (u *QuotaRepository) FindCreated(namespace string, day string) (int64, error) {
+	var created int64
+	err := u.db.Model(&model.QuotaCounter{}).Where("counter_namespace = ? AND counter_day = ?", namespace, day).Select("counter_created").Scan(&created).Error
+	return created, err
+}
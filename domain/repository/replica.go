@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// ReplicaResolver 只读副本的 resolver 名称，列表和统计查询通过它读取
+const ReplicaResolver = "replicas"
+
+// ReplicaConfig 只读副本，未配置时所有查询使用主库
+type ReplicaConfig struct {
+	// DSNs 副本的连接串，格式同主库
+	DSNs []string `json:"dsns"`
+	// MaxOpenConns 每个副本的最大连接数，为 0 时不限制
+	MaxOpenConns int `json:"max_open_conns"`
+}
+
+// UseReplicas 注册只读副本，只有显式指定副本的查询会读副本，其余读写仍使用主库，避免写后立即读到旧数据
+func UseReplicas(db *gorm.DB, config ReplicaConfig) error {
+	if len(config.DSNs) == 0 {
+		return nil
+	}
+	replicas := make([]gorm.Dialector, 0, len(config.DSNs))
+	for _, dsn := range config.DSNs {
+		replicas = append(replicas, mysql.Open(dsn))
+	}
+	resolver := dbresolver.Register(dbresolver.Config{Replicas: replicas, Policy: dbresolver.RandomPolicy{}}, ReplicaResolver)
+	if config.MaxOpenConns > 0 {
+		resolver.SetMaxOpenConns(config.MaxOpenConns)
+	}
+	return db.Use(resolver)
+}
+
+// 副本可能有复制延迟，只用于可以接受短暂旧数据的列表和统计
+func readReplica(db *gorm.DB) *gorm.DB {
+	return db.Clauses(dbresolver.Use(ReplicaResolver))
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"github.com/zxnlx/route/domain/model"
+	"gorm.io/gorm"
+)
+
+// IReservedHostRepository 保留域名需要实现的接口
+type IReservedHostRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+	// FindReservedHostByID 根据ID查找数据
+	FindReservedHostByID(int64) (*model.ReservedHost, error)
+	// CreateReservedHost 创建一条数据
+	CreateReservedHost(*model.ReservedHost) (int64, error)
+	// DeleteReservedHostByID 根据ID删除一条数据
+	DeleteReservedHostByID(int64) error
+	// UpdateReservedHost 修改更新数据
+	UpdateReservedHost(*model.ReservedHost) error
+	// FindAll 查找所有数据
+	FindAll() ([]model.ReservedHost, error)
+}
+
+// NewReservedHostRepository 创建reservedHostRepository
+func NewReservedHostRepository(db *gorm.DB) IReservedHostRepository {
+	return &ReservedHostRepository{db: db}
+}
+
+type ReservedHostRepository struct {
+	db *gorm.DB
+}
+
+func (u *ReservedHostRepository) InitTable() error {
+	return u.db.AutoMigrate(&model.ReservedHost{})
+}
+
+// FindReservedHostByID 根据ID查找
+func (u *ReservedHostRepository) FindReservedHostByID(id int64) (reservedHost *model.ReservedHost, err error) {
+	reservedHost = &model.ReservedHost{}
+	return reservedHost, u.db.First(reservedHost, id).Error
+}
+
+// CreateReservedHost 创建
+func (u *ReservedHostRepository) CreateReservedHost(reservedHost *model.ReservedHost) (int64, error) {
+	return reservedHost.ID, u.db.Create(reservedHost).Error
+}
+
+// DeleteReservedHostByID 根据ID删除
+func (u *ReservedHostRepository) DeleteReservedHostByID(id int64) error {
+	return u.db.Where("id = ?", id).Delete(&model.ReservedHost{}).Error
+}
+
+// UpdateReservedHost 更新
+func (u *ReservedHostRepository) UpdateReservedHost(reservedHost *model.ReservedHost) error {
+	return u.db.Model(reservedHost).Updates(reservedHost).Error
+}
+
+// FindAll 获取结果集
+func (u *ReservedHostRepository) FindAll() (reservedHostAll []model.ReservedHost, err error) {
+	return reservedHostAll, u.db.Find(&reservedHostAll).Error
+}
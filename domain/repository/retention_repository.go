@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"errors"
+	"github.com/zxnlx/route/domain/model"
+	"gorm.io/gorm"
+	"time"
+)
+
+// 可以按保留策略清理的表
+const (
+	RetentionEvents         = "events"
+	RetentionOutbox         = "outbox"
+	RetentionArchives       = "archives"
+	RetentionUsageSnapshots = "usage_snapshots"
+)
+
+// 每批删除的行数，避免长事务锁表
+const retentionBatchSize = 1000
+
+// IRetentionRepository 历史数据清理需要实现的接口
+type IRetentionRepository interface {
+	// Prune 删除早于指定时间或超出保留行数的记录，archive 为 true 时先复制到冷表，返回删除的行数
+	Prune(string, time.Time, int64, bool) (int64, error)
+}
+
+// NewRetentionRepository 创建retentionRepository
+func NewRetentionRepository(db *gorm.DB) IRetentionRepository {
+	return &RetentionRepository{db: db}
+}
+
+type RetentionRepository struct {
+	db *gorm.DB
+}
+
+// 未发布的 outbox 消息不能清理
+func retentionScope(table string) (interface{}, string, error) {
+	switch table {
+	case RetentionEvents:
+		return &model.Event{}, "", nil
+	case RetentionOutbox:
+		return &model.OutboxMessage{}, "published_at IS NOT NULL", nil
+	case RetentionArchives:
+		return &model.RouteArchive{}, "", nil
+	case RetentionUsageSnapshots:
+		return &model.UsageSnapshot{}, "", nil
+	}
+	return nil, "", errors.New("不支持清理的表：" + table)
+}
+
+// Prune 按ID分批处理，冷表为原表名加 _cold，结构和原表相同；before 为零值时不按时间清理，keepRows 为 0 时不按行数清理
+func (u *RetentionRepository) Prune(table string, before time.Time, keepRows int64, archive bool) (int64, error) {
+	value, condition, err := retentionScope(table)
+	if err != nil {
+		return 0, err
+	}
+	stmt := &gorm.Statement{DB: u.db}
+	if err := stmt.Parse(value); err != nil {
+		return 0, err
+	}
+	tableName := stmt.Schema.Table
+	scope := func() *gorm.DB {
+		tx := u.db.Model(value)
+		if condition != "" {
+			tx = tx.Where(condition)
+		}
+		return tx
+	}
+	//保留最新的 keepRows 行，第 keepRows+1 行及更早的都清理
+	var cutoffID int64
+	if keepRows > 0 {
+		var ids []int64
+		if err := scope().Order("id DESC").Offset(int(keepRows)).Limit(1).Pluck("id", &ids).Error; err != nil {
+			return 0, err
+		}
+		if len(ids) > 0 {
+			cutoffID = ids[0]
+		}
+	}
+	if before.IsZero() && cutoffID == 0 {
+		return 0, nil
+	}
+	if archive {
+		if err := u.db.Exec("CREATE TABLE IF NOT EXISTS `" + tableName + "_cold` LIKE `" + tableName + "`").Error; err != nil {
+			return 0, err
+		}
+	}
+	var pruned int64
+	for {
+		tx := scope()
+		switch {
+		case !before.IsZero() && cutoffID > 0:
+			tx = tx.Where("(created_at < ? OR id <= ?)", before, cutoffID)
+		case cutoffID > 0:
+			tx = tx.Where("id <= ?", cutoffID)
+		default:
+			tx = tx.Where("created_at < ?", before)
+		}
+		var ids []int64
+		if err := tx.Order("id").Limit(retentionBatchSize).Pluck("id", &ids).Error; err != nil {
+			return pruned, err
+		}
+		if len(ids) == 0 {
+			return pruned, nil
+		}
+		err := u.db.Transaction(func(tx *gorm.DB) error {
+			if archive {
+				if err := tx.Exec("INSERT IGNORE INTO `"+tableName+"_cold` SELECT * FROM `"+tableName+"` WHERE id IN ?", ids).Error; err != nil {
+					return err
+				}
+			}
+			return tx.Where("id IN ?", ids).Delete(value).Error
+		})
+		if err != nil {
+			return pruned, err
+		}
+		pruned += int64(len(ids))
+		if len(ids) < retentionBatchSize {
+			return pruned, nil
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"github.com/zxnlx/route/domain/model"
+	"gorm.io/gorm"
+)
+
+// IRoleBindingRepository 角色绑定需要实现的接口
+type IRoleBindingRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+	// CreateRoleBinding 创建一条数据
+	CreateRoleBinding(*model.RoleBinding) (int64, error)
+	// DeleteRoleBindingByID 根据ID删除一条数据
+	DeleteRoleBindingByID(int64) error
+	// FindBySubject 查找调用方的所有角色
+	FindBySubject(string) ([]model.RoleBinding, error)
+	// FindAll 查找所有数据
+	FindAll() ([]model.RoleBinding, error)
+}
+
+// NewRoleBindingRepository 创建roleBindingRepository
+func NewRoleBindingRepository(db *gorm.DB) IRoleBindingRepository {
+	return &RoleBindingRepository{db: db}
+}
+
+type RoleBindingRepository struct {
+	db *gorm.DB
+}
+
+func (u *RoleBindingRepository) InitTable() error {
+	return u.db.AutoMigrate(&model.RoleBinding{})
+}
+
+// CreateRoleBinding 创建
+func (u *RoleBindingRepository) CreateRoleBinding(roleBinding *model.RoleBinding) (int64, error) {
+	return roleBinding.ID, u.db.Create(roleBinding).Error
+}
+
+// DeleteRoleBindingByID 根据ID删除
+func (u *RoleBindingRepository) DeleteRoleBindingByID(id int64) error {
+	return u.db.Where("id = ?", id).Delete(&model.RoleBinding{}).Error
+}
+
+// FindBySubject 根据调用方查找
+func (u *RoleBindingRepository) FindBySubject(subject string) (roleBindingAll []model.RoleBinding, err error) {
+	return roleBindingAll, u.db.Where("binding_subject = ?", subject).Find(&roleBindingAll).Error
+}
+
+// FindAll 获取结果集
+func (u *RoleBindingRepository) FindAll() (roleBindingAll []model.RoleBinding, err error) {
+	return roleBindingAll, u.db.Find(&roleBindingAll).Error
+}
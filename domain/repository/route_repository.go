@@ -1,9 +1,14 @@
 package repository
 
 import (
+	"database/sql"
 	"github.com/zxnlx/common"
 	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/i18n"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
+	"time"
 )
 
 // IRouteRepository 创建需要实现的接口
@@ -14,12 +19,123 @@ type IRouteRepository interface {
 	FindRouteByID(int64) (*model.Route, error)
 	// CreateRoute 创建一条 route 数据
 	CreateRoute(*model.Route) (int64, error)
+	// UpsertRoute 按命名空间和名称插入或更新，返回ID和是否新建
+	UpsertRoute(*model.Route) (int64, bool, error)
+	// CreateRoutes 分批插入多条 route 数据，第二个参数为每批条数
+	CreateRoutes([]*model.Route, int) error
+	// DeleteRoutesByID 批量删除 route 数据
+	DeleteRoutesByID([]int64) error
 	// DeleteRouteByID 根据ID删除一条 route 数据
 	DeleteRouteByID(int64) error
 	// UpdateRoute 修改更新数据
 	UpdateRoute(*model.Route) error
 	// FindAll 查找route所有数据
 	FindAll() ([]model.Route, error)
+	// FindRouteByApplicationID 根据应用ID查找route数据
+	FindRouteByApplicationID(int64) ([]model.Route, error)
+	// FindRouteByName 根据命名空间和名称查找route数据
+	FindRouteByName(string, string) (*model.Route, error)
+	// UpdateRouteDisabled 修改route禁用状态
+	UpdateRouteDisabled(int64, bool) error
+	// MarkRouteDeleting 标记route删除中并记录删除策略
+	MarkRouteDeleting(int64, string) error
+	// FindDeletingRoutes 查找删除中的route
+	FindDeletingRoutes() ([]model.Route, error)
+	// UpdateRouteApplyStatus 记录写入k8s的结果，返回连续失败次数
+	UpdateRouteApplyStatus(int64, time.Time, time.Duration, error) (int64, error)
+	// ScheduleRouteRetry 记录下次自动重试的时间和是否进入死信列表
+	ScheduleRouteRetry(int64, int64, bool) error
+	// FindRoutesDueForRetry 查找到达重试时间的route
+	FindRoutesDueForRetry(time.Time, int) ([]model.Route, error)
+	// FindFailedRoutes 查找死信列表中的route，includeRetrying 时包括等待重试的
+	FindFailedRoutes(bool) ([]model.Route, error)
+	// CreateOutbox 写入事件记录和待发布消息
+	CreateOutbox(*model.Event, *model.OutboxMessage) error
+	// ArchiveRoute 写入归档记录并删除路由
+	ArchiveRoute(*model.RouteArchive) error
+	// Transaction 在同一事务中执行多个操作
+	Transaction(func(IRouteRepository) error) error
+	// ReencryptAll 用当前主密钥重新写入加密字段，返回处理的路由数和归档数
+	ReencryptAll() (int64, int64, error)
+	// FindPage 按条件和排序查询游标之后的一页，游标为空时从第一条开始，条数为 0 时不限制
+	// 最后一个参数为需要的字段（json 名称），为空时查询全部，没有 route_path 时不加载路径
+	FindPage(RouteFilter, string, *RouteCursor, int, []string) ([]model.Route, error)
+	// Count 按条件统计路由数
+	Count(RouteFilter) (int64, error)
+	// ExistsByName 命名空间中是否存在同名路由
+	ExistsByName(string, string) (bool, error)
+	// AggregateByNamespace 按命名空间汇总路由数和域名数
+	AggregateByNamespace() ([]NamespaceAggregate, error)
+	// Version 路由数和最后修改时间，用于判断列表是否变化
+	Version() (int64, time.Time, error)
+}
+
+// RouteFilter 路由查询条件，零值字段不参与过滤
+type RouteFilter struct {
+	Namespace     string
+	Host          string
+	ApplicationID int64
+	Adapter       string
+	// Disabled 为空时不按禁用状态过滤
+	Disabled *bool
+}
+
+// 分页的排序方式，都以ID作为最后的排序键，保证顺序稳定
+const (
+	RouteOrderID   = "id"
+	RouteOrderName = "name"
+	RouteOrderHost = "host"
+)
+
+// RouteCursor 上一页最后一条记录的排序键
+type RouteCursor struct {
+	OrderBy string
+	// Keys 除ID外的排序键，name 为命名空间和名称，host 为域名
+	Keys []string
+	ID   int64
+}
+
+// After 从路由生成游标
+func (c RouteCursor) After(route *model.Route) *RouteCursor {
+	cursor := &RouteCursor{OrderBy: c.OrderBy, ID: route.ID}
+	switch c.OrderBy {
+	case RouteOrderName:
+		cursor.Keys = []string{route.RouteNamespace, route.RouteName}
+	case RouteOrderHost:
+		cursor.Keys = []string{route.RouteHost}
+	}
+	return cursor
+}
+
+// Less 路由是否排在游标之前或等于游标，kvstore 等不支持查询排序的实现使用
+func (c RouteCursor) Less(route *model.Route) bool {
+	keys := c.After(route).Keys
+	for i := range keys {
+		if i >= len(c.Keys) || keys[i] != c.Keys[i] {
+			return i < len(c.Keys) && keys[i] < c.Keys[i]
+		}
+	}
+	return route.ID <= c.ID
+}
+
+// 排序键对应的列
+func routeOrderColumns(orderBy string) []string {
+	switch orderBy {
+	case RouteOrderName:
+		return []string{"route_namespace", "route_name", "id"}
+	case RouteOrderHost:
+		return []string{"route_host", "id"}
+	}
+	return []string{"id"}
+}
+
+// NamespaceAggregate 一个命名空间的路由汇总
+type NamespaceAggregate struct {
+	Namespace string
+	Routes    int64
+	// Hosts 不同域名的个数，不含空域名
+	Hosts    int64
+	Disabled int64
 }
 
 // NewRouteRepository 创建routeRepository
@@ -33,7 +149,7 @@ type RouteRepository struct {
 
 func (u *RouteRepository) InitTable() error {
 	common.Info("Init table 11")
-	return u.db.AutoMigrate(&model.Route{}, &model.RoutePath{})
+	return u.db.AutoMigrate(&model.Route{}, &model.RoutePath{}, &model.RouteArchive{})
 }
 
 // FindRouteByID 根据ID查找Route信息
@@ -47,6 +163,81 @@ func (u *RouteRepository) CreateRoute(route *model.Route) (int64, error) {
 	return route.ID, u.db.Create(route).Error
 }
 
+// CreateRoutes 使用 CreateInBatches，每批一条 INSERT，路径同样按批插入
+func (u *RouteRepository) CreateRoutes(routes []*model.Route, batchSize int) error {
+	if len(routes) == 0 {
+		return nil
+	}
+	return u.db.CreateInBatches(routes, batchSize).Error
+}
+
+// DeleteRoutesByID 在同一事务中删除路由和路径
+func (u *RouteRepository) DeleteRoutesByID(ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return u.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id IN ?", ids).Delete(&model.Route{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("route_id IN ?", ids).Delete(&model.RoutePath{}).Error
+	})
+}
+
+// UpsertRoute 使用 INSERT ... ON DUPLICATE KEY UPDATE，已存在时保留ID、版本号、创建人和创建时间，路径整体替换
+func (u *RouteRepository) UpsertRoute(route *model.Route) (id int64, created bool, err error) {
+	columns, err := routeUpsertColumns(u.db)
+	if err != nil {
+		return 0, false, err
+	}
+	err = u.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Omit(clause.Associations).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "route_namespace"}, {Name: "route_name"}},
+			DoUpdates: clause.AssignmentColumns(columns),
+		}).Create(route)
+		if result.Error != nil {
+			return result.Error
+		}
+		//MySQL 插入时影响 1 行，更新时影响 2 行，内容相同时为 0
+		created = result.RowsAffected == 1
+		current := &model.Route{}
+		if err := tx.Select("id", "route_revision").Where("route_namespace = ? AND route_name = ?", route.RouteNamespace, route.RouteName).First(current).Error; err != nil {
+			return err
+		}
+		route.ID = current.ID
+		route.RouteRevision = current.RouteRevision
+		if err := tx.Where("route_id = ?", route.ID).Delete(&model.RoutePath{}).Error; err != nil {
+			return err
+		}
+		for i := range route.RoutePath {
+			route.RoutePath[i].ID = 0
+			route.RoutePath[i].RouteID = route.ID
+		}
+		if len(route.RoutePath) == 0 {
+			return nil
+		}
+		return tx.Create(&route.RoutePath).Error
+	})
+	return route.ID, created, err
+}
+
+// 冲突时更新的列，不包括主键、唯一键和只在创建时写入的列
+func routeUpsertColumns(db *gorm.DB) ([]string, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(&model.Route{}); err != nil {
+		return nil, err
+	}
+	columns := []string{}
+	for _, field := range stmt.Schema.Fields {
+		switch field.DBName {
+		case "", "id", "route_namespace", "route_name", "route_revision", "route_created_by", "route_deleting", "route_delete_policy", "created_at":
+			continue
+		}
+		columns = append(columns, field.DBName)
+	}
+	return columns, nil
+}
+
 // DeleteRouteByID 根据ID删除Route信息
 func (u *RouteRepository) DeleteRouteByID(routeID int64) error {
 	tx := u.db.Begin()
@@ -77,10 +268,261 @@ func (u *RouteRepository) DeleteRouteByID(routeID int64) error {
 
 // UpdateRoute 更新Route信息
 func (u *RouteRepository) UpdateRoute(route *model.Route) error {
-	return u.db.Model(route).Updates(route).Error
+	if err := u.db.Model(route).Updates(route).Error; err != nil {
+		return err
+	}
+	//Updates 会跳过零值，可以关闭或清空的字段通过 Select 单独写入
+	return u.db.Model(route).Select("route_compression", "route_websocket", "route_websocket_timeout_seconds", "route_backend_http2", "route_auth_url", "route_auth_signin", "route_auth_response_headers").Updates(route).Error
 }
 
-// FindAll 获取结果集
+// FindAll 获取结果集，从只读副本读取
 func (u *RouteRepository) FindAll() (routeAll []model.Route, err error) {
-	return routeAll, u.db.Preload("RoutePath").Find(&routeAll).Error
+	return routeAll, readReplica(u.db).Preload("RoutePath").Find(&routeAll).Error
+}
+
+// FindRouteByApplicationID 根据应用ID获取结果集，从只读副本读取
+func (u *RouteRepository) FindRouteByApplicationID(applicationID int64) (routeAll []model.Route, err error) {
+	return routeAll, readReplica(u.db).Preload("RoutePath").Where("route_application_id = ?", applicationID).Find(&routeAll).Error
+}
+
+// FindRouteByName 根据命名空间和名称查找Route信息
+func (u *RouteRepository) FindRouteByName(namespace string, name string) (route *model.Route, err error) {
+	route = &model.Route{}
+	return route, u.db.Preload("RoutePath").Where("route_namespace = ? AND route_name = ?", namespace, name).First(route).Error
+}
+
+// UpdateRouteDisabled 更新禁用状态，Updates 不会更新零值所以单独处理
+func (u *RouteRepository) UpdateRouteDisabled(routeID int64, disabled bool) error {
+	return u.db.Model(&model.Route{}).Where("id = ?", routeID).Update("route_disabled", disabled).Error
+}
+
+// MarkRouteDeleting 标记删除中，记录保留到k8s中的资源删除完成
+func (u *RouteRepository) MarkRouteDeleting(routeID int64, policy string) error {
+	return u.db.Model(&model.Route{}).Where("id = ?", routeID).Updates(map[string]interface{}{
+		"route_deleting":      true,
+		"route_delete_policy": policy,
+	}).Error
+}
+
+// FindDeletingRoutes 查找删除中断的route，从主库读取
+func (u *RouteRepository) FindDeletingRoutes() (routeAll []model.Route, err error) {
+	return routeAll, u.db.Preload("RoutePath").Where("route_deleting = ?", true).Find(&routeAll).Error
+}
+
+// UpdateRouteApplyStatus 成功时清零连续失败次数，失败时在数据库中累加
+func (u *RouteRepository) UpdateRouteApplyStatus(routeID int64, applyTime time.Time, duration time.Duration, applyErr error) (int64, error) {
+	values := map[string]interface{}{
+		"route_last_apply_time":        applyTime.Unix(),
+		"route_last_apply_duration_ms": duration.Milliseconds(),
+		"route_last_error":             "",
+		"route_consecutive_failures":   0,
+		"route_next_retry_at":          0,
+		"route_dead_letter":            false,
+	}
+	if applyErr != nil {
+		values["route_last_error"] = applyErr.Error()
+		values["route_consecutive_failures"] = gorm.Expr("route_consecutive_failures + 1")
+	}
+	if err := u.db.Model(&model.Route{}).Where("id = ?", routeID).Updates(values).Error; err != nil {
+		return 0, err
+	}
+	var failures int64
+	return failures, u.db.Model(&model.Route{}).Where("id = ?", routeID).Select("route_consecutive_failures").Scan(&failures).Error
+}
+
+// ScheduleRouteRetry 写入失败后由服务层按失败次数计算
+func (u *RouteRepository) ScheduleRouteRetry(routeID int64, nextRetryAt int64, deadLetter bool) error {
+	return u.db.Model(&model.Route{}).Where("id = ?", routeID).Updates(map[string]interface{}{
+		"route_next_retry_at": nextRetryAt,
+		"route_dead_letter":   deadLetter,
+	}).Error
+}
+
+// FindRoutesDueForRetry 按重试时间排序，从主库读取
+func (u *RouteRepository) FindRoutesDueForRetry(now time.Time, limit int) (routeAll []model.Route, err error) {
+	return routeAll, u.db.Where("route_next_retry_at > 0 AND route_next_retry_at <= ? AND route_dead_letter = ?", now.Unix(), false).
+		Order("route_next_retry_at").Limit(limit).Find(&routeAll).Error
+}
+
+// FindFailedRoutes 查找死信列表中的route
+func (u *RouteRepository) FindFailedRoutes(includeRetrying bool) (routeAll []model.Route, err error) {
+	db := u.db.Preload("RoutePath")
+	if includeRetrying {
+		db = db.Where("route_dead_letter = ? OR route_next_retry_at > 0", true)
+	} else {
+		db = db.Where("route_dead_letter = ?", true)
+	}
+	return routeAll, db.Order("route_namespace, route_name").Find(&routeAll).Error
+}
+
+// CreateOutbox 事件记录供 ListEvents 查询，待发布消息由 relay 发布
+func (u *RouteRepository) CreateOutbox(event *model.Event, message *model.OutboxMessage) error {
+	if err := u.db.Create(event).Error; err != nil {
+		return err
+	}
+	return u.db.Create(message).Error
+}
+
+// ArchiveRoute 归档后删除路由和路径，同名资源可以再次接管
+func (u *RouteRepository) ArchiveRoute(archive *model.RouteArchive) error {
+	return u.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(archive).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", archive.RouteID).Delete(&model.Route{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("route_id = ?", archive.RouteID).Delete(&model.RoutePath{}).Error
+	})
+}
+
+// Transaction 出错时回滚
+func (u *RouteRepository) Transaction(fn func(IRouteRepository) error) error {
+	return u.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&RouteRepository{db: tx})
+	})
+}
+
+// ReencryptAll 重新写入加密字段，密钥轮换后把旧密钥加密的数据改为主密钥加密，不修改更新时间
+func (u *RouteRepository) ReencryptAll() (int64, int64, error) {
+	var routes []model.Route
+	if err := u.db.Find(&routes).Error; err != nil {
+		return 0, 0, err
+	}
+	for i := range routes {
+		if err := u.db.Model(&routes[i]).Select("route_request_header_add", "route_request_header_set", "route_auth_url").UpdateColumns(&routes[i]).Error; err != nil {
+			return 0, 0, err
+		}
+	}
+	var archives []model.RouteArchive
+	if err := u.db.Find(&archives).Error; err != nil {
+		return int64(len(routes)), 0, err
+	}
+	for i := range archives {
+		if err := u.db.Model(&archives[i]).Select("route_spec").UpdateColumns(&archives[i]).Error; err != nil {
+			return int64(len(routes)), 0, err
+		}
+	}
+	return int64(len(routes)), int64(len(archives)), nil
+}
+
+// Count 只执行 COUNT 查询，不加载路径
+func (u *RouteRepository) Count(filter RouteFilter) (count int64, err error) {
+	return count, u.filter(u.db.Model(&model.Route{}), filter).Count(&count).Error
+}
+
+// FindPage 按排序键的范围查询，不使用 OFFSET，翻页期间新增或删除的行不影响之后的页
+func (u *RouteRepository) FindPage(filter RouteFilter, orderBy string, after *RouteCursor, limit int, fields []string) (routeAll []model.Route, err error) {
+	columns := routeOrderColumns(orderBy)
+	db, err := u.selectFields(u.db, fields, columns)
+	if err != nil {
+		return nil, err
+	}
+	db = u.filter(db, filter)
+	if after != nil {
+		//展开为 (a > ?) OR (a = ? AND b > ?) ...，兼容不支持行比较的数据库
+		values := append(append([]interface{}{}, stringsToValues(after.Keys)...), after.ID)
+		if after.OrderBy != orderBy || len(values) != len(columns) {
+			return nil, i18n.NewError(i18n.CodePageTokenMismatch, "分页游标和排序方式不一致")
+		}
+		var conditions *gorm.DB
+		for i := range columns {
+			query := u.db.Where(columns[i]+" > ?", values[i])
+			for j := 0; j < i; j++ {
+				query = query.Where(columns[j]+" = ?", values[j])
+			}
+			if conditions == nil {
+				conditions = query
+			} else {
+				conditions = conditions.Or(query)
+			}
+		}
+		db = db.Where(conditions)
+	}
+	for _, v := range columns {
+		db = db.Order(v)
+	}
+	if limit > 0 {
+		db = db.Limit(limit)
+	}
+	return routeAll, db.Find(&routeAll).Error
+}
+
+// 按 json 名称找到对应的列，排序列总是查询，数据库中没有的字段忽略
+func (u *RouteRepository) selectFields(db *gorm.DB, fields []string, required []string) (*gorm.DB, error) {
+	if len(fields) == 0 {
+		return db.Preload("RoutePath"), nil
+	}
+	stmt := &gorm.Statement{DB: u.db}
+	if err := stmt.Parse(&model.Route{}); err != nil {
+		return nil, err
+	}
+	columns := map[string]string{}
+	for _, field := range stmt.Schema.Fields {
+		if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" && name != "-" && field.DBName != "" {
+			columns[name] = field.DBName
+		}
+	}
+	selected := append([]string{}, required...)
+	for _, v := range fields {
+		if v == "route_path" {
+			db = db.Preload("RoutePath")
+			continue
+		}
+		if column, ok := columns[v]; ok {
+			selected = append(selected, column)
+		}
+	}
+	return db.Select(selected), nil
+}
+
+func stringsToValues(keys []string) []interface{} {
+	values := make([]interface{}, 0, len(keys))
+	for _, v := range keys {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (u *RouteRepository) filter(db *gorm.DB, filter RouteFilter) *gorm.DB {
+	if filter.Namespace != "" {
+		db = db.Where("route_namespace = ?", filter.Namespace)
+	}
+	if filter.Host != "" {
+		db = db.Where("route_host = ?", filter.Host)
+	}
+	if filter.ApplicationID != 0 {
+		db = db.Where("route_application_id = ?", filter.ApplicationID)
+	}
+	if filter.Adapter != "" {
+		db = db.Where("route_adapter = ?", filter.Adapter)
+	}
+	if filter.Disabled != nil {
+		db = db.Where("route_disabled = ?", *filter.Disabled)
+	}
+	return db
+}
+
+// ExistsByName 使用命名空间和名称的索引，找到一行即返回
+func (u *RouteRepository) ExistsByName(namespace string, name string) (bool, error) {
+	var ids []int64
+	err := u.db.Model(&model.Route{}).Where("route_namespace = ? AND route_name = ?", namespace, name).Limit(1).Pluck("id", &ids).Error
+	return len(ids) > 0, err
+}
+
+// AggregateByNamespace 在数据库中分组统计
+func (u *RouteRepository) AggregateByNamespace() (aggregates []NamespaceAggregate, err error) {
+	return aggregates, u.db.Model(&model.Route{}).
+		Select("route_namespace AS namespace, COUNT(*) AS routes, COUNT(DISTINCT NULLIF(route_host, '')) AS hosts, SUM(CASE WHEN route_disabled THEN 1 ELSE 0 END) AS disabled").
+		Group("route_namespace").Order("route_namespace").Scan(&aggregates).Error
+}
+
+// Version 只执行一次聚合查询，没有路由时修改时间为零值
+func (u *RouteRepository) Version() (int64, time.Time, error) {
+	var result struct {
+		Count     int64
+		UpdatedAt sql.NullTime
+	}
+	err := u.db.Model(&model.Route{}).Select("COUNT(*) AS count, MAX(updated_at) AS updated_at").Scan(&result).Error
+	return result.Count, result.UpdatedAt.Time, err
 }
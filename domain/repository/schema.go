@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"github.com/zxnlx/route/domain/model"
+	"gorm.io/gorm"
+)
+
+// 各仓库 InitTable 迁移的模型，新增模型时需要同步
+var migratedModels = []interface{}{
+	&model.Route{},
+	&model.RoutePath{},
+	&model.RouteArchive{},
+	&model.NamespaceDefault{},
+	&model.Application{},
+	&model.Event{},
+	&model.FreezeWindow{},
+	&model.OutboxMessage{},
+	&model.AnnotationTemplate{},
+	&model.RoleBinding{},
+	&model.APIKey{},
+	&model.Quota{},
+	&model.QuotaCounter{},
+	&model.UsageSnapshot{},
+	&model.ReservedHost{},
+}
+
+// Migrate 迁移全部表结构，和依次调用各仓库的 InitTable 相同，用于测试和新建的数据库
+func Migrate(db *gorm.DB) error {
+	return db.AutoMigrate(migratedModels...)
+}
+
+// PendingMigrations 对比模型和数据库，返回缺少的表和字段，为空表示已迁移到最新
+func PendingMigrations(db *gorm.DB) ([]string, error) {
+	pending := []string{}
+	migrator := db.Migrator()
+	for _, m := range migratedModels {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(m); err != nil {
+			return nil, err
+		}
+		table := stmt.Schema.Table
+		if !migrator.HasTable(m) {
+			pending = append(pending, table)
+			continue
+		}
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName == "" {
+				continue
+			}
+			if !migrator.HasColumn(m, field.DBName) {
+				pending = append(pending, table+"."+field.DBName)
+			}
+		}
+	}
+	return pending, nil
+}
+
+// ServerVersion 数据库版本
+func ServerVersion(db *gorm.DB) (string, error) {
+	var version string
+	err := db.Raw("SELECT VERSION()").Scan(&version).Error
+	return version, err
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"github.com/zxnlx/route/domain/model"
+	"gorm.io/gorm"
+)
+
+// IUsageSnapshotRepository 用量快照需要实现的接口
+type IUsageSnapshotRepository interface {
+	// InitTable 初始化表
+	InitTable() error
+	// SaveSnapshots 替换某一天的所有快照
+	SaveSnapshots(string, []model.UsageSnapshot) error
+	// FindSnapshots 查找日期范围内的快照，包含起止日期
+	FindSnapshots(string, string) ([]model.UsageSnapshot, error)
+}
+
+// NewUsageSnapshotRepository 创建usageSnapshotRepository
+func NewUsageSnapshotRepository(db *gorm.DB) IUsageSnapshotRepository {
+	return &UsageSnapshotRepository{db: db}
+}
+
+type UsageSnapshotRepository struct {
+	db *gorm.DB
+}
+
+func (u *UsageSnapshotRepository) InitTable() error {
+	return u.db.AutoMigrate(&model.UsageSnapshot{})
+}
+
+// SaveSnapshots 同一天多次记录时以最后一次为准
+func (u *UsageSnapshotRepository) SaveSnapshots(day string, snapshots []model.UsageSnapshot) error {
+	return u.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("snapshot_day = ?", day).Delete(&model.UsageSnapshot{}).Error; err != nil {
+			return err
+		}
+		if len(snapshots) == 0 {
+			return nil
+		}
+		return tx.Create(&snapshots).Error
+	})
+}
+
+// FindSnapshots 按日期排序，从只读副本读取
+func (u *UsageSnapshotRepository) FindSnapshots(from string, to string) (snapshotAll []model.UsageSnapshot, err error) {
+	return snapshotAll, readReplica(u.db).Where("snapshot_day BETWEEN ? AND ?", from, to).Order("snapshot_day, snapshot_namespace, snapshot_team").Find(&snapshotAll).Error
+}
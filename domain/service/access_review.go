@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 管理 Ingress 需要的权限
+var ingressVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+
+// PermissionCheckConfig 写入 Ingress 前通过 SelfSubjectAccessReview 预检权限，缺少权限时返回明确的错误
+type PermissionCheckConfig struct {
+	// Disabled 关闭预检，由 API server 直接返回 Forbidden
+	Disabled bool `json:"disabled"`
+	// CacheSeconds 检查结果的缓存时间，默认 60
+	CacheSeconds int `json:"cache_seconds"`
+}
+
+func (c PermissionCheckConfig) cacheTTL() time.Duration {
+	if c.CacheSeconds <= 0 {
+		return 60 * time.Second
+	}
+	return time.Duration(c.CacheSeconds) * time.Second
+}
+
+// ErrPermissionDenied 缺少操作 k8s 资源的权限，使用 errors.Is 判断
+var ErrPermissionDenied = i18n.NewError(i18n.CodePermissionDenied, "权限不足")
+
+// PermissionDeniedError 缺少的权限
+type PermissionDeniedError struct {
+	Namespace string
+	Group     string
+	Resource  string
+	Verb      string
+	Reason    string
+}
+
+func (e *PermissionDeniedError) Error() string {
+	resource := e.Resource
+	if e.Group != "" {
+		resource += "." + e.Group
+	}
+	msg := "权限不足：service account 没有命名空间 " + e.Namespace + " 下 " + resource + " 的 " + e.Verb + " 权限"
+	if e.Reason != "" {
+		msg += "（" + e.Reason + "）"
+	}
+	return msg
+}
+
+func (e *PermissionDeniedError) Is(target error) bool {
+	return target == ErrPermissionDenied
+}
+
+// ErrorCode 错误码
+func (e *PermissionDeniedError) ErrorCode() string {
+	return i18n.CodePermissionDenied
+}
+
+// permissionCache 缓存预检结果，避免每次写入都请求 API server
+type permissionCache struct {
+	mu      sync.Mutex
+	entries map[string]permissionEntry
+}
+
+type permissionEntry struct {
+	check   *route.AccessCheck
+	expires time.Time
+}
+
+func (c *permissionCache) get(key string) (*route.AccessCheck, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.check, true
+}
+
+func (c *permissionCache) put(key string, check *route.AccessCheck, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = map[string]permissionEntry{}
+	}
+	c.entries[key] = permissionEntry{check: check, expires: time.Now().Add(ttl)}
+}
+
+// 检查是否有权限，无法完成检查时交给 API server 判断
+func (u *RouteDataService) checkPermission(ctx context.Context, namespace string, group string, resource string, verb string) error {
+	config := u.Config.PermissionCheck
+	if config.Disabled {
+		return nil
+	}
+	key := namespace + "/" + group + "/" + resource + "/" + verb
+	check, ok := u.permissions.get(key)
+	if !ok {
+		var err error
+		check, err = u.reviewAccess(ctx, namespace, group, resource, verb)
+		if err != nil {
+			common.Error("权限预检失败：" + err.Error())
+			return nil
+		}
+		u.permissions.put(key, check, config.cacheTTL())
+	}
+	if !check.Allowed {
+		return &PermissionDeniedError{Namespace: namespace, Group: group, Resource: resource, Verb: verb, Reason: check.Reason}
+	}
+	return nil
+}
+
+// preflightIngressClient 写入 Ingress 前预检权限
+type preflightIngressClient struct {
+	ingressClient
+	service   *RouteDataService
+	namespace string
+}
+
+func (c *preflightIngressClient) Create(ctx context.Context, ingress *networkingv1.Ingress, opts metav1.CreateOptions) (*networkingv1.Ingress, error) {
+	if err := c.service.checkPermission(ctx, c.namespace, "networking.k8s.io", "ingresses", "create"); err != nil {
+		return nil, err
+	}
+	return c.ingressClient.Create(ctx, ingress, opts)
+}
+
+func (c *preflightIngressClient) Update(ctx context.Context, ingress *networkingv1.Ingress, opts metav1.UpdateOptions) (*networkingv1.Ingress, error) {
+	if err := c.service.checkPermission(ctx, c.namespace, "networking.k8s.io", "ingresses", "update"); err != nil {
+		return nil, err
+	}
+	return c.ingressClient.Update(ctx, ingress, opts)
+}
+
+func (c *preflightIngressClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*networkingv1.Ingress, error) {
+	if err := c.service.checkPermission(ctx, c.namespace, "networking.k8s.io", "ingresses", "patch"); err != nil {
+		return nil, err
+	}
+	return c.ingressClient.Patch(ctx, name, pt, data, opts, subresources...)
+}
+
+func (c *preflightIngressClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	if err := c.service.checkPermission(ctx, c.namespace, "networking.k8s.io", "ingresses", "delete"); err != nil {
+		return err
+	}
+	return c.ingressClient.Delete(ctx, name, opts)
+}
+
+// 通过 SelfSubjectAccessReview 检查当前 service account 的权限，namespace 为空表示所有命名空间
+func (u *RouteDataService) reviewAccess(ctx context.Context, namespace string, group string, resource string, verb string) (*route.AccessCheck, error) {
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Group:     group,
+				Resource:  resource,
+				Verb:      verb,
+			},
+		},
+	}
+	result, err := u.K8sClientSet.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &route.AccessCheck{
+		Namespace: namespace,
+		Group:     group,
+		Resource:  resource,
+		Verb:      verb,
+		Allowed:   result.Status.Allowed,
+		Reason:    result.Status.Reason,
+	}, nil
+}
+
+// 需要检查权限的命名空间：配置了不含通配符的白名单时逐个检查，否则检查所有命名空间
+func (u *RouteDataService) accessReviewNamespaces() []string {
+	namespaces := []string{}
+	for _, v := range u.Config.Scope.AllowedNamespaces {
+		if strings.ContainsAny(v, "*?[") {
+			return []string{""}
+		}
+		namespaces = append(namespaces, v)
+	}
+	if len(namespaces) == 0 {
+		return []string{""}
+	}
+	return namespaces
+}
+
+// DiagnoseCluster 检查集群连通性和 Ingress 权限
+func (u *RouteDataService) DiagnoseCluster(ctx context.Context) *route.ClusterDiagnosis {
+	diagnosis := &route.ClusterDiagnosis{Cluster: DefaultCluster, IngressApiVersion: u.IngressAPIVersion}
+	version, err := u.K8sClientSet.Discovery().ServerVersion()
+	if err != nil {
+		diagnosis.Error = err.Error()
+		return diagnosis
+	}
+	diagnosis.Reachable = true
+	diagnosis.ServerVersion = version.GitVersion
+	for _, namespace := range u.accessReviewNamespaces() {
+		for _, verb := range ingressVerbs {
+			check, err := u.reviewAccess(ctx, namespace, "networking.k8s.io", "ingresses", verb)
+			if err != nil {
+				diagnosis.Error = err.Error()
+				return diagnosis
+			}
+			diagnosis.Permissions = append(diagnosis.Permissions, check)
+		}
+	}
+	return diagnosis
+}
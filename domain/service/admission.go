@@ -0,0 +1,173 @@
+package service
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/i18n"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// 外部校验不可用时的处理
+const (
+	AdmissionFailurePolicyFail   = "fail"
+	AdmissionFailurePolicyIgnore = "ignore"
+)
+
+// AdmissionWebhookConfig 写入k8s前调用的外部校验，安全团队可以在服务之外检查渲染后的资源
+type AdmissionWebhookConfig struct {
+	// URL https 地址，为空时不调用
+	URL string `json:"url"`
+	// TimeoutSeconds 超时秒数，默认 5
+	TimeoutSeconds int `json:"timeout_seconds"`
+	// FailurePolicy 调用失败或超时时的处理：fail（默认）拒绝写入，ignore 放行
+	FailurePolicy string `json:"failure_policy"`
+	// CABundle 校验服务证书的 CA，PEM 格式，为空时使用系统根证书
+	CABundle string `json:"ca_bundle"`
+}
+
+func (c AdmissionWebhookConfig) enabled() bool {
+	return c.URL != ""
+}
+
+func (c AdmissionWebhookConfig) timeout() time.Duration {
+	if c.TimeoutSeconds <= 0 {
+		return 5 * time.Second
+	}
+	return time.Duration(c.TimeoutSeconds) * time.Second
+}
+
+// AdmissionRequest 发送给外部校验的请求
+type AdmissionRequest struct {
+	UID string `json:"uid"`
+	// Operation 为 create 或 update
+	Operation string `json:"operation"`
+	// Object 即将写入k8s的资源
+	Object map[string]interface{} `json:"object"`
+	// RouteID 创建时也已分配
+	RouteID  int64  `json:"route_id"`
+	Revision int64  `json:"revision"`
+	Actor    string `json:"actor"`
+}
+
+// AdmissionResponse 外部校验的返回，allowed 为 false 时 message 返回给调用方
+type AdmissionResponse struct {
+	Allowed bool   `json:"allowed"`
+	Message string `json:"message"`
+}
+
+// AdmissionDeniedError 外部校验拒绝写入
+type AdmissionDeniedError struct {
+	Message string
+}
+
+func (e *AdmissionDeniedError) Error() string {
+	if e.Message == "" {
+		return "外部校验拒绝写入"
+	}
+	return "外部校验拒绝写入：" + e.Message
+}
+
+type admissionClient struct {
+	once   sync.Once
+	client *http.Client
+	err    error
+}
+
+func (u *RouteDataService) admissionHTTPClient() (*http.Client, error) {
+	config := u.Config.AdmissionWebhook
+	u.admission.once.Do(func() {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if config.CABundle != "" {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM([]byte(config.CABundle)) {
+				u.admission.err = errors.New("外部校验的 ca_bundle 不是有效的 PEM 证书")
+				return
+			}
+			transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+		}
+		u.admission.client = &http.Client{Transport: transport, Timeout: config.timeout()}
+	})
+	return u.admission.client, u.admission.err
+}
+
+// 写入k8s前把渲染后的资源发送给外部校验，拒绝时中止本次写入
+func (u *RouteDataService) admit(op *RouteOperation) error {
+	config := u.Config.AdmissionWebhook
+	if !config.enabled() {
+		return nil
+	}
+	err := u.callAdmissionWebhook(op)
+	var denied *AdmissionDeniedError
+	if err == nil || errors.As(err, &denied) {
+		return err
+	}
+	if config.FailurePolicy == AdmissionFailurePolicyIgnore {
+		common.Error("外部校验失败，按 ignore 放行：" + err.Error())
+		return nil
+	}
+	return i18n.NewError(i18n.CodeAdmissionFailed, "外部校验失败："+err.Error())
+}
+
+func (u *RouteDataService) callAdmissionWebhook(op *RouteOperation) error {
+	config := u.Config.AdmissionWebhook
+	target, err := url.Parse(config.URL)
+	if err != nil || target.Scheme != "https" || target.Host == "" {
+		return errors.New("外部校验地址 " + config.URL + " 必须是 https 地址")
+	}
+	client, err := u.admissionHTTPClient()
+	if err != nil {
+		return err
+	}
+	object, err := u.renderObject(op.Info)
+	if err != nil {
+		return err
+	}
+	uid, err := newReapplyJobID()
+	if err != nil {
+		return err
+	}
+	actor := op.Info.RouteUpdatedBy
+	if op.Action == ActionCreate {
+		actor = op.Info.RouteCreatedBy
+	}
+	body, err := json.Marshal(&AdmissionRequest{
+		UID:       uid,
+		Operation: op.Action,
+		Object:    object,
+		RouteID:   op.Info.Id,
+		Revision:  op.Info.RouteRevision,
+		Actor:     actor,
+	})
+	if err != nil {
+		return err
+	}
+	rsp, err := client.Post(config.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(rsp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if rsp.StatusCode != http.StatusOK {
+		return errors.New("返回 " + strconv.Itoa(rsp.StatusCode))
+	}
+	result := &AdmissionResponse{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return errors.New("返回格式不正确：" + err.Error())
+	}
+	if !result.Allowed {
+		return &AdmissionDeniedError{Message: result.Message}
+	}
+	return nil
+}
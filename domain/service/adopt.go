@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/metrics"
+	"github.com/zxnlx/route/proto/route"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"time"
+)
+
+// 接管时每批写入数据库的路由数
+const adoptBatchSize = 500
+
+// AdoptIngresses 批量接管命名空间下匹配标签选择器的 Ingress，以k8s中的规格作为版本 1 写入数据库，
+// 已由本服务管理、不在管理范围内或无法导入的 Ingress 跳过并返回原因
+func (u *RouteDataService) AdoptIngresses(namespace string, selector string, actor string) (*route.AdoptIngressesResponse, error) {
+	if err := u.checkNamespaceScope(namespace); err != nil {
+		return nil, err
+	}
+	list, err := u.ingresses(namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		common.Error(err)
+		return nil, err
+	}
+	result := &route.AdoptIngressesResponse{}
+	items := []*route.AdoptedIngress{}
+	ops := []*RouteOperation{}
+	routes := []*model.Route{}
+	for i := range list.Items {
+		v := &list.Items[i]
+		item := &route.AdoptedIngress{RouteNamespace: v.Namespace, RouteName: v.Name}
+		reason := u.adoptSkipReason(v.Namespace, v.Name, v.Labels)
+		var op *RouteOperation
+		route2 := &model.Route{}
+		if reason == "" {
+			op, err = u.renderAdopt(v, actor)
+			if err == nil {
+				err = common.SwapTo(op.Info, route2)
+			}
+			if err != nil {
+				reason = err.Error()
+			}
+		}
+		if reason != "" {
+			item.Reason = reason
+			result.Skipped = append(result.Skipped, item)
+			continue
+		}
+		route2.RouteRevision = 1
+		items = append(items, item)
+		ops = append(ops, op)
+		routes = append(routes, route2)
+	}
+	//先分批写入数据库拿到ID，再逐个在k8s中打上标签，失败的记录最后一起删除
+	if err := u.RouteRepository.CreateRoutes(routes, adoptBatchSize); err != nil {
+		common.Error(err)
+		return nil, err
+	}
+	failed := []int64{}
+	for i, op := range ops {
+		op.Info.Id = routes[i].ID
+		op.Info.RouteRevision = routes[i].RouteRevision
+		if err := u.applyAdopt(op); err != nil {
+			failed = append(failed, op.Info.Id)
+			items[i].Reason = err.Error()
+			result.Skipped = append(result.Skipped, items[i])
+			continue
+		}
+		items[i].Id = op.Info.Id
+		result.Adopted = append(result.Adopted, items[i])
+	}
+	if err := u.RouteRepository.DeleteRoutesByID(failed); err != nil {
+		common.Error(err)
+	}
+	common.Infof("命名空间 %s 接管 Ingress %d 个，跳过 %d 个", namespace, len(result.Adopted), len(result.Skipped))
+	return result, nil
+}
+
+// 从列表中的 Ingress 导入规格，经过校验和渲染阶段
+func (u *RouteDataService) renderAdopt(ingress *networkingv1.Ingress, actor string) (*RouteOperation, error) {
+	info := &route.RouteInfo{
+		RouteName:           ingress.Name,
+		RouteNamespace:      ingress.Namespace,
+		RouteConflictPolicy: ConflictPolicyAdopt,
+		RouteCreatedBy:      actor,
+		RouteUpdatedBy:      actor,
+	}
+	if err := u.importIngress(ingress, info); err != nil {
+		return nil, err
+	}
+	op := &RouteOperation{Action: ActionCreate, Info: info}
+	if err := u.runStage(StageValidate, op, u.validateRoute); err != nil {
+		return nil, err
+	}
+	//配额按数据库中已有的路由计算，同一批接管的路由之间不累计
+	if err := u.checkQuota(info); err != nil {
+		return nil, err
+	}
+	err := u.runStage(StageRender, op, func(op *RouteOperation) (err error) {
+		op.Info.RouteSpecHash, err = appliedSpecHash(op.Info)
+		op.Changed = true
+		return err
+	})
+	return op, err
+}
+
+// 加锁后写入k8s，数据库记录已批量写入
+func (u *RouteDataService) applyAdopt(op *RouteOperation) error {
+	unlock, err := u.lockRoute(op.Info.RouteNamespace, op.Info.RouteName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	start := time.Now()
+	if err := u.runStage(StageApply, op, u.applyRoute); err != nil {
+		metrics.ObserveApply(op.Info.RouteNamespace, op.Info.RouteName, time.Since(start), 1, err)
+		return err
+	}
+	if err := u.runStage(StagePersist, op, u.persistRoute); err != nil {
+		return err
+	}
+	u.recordApply(op.Info, start, nil, "接管成功，版本 1")
+	return nil
+}
+
+// 不能接管的原因，可以接管时返回空
+func (u *RouteDataService) adoptSkipReason(namespace string, name string, labels map[string]string) string {
+	if _, ok := labels[u.Config.Stamp.prefix()+"managed-by"]; ok {
+		return "已由本服务管理"
+	}
+	if err := u.checkObjectScope("Ingress", namespace, name, labels); err != nil {
+		return err.Error()
+	}
+	if exists, err := u.RouteRepository.ExistsByName(namespace, name); err != nil {
+		return err.Error()
+	} else if exists {
+		return "数据库中已存在同名路由"
+	}
+	return ""
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/proto/route"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// IAnnotationTemplateDataService 注解模板接口
+type IAnnotationTemplateDataService interface {
+	AddAnnotationTemplate(*model.AnnotationTemplate) (int64, error)
+	DeleteAnnotationTemplate(int64) error
+	UpdateAnnotationTemplate(*model.AnnotationTemplate) error
+	FindAnnotationTemplateByID(int64) (*model.AnnotationTemplate, error)
+	FindAllAnnotationTemplate() ([]model.AnnotationTemplate, error)
+}
+
+// NewAnnotationTemplateDataService 创建
+func NewAnnotationTemplateDataService(annotationTemplateRepository repository.IAnnotationTemplateRepository) IAnnotationTemplateDataService {
+	return &AnnotationTemplateDataService{AnnotationTemplateRepository: annotationTemplateRepository}
+}
+
+type AnnotationTemplateDataService struct {
+	AnnotationTemplateRepository repository.IAnnotationTemplateRepository
+}
+
+// TemplateVars 注解模板可以使用的变量
+type TemplateVars struct {
+	RouteName     string
+	Namespace     string
+	Env           string
+	Host          string
+	Class         string
+	ApplicationID int64
+	OwnerTeam     string
+}
+
+// AddAnnotationTemplate 插入
+func (u *AnnotationTemplateDataService) AddAnnotationTemplate(annotationTemplate *model.AnnotationTemplate) (int64, error) {
+	if err := checkAnnotationTemplate(annotationTemplate); err != nil {
+		return 0, err
+	}
+	return u.AnnotationTemplateRepository.CreateAnnotationTemplate(annotationTemplate)
+}
+
+// DeleteAnnotationTemplate 删除
+func (u *AnnotationTemplateDataService) DeleteAnnotationTemplate(id int64) error {
+	return u.AnnotationTemplateRepository.DeleteAnnotationTemplateByID(id)
+}
+
+// UpdateAnnotationTemplate 更新，已写入k8s的路由需要更新或通过 ReapplyAll 重新写入后生效
+func (u *AnnotationTemplateDataService) UpdateAnnotationTemplate(annotationTemplate *model.AnnotationTemplate) error {
+	if err := checkAnnotationTemplate(annotationTemplate); err != nil {
+		return err
+	}
+	return u.AnnotationTemplateRepository.UpdateAnnotationTemplate(annotationTemplate)
+}
+
+// FindAnnotationTemplateByID 查找
+func (u *AnnotationTemplateDataService) FindAnnotationTemplateByID(id int64) (*model.AnnotationTemplate, error) {
+	return u.AnnotationTemplateRepository.FindAnnotationTemplateByID(id)
+}
+
+// FindAllAnnotationTemplate 查找
+func (u *AnnotationTemplateDataService) FindAllAnnotationTemplate() ([]model.AnnotationTemplate, error) {
+	return u.AnnotationTemplateRepository.FindAll()
+}
+
+// 保存前用示例变量渲染一次，提前发现语法错误和不存在的变量
+func checkAnnotationTemplate(annotationTemplate *model.AnnotationTemplate) error {
+	if annotationTemplate.TemplateName == "" {
+		return errors.New("模板名称不能为空")
+	}
+	for _, v := range annotationTemplate.TemplateNamespaces {
+		if _, err := path.Match(v, ""); err != nil {
+			return errors.New("命名空间通配符 " + v + " 格式错误")
+		}
+	}
+	for k := range annotationTemplate.TemplateAnnotations {
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return errors.New("注解 " + k + " 不合法：" + strings.Join(errs, "；"))
+		}
+	}
+	_, err := renderAnnotationTemplate(annotationTemplate, TemplateVars{RouteName: "example", Namespace: "default", Env: "dev", Host: "example.com", Class: "nginx", ApplicationID: 1, OwnerTeam: "team"})
+	return err
+}
+
+func renderAnnotationTemplate(annotationTemplate *model.AnnotationTemplate, vars TemplateVars) (map[string]string, error) {
+	annotations := map[string]string{}
+	for k, v := range annotationTemplate.TemplateAnnotations {
+		tpl, err := template.New(k).Option("missingkey=error").Parse(v)
+		if err != nil {
+			return nil, errors.New("模板 " + annotationTemplate.TemplateName + " 的注解 " + k + " 格式错误：" + err.Error())
+		}
+		var buf bytes.Buffer
+		if err := tpl.Execute(&buf, vars); err != nil {
+			return nil, errors.New("模板 " + annotationTemplate.TemplateName + " 的注解 " + k + " 渲染失败：" + err.Error())
+		}
+		annotations[k] = buf.String()
+	}
+	return annotations, nil
+}
+
+func annotationTemplateMatches(annotationTemplate *model.AnnotationTemplate, namespace string, class string) bool {
+	if annotationTemplate.TemplateClass != "" && annotationTemplate.TemplateClass != class {
+		return false
+	}
+	return len(annotationTemplate.TemplateNamespaces) == 0 || matchAny(annotationTemplate.TemplateNamespaces, namespace)
+}
+
+// 渲染匹配的模板，按优先级从小到大合并，优先级大的覆盖
+func (u *RouteDataService) renderTemplateAnnotations(info *route.RouteInfo) (map[string]string, error) {
+	annotations := map[string]string{}
+	if u.AnnotationTemplateRepository == nil {
+		return annotations, nil
+	}
+	all, err := u.AnnotationTemplateRepository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].TemplatePriority != all[j].TemplatePriority {
+			return all[i].TemplatePriority < all[j].TemplatePriority
+		}
+		return all[i].ID < all[j].ID
+	})
+	class := u.getIngressClassName(info)
+	env := u.Config.Environment
+	if name, _, ok := u.environmentOf(info.RouteNamespace); ok {
+		env = name
+	}
+	vars := TemplateVars{
+		RouteName:     info.RouteName,
+		Namespace:     info.RouteNamespace,
+		Env:           env,
+		Host:          info.RouteHost,
+		Class:         class,
+		ApplicationID: info.RouteApplicationId,
+		OwnerTeam:     info.RouteOwnerTeam,
+	}
+	for i := range all {
+		if !annotationTemplateMatches(&all[i], info.RouteNamespace, class) {
+			continue
+		}
+		rendered, err := renderAnnotationTemplate(&all[i], vars)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range rendered {
+			annotations[k] = v
+		}
+	}
+	return annotations, nil
+}
+
+// 渲染 Ingress 时没有返回错误的途径，checkRoute 已经提前渲染过一次
+func (u *RouteDataService) getTemplateAnnotations(info *route.RouteInfo) map[string]string {
+	annotations, err := u.renderTemplateAnnotations(info)
+	if err != nil {
+		common.Error(err)
+		return map[string]string{}
+	}
+	return annotations
+}
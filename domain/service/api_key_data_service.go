@@ -0,0 +1,216 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/i18n"
+	"path"
+	"strings"
+	"time"
+)
+
+// API 密钥的操作范围，admin 包含 write，write 包含 read
+const (
+	APIKeyVerbRead  = "read"
+	APIKeyVerbWrite = "write"
+	APIKeyVerbAdmin = "admin"
+)
+
+const (
+	apiKeyScheme = "rk_"
+	//最后使用时间最多每分钟写一次
+	apiKeyLastUsedInterval = 60
+)
+
+// ErrInvalidAPIKey 密钥不存在、已吊销或已过期
+var ErrInvalidAPIKey = i18n.NewError(i18n.CodeInvalidAPIKey, "API 密钥无效")
+
+func verbLevel(verb string) int {
+	switch verb {
+	case APIKeyVerbRead:
+		return 1
+	case APIKeyVerbWrite:
+		return 2
+	case APIKeyVerbAdmin:
+		return 3
+	}
+	return 0
+}
+
+// 操作需要的角色对应的密钥范围
+func requiredVerb(role string) string {
+	switch role {
+	case RoleViewer:
+		return APIKeyVerbRead
+	case RoleEditor:
+		return APIKeyVerbWrite
+	}
+	return APIKeyVerbAdmin
+}
+
+// IAPIKeyDataService API 密钥接口
+type IAPIKeyDataService interface {
+	CreateAPIKey(*model.APIKey, string) (string, error)
+	RevokeAPIKey(int64, string) error
+	ListAPIKeys() ([]model.APIKey, error)
+
+	AuthorizeAPIKey(string, string, interface{}) (string, error)
+}
+
+// NewAPIKeyDataService 创建，environments 用于确定晋级的目标命名空间
+func NewAPIKeyDataService(apiKeyRepository repository.IAPIKeyRepository, routeRepository repository.IRouteRepository, environments map[string]EnvironmentConfig) IAPIKeyDataService {
+	return &APIKeyDataService{APIKeyRepository: apiKeyRepository, resolver: namespaceResolver{routes: routeRepository, apiKeys: apiKeyRepository, environments: environments}}
+}
+
+type APIKeyDataService struct {
+	APIKeyRepository repository.IAPIKeyRepository
+	resolver         namespaceResolver
+}
+
+func checkAPIKey(apiKey *model.APIKey) error {
+	if apiKey.KeyName == "" {
+		return errors.New("API 密钥名称不能为空")
+	}
+	if len(apiKey.KeyVerbs) == 0 {
+		return errors.New("API 密钥至少需要一个操作范围")
+	}
+	for _, v := range apiKey.KeyVerbs {
+		if verbLevel(v) == 0 {
+			return errors.New("不支持的操作范围：" + v)
+		}
+	}
+	for _, v := range apiKey.KeyNamespaces {
+		if _, err := path.Match(v, ""); err != nil {
+			return errors.New("命名空间通配符 " + v + " 格式错误")
+		}
+	}
+	if apiKey.KeyExpiresAt != 0 && apiKey.KeyExpiresAt <= time.Now().Unix() {
+		return i18n.NewError(i18n.CodeInvalidArgument, "过期时间必须晚于当前时间")
+	}
+	return nil
+}
+
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// CreateAPIKey 生成密钥，格式为 rk_<前缀>_<密文>，明文只在创建时返回一次
+func (u *APIKeyDataService) CreateAPIKey(apiKey *model.APIKey, actor string) (string, error) {
+	if err := checkAPIKey(apiKey); err != nil {
+		return "", err
+	}
+	prefix, err := randomHex(8)
+	if err != nil {
+		return "", err
+	}
+	secret, err := randomHex(24)
+	if err != nil {
+		return "", err
+	}
+	key := apiKeyScheme + prefix + "_" + secret
+	apiKey.ID = 0
+	apiKey.KeyPrefix = prefix
+	apiKey.KeyHash = hashAPIKey(key)
+	apiKey.KeyRevokedAt = 0
+	apiKey.KeyLastUsedAt = 0
+	apiKey.KeyCreatedBy = actor
+	if _, err := u.APIKeyRepository.CreateAPIKey(apiKey); err != nil {
+		return "", err
+	}
+	common.Info(actor + " 创建 API 密钥 " + apiKey.KeyName + "（" + prefix + "）")
+	return key, nil
+}
+
+// RevokeAPIKey 吊销密钥，保留记录用于审计
+func (u *APIKeyDataService) RevokeAPIKey(id int64, actor string) error {
+	apiKey, err := u.APIKeyRepository.FindAPIKeyByID(id)
+	if err != nil {
+		return err
+	}
+	if apiKey.KeyRevokedAt != 0 {
+		return nil
+	}
+	if err := u.APIKeyRepository.RevokeAPIKey(id, time.Now().Unix()); err != nil {
+		return err
+	}
+	common.Info(actor + " 吊销 API 密钥 " + apiKey.KeyName + "（" + apiKey.KeyPrefix + "）")
+	return nil
+}
+
+// ListAPIKeys 查找，不包含密钥哈希
+func (u *APIKeyDataService) ListAPIKeys() ([]model.APIKey, error) {
+	return u.APIKeyRepository.FindAll()
+}
+
+// AuthorizeAPIKey 校验密钥及其范围，返回作为调用方身份的 apikey:<名称>，范围不够时同时返回身份和错误
+func (u *APIKeyDataService) AuthorizeAPIKey(key string, method string, req interface{}) (string, error) {
+	apiKey, err := u.lookup(key)
+	if err != nil {
+		return "", err
+	}
+	subject := "apikey:" + apiKey.KeyName
+	required := RequiredRole(method)
+	verb := requiredVerb(required)
+	allowed := false
+	for _, v := range apiKey.KeyVerbs {
+		if verbLevel(v) >= verbLevel(verb) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return subject, fmt.Errorf("%w：API 密钥 %s 没有 %s 范围", ErrAccessDenied, apiKey.KeyName, verb)
+	}
+	if len(apiKey.KeyNamespaces) > 0 {
+		//global-admin 的操作和无法确定命名空间的操作只允许不限命名空间的密钥，涉及多个命名空间时都需要在范围内
+		for _, namespace := range u.resolver.requestNamespaces(req) {
+			if required == RoleGlobalAdmin || namespace == "" || !matchAny(apiKey.KeyNamespaces, namespace) {
+				return subject, fmt.Errorf("%w：API 密钥 %s 不能操作命名空间 %s", ErrAccessDenied, apiKey.KeyName, namespace)
+			}
+		}
+	}
+	now := time.Now().Unix()
+	if now-apiKey.KeyLastUsedAt >= apiKeyLastUsedInterval {
+		if err := u.APIKeyRepository.UpdateLastUsed(apiKey.ID, now); err != nil {
+			common.Error(err)
+		}
+	}
+	return subject, nil
+}
+
+func (u *APIKeyDataService) lookup(key string) (*model.APIKey, error) {
+	if !strings.HasPrefix(key, apiKeyScheme) {
+		return nil, ErrInvalidAPIKey
+	}
+	parts := strings.SplitN(strings.TrimPrefix(key, apiKeyScheme), "_", 2)
+	if len(parts) != 2 {
+		return nil, ErrInvalidAPIKey
+	}
+	apiKey, err := u.APIKeyRepository.FindAPIKeyByPrefix(parts[0])
+	if err != nil {
+		return nil, ErrInvalidAPIKey
+	}
+	if !hmac.Equal([]byte(apiKey.KeyHash), []byte(hashAPIKey(key))) {
+		return nil, ErrInvalidAPIKey
+	}
+	if apiKey.KeyRevokedAt != 0 || apiKey.KeyExpiresAt != 0 && apiKey.KeyExpiresAt <= time.Now().Unix() {
+		return nil, ErrInvalidAPIKey
+	}
+	return apiKey, nil
+}
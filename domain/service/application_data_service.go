@@ -0,0 +1,104 @@
+package service
+
+import (
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+)
+
+// IApplicationDataService 应用接口
+type IApplicationDataService interface {
+	AddApplication(*model.Application) (int64, error)
+	DeleteApplication(int64) error
+	UpdateApplication(*model.Application) error
+	FindApplicationByID(int64) (*model.Application, error)
+	FindAllApplication() ([]model.Application, error)
+
+	DisableApplication(int64) error
+	EnableApplication(int64) error
+	ExportApplication(int64) ([]model.Route, error)
+}
+
+// NewApplicationDataService 创建，应用下路由的k8s操作交给 IRouteDataService
+func NewApplicationDataService(applicationRepository repository.IApplicationRepository, routeDataService IRouteDataService) IApplicationDataService {
+	return &ApplicationDataService{ApplicationRepository: applicationRepository, RouteDataService: routeDataService}
+}
+
+type ApplicationDataService struct {
+	ApplicationRepository repository.IApplicationRepository
+	RouteDataService      IRouteDataService
+}
+
+// AddApplication 插入
+func (u *ApplicationDataService) AddApplication(application *model.Application) (int64, error) {
+	return u.ApplicationRepository.CreateApplication(application)
+}
+
+// DeleteApplication 删除应用，同时从k8s和数据库中删除应用下所有路由
+func (u *ApplicationDataService) DeleteApplication(id int64) error {
+	routes, err := u.RouteDataService.FindRouteByApplicationID(id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for i := range routes {
+		if err = u.RouteDataService.DeleteRouteFromK8s(&routes[i], DeletePolicyDefault); err != nil {
+			common.Error(err)
+			return err
+		}
+	}
+	return u.ApplicationRepository.DeleteApplicationByID(id)
+}
+
+// UpdateApplication 更新
+func (u *ApplicationDataService) UpdateApplication(application *model.Application) error {
+	return u.ApplicationRepository.UpdateApplication(application)
+}
+
+// FindApplicationByID 查找
+func (u *ApplicationDataService) FindApplicationByID(id int64) (*model.Application, error) {
+	return u.ApplicationRepository.FindApplicationByID(id)
+}
+
+// FindAllApplication 查找
+func (u *ApplicationDataService) FindAllApplication() ([]model.Application, error) {
+	return u.ApplicationRepository.FindAll()
+}
+
+// DisableApplication 禁用应用下所有路由
+func (u *ApplicationDataService) DisableApplication(id int64) error {
+	routes, err := u.RouteDataService.FindRouteByApplicationID(id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for i := range routes {
+		if err := u.RouteDataService.DisableRouteFromK8s(&routes[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnableApplication 启用应用下所有路由
+func (u *ApplicationDataService) EnableApplication(id int64) error {
+	routes, err := u.RouteDataService.FindRouteByApplicationID(id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for i := range routes {
+		if err := u.RouteDataService.EnableRouteToK8s(&routes[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportApplication 导出应用下所有路由
+func (u *ApplicationDataService) ExportApplication(id int64) ([]model.Route, error) {
+	if _, err := u.ApplicationRepository.FindApplicationByID(id); err != nil {
+		return nil, err
+	}
+	return u.RouteDataService.FindRouteByApplicationID(id)
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/metrics"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultApplyWorkers           = 16
+	defaultApplyQueueDepth        = 256
+	defaultApplyRetryAfterSeconds = 5
+	defaultApplyWaitSeconds       = 30
+)
+
+// ApplyQueueConfig 写入k8s的并发和排队限制，超出排队上限时直接拒绝，避免协程无限增长
+type ApplyQueueConfig struct {
+	// Workers 同时写入k8s的最大个数，默认 16
+	Workers int `json:"workers"`
+	// QueueDepth 等待写入的最大个数，默认 256，超出时返回 ApplyQueueFullError，后台任务不受限制
+	QueueDepth int `json:"queue_depth"`
+	// ClusterConcurrency 按集群限制同时写入的个数，key 为集群名称，当前实例写入 cluster_name 对应的集群
+	ClusterConcurrency map[string]int `json:"cluster_concurrency"`
+	// RetryAfterSeconds 队列已满时建议调用方等待的秒数，默认 5
+	RetryAfterSeconds int `json:"retry_after_seconds"`
+	// WaitSeconds 请求排队等待的最长时间，默认 30，超时返回 ApplyQueueFullError，后台任务不受限制
+	WaitSeconds int `json:"wait_seconds"`
+}
+
+// ErrApplyQueueFull 写入队列已满，使用 errors.Is 判断
+var ErrApplyQueueFull = i18n.NewError(i18n.CodeApplyQueueFull, "写入队列已满")
+
+// ApplyQueueFullError 写入队列已满或排队超时，RetryAfter 为建议的重试间隔
+type ApplyQueueFullError struct {
+	Depth int
+	// Timeout 排队超过 WaitSeconds 仍未拿到写入槽位
+	Timeout    bool
+	retryAfter time.Duration
+}
+
+func (e *ApplyQueueFullError) Error() string {
+	if e.Timeout {
+		return "等待写入队列超时（" + strconv.Itoa(e.Depth) + " 个等待中），请 " + strconv.Itoa(int(e.retryAfter.Seconds())) + " 秒后重试"
+	}
+	return ErrApplyQueueFull.Error() + "（" + strconv.Itoa(e.Depth) + " 个等待中），请 " + strconv.Itoa(int(e.retryAfter.Seconds())) + " 秒后重试"
+}
+
+func (e *ApplyQueueFullError) Unwrap() error {
+	return ErrApplyQueueFull
+}
+
+// ErrorCode 排队超时和队列已满使用同一个错误码
+func (e *ApplyQueueFullError) ErrorCode() string {
+	return i18n.CodeApplyQueueFull
+}
+
+// RetryAfter 建议的重试间隔
+func (e *ApplyQueueFullError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// applyQueue 按信号量限制写入并发，等待中的个数即为队列深度
+type applyQueue struct {
+	once       sync.Once
+	workers    chan struct{}
+	clusters   map[string]chan struct{}
+	depth      int
+	retryAfter time.Duration
+	wait       time.Duration
+	mu         sync.Mutex
+	waiting    int
+}
+
+// 第一次写入时按配置初始化，配置在启动时读取
+func (q *applyQueue) init(config ApplyQueueConfig) {
+	q.once.Do(func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		workers := config.Workers
+		if workers <= 0 {
+			workers = defaultApplyWorkers
+		}
+		q.depth = config.QueueDepth
+		if q.depth <= 0 {
+			q.depth = defaultApplyQueueDepth
+		}
+		retryAfter := config.RetryAfterSeconds
+		if retryAfter <= 0 {
+			retryAfter = defaultApplyRetryAfterSeconds
+		}
+		q.retryAfter = time.Duration(retryAfter) * time.Second
+		wait := config.WaitSeconds
+		if wait <= 0 {
+			wait = defaultApplyWaitSeconds
+		}
+		q.wait = time.Duration(wait) * time.Second
+		q.workers = make(chan struct{}, workers)
+		q.clusters = map[string]chan struct{}{}
+		for k, v := range config.ClusterConcurrency {
+			if v > 0 && v < workers {
+				q.clusters[k] = make(chan struct{}, v)
+			}
+		}
+		metrics.SetApplyWorkers(workers, q.depth)
+	})
+}
+
+// 等待空闲的写入槽位，wait 为 false 且排队已满时返回 ApplyQueueFullError
+// ctx 取消时返回 ctx 的错误，wait 为 false 时最多等待 WaitSeconds，超时返回 Timeout 的 ApplyQueueFullError
+func (q *applyQueue) acquire(ctx context.Context, cluster string, wait bool) (func(), error) {
+	q.mu.Lock()
+	if !wait && q.waiting >= q.depth {
+		depth := q.waiting
+		q.mu.Unlock()
+		metrics.ObserveApplyQueueRejected(cluster)
+		return nil, &ApplyQueueFullError{Depth: depth, retryAfter: q.retryAfter}
+	}
+	q.waiting++
+	q.observe(cluster)
+	q.mu.Unlock()
+	done := func() {
+		q.mu.Lock()
+		q.waiting--
+		q.observe(cluster)
+		q.mu.Unlock()
+	}
+
+	var expired <-chan time.Time
+	if !wait {
+		timer := time.NewTimer(q.wait)
+		defer timer.Stop()
+		expired = timer.C
+	}
+	timeout := func() error {
+		q.mu.Lock()
+		depth := q.waiting
+		q.mu.Unlock()
+		metrics.ObserveApplyQueueRejected(cluster)
+		return &ApplyQueueFullError{Depth: depth, Timeout: true, retryAfter: q.retryAfter}
+	}
+
+	//先占集群的槽位，避免占着全局槽位等待单个集群
+	clusterSlots := q.clusters[cluster]
+	if clusterSlots != nil {
+		select {
+		case clusterSlots <- struct{}{}:
+		case <-ctx.Done():
+			done()
+			return nil, ctx.Err()
+		case <-expired:
+			err := timeout()
+			done()
+			return nil, err
+		}
+	}
+	releaseCluster := func() {
+		if clusterSlots != nil {
+			<-clusterSlots
+		}
+	}
+	select {
+	case q.workers <- struct{}{}:
+	case <-ctx.Done():
+		releaseCluster()
+		done()
+		return nil, ctx.Err()
+	case <-expired:
+		err := timeout()
+		releaseCluster()
+		done()
+		return nil, err
+	}
+
+	done()
+	return func() {
+		<-q.workers
+		releaseCluster()
+		q.mu.Lock()
+		q.observe(cluster)
+		q.mu.Unlock()
+	}, nil
+}
+
+// 在锁内调用
+func (q *applyQueue) observe(cluster string) {
+	inflight := len(q.workers)
+	if slots := q.clusters[cluster]; slots != nil {
+		inflight = len(slots)
+	}
+	metrics.ObserveApplyQueue(cluster, q.waiting, len(q.workers), inflight)
+}
+
+// 写入k8s前占用写入槽位，后台任务排队等待，其余请求在队列已满时直接拒绝
+func (u *RouteDataService) acquireApply(op *RouteOperation) (func(), error) {
+	u.applyQueue.init(u.Config.ApplyQueue)
+	return u.applyQueue.acquire(op.context(), u.Config.ClusterName, op.background)
+}
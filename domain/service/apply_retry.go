@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/logsample"
+	"github.com/zxnlx/route/notify"
+	"github.com/zxnlx/route/proto/route"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultApplyRetryMaxAttempts    = 5
+	defaultApplyRetryInitialSeconds = 30
+	defaultApplyRetryMaxSeconds     = 3600
+	applyRetryInterval              = 15 * time.Second
+	applyRetryBatchSize             = 50
+)
+
+// ApplyRetryConfig 写入k8s失败后的自动重试，按数据库记录重新写入
+type ApplyRetryConfig struct {
+	// MaxAttempts 自动重试次数，默认 5，为负数时不重试，超过后进入死信列表
+	MaxAttempts int `json:"max_attempts"`
+	// InitialBackoffSeconds 第一次重试的间隔，之后每次翻倍，默认 30 秒
+	InitialBackoffSeconds int `json:"initial_backoff_seconds"`
+	// MaxBackoffSeconds 重试间隔的上限，默认 3600 秒
+	MaxBackoffSeconds int `json:"max_backoff_seconds"`
+}
+
+// ErrRouteNotFailed 路由最近一次写入成功，不需要重试
+var ErrRouteNotFailed = i18n.NewError(i18n.CodeRouteNotFailed, "路由最近一次写入成功，不需要重试")
+
+// 按连续失败次数计算下次重试时间，返回 0 时不再重试
+func (c ApplyRetryConfig) next(now time.Time, failures int64) (int64, bool) {
+	maxAttempts := c.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = defaultApplyRetryMaxAttempts
+	}
+	if maxAttempts < 0 {
+		return 0, false
+	}
+	if failures > int64(maxAttempts) {
+		return 0, true
+	}
+	initial := c.InitialBackoffSeconds
+	if initial <= 0 {
+		initial = defaultApplyRetryInitialSeconds
+	}
+	maxBackoff := c.MaxBackoffSeconds
+	if maxBackoff <= 0 {
+		maxBackoff = defaultApplyRetryMaxSeconds
+	}
+	backoff := time.Duration(initial) * time.Second
+	for i := int64(1); i < failures && backoff < time.Duration(maxBackoff)*time.Second; i++ {
+		backoff *= 2
+	}
+	if backoff > time.Duration(maxBackoff)*time.Second {
+		backoff = time.Duration(maxBackoff) * time.Second
+	}
+	return now.Add(backoff).Unix(), false
+}
+
+// 在记录写入结果的事务中安排重试，进入死信列表时记录事件
+func (u *RouteDataService) scheduleRetry(repo repository.IRouteRepository, info *route.RouteInfo, failures int64) error {
+	nextRetryAt, deadLetter := u.Config.Retry.next(time.Now(), failures)
+	if err := repo.ScheduleRouteRetry(info.Id, nextRetryAt, deadLetter); err != nil {
+		return err
+	}
+	if !deadLetter {
+		return nil
+	}
+	return u.createOutbox(repo, info, notify.EventRouteDeadLettered, "连续写入失败 "+strconv.FormatInt(failures, 10)+" 次，已停止自动重试")
+}
+
+// RetryDueRoutes 重新写入到达重试时间的路由，返回处理的个数
+func (u *RouteDataService) RetryDueRoutes() (int, error) {
+	routes, err := u.RouteRepository.FindRoutesDueForRetry(time.Now(), applyRetryBatchSize)
+	if err != nil {
+		common.Error(err)
+		return 0, err
+	}
+	retried := 0
+	for _, v := range routes {
+		ok, err := u.retryRoute(v.ID, false)
+		if err != nil {
+			logsample.Error("自动重试路由 " + v.RouteNamespace + "/" + v.RouteName + " 失败：" + err.Error())
+		}
+		if ok {
+			retried++
+		}
+	}
+	return retried, nil
+}
+
+// RunApplyRetrier 定时重试写入失败的路由，ctx 结束时退出
+func (u *RouteDataService) RunApplyRetrier(ctx context.Context) {
+	ticker := time.NewTicker(applyRetryInterval)
+	defer ticker.Stop()
+	for {
+		if retried, err := u.RetryDueRoutes(); err == nil && retried > 0 {
+			common.Info("已自动重试 " + strconv.Itoa(retried) + " 个写入失败的路由")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// 加锁后重新读取，其他副本已经重试过或路由已禁用、删除时跳过，返回是否执行了写入
+func (u *RouteDataService) retryRoute(id int64, manual bool) (bool, error) {
+	route2, err := u.RouteRepository.FindRouteByID(id)
+	if err != nil {
+		return false, err
+	}
+	unlock, err := u.lockRoute(route2.RouteNamespace, route2.RouteName)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+	if route2, err = u.RouteRepository.FindRouteByID(id); err != nil {
+		return false, err
+	}
+	if route2.RouteDisabled || route2.RouteDeleting {
+		return false, u.RouteRepository.ScheduleRouteRetry(id, 0, false)
+	}
+	if manual {
+		if route2.RouteConsecutiveFailures == 0 {
+			return false, ErrRouteNotFailed
+		}
+	} else if route2.RouteDeadLetter || route2.RouteNextRetryAt == 0 || route2.RouteNextRetryAt > time.Now().Unix() {
+		return false, nil
+	}
+	info := &route.RouteInfo{}
+	if err := common.SwapTo(route2, info); err != nil {
+		return false, err
+	}
+	return true, u.applyUpdateToK8s(info)
+}
+
+// ListFailedRoutes 死信列表中的路由，命名空间支持通配符
+func (u *RouteDataService) ListFailedRoutes(req *route.FailedRoutesRequest) ([]model.Route, error) {
+	routes, err := u.RouteRepository.FindFailedRoutes(req.IncludeRetrying)
+	if err != nil {
+		return nil, err
+	}
+	if req.RouteNamespace == "" {
+		return routes, nil
+	}
+	matched := []model.Route{}
+	for _, v := range routes {
+		if matchAny([]string{req.RouteNamespace}, v.RouteNamespace) {
+			matched = append(matched, v)
+		}
+	}
+	return matched, nil
+}
+
+// RetryRoute 立即重新写入一次，失败时仍在死信列表中
+func (u *RouteDataService) RetryRoute(id int64, actor string) error {
+	common.Info("手动重试路由 ID：" + strconv.FormatInt(id, 10) + "，操作人 " + actor)
+	_, err := u.retryRoute(id, true)
+	return err
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"encoding/json"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/metrics"
+	"github.com/zxnlx/route/notify"
+	"github.com/zxnlx/route/proto/route"
+	"time"
+)
+
+// 记录写入k8s的结果到数据库和指标，写入状态和事件在同一事务中
+func (u *RouteDataService) recordApply(info *route.RouteInfo, start time.Time, applyErr error, message string) {
+	duration := time.Since(start)
+	eventType := notify.EventApplySucceeded
+	if applyErr != nil {
+		eventType = notify.EventApplyFailed
+		message = applyErr.Error()
+	}
+	var failures int64
+	err := u.RouteRepository.Transaction(func(repo repository.IRouteRepository) (err error) {
+		if failures, err = repo.UpdateRouteApplyStatus(info.Id, start, duration, applyErr); err != nil {
+			return err
+		}
+		if applyErr != nil {
+			if err := u.scheduleRetry(repo, info, failures); err != nil {
+				return err
+			}
+		}
+		return u.createOutbox(repo, info, eventType, message)
+	})
+	if err != nil {
+		common.Error(err)
+	}
+	metrics.ObserveApply(info.RouteNamespace, info.RouteName, duration, failures, applyErr)
+}
+
+// 没有对应数据库变更的事件单独写入
+func (u *RouteDataService) emitEvent(info *route.RouteInfo, eventType string, message string) {
+	if err := u.createOutbox(u.RouteRepository, info, eventType, message); err != nil {
+		common.Error(err)
+	}
+}
+
+func (u *RouteDataService) createOutbox(repo repository.IRouteRepository, info *route.RouteInfo, eventType string, message string) error {
+	now := time.Now()
+	payload, err := json.Marshal(notify.Event{
+		Type:           eventType,
+		RouteID:        info.Id,
+		RouteName:      info.RouteName,
+		RouteNamespace: info.RouteNamespace,
+		Message:        message,
+		Time:           now,
+	})
+	if err != nil {
+		return err
+	}
+	return repo.CreateOutbox(&model.Event{
+		RouteID:      info.Id,
+		EventType:    eventType,
+		EventMessage: message,
+		CreatedAt:    now,
+	}, &model.OutboxMessage{
+		EventType:     eventType,
+		Payload:       string(payload),
+		NextAttemptAt: now,
+	})
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultCluster 目前只管理启动时配置的一个集群
+const DefaultCluster = "default"
+
+// GetClusterCapabilities 通过 API 发现探测集群支持的功能
+func (u *RouteDataService) GetClusterCapabilities(cluster string) (*route.ClusterCapabilities, error) {
+	if cluster == "" {
+		cluster = DefaultCluster
+	}
+	if cluster != DefaultCluster {
+		return nil, i18n.NewError(i18n.CodeUnknownCluster, "未知的集群："+cluster)
+	}
+	if err := u.checkClusterScope(cluster); err != nil {
+		return nil, err
+	}
+	capabilities := &route.ClusterCapabilities{Cluster: cluster, IngressApiVersion: u.IngressAPIVersion}
+	version, err := u.K8sClientSet.Discovery().ServerVersion()
+	if err != nil {
+		return nil, err
+	}
+	capabilities.ServerVersion = version.GitVersion
+	groups, err := u.K8sClientSet.Discovery().ServerGroups()
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups.Groups {
+		switch group.Name {
+		case "networking.k8s.io":
+			for _, v := range group.Versions {
+				if v.GroupVersion == IngressAPIV1 || v.GroupVersion == IngressAPIV1beta1 {
+					capabilities.IngressApiVersions = append(capabilities.IngressApiVersions, v.GroupVersion)
+				}
+			}
+		case httpRouteResource.Group:
+			capabilities.GatewayApi = true
+			for _, v := range group.Versions {
+				capabilities.GatewayApiVersions = append(capabilities.GatewayApiVersions, v.GroupVersion)
+			}
+		case certificateResource.Group:
+			capabilities.CertManager = true
+		case "networking.istio.io":
+			capabilities.Istio = true
+		}
+	}
+	if capabilities.IngressClasses, err = u.listIngressClasses(); err != nil {
+		return nil, err
+	}
+	return capabilities, nil
+}
+
+// IngressClass 在 1.18 为 v1beta1，1.19 起为 v1
+func (u *RouteDataService) listIngressClasses() ([]string, error) {
+	names := []string{}
+	if u.IngressAPIVersion == IngressAPIV1beta1 {
+		list, err := u.K8sClientSet.NetworkingV1beta1().IngressClasses().List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range list.Items {
+			names = append(names, v.Name)
+		}
+		return names, nil
+	}
+	list, err := u.K8sClientSet.NetworkingV1().IngressClasses().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range list.Items {
+		names = append(names, v.Name)
+	}
+	return names, nil
+}
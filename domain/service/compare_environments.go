@@ -0,0 +1,147 @@
+package service
+
+import (
+	"errors"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/proto/route"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 环境差异类型
+const (
+	EnvDiffMissingInA     = "missing_in_a"
+	EnvDiffMissingInB     = "missing_in_b"
+	EnvDiffHostMismatch   = "host_mismatch"
+	EnvDiffBackendDrift   = "backend_drift"
+	EnvDiffPathMissingInA = "path_missing_in_a"
+	EnvDiffPathMissingInB = "path_missing_in_b"
+)
+
+// CompareEnvironments 对比两个环境中的路由，按去掉环境后缀的命名空间和路由名称配对
+// applicationID、routeName 为空时对比全部路由
+func (u *RouteDataService) CompareEnvironments(req *route.CompareEnvironmentsRequest) (*route.EnvironmentComparison, error) {
+	envA, ok := u.Config.Environments[req.EnvA]
+	if !ok {
+		return nil, errors.New("未配置环境 " + req.EnvA)
+	}
+	envB, ok := u.Config.Environments[req.EnvB]
+	if !ok {
+		return nil, errors.New("未配置环境 " + req.EnvB)
+	}
+	if req.EnvA == req.EnvB {
+		return nil, errors.New("对比的两个环境不能相同")
+	}
+	routes, err := u.RouteRepository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	routesA := map[string]*model.Route{}
+	routesB := map[string]*model.Route{}
+	for i := range routes {
+		v := &routes[i]
+		if req.RouteApplicationId != 0 && v.RouteApplicationID != req.RouteApplicationId {
+			continue
+		}
+		if req.RouteName != "" && v.RouteName != req.RouteName {
+			continue
+		}
+		name, env, ok := u.environmentOf(v.RouteNamespace)
+		if !ok {
+			continue
+		}
+		key := strings.TrimSuffix(v.RouteNamespace, env.NamespaceSuffix) + "/" + v.RouteName
+		switch name {
+		case req.EnvA:
+			routesA[key] = v
+		case req.EnvB:
+			routesB[key] = v
+		}
+	}
+	keys := []string{}
+	for k := range routesA {
+		keys = append(keys, k)
+	}
+	for k := range routesB {
+		if _, ok := routesA[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	comparison := &route.EnvironmentComparison{EnvA: req.EnvA, EnvB: req.EnvB}
+	for _, k := range keys {
+		a, b := routesA[k], routesB[k]
+		switch {
+		case a == nil:
+			comparison.Differences = append(comparison.Differences, envDifference(EnvDiffMissingInA, k, a, b, "", ""))
+		case b == nil:
+			comparison.Differences = append(comparison.Differences, envDifference(EnvDiffMissingInB, k, a, b, "", ""))
+		default:
+			comparison.Differences = append(comparison.Differences, compareRoutes(k, a, b, envA, envB)...)
+		}
+	}
+	comparison.InSync = len(comparison.Differences) == 0
+	return comparison, nil
+}
+
+// 域名去掉环境后缀后对比，后端按路径名称配对对比服务和端口
+func compareRoutes(key string, a *model.Route, b *model.Route, envA EnvironmentConfig, envB EnvironmentConfig) []*route.EnvironmentDifference {
+	differences := []*route.EnvironmentDifference{}
+	if strings.TrimSuffix(a.RouteHost, envA.HostSuffix) != strings.TrimSuffix(b.RouteHost, envB.HostSuffix) {
+		differences = append(differences, envDifference(EnvDiffHostMismatch, key, a, b, a.RouteHost, b.RouteHost))
+	}
+	pathsA := map[string]model.RoutePath{}
+	for _, v := range a.RoutePath {
+		pathsA[v.RoutePathName] = v
+	}
+	pathsB := map[string]model.RoutePath{}
+	for _, v := range b.RoutePath {
+		pathsB[v.RoutePathName] = v
+	}
+	names := []string{}
+	for k := range pathsA {
+		names = append(names, k)
+	}
+	for k := range pathsB {
+		if _, ok := pathsA[k]; !ok {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pa, inA := pathsA[name]
+		pb, inB := pathsB[name]
+		var diff *route.EnvironmentDifference
+		switch {
+		case !inA:
+			diff = envDifference(EnvDiffPathMissingInA, key, a, b, "", pathBackend(pb))
+		case !inB:
+			diff = envDifference(EnvDiffPathMissingInB, key, a, b, pathBackend(pa), "")
+		case pathBackend(pa) != pathBackend(pb):
+			diff = envDifference(EnvDiffBackendDrift, key, a, b, pathBackend(pa), pathBackend(pb))
+		default:
+			continue
+		}
+		diff.RoutePath = name
+		differences = append(differences, diff)
+	}
+	return differences
+}
+
+func envDifference(kind string, key string, a *model.Route, b *model.Route, valueA string, valueB string) *route.EnvironmentDifference {
+	diff := &route.EnvironmentDifference{Kind: kind, Key: key, ValueA: valueA, ValueB: valueB}
+	if a != nil {
+		diff.IdA = a.ID
+		diff.NamespaceA = a.RouteNamespace
+	}
+	if b != nil {
+		diff.IdB = b.ID
+		diff.NamespaceB = b.RouteNamespace
+	}
+	return diff
+}
+
+func pathBackend(path model.RoutePath) string {
+	return path.RouteBackendService + ":" + strconv.Itoa(int(path.RouteBackendServicePort))
+}
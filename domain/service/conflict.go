@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/zxnlx/route/proto/route"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"strings"
+)
+
+// 创建时k8s中已存在同名资源的处理策略
+const (
+	// ConflictPolicyFail 直接失败，默认
+	ConflictPolicyFail = "fail"
+	// ConflictPolicyAdopt 以k8s中的规格为准写入数据库，只补上管理标签
+	ConflictPolicyAdopt = "adopt"
+	// ConflictPolicyReplace 用本服务的规格覆盖k8s中的资源
+	ConflictPolicyReplace = "replace"
+)
+
+func checkConflictPolicy(policy string) error {
+	switch policy {
+	case "", ConflictPolicyFail, ConflictPolicyAdopt, ConflictPolicyReplace:
+		return nil
+	}
+	return errors.New("不支持的冲突策略：" + policy)
+}
+
+// 合并标签或注解，src 覆盖 dst
+func mergeStringMap(dst map[string]string, src map[string]string) map[string]string {
+	if dst == nil {
+		dst = map[string]string{}
+	}
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
+
+// 读取k8s中已存在的同名资源，用它的规格覆盖 info，不存在时返回 false
+func (u *RouteDataService) importFromK8s(info *route.RouteInfo) (bool, error) {
+	if err := u.checkScope(info); err != nil {
+		return false, err
+	}
+	if info.RouteAdapter == AdapterIstio {
+		return false, errors.New("路由 " + info.RouteName + " 使用 " + AdapterIstio + " 时不支持导入已存在的 VirtualService")
+	}
+	if info.RouteAdapter == AdapterGatewayAPI {
+		httpRoute, err := u.K8sDynamicClient.Resource(httpRouteResource).Namespace(info.RouteNamespace).Get(context.TODO(), info.RouteName, metav1.GetOptions{})
+		if err != nil {
+			return false, ignoreNotFound(err)
+		}
+		if err := u.checkObjectScope("HTTPRoute", httpRoute.GetNamespace(), httpRoute.GetName(), httpRoute.GetLabels()); err != nil {
+			return false, err
+		}
+		return true, u.importHTTPRoute(httpRoute, info)
+	}
+	ingress, err := u.ingresses(info.RouteNamespace).Get(context.TODO(), info.RouteName, metav1.GetOptions{})
+	if err != nil {
+		return false, ignoreNotFound(err)
+	}
+	if err := u.checkObjectScope("Ingress", ingress.Namespace, ingress.Name, ingress.Labels); err != nil {
+		return false, err
+	}
+	return true, u.importIngress(ingress, info)
+}
+
+// 清空 info 中的规格字段，由导入的资源重新填充
+func resetRouteSpec(info *route.RouteInfo) {
+	info.RouteHost = ""
+	info.RoutePath = nil
+	info.RouteClass = ""
+	info.RouteAnnotations = nil
+	info.RouteTlsIssuer = ""
+	info.RouteGateway = ""
+	info.RouteResponseHeaderSet = nil
+	info.RouteResponseHeaderRemove = nil
+	info.RouteRequestHeaderAdd = nil
+	info.RouteRequestHeaderSet = nil
+	info.RouteRequestHeaderRemove = nil
+}
+
+// 导入 Ingress，只支持单个 host，管理注解不导入，证书签发者转换为 RouteTlsIssuer
+func (u *RouteDataService) importIngress(ingress *networkingv1.Ingress, info *route.RouteInfo) error {
+	resetRouteSpec(info)
+	if ingress.Spec.IngressClassName != nil {
+		info.RouteClass = *ingress.Spec.IngressClassName
+	}
+	for k, v := range ingress.Annotations {
+		if strings.HasPrefix(k, u.Config.Stamp.prefix()) {
+			continue
+		}
+		if k == "cert-manager.io/cluster-issuer" {
+			info.RouteTlsIssuer = v
+			continue
+		}
+		if info.RouteAnnotations == nil {
+			info.RouteAnnotations = map[string]string{}
+		}
+		info.RouteAnnotations[k] = v
+	}
+	for _, rule := range ingress.Spec.Rules {
+		if info.RouteHost != "" && rule.Host != info.RouteHost {
+			return errors.New("Ingress " + ingress.Name + " 包含多个 host，无法导入")
+		}
+		info.RouteHost = rule.Host
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, v := range rule.HTTP.Paths {
+			if v.Backend.Service == nil {
+				return errors.New("Ingress " + ingress.Name + " 的路径 " + v.Path + " 不是 Service 后端，无法导入")
+			}
+			info.RoutePath = append(info.RoutePath, &route.RoutePath{
+				RoutePathName:           v.Path,
+				RouteBackendService:     v.Backend.Service.Name,
+				RouteBackendServicePort: v.Backend.Service.Port.Number,
+			})
+		}
+	}
+	return nil
+}
+
+// 导入 HTTPRoute，只导入 host、Gateway、路径和后端，每条规则取第一个匹配条件和第一个后端
+func (u *RouteDataService) importHTTPRoute(httpRoute *unstructured.Unstructured, info *route.RouteInfo) error {
+	resetRouteSpec(info)
+	hostnames, _, _ := unstructured.NestedStringSlice(httpRoute.Object, "spec", "hostnames")
+	if len(hostnames) > 1 {
+		return errors.New("HTTPRoute " + httpRoute.GetName() + " 包含多个 host，无法导入")
+	}
+	if len(hostnames) == 1 {
+		info.RouteHost = hostnames[0]
+	}
+	parentRefs, _, _ := unstructured.NestedSlice(httpRoute.Object, "spec", "parentRefs")
+	if len(parentRefs) > 0 {
+		parentRef, _ := parentRefs[0].(map[string]interface{})
+		name, _, _ := unstructured.NestedString(parentRef, "name")
+		info.RouteGateway = name
+		if namespace, _, _ := unstructured.NestedString(parentRef, "namespace"); namespace != "" {
+			info.RouteGateway = namespace + "/" + name
+		}
+	}
+	rules, _, _ := unstructured.NestedSlice(httpRoute.Object, "spec", "rules")
+	for _, v := range rules {
+		rule, _ := v.(map[string]interface{})
+		path := &route.RoutePath{RoutePathName: "/"}
+		if matches, _, _ := unstructured.NestedSlice(rule, "matches"); len(matches) > 0 {
+			match, _ := matches[0].(map[string]interface{})
+			if value, _, _ := unstructured.NestedString(match, "path", "value"); value != "" {
+				path.RoutePathName = value
+			}
+		}
+		backendRefs, _, _ := unstructured.NestedSlice(rule, "backendRefs")
+		if len(backendRefs) == 0 {
+			return errors.New("HTTPRoute " + httpRoute.GetName() + " 存在没有后端的规则，无法导入")
+		}
+		backendRef, _ := backendRefs[0].(map[string]interface{})
+		path.RouteBackendService, _, _ = unstructured.NestedString(backendRef, "name")
+		port, _, _ := unstructured.NestedInt64(backendRef, "port")
+		path.RouteBackendServicePort = int32(port)
+		info.RoutePath = append(info.RoutePath, path)
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/metrics"
+	"github.com/zxnlx/route/notify"
+	"github.com/zxnlx/route/proto/route"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"strconv"
+	"time"
+)
+
+// ConsistencyCheckConfig 启动时对比数据库和集群，默认关闭
+type ConsistencyCheckConfig struct {
+	Enabled bool `json:"enabled"`
+	// RepairMissing 重新创建集群中缺失的资源
+	RepairMissing bool `json:"repair_missing"`
+	// DeleteOrphaned 删除带有本服务管理标签但数据库中没有对应路由的资源
+	DeleteOrphaned bool `json:"delete_orphaned"`
+}
+
+// ConsistencyIssue 一个不一致的资源
+type ConsistencyIssue struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	RouteID   int64  `json:"route_id"`
+	Repaired  bool   `json:"repaired"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ConsistencyReport 一致性检查结果
+type ConsistencyReport struct {
+	// Missing 数据库中启用但集群中不存在
+	Missing []ConsistencyIssue `json:"missing"`
+	// Orphaned 集群中带有管理标签但数据库中不存在或已禁用
+	Orphaned []ConsistencyIssue `json:"orphaned"`
+}
+
+// 按路由实现方式区分的资源
+type managedKind struct {
+	kind     string
+	resource schema.GroupVersionResource
+}
+
+var managedKinds = []managedKind{
+	{kind: "Ingress"},
+	{kind: "HTTPRoute", resource: httpRouteResource},
+	{kind: "VirtualService", resource: virtualServiceResource},
+}
+
+func routeKind(adapter string) string {
+	switch adapter {
+	case AdapterGatewayAPI:
+		return "HTTPRoute"
+	case AdapterIstio:
+		return "VirtualService"
+	}
+	return "Ingress"
+}
+
+// CheckConsistency 对比数据库和集群，按配置修复，结果写入指标和事件
+// 多副本时通过分布式锁串行执行，先完成的副本修复后其他副本不会再发现差异
+func (u *RouteDataService) CheckConsistency(config ConsistencyCheckConfig) (*ConsistencyReport, error) {
+	if u.Locker != nil {
+		unlock, err := u.Locker.Lock("consistency-check")
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+	}
+	routes, err := u.RouteRepository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	live, err := u.listManagedObjects()
+	if err != nil {
+		return nil, err
+	}
+	report := &ConsistencyReport{Missing: []ConsistencyIssue{}, Orphaned: []ConsistencyIssue{}}
+	enabled := map[int64]bool{}
+	for i := range routes {
+		v := &routes[i]
+		if v.RouteDisabled || v.RouteDeleting || u.checkNamespaceScope(v.RouteNamespace) != nil {
+			continue
+		}
+		enabled[v.ID] = true
+		kind := routeKind(v.RouteAdapter)
+		if _, ok := live[kind+"/"+v.RouteNamespace+"/"+v.RouteName]; ok {
+			continue
+		}
+		issue := ConsistencyIssue{Kind: kind, Namespace: v.RouteNamespace, Name: v.RouteName, RouteID: v.ID}
+		if config.RepairMissing {
+			if err := u.repairMissing(v.ID); err != nil {
+				issue.Error = err.Error()
+			} else {
+				issue.Repaired = true
+			}
+		}
+		u.emitConsistencyEvent(notify.EventDriftDetected, issue, "数据库中存在，集群中缺失")
+		report.Missing = append(report.Missing, issue)
+	}
+	for _, issue := range live {
+		if enabled[issue.RouteID] {
+			continue
+		}
+		eventType := notify.EventDriftDetected
+		if config.DeleteOrphaned {
+			if err := u.deleteOrphaned(issue); err != nil {
+				issue.Error = err.Error()
+			} else {
+				issue.Repaired = true
+				eventType = notify.EventOrphanDeleted
+			}
+		}
+		u.emitConsistencyEvent(eventType, issue, "集群中存在，数据库中没有启用的路由")
+		report.Orphaned = append(report.Orphaned, issue)
+	}
+	repairedMissing, repairedOrphaned := 0, 0
+	for _, v := range report.Missing {
+		if v.Repaired {
+			repairedMissing++
+		}
+	}
+	for _, v := range report.Orphaned {
+		if v.Repaired {
+			repairedOrphaned++
+		}
+	}
+	metrics.ObserveConsistency(len(report.Missing), len(report.Orphaned), repairedMissing, repairedOrphaned)
+	return report, nil
+}
+
+// 列出所有命名空间中带有本服务管理标签的资源，key 为 kind/namespace/name，没有安装的 CRD 跳过
+func (u *RouteDataService) listManagedObjects() (map[string]ConsistencyIssue, error) {
+	stamp := u.Config.Stamp
+	identity := stamp.ServiceIdentity
+	if identity == "" {
+		identity = defaultStampServiceIdentity
+	}
+	selector := stamp.prefix() + "managed-by=" + identity
+	//多个环境共用集群时只检查本环境的资源
+	if stamp.Environment != "" {
+		selector += "," + stamp.prefix() + "environment=" + stamp.Environment
+	}
+	options := metav1.ListOptions{LabelSelector: selector}
+	objects := map[string]ConsistencyIssue{}
+	add := func(kind string, obj metav1.Object) {
+		if u.checkNamespaceScope(obj.GetNamespace()) != nil {
+			return
+		}
+		id, _ := strconv.ParseInt(obj.GetLabels()[stamp.prefix()+"route-id"], 10, 64)
+		objects[kind+"/"+obj.GetNamespace()+"/"+obj.GetName()] = ConsistencyIssue{Kind: kind, Namespace: obj.GetNamespace(), Name: obj.GetName(), RouteID: id}
+	}
+	for _, v := range managedKinds {
+		if v.kind == "Ingress" {
+			ingresses, err := u.ingresses(metav1.NamespaceAll).List(context.TODO(), options)
+			if err != nil {
+				return nil, err
+			}
+			for i := range ingresses.Items {
+				add(v.kind, &ingresses.Items[i])
+			}
+			continue
+		}
+		list, err := u.K8sDynamicClient.Resource(v.resource).Namespace(metav1.NamespaceAll).List(context.TODO(), options)
+		if err != nil {
+			if err = ignoreNotFound(err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		for i := range list.Items {
+			add(v.kind, &list.Items[i])
+		}
+	}
+	return objects, nil
+}
+
+// 按数据库记录重新创建，不改变版本号
+func (u *RouteDataService) repairMissing(id int64) error {
+	route2, err := u.RouteRepository.FindRouteByID(id)
+	if err != nil {
+		return err
+	}
+	unlock, err := u.lockRoute(route2.RouteNamespace, route2.RouteName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if route2, err = u.RouteRepository.FindRouteByID(id); err != nil {
+		return err
+	}
+	if route2.RouteDisabled || route2.RouteDeleting {
+		return nil
+	}
+	info := &route.RouteInfo{}
+	if err := common.SwapTo(route2, info); err != nil {
+		return err
+	}
+	op := &RouteOperation{Action: ActionCreate, Info: info, Changed: true}
+	if err := u.runStage(StageValidate, op, u.validateRoute); err != nil {
+		return err
+	}
+	start := time.Now()
+	err = u.runStage(StageApply, op, u.applyRoute)
+	u.recordApply(info, start, err, "一致性检查重新创建，版本 "+strconv.FormatInt(info.RouteRevision, 10))
+	return err
+}
+
+func (u *RouteDataService) deleteOrphaned(issue ConsistencyIssue) error {
+	route2 := &model.Route{ID: issue.RouteID, RouteNamespace: issue.Namespace, RouteName: issue.Name}
+	switch issue.Kind {
+	case "HTTPRoute":
+		route2.RouteAdapter = AdapterGatewayAPI
+	case "VirtualService":
+		route2.RouteAdapter = AdapterIstio
+	}
+	return ignoreNotFound(u.deleteFromK8s(route2))
+}
+
+func (u *RouteDataService) emitConsistencyEvent(eventType string, issue ConsistencyIssue, message string) {
+	message = issue.Kind + " " + message
+	if issue.Repaired {
+		message += "，已修复"
+	} else if issue.Error != "" {
+		message += "，修复失败：" + issue.Error
+	}
+	u.emitEvent(&route.RouteInfo{Id: issue.RouteID, RouteName: issue.Name, RouteNamespace: issue.Namespace}, eventType, message)
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/notify"
+	"github.com/zxnlx/route/proto/route"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sort"
+	"strings"
+)
+
+// ControllerConfigMap Ingress 控制器的全局配置所在的 ConfigMap
+type ControllerConfigMap struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// AllowedKeys 允许修改的 key，支持通配符，为空时不限制
+	AllowedKeys []string `json:"allowed_keys"`
+}
+
+// 未配置时使用 ingress-nginx 默认安装的 ConfigMap
+var defaultControllerConfigMaps = map[string]ControllerConfigMap{
+	"nginx": {Namespace: "ingress-nginx", Name: "ingress-nginx-controller"},
+}
+
+func (u *RouteDataService) controllerConfigMap(controller string) (ControllerConfigMap, error) {
+	if v, ok := u.Config.ControllerConfigMaps[controller]; ok {
+		return v, nil
+	}
+	if v, ok := defaultControllerConfigMaps[controller]; ok {
+		return v, nil
+	}
+	return ControllerConfigMap{}, errors.New("未配置控制器 " + controller + " 的全局 ConfigMap")
+}
+
+// GetControllerConfig 读取控制器的全局配置
+func (u *RouteDataService) GetControllerConfig(controller string) (*route.ControllerConfig, error) {
+	config, err := u.controllerConfigMap(controller)
+	if err != nil {
+		return nil, err
+	}
+	configMap, err := u.K8sClientSet.CoreV1().ConfigMaps(config.Namespace).Get(context.TODO(), config.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	prefix := u.Config.Stamp.prefix()
+	return &route.ControllerConfig{
+		Controller:      controller,
+		Namespace:       configMap.Namespace,
+		Name:            configMap.Name,
+		Data:            configMap.Data,
+		ResourceVersion: configMap.ResourceVersion,
+		ChangedBy:       configMap.Annotations[prefix+"changed-by"],
+		ChangeReason:    configMap.Annotations[prefix+"change-reason"],
+	}, nil
+}
+
+// PatchControllerConfig 修改控制器的全局配置，修改人和原因写入注解，变更内容记录为事件
+func (u *RouteDataService) PatchControllerConfig(patch *route.ControllerConfigPatch, actor string) (*route.ControllerConfig, error) {
+	config, err := u.controllerConfigMap(patch.Controller)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(patch.Reason) == "" {
+		return nil, errors.New("修改控制器全局配置需要填写原因")
+	}
+	if len(patch.Set) == 0 && len(patch.Remove) == 0 {
+		return nil, errors.New("没有需要修改的配置")
+	}
+	keys := make([]string, 0, len(patch.Set)+len(patch.Remove))
+	for k := range patch.Set {
+		keys = append(keys, k)
+	}
+	keys = append(keys, patch.Remove...)
+	for _, k := range keys {
+		if len(config.AllowedKeys) > 0 && !matchAny(config.AllowedKeys, k) {
+			return nil, errors.New("不允许修改控制器配置 " + k)
+		}
+	}
+	client := u.K8sClientSet.CoreV1().ConfigMaps(config.Namespace)
+	configMap, err := client.Get(context.TODO(), config.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if patch.ResourceVersion != "" && patch.ResourceVersion != configMap.ResourceVersion {
+		return nil, i18n.NewError(i18n.CodeConfigMapConflict, "ConfigMap "+config.Namespace+"/"+config.Name+" 已被修改，请重新读取")
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	changes := []string{}
+	sort.Strings(keys)
+	for _, k := range keys {
+		old, existed := configMap.Data[k]
+		if v, ok := patch.Set[k]; ok {
+			if existed && old == v {
+				continue
+			}
+			configMap.Data[k] = v
+			changes = append(changes, k+": "+quoteConfigValue(old, existed)+" -> "+quoteConfigValue(v, true))
+		} else if existed {
+			delete(configMap.Data, k)
+			changes = append(changes, k+": "+quoteConfigValue(old, true)+" -> <删除>")
+		}
+	}
+	prefix := u.Config.Stamp.prefix()
+	configMap.Annotations = mergeStringMap(configMap.Annotations, map[string]string{
+		prefix + "changed-by":    actor,
+		prefix + "change-reason": patch.Reason,
+	})
+	//乐观锁，期间被修改时返回冲突
+	if _, err := client.Update(context.TODO(), configMap, metav1.UpdateOptions{}); err != nil {
+		return nil, err
+	}
+	if len(changes) > 0 {
+		message := actor + " 修改了 " + patch.Controller + " 控制器配置 " + config.Namespace + "/" + config.Name + "，原因：" + patch.Reason + "；" + strings.Join(changes, "；")
+		common.Info(message)
+		u.emitEvent(&route.RouteInfo{}, notify.EventControllerConfigChanged, message)
+	}
+	return u.GetControllerConfig(patch.Controller)
+}
+
+func quoteConfigValue(v string, existed bool) string {
+	if !existed {
+		return "<未设置>"
+	}
+	return "\"" + v + "\""
+}
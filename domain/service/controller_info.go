@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ControllerWorkload 查找 Ingress 控制器工作负载的条件
+type ControllerWorkload struct {
+	// Namespace 为空时在所有命名空间中查找
+	Namespace string `json:"namespace"`
+	// Selector Deployment/DaemonSet 的标签选择器
+	Selector string `json:"selector"`
+}
+
+// 按 IngressClass 的 spec.controller 内置常见控制器的 Helm chart 标签
+var defaultControllerWorkloads = map[string]ControllerWorkload{
+	"k8s.io/ingress-nginx":          {Selector: "app.kubernetes.io/name=ingress-nginx,app.kubernetes.io/component=controller"},
+	"traefik.io/ingress-controller": {Selector: "app.kubernetes.io/name=traefik"},
+	"ingress.k8s.aws/alb":           {Namespace: "kube-system", Selector: "app.kubernetes.io/name=aws-load-balancer-controller"},
+}
+
+// 最近重启的统计时间范围
+const controllerRecentRestartWindow = time.Hour
+
+const defaultIngressClassAnnotation = "ingressclass.kubernetes.io/is-default-class"
+
+// GetControllerInfo 查找 Ingress class 对应的控制器工作负载，返回副本状态、镜像版本和重启次数
+func (u *RouteDataService) GetControllerInfo(req *route.ControllerInfoRequest) (*route.ControllerInfo, error) {
+	cluster := req.Cluster
+	if cluster == "" {
+		cluster = DefaultCluster
+	}
+	if cluster != DefaultCluster {
+		return nil, i18n.NewError(i18n.CodeUnknownCluster, "未知的集群："+cluster)
+	}
+	if err := u.checkClusterScope(cluster); err != nil {
+		return nil, err
+	}
+	class, controller, err := u.ingressClassController(req.RouteClass)
+	if err != nil {
+		return nil, err
+	}
+	workload, ok := u.Config.ControllerWorkloads[controller]
+	if !ok {
+		if workload, ok = defaultControllerWorkloads[controller]; !ok {
+			return nil, errors.New("未配置控制器 " + controller + " 的工作负载，请在 controller_workloads 中添加")
+		}
+	}
+	info := &route.ControllerInfo{RouteClass: class, Cluster: cluster, Controller: controller, Healthy: true}
+	options := metav1.ListOptions{LabelSelector: workload.Selector}
+	deployments, err := u.K8sClientSet.AppsV1().Deployments(workload.Namespace).List(context.TODO(), options)
+	if err != nil {
+		return nil, err
+	}
+	for i := range deployments.Items {
+		v := &deployments.Items[i]
+		desired := int32(1)
+		if v.Spec.Replicas != nil {
+			desired = *v.Spec.Replicas
+		}
+		w := &route.ControllerWorkloadInfo{
+			Kind:              "Deployment",
+			Namespace:         v.Namespace,
+			Name:              v.Name,
+			DesiredReplicas:   desired,
+			ReadyReplicas:     v.Status.ReadyReplicas,
+			UpdatedReplicas:   v.Status.UpdatedReplicas,
+			AvailableReplicas: v.Status.AvailableReplicas,
+		}
+		if err := u.fillControllerWorkload(w, v.Labels, v.Spec.Template.Spec, v.Spec.Selector); err != nil {
+			return nil, err
+		}
+		info.Workloads = append(info.Workloads, w)
+	}
+	daemonSets, err := u.K8sClientSet.AppsV1().DaemonSets(workload.Namespace).List(context.TODO(), options)
+	if err != nil {
+		return nil, err
+	}
+	for i := range daemonSets.Items {
+		v := &daemonSets.Items[i]
+		w := &route.ControllerWorkloadInfo{
+			Kind:              "DaemonSet",
+			Namespace:         v.Namespace,
+			Name:              v.Name,
+			DesiredReplicas:   v.Status.DesiredNumberScheduled,
+			ReadyReplicas:     v.Status.NumberReady,
+			UpdatedReplicas:   v.Status.UpdatedNumberScheduled,
+			AvailableReplicas: v.Status.NumberAvailable,
+		}
+		if err := u.fillControllerWorkload(w, v.Labels, v.Spec.Template.Spec, v.Spec.Selector); err != nil {
+			return nil, err
+		}
+		info.Workloads = append(info.Workloads, w)
+	}
+	if len(info.Workloads) == 0 {
+		return nil, errors.New("没有找到控制器 " + controller + " 的 Deployment 或 DaemonSet（" + workload.Selector + "）")
+	}
+	for _, w := range info.Workloads {
+		info.Healthy = info.Healthy && w.Healthy
+	}
+	return info, nil
+}
+
+// 为空时使用带默认注解的 IngressClass
+func (u *RouteDataService) ingressClassController(class string) (string, string, error) {
+	type ingressClass struct {
+		name       string
+		controller string
+		isDefault  bool
+	}
+	classes := []ingressClass{}
+	if u.IngressAPIVersion == IngressAPIV1beta1 {
+		list, err := u.K8sClientSet.NetworkingV1beta1().IngressClasses().List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return "", "", err
+		}
+		for _, v := range list.Items {
+			classes = append(classes, ingressClass{v.Name, v.Spec.Controller, v.Annotations[defaultIngressClassAnnotation] == "true"})
+		}
+	} else {
+		list, err := u.K8sClientSet.NetworkingV1().IngressClasses().List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return "", "", err
+		}
+		for _, v := range list.Items {
+			classes = append(classes, ingressClass{v.Name, v.Spec.Controller, v.Annotations[defaultIngressClassAnnotation] == "true"})
+		}
+	}
+	for _, v := range classes {
+		if class == "" && v.isDefault || class != "" && v.name == class {
+			return v.name, v.controller, nil
+		}
+	}
+	if class == "" {
+		return "", "", i18n.NewError(i18n.CodeIngressClassRequired, "集群没有默认的 IngressClass，请指定 route_class")
+	}
+	return "", "", i18n.NewError(i18n.CodeIngressClassNotFound, "IngressClass "+class+" 不存在")
+}
+
+// 补充镜像、版本和 Pod 的重启情况
+func (u *RouteDataService) fillControllerWorkload(w *route.ControllerWorkloadInfo, labels map[string]string, spec corev1.PodSpec, selector *metav1.LabelSelector) error {
+	for _, c := range spec.Containers {
+		w.Images = append(w.Images, c.Image)
+	}
+	w.Version = labels["app.kubernetes.io/version"]
+	if w.Version == "" && len(w.Images) > 0 {
+		w.Version = imageTag(w.Images[0])
+	}
+	w.Healthy = w.DesiredReplicas > 0 && w.ReadyReplicas >= w.DesiredReplicas && w.UpdatedReplicas >= w.DesiredReplicas
+	podSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return err
+	}
+	pods, err := u.K8sClientSet.CoreV1().Pods(w.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: podSelector.String()})
+	if err != nil {
+		return err
+	}
+	since := time.Now().Add(-controllerRecentRestartWindow)
+	for _, pod := range pods.Items {
+		p := &route.ControllerPodInfo{Name: pod.Name, Node: pod.Spec.NodeName, Phase: string(pod.Status.Phase), Ready: podReady(&pod)}
+		var lastRestart time.Time
+		for _, c := range pod.Status.ContainerStatuses {
+			p.Restarts += c.RestartCount
+			if p.Image == "" {
+				p.Image = c.Image
+			}
+			if t := c.LastTerminationState.Terminated; t != nil && t.FinishedAt.Time.After(lastRestart) {
+				lastRestart = t.FinishedAt.Time
+				p.LastRestartReason = t.Reason
+				p.LastRestartTime = t.FinishedAt.Unix()
+			}
+		}
+		w.Restarts += p.Restarts
+		if lastRestart.After(since) {
+			w.RecentlyRestartedPods++
+		}
+		w.Pods = append(w.Pods, p)
+	}
+	sort.Slice(w.Pods, func(i, j int) bool { return w.Pods[i].Name < w.Pods[j].Name })
+	return nil
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// 去掉 digest 后取最后一个冒号之后的部分，冒号在最后一个 / 之前时为端口
+func imageTag(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	i := strings.LastIndex(image, ":")
+	if i < 0 || i < strings.LastIndex(image, "/") {
+		return "latest"
+	}
+	return image[i+1:]
+}
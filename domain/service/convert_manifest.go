@@ -0,0 +1,235 @@
+package service
+
+import (
+	"errors"
+	"github.com/zxnlx/route/proto/route"
+	"io"
+	networkingv1 "k8s.io/api/networking/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 转换 Ingress 时的警告规则
+const (
+	// ConvertUnsupported 字段无法对应到路由规格，已跳过
+	ConvertUnsupported = "unsupported-field"
+	// ConvertApproximate 字段按近似的方式转换，行为可能和原来不同
+	ConvertApproximate = "approximate-field"
+)
+
+// ConvertManifest 转换 Ingress YAML 为路由规格，每个 Ingress 的每个域名一条，无法对应的字段以警告返回，不写入
+func (u *RouteDataService) ConvertManifest(req *route.ConvertManifestRequest) (*route.LegacyImportResult, error) {
+	if strings.TrimSpace(req.Manifest) == "" {
+		return nil, errors.New("YAML 不能为空")
+	}
+	if len(req.Manifest) > maxLegacyConfigSize {
+		return nil, errors.New("YAML 超过 4MB")
+	}
+	rsp := &route.LegacyImportResult{}
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(req.Manifest), 4096)
+	for doc := 1; ; doc++ {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, errors.New("第 " + strconv.Itoa(doc) + " 个文档：" + err.Error())
+		}
+		//空文档
+		if len(obj.Object) == 0 {
+			continue
+		}
+		objects := []*unstructured.Unstructured{obj}
+		if obj.IsList() {
+			objects = nil
+			_ = obj.EachListItem(func(item runtime.Object) error {
+				objects = append(objects, item.(*unstructured.Unstructured))
+				return nil
+			})
+		}
+		for _, v := range objects {
+			source := v.GetKind() + " " + v.GetName()
+			if v.GetKind() != "Ingress" {
+				rsp.Warnings = append(rsp.Warnings, convertWarning(ConvertUnsupported, LintSeverityInfo, "kind", source+" 不是 Ingress，已跳过"))
+				continue
+			}
+			ingress, err := decodeIngress(v)
+			if err != nil {
+				return nil, errors.New(source + "：" + err.Error())
+			}
+			if ingress == nil {
+				rsp.Warnings = append(rsp.Warnings, convertWarning(ConvertUnsupported, LintSeverityWarning, "apiVersion", source+" 的 apiVersion "+v.GetAPIVersion()+" 不支持"))
+				continue
+			}
+			if ingress.Namespace == "" {
+				ingress.Namespace = req.Namespace
+			}
+			drafts, warnings := u.convertIngress(ingress)
+			rsp.Drafts = append(rsp.Drafts, drafts...)
+			rsp.Warnings = append(rsp.Warnings, warnings...)
+		}
+	}
+	if len(rsp.Drafts) == 0 && len(rsp.Warnings) == 0 {
+		return nil, errors.New("YAML 中没有 Ingress")
+	}
+	return rsp, nil
+}
+
+func convertWarning(rule, severity, field, message string) *route.LintWarning {
+	return &route.LintWarning{Rule: rule, Severity: severity, Field: field, Message: message}
+}
+
+// v1beta1 的 Ingress 先转换为 v1，不支持的版本返回 nil
+func decodeIngress(obj *unstructured.Unstructured) (*networkingv1.Ingress, error) {
+	switch obj.GetAPIVersion() {
+	case IngressAPIV1:
+		ingress := &networkingv1.Ingress{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, ingress); err != nil {
+			return nil, err
+		}
+		return ingress, nil
+	case IngressAPIV1beta1, "extensions/v1beta1":
+		ingress := &networkingv1beta1.Ingress{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, ingress); err != nil {
+			return nil, err
+		}
+		return fromV1beta1Ingress(ingress), nil
+	}
+	return nil, nil
+}
+
+// 按域名拆分为多条路由，第一条沿用 Ingress 的名称，其余加上序号，没有可转换的路径时只返回警告
+func (u *RouteDataService) convertIngress(ingress *networkingv1.Ingress) ([]*route.LegacyRouteDraft, []*route.LintWarning) {
+	source := "Ingress " + ingress.Namespace + "/" + ingress.Name
+	if ingress.Namespace == "" {
+		source = "Ingress " + ingress.Name
+	}
+	base := &route.RouteInfo{RouteName: ingress.Name, RouteNamespace: ingress.Namespace}
+	shared := []*route.LintWarning{}
+	warn := func(rule, severity, field, message string) {
+		shared = append(shared, convertWarning(rule, severity, field, message))
+	}
+	if ingress.Spec.IngressClassName != nil {
+		base.RouteClass = *ingress.Spec.IngressClassName
+	}
+	prefix := u.Config.Stamp.prefix()
+	keys := make([]string, 0, len(ingress.Annotations))
+	for k := range ingress.Annotations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := ingress.Annotations[k]
+		switch {
+		case strings.HasPrefix(k, prefix):
+		case k == "cert-manager.io/cluster-issuer":
+			base.RouteTlsIssuer = v
+		case k == "kubernetes.io/ingress.class":
+			if base.RouteClass == "" {
+				base.RouteClass = v
+			}
+			warn(ConvertApproximate, LintSeverityInfo, "metadata.annotations."+k, "废弃的注解 "+k+" 已转换为 route_class")
+		case k == "cert-manager.io/issuer":
+			warn(ConvertUnsupported, LintSeverityWarning, "metadata.annotations."+k, "命名空间级的 Issuer "+v+" 不支持，请改为 ClusterIssuer 并设置 route_tls_issuer")
+		case k == "kubectl.kubernetes.io/last-applied-configuration":
+		default:
+			if base.RouteAnnotations == nil {
+				base.RouteAnnotations = map[string]string{}
+			}
+			base.RouteAnnotations[k] = v
+		}
+	}
+	for k := range ingress.Labels {
+		if !strings.HasPrefix(k, prefix) {
+			warn(ConvertUnsupported, LintSeverityInfo, "metadata.labels", "路由的标签由服务生成，原有标签不会保留")
+			break
+		}
+	}
+	if ingress.Spec.DefaultBackend != nil && len(ingress.Spec.Rules) > 0 {
+		warn(ConvertUnsupported, LintSeverityWarning, "spec.defaultBackend", "路由不支持默认后端，已跳过")
+	}
+	//同一域名的多条规则合并
+	hosts := []string{}
+	paths := map[string][]*route.RoutePath{}
+	addPath := func(host string, field string, path string, pathType *networkingv1.PathType, backend networkingv1.IngressBackend) {
+		if _, ok := paths[host]; !ok {
+			hosts = append(hosts, host)
+			paths[host] = nil
+		}
+		if backend.Service == nil {
+			warn(ConvertUnsupported, LintSeverityWarning, field+".backend", "路径 "+path+" 不是 Service 后端，已跳过")
+			return
+		}
+		if pathType != nil && *pathType != networkingv1.PathTypePrefix {
+			warn(ConvertApproximate, LintSeverityWarning, field+".pathType", "路径 "+path+" 的 pathType "+string(*pathType)+" 按 Prefix 转换")
+		}
+		if backend.Service.Port.Name != "" {
+			warn(ConvertUnsupported, LintSeverityWarning, field+".backend.service.port", "路由只支持数字端口，请把端口 "+backend.Service.Port.Name+" 替换为端口号")
+		}
+		if path == "" {
+			path = "/"
+		}
+		paths[host] = append(paths[host], &route.RoutePath{
+			RoutePathName:           path,
+			RouteBackendService:     backend.Service.Name,
+			RouteBackendServicePort: backend.Service.Port.Number,
+		})
+	}
+	if ingress.Spec.DefaultBackend != nil && len(ingress.Spec.Rules) == 0 {
+		addPath("", "spec.defaultBackend", "/", nil, *ingress.Spec.DefaultBackend)
+	}
+	for i, rule := range ingress.Spec.Rules {
+		field := "spec.rules[" + strconv.Itoa(i) + "]"
+		if rule.HTTP == nil {
+			warn(ConvertUnsupported, LintSeverityWarning, field, "规则 "+rule.Host+" 没有 http 路径，已跳过")
+			continue
+		}
+		for j, v := range rule.HTTP.Paths {
+			addPath(rule.Host, field+".http.paths["+strconv.Itoa(j)+"]", v.Path, v.PathType, v.Backend)
+		}
+	}
+	for i, tls := range ingress.Spec.TLS {
+		field := "spec.tls[" + strconv.Itoa(i) + "]"
+		if base.RouteTlsIssuer == "" {
+			warn(ConvertUnsupported, LintSeverityWarning, field, "已有的证书 secret "+tls.SecretName+" 不会使用，路由只支持通过 route_tls_issuer 签发证书")
+		}
+		for _, host := range tls.Hosts {
+			if _, ok := paths[host]; !ok {
+				warn(ConvertUnsupported, LintSeverityWarning, field+".hosts", "证书中的域名 "+host+" 没有对应的规则，已跳过")
+			}
+		}
+	}
+	drafts := []*route.LegacyRouteDraft{}
+	for i, host := range hosts {
+		if len(paths[host]) == 0 {
+			continue
+		}
+		info := &route.RouteInfo{
+			RouteName:        base.RouteName,
+			RouteNamespace:   base.RouteNamespace,
+			RouteHost:        host,
+			RoutePath:        paths[host],
+			RouteClass:       base.RouteClass,
+			RouteAnnotations: mergeStringMap(nil, base.RouteAnnotations),
+			RouteTlsIssuer:   base.RouteTlsIssuer,
+		}
+		warnings := append([]*route.LintWarning(nil), shared...)
+		if i > 0 {
+			info.RouteName = base.RouteName + "-" + strconv.Itoa(i+1)
+			warnings = append(warnings, convertWarning(ConvertApproximate, LintSeverityWarning, "route_name", "Ingress 包含多个域名，域名 "+host+" 拆分为路由 "+info.RouteName))
+		}
+		if host == "" {
+			warnings = append(warnings, convertWarning(ConvertApproximate, LintSeverityWarning, "route_host", "规则没有指定域名，请补充 route_host"))
+		}
+		drafts = append(drafts, &route.LegacyRouteDraft{Route: info, Source: source, Warnings: warnings})
+	}
+	if len(drafts) == 0 {
+		return nil, append(shared, convertWarning(ConvertUnsupported, LintSeverityWarning, "spec.rules", source+" 没有可以转换的路径，未生成路由"))
+	}
+	return drafts, nil
+}
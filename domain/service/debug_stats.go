@@ -0,0 +1,57 @@
+package service
+
+// DebugStats 调试端口展示的内部状态，用于排查卡住或积压，不做为接口对外提供
+type DebugStats struct {
+	// ApplyQueue 写入k8s的排队和并发
+	ApplyQueue ApplyQueueStats `json:"apply_queue"`
+	// RouteLocks 持有或等待中的路由本地锁个数
+	RouteLocks int `json:"route_locks"`
+	// PermissionCache 权限预检缓存的条目数，含已过期未清理的
+	PermissionCache int `json:"permission_cache"`
+	// ReapplyJobs 内存中保留的批量重新写入任务，RunningReapplyJobs 为其中仍在执行的
+	ReapplyJobs        int `json:"reapply_jobs"`
+	RunningReapplyJobs int `json:"running_reapply_jobs"`
+}
+
+// ApplyQueueStats 写入队列的状态，第一次写入前未初始化，全部为 0
+type ApplyQueueStats struct {
+	Workers  int `json:"workers"`
+	Busy     int `json:"busy"`
+	Capacity int `json:"capacity"`
+	Waiting  int `json:"waiting"`
+	// Clusters 按集群限制的并发，key 为集群名称，值为正在写入的个数
+	Clusters map[string]int `json:"clusters,omitempty"`
+}
+
+// DebugStats 读取当前的内部状态
+func (u *RouteDataService) DebugStats() *DebugStats {
+	stats := &DebugStats{ApplyQueue: u.applyQueue.stats()}
+	u.locks.mu.Lock()
+	stats.RouteLocks = len(u.locks.locks)
+	u.locks.mu.Unlock()
+	u.permissions.mu.Lock()
+	stats.PermissionCache = len(u.permissions.entries)
+	u.permissions.mu.Unlock()
+	u.reapplyJobs.mu.Lock()
+	stats.ReapplyJobs = len(u.reapplyJobs.jobs)
+	for _, v := range u.reapplyJobs.jobs {
+		if v.State == ReapplyRunning {
+			stats.RunningReapplyJobs++
+		}
+	}
+	u.reapplyJobs.mu.Unlock()
+	return stats
+}
+
+func (q *applyQueue) stats() ApplyQueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	stats := ApplyQueueStats{Workers: cap(q.workers), Busy: len(q.workers), Capacity: q.depth, Waiting: q.waiting}
+	for k, v := range q.clusters {
+		if stats.Clusters == nil {
+			stats.Clusters = map[string]int{}
+		}
+		stats.Clusters[k] = len(v)
+	}
+	return stats
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/zxnlx/route/domain/model"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"strconv"
+)
+
+// 删除策略
+const (
+	// DeletePolicyDefault 删除 Ingress/HTTPRoute 和数据库记录
+	DeletePolicyDefault = ""
+	// DeletePolicyCascade 同时删除本服务创建的 Service、Secret 以及 cert-manager 证书
+	DeletePolicyCascade = "cascade"
+	// DeletePolicyOrphan 保留k8s中的资源，只删除数据库记录
+	DeletePolicyOrphan = "orphan"
+)
+
+var certificateResource = schema.GroupVersionResource{
+	Group:    "cert-manager.io",
+	Version:  "v1",
+	Resource: "certificates",
+}
+
+func checkDeletePolicy(policy string) error {
+	switch policy {
+	case DeletePolicyDefault, DeletePolicyCascade, DeletePolicyOrphan:
+		return nil
+	}
+	return errors.New("不支持的删除策略：" + policy)
+}
+
+// 事件中展示的删除策略名称
+func deletePolicyName(policy string) string {
+	if policy == DeletePolicyDefault {
+		return "default"
+	}
+	return policy
+}
+
+// 删除关联资源，已经不存在的忽略
+func (u *RouteDataService) deleteAssociatedFromK8s(route2 *model.Route) error {
+	ctx := context.TODO()
+	namespace := route2.RouteNamespace
+	//带有本服务管理标签的 Service、Secret
+	stamp := u.Config.Stamp
+	identity := stamp.ServiceIdentity
+	if identity == "" {
+		identity = defaultStampServiceIdentity
+	}
+	selector := metav1.ListOptions{
+		LabelSelector: stamp.prefix() + "managed-by=" + identity + "," + stamp.prefix() + "route-id=" + strconv.FormatInt(route2.ID, 10),
+	}
+	services, err := u.K8sClientSet.CoreV1().Services(namespace).List(ctx, selector)
+	if err != nil {
+		return err
+	}
+	for _, v := range services.Items {
+		if err := ignoreNotFound(u.K8sClientSet.CoreV1().Services(namespace).Delete(ctx, v.Name, metav1.DeleteOptions{})); err != nil {
+			return err
+		}
+	}
+	secrets, err := u.K8sClientSet.CoreV1().Secrets(namespace).List(ctx, selector)
+	if err != nil {
+		return err
+	}
+	for _, v := range secrets.Items {
+		if err := ignoreNotFound(u.K8sClientSet.CoreV1().Secrets(namespace).Delete(ctx, v.Name, metav1.DeleteOptions{})); err != nil {
+			return err
+		}
+	}
+	//cert-manager 根据 Ingress 的 tls secretName 创建同名 Certificate 和 Secret
+	if route2.RouteTlsIssuer != "" {
+		name := route2.RouteName + "-tls"
+		if err := ignoreNotFound(u.K8sDynamicClient.Resource(certificateResource).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})); err != nil {
+			return err
+		}
+		if err := ignoreNotFound(u.K8sClientSet.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{})); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ignoreNotFound(err error) error {
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/logsample"
+	"gorm.io/gorm"
+	"strconv"
+	"time"
+)
+
+const deleteReconcileInterval = time.Minute
+
+// ReconcileDeletions 继续删除中断的路由，返回完成的个数，单个路由失败时下次再试
+func (u *RouteDataService) ReconcileDeletions() (int, error) {
+	routes, err := u.RouteRepository.FindDeletingRoutes()
+	if err != nil {
+		common.Error(err)
+		return 0, err
+	}
+	completed := 0
+	for i := range routes {
+		err := u.DeleteRouteFromK8s(&routes[i], routes[i].RouteDeletePolicy)
+		//其他副本已经完成
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			logsample.Error("继续删除路由 ID：" + strconv.FormatInt(routes[i].ID, 10) + " 失败：" + err.Error())
+			continue
+		}
+		completed++
+	}
+	return completed, nil
+}
+
+// RunDeleteReconciler 定时继续中断的删除，ctx 结束时退出
+func (u *RouteDataService) RunDeleteReconciler(ctx context.Context) {
+	ticker := time.NewTicker(deleteReconcileInterval)
+	defer ticker.Stop()
+	for {
+		if completed, err := u.ReconcileDeletions(); err == nil && completed > 0 {
+			common.Info("已继续完成 " + strconv.Itoa(completed) + " 个中断的删除")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/asim/go-micro/v3/registry"
+	"github.com/asim/go-micro/v3/server"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/proto/route"
+	"gorm.io/gorm"
+	"time"
+)
+
+// IDiagnosticsDataService 启动自检和诊断
+type IDiagnosticsDataService interface {
+	Diagnose(ctx context.Context) *route.DiagnoseReport
+}
+
+// NewDiagnosticsDataService 创建，config 返回需要展示的生效配置，不要包含密钥，db 为 nil 时不检查数据库
+func NewDiagnosticsDataService(db *gorm.DB, reg registry.Registry, srv server.Server, routeDataService IRouteDataService, config func() map[string]interface{}) IDiagnosticsDataService {
+	return &DiagnosticsDataService{db: db, registry: reg, server: srv, routeDataService: routeDataService, config: config, timeout: 10 * time.Second}
+}
+
+type DiagnosticsDataService struct {
+	db               *gorm.DB
+	registry         registry.Registry
+	server           server.Server
+	routeDataService IRouteDataService
+	config           func() map[string]interface{}
+	timeout          time.Duration
+}
+
+// Diagnose 生成自检报告，单项失败不影响其他检查
+func (u *DiagnosticsDataService) Diagnose(ctx context.Context) *route.DiagnoseReport {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+	report := &route.DiagnoseReport{
+		Database:    u.diagnoseDatabase(ctx),
+		Registry:    u.diagnoseRegistry(),
+		Clusters:    []*route.ClusterDiagnosis{u.routeDataService.DiagnoseCluster(ctx)},
+		Config:      map[string]string{},
+		GeneratedAt: time.Now().Unix(),
+	}
+	for k, v := range u.config() {
+		data, err := json.Marshal(v)
+		if err != nil {
+			report.Config[k] = err.Error()
+			continue
+		}
+		report.Config[k] = string(data)
+	}
+	report.Ok = report.Database.Reachable && len(report.Database.PendingMigrations) == 0 && report.Registry.Registered
+	for _, cluster := range report.Clusters {
+		if !cluster.Reachable || cluster.Error != "" {
+			report.Ok = false
+		}
+		for _, v := range cluster.Permissions {
+			if !v.Allowed {
+				report.Ok = false
+			}
+		}
+	}
+	return report
+}
+
+func (u *DiagnosticsDataService) diagnoseDatabase(ctx context.Context) *route.DatabaseDiagnosis {
+	diagnosis := &route.DatabaseDiagnosis{}
+	//使用 kubernetes 存储后端时不连接数据库
+	if u.db == nil {
+		diagnosis.Reachable = true
+		return diagnosis
+	}
+	sqlDB, err := u.db.DB()
+	if err != nil {
+		diagnosis.Error = err.Error()
+		return diagnosis
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		diagnosis.Error = err.Error()
+		return diagnosis
+	}
+	diagnosis.Reachable = true
+	db := u.db.WithContext(ctx)
+	if diagnosis.ServerVersion, err = repository.ServerVersion(db); err != nil {
+		diagnosis.Error = err.Error()
+		return diagnosis
+	}
+	if diagnosis.PendingMigrations, err = repository.PendingMigrations(db); err != nil {
+		diagnosis.Error = err.Error()
+	}
+	return diagnosis
+}
+
+// 注册中心中能找到本实例的节点即为已注册
+func (u *DiagnosticsDataService) diagnoseRegistry() *route.RegistryDiagnosis {
+	options := u.server.Options()
+	diagnosis := &route.RegistryDiagnosis{
+		Registry: u.registry.String(),
+		Service:  options.Name,
+		Node:     options.Name + "-" + options.Id,
+	}
+	services, err := u.registry.GetService(options.Name)
+	if err != nil {
+		diagnosis.Error = err.Error()
+		return diagnosis
+	}
+	for _, s := range services {
+		for _, node := range s.Nodes {
+			if node.Id == diagnosis.Node {
+				diagnosis.Registered = true
+				return diagnosis
+			}
+		}
+	}
+	return diagnosis
+}
@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/proto/route"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 差异类型
+const (
+	DiffChanged = "changed"
+	DiffMissing = "missing"
+	DiffExtra   = "extra"
+)
+
+// 由 API server 维护的字段，不参与对比
+var ignoredDiffFields = map[string]bool{
+	"apiVersion":                          true,
+	"kind":                                true,
+	"status":                              true,
+	"metadata.resourceVersion":            true,
+	"metadata.uid":                        true,
+	"metadata.generation":                 true,
+	"metadata.creationTimestamp":          true,
+	"metadata.managedFields":              true,
+	"metadata.selfLink":                   true,
+	"metadata.deletionTimestamp":          true,
+	"metadata.deletionGracePeriodSeconds": true,
+}
+
+// 字段路径的一段，index 为 -1 时是 map 的 key
+type diffSegment struct {
+	key   string
+	index int
+}
+
+// DiffRoute 对比数据库中的期望规格和k8s中的线上资源
+func (u *RouteDataService) DiffRoute(id int64) (*route.RouteDiff, error) {
+	route2, err := u.RouteRepository.FindRouteByID(id)
+	if err != nil {
+		return nil, err
+	}
+	info := &route.RouteInfo{}
+	if err := common.SwapTo(route2, info); err != nil {
+		return nil, err
+	}
+	diff := &route.RouteDiff{Id: id, Kind: "Ingress"}
+	var desired, live interface{}
+	var managedFields []metav1.ManagedFieldsEntry
+	if info.RouteAdapter == AdapterIstio {
+		diff.Kind = "VirtualService"
+		desired = u.setVirtualService(info).Object
+		current, err := u.K8sDynamicClient.Resource(virtualServiceResource).Namespace(info.RouteNamespace).Get(context.TODO(), info.RouteName, metav1.GetOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			live = current.Object
+			managedFields = current.GetManagedFields()
+		}
+	} else if info.RouteAdapter == AdapterGatewayAPI {
+		diff.Kind = "HTTPRoute"
+		httpRoute, err := u.setHTTPRoute(info)
+		if err != nil {
+			return nil, err
+		}
+		desired = httpRoute.Object
+		current, err := u.K8sDynamicClient.Resource(httpRouteResource).Namespace(info.RouteNamespace).Get(context.TODO(), info.RouteName, metav1.GetOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			live = current.Object
+			managedFields = current.GetManagedFields()
+		}
+	} else {
+		if desired, err = runtime.DefaultUnstructuredConverter.ToUnstructured(u.setIngress(info)); err != nil {
+			return nil, err
+		}
+		current, err := u.ingresses(info.RouteNamespace).Get(context.TODO(), info.RouteName, metav1.GetOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			if live, err = runtime.DefaultUnstructuredConverter.ToUnstructured(current); err != nil {
+				return nil, err
+			}
+			managedFields = current.ManagedFields
+		}
+	}
+	if live == nil {
+		diff.LiveMissing = true
+		//已禁用的路由k8s中本来就不存在
+		diff.InSync = route2.RouteDisabled
+		return diff, nil
+	}
+	if desired, err = normalizeDiffValue(desired); err != nil {
+		return nil, err
+	}
+	if live, err = normalizeDiffValue(live); err != nil {
+		return nil, err
+	}
+	owners := parseManagedFields(managedFields)
+	ignored := u.Config.Stamp.prefix() + lastAppliedAnnotation
+	diffValue(nil, desired, live, func(path []diffSegment, op string, desired interface{}, live interface{}) {
+		p := formatDiffPath(path)
+		if strings.HasSuffix(p, `["`+ignored+`"]`) {
+			return
+		}
+		change := &route.FieldDiff{Path: p, Op: op, Desired: diffJSON(desired), Live: diffJSON(live)}
+		if op != DiffMissing {
+			change.Manager = fieldManager(owners, path)
+		}
+		diff.Changes = append(diff.Changes, change)
+	})
+	diff.InSync = len(diff.Changes) == 0
+	return diff, nil
+}
+
+// 经过一次 JSON 序列化统一数字类型，去掉值为 null 的字段
+func normalizeDiffValue(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return dropNull(out), nil
+}
+
+func dropNull(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			if child == nil {
+				delete(t, k)
+				continue
+			}
+			t[k] = dropNull(child)
+		}
+	case []interface{}:
+		for i := range t {
+			t[i] = dropNull(t[i])
+		}
+	}
+	return v
+}
+
+// 递归对比，map 按 key 对比，长度相同的列表按下标对比，其余整体对比
+func diffValue(path []diffSegment, desired interface{}, live interface{}, report func([]diffSegment, string, interface{}, interface{})) {
+	if ignoredDiffFields[formatDiffPath(path)] {
+		return
+	}
+	desiredMap, ok1 := desired.(map[string]interface{})
+	liveMap, ok2 := live.(map[string]interface{})
+	if ok1 && ok2 {
+		keys := map[string]bool{}
+		for k := range desiredMap {
+			keys[k] = true
+		}
+		for k := range liveMap {
+			keys[k] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+		for _, k := range sorted {
+			child := append(append([]diffSegment{}, path...), diffSegment{key: k, index: -1})
+			d, inDesired := desiredMap[k]
+			l, inLive := liveMap[k]
+			switch {
+			case !inLive:
+				if !ignoredDiffFields[formatDiffPath(child)] {
+					report(child, DiffMissing, d, nil)
+				}
+			case !inDesired:
+				if !ignoredDiffFields[formatDiffPath(child)] {
+					report(child, DiffExtra, nil, l)
+				}
+			default:
+				diffValue(child, d, l, report)
+			}
+		}
+		return
+	}
+	desiredList, ok1 := desired.([]interface{})
+	liveList, ok2 := live.([]interface{})
+	if ok1 && ok2 && len(desiredList) == len(liveList) {
+		for i := range desiredList {
+			diffValue(append(append([]diffSegment{}, path...), diffSegment{index: i}), desiredList[i], liveList[i], report)
+		}
+		return
+	}
+	if !reflect.DeepEqual(desired, live) {
+		report(path, DiffChanged, desired, live)
+	}
+}
+
+// 格式如 spec.rules[0].host，包含 . 或 / 的 key 使用 ["key"]
+func formatDiffPath(path []diffSegment) string {
+	var b strings.Builder
+	for _, v := range path {
+		switch {
+		case v.index >= 0:
+			b.WriteString("[" + strconv.Itoa(v.index) + "]")
+		case strings.ContainsAny(v.key, "./"):
+			b.WriteString(`["` + v.key + `"]`)
+		default:
+			if b.Len() > 0 {
+				b.WriteString(".")
+			}
+			b.WriteString(v.key)
+		}
+	}
+	return b.String()
+}
+
+func diffJSON(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err.Error()
+	}
+	return string(data)
+}
+
+type fieldOwner struct {
+	manager string
+	fields  map[string]interface{}
+}
+
+func parseManagedFields(entries []metav1.ManagedFieldsEntry) []fieldOwner {
+	owners := []fieldOwner{}
+	for _, v := range entries {
+		if v.FieldsV1 == nil {
+			continue
+		}
+		fields := map[string]interface{}{}
+		if err := json.Unmarshal(v.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		owners = append(owners, fieldOwner{manager: v.Manager, fields: fields})
+	}
+	return owners
+}
+
+// 按 managedFields 找到管理该字段的 field manager，列表元素的 key 无法还原，匹配到列表为止
+func fieldManager(owners []fieldOwner, path []diffSegment) string {
+	manager, best := "", 0
+	for _, owner := range owners {
+		depth := 0
+		node := owner.fields
+		for _, v := range path {
+			if v.index >= 0 {
+				break
+			}
+			child, ok := node["f:"+v.key].(map[string]interface{})
+			if !ok {
+				depth = -1
+				break
+			}
+			node = child
+			depth++
+		}
+		if depth > best {
+			manager, best = owner.manager, depth
+		}
+	}
+	return manager
+}
@@ -0,0 +1,22 @@
+package service
+
+import "context"
+
+// DistributedLockConfig 多副本部署时对同一路由的变更加分布式锁，默认关闭
+type DistributedLockConfig struct {
+	Enabled bool `json:"enabled"`
+	// Prefix 锁在 Consul KV 中的前缀，默认 route/locks/
+	Prefix string `json:"prefix"`
+	// WaitSeconds 等待锁的秒数，默认 10
+	WaitSeconds int64 `json:"wait_seconds"`
+}
+
+// RouteLocker 分布式锁，拿不到锁时返回错误
+type RouteLocker interface {
+	Lock(key string) (func(), error)
+}
+
+// LeaderElector 多副本时选出一个副本执行只需执行一次的任务，拿到领导权前阻塞
+type LeaderElector interface {
+	Lead(ctx context.Context, key string) (<-chan struct{}, error)
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 同一路由中匹配相同请求的路径，k8s 不会拒绝，但请求转发到哪个后端由控制器决定
+func checkDuplicatePaths(info *route.RouteInfo) error {
+	for j := range info.RoutePath {
+		for i := 0; i < j; i++ {
+			a, b := info.RoutePath[i], info.RoutePath[j]
+			if prefixPathKey(a.RoutePathName) != prefixPathKey(b.RoutePathName) || headerMatchKey(a) != headerMatchKey(b) {
+				continue
+			}
+			methods, ok := overlappingMethods(a.RouteMethod, b.RouteMethod)
+			if !ok {
+				continue
+			}
+			detail := "路径 " + b.RoutePathName
+			if a.RoutePathName != b.RoutePathName {
+				detail = "路径 " + a.RoutePathName + " 和 " + b.RoutePathName + " 按前缀匹配时相同"
+			}
+			if len(methods) > 0 {
+				detail += "，请求方法 " + strings.Join(methods, "、")
+			}
+			return i18n.NewError(i18n.CodeDuplicatePath, "路由 "+info.RouteName+" 的 route_path["+strconv.Itoa(j)+"] 和 route_path["+strconv.Itoa(i)+"] 重复："+detail)
+		}
+	}
+	return nil
+}
+
+// Prefix 类型按 / 分段匹配，末尾的 / 不影响匹配
+func prefixPathKey(path string) string {
+	if path == "" {
+		return "/"
+	}
+	if trimmed := strings.TrimRight(path, "/"); trimmed != "" {
+		return trimmed
+	}
+	return "/"
+}
+
+// 请求头名称不区分大小写，顺序不影响匹配
+func headerMatchKey(path *route.RoutePath) string {
+	keys := make([]string, 0, len(path.RouteHeaderMatch))
+	for _, v := range path.RouteHeaderMatch {
+		matchType := v.Type
+		if matchType == "" {
+			matchType = "Exact"
+		}
+		keys = append(keys, matchType+"\x00"+http.CanonicalHeaderKey(v.Name)+"\x00"+v.Value)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, "\x01")
+}
+
+// 请求方法为空时匹配所有方法，返回两边都匹配的方法，都为空时返回空列表和 true
+func overlappingMethods(a []string, b []string) ([]string, bool) {
+	if len(a) == 0 && len(b) == 0 {
+		return nil, true
+	}
+	if len(a) == 0 {
+		return upperAll(b), true
+	}
+	if len(b) == 0 {
+		return upperAll(a), true
+	}
+	set := map[string]bool{}
+	for _, v := range a {
+		set[strings.ToUpper(v)] = true
+	}
+	var methods []string
+	for _, v := range upperAll(b) {
+		if set[v] {
+			methods = append(methods, v)
+			delete(set, v)
+		}
+	}
+	return methods, len(methods) > 0
+}
+
+func upperAll(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, strings.ToUpper(v))
+	}
+	return result
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+	"time"
+)
+
+// IEventDataService 事件接口，路由事件由 IRouteDataService 随 outbox 写入
+type IEventDataService interface {
+	AddEvent(*model.Event) (int64, error)
+	ListEvents(int64, string, time.Time) ([]model.Event, error)
+	ListEventsAfter(int64, int) ([]model.Event, error)
+	LatestEventID() (int64, error)
+}
+
+// NewEventDataService 创建
+func NewEventDataService(eventRepository repository.IEventRepository) IEventDataService {
+	return &EventDataService{EventRepository: eventRepository}
+}
+
+type EventDataService struct {
+	EventRepository repository.IEventRepository
+}
+
+// AddEvent 插入
+func (u *EventDataService) AddEvent(event *model.Event) (int64, error) {
+	return u.EventRepository.CreateEvent(event)
+}
+
+// ListEvents 查询
+func (u *EventDataService) ListEvents(routeID int64, eventType string, since time.Time) ([]model.Event, error) {
+	return u.EventRepository.FindEvents(routeID, eventType, since)
+}
+
+// ListEventsAfter 按 ID 升序查询之后的事件，用于推送
+func (u *EventDataService) ListEventsAfter(afterID int64, limit int) ([]model.Event, error) {
+	return u.EventRepository.FindEventsAfter(afterID, limit)
+}
+
+// LatestEventID 最新事件的 ID
+func (u *EventDataService) LatestEventID() (int64, error) {
+	return u.EventRepository.FindLatestEventID()
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/proto/route"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"net/url"
+	"strings"
+)
+
+// Traefik 通过 ForwardAuth 中间件实现外部认证，中间件与路由同名加 -auth 后缀
+var traefikMiddlewareResource = schema.GroupVersionResource{
+	Group:    "traefik.io",
+	Version:  "v1alpha1",
+	Resource: "middlewares",
+}
+
+func hasExternalAuth(info *route.RouteInfo) bool {
+	return info.RouteAuthUrl != ""
+}
+
+func authMiddlewareName(routeName string) string {
+	return routeName + "-auth"
+}
+
+// 认证地址会写入 nginx 配置，只允许 http、https 的绝对地址
+func checkAuthURL(field string, value string) error {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New(field + " " + value + " 必须是 http 或 https 的绝对地址")
+	}
+	if strings.ContainsAny(value, " \t\r\n\"';") {
+		return errors.New(field + " " + value + " 包含不允许的字符")
+	}
+	return nil
+}
+
+// 外部认证支持 nginx 的 auth-url 注解和 Traefik 的 ForwardAuth 中间件，登录跳转地址只有 nginx 支持
+func (u *RouteDataService) checkExternalAuth(info *route.RouteInfo) error {
+	if !hasExternalAuth(info) {
+		if info.RouteAuthSignin != "" || len(info.RouteAuthResponseHeaders) > 0 {
+			return errors.New("设置登录跳转地址或认证响应头需要同时设置认证地址")
+		}
+		return nil
+	}
+	if !isIngressAdapter(info.RouteAdapter) {
+		return errors.New("路由 " + info.RouteName + " 设置了外部认证，" + info.RouteAdapter + " 不支持")
+	}
+	if err := checkAuthURL("认证地址", info.RouteAuthUrl); err != nil {
+		return err
+	}
+	if info.RouteAuthSignin != "" {
+		if err := checkAuthURL("登录跳转地址", info.RouteAuthSignin); err != nil {
+			return err
+		}
+	}
+	for _, v := range info.RouteAuthResponseHeaders {
+		if err := checkHeader(v, ""); err != nil {
+			return err
+		}
+	}
+	switch class := u.getIngressClassName(info); class {
+	case "nginx":
+		return nil
+	case "traefik":
+		if info.RouteAuthSignin != "" {
+			return errors.New("路由 " + info.RouteName + " 设置了登录跳转地址，Traefik 不支持，请由认证服务返回跳转")
+		}
+		return nil
+	default:
+		return errors.New("路由 " + info.RouteName + " 设置了外部认证，Ingress class " + class + " 不支持")
+	}
+}
+
+// 外部认证转换为控制器注解
+func (u *RouteDataService) getExternalAuthAnnotations(info *route.RouteInfo) map[string]string {
+	annotations := map[string]string{}
+	if !hasExternalAuth(info) {
+		return annotations
+	}
+	switch u.getIngressClassName(info) {
+	case "nginx":
+		annotations["nginx.ingress.kubernetes.io/auth-url"] = info.RouteAuthUrl
+		if info.RouteAuthSignin != "" {
+			annotations["nginx.ingress.kubernetes.io/auth-signin"] = info.RouteAuthSignin
+		}
+		if len(info.RouteAuthResponseHeaders) > 0 {
+			annotations["nginx.ingress.kubernetes.io/auth-response-headers"] = strings.Join(info.RouteAuthResponseHeaders, ",")
+		}
+	case "traefik":
+		annotations["traefik.ingress.kubernetes.io/router.middlewares"] = info.RouteNamespace + "-" + authMiddlewareName(info.RouteName) + "@kubernetescrd"
+	}
+	return annotations
+}
+
+func (u *RouteDataService) setAuthMiddleware(info *route.RouteInfo) *unstructured.Unstructured {
+	forwardAuth := map[string]interface{}{
+		"address": info.RouteAuthUrl,
+	}
+	if len(info.RouteAuthResponseHeaders) > 0 {
+		headers := make([]interface{}, 0, len(info.RouteAuthResponseHeaders))
+		for _, v := range info.RouteAuthResponseHeaders {
+			headers = append(headers, v)
+		}
+		forwardAuth["authResponseHeaders"] = headers
+	}
+	middleware := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": traefikMiddlewareResource.Group + "/" + traefikMiddlewareResource.Version,
+		"kind":       "Middleware",
+		"metadata": map[string]interface{}{
+			"name":      authMiddlewareName(info.RouteName),
+			"namespace": info.RouteNamespace,
+		},
+		"spec": map[string]interface{}{
+			"forwardAuth": forwardAuth,
+		},
+	}}
+	middleware.SetLabels(u.getStampLabels(info))
+	return middleware
+}
+
+// 写入 Ingress 前同步 Traefik 中间件，取消外部认证时删除
+func (u *RouteDataService) syncAuthMiddleware(info *route.RouteInfo) error {
+	ctx := context.TODO()
+	client := u.K8sDynamicClient.Resource(traefikMiddlewareResource).Namespace(info.RouteNamespace)
+	name := authMiddlewareName(info.RouteName)
+	if !hasExternalAuth(info) {
+		return ignoreNotFound(client.Delete(ctx, name, metav1.DeleteOptions{}))
+	}
+	middleware := u.setAuthMiddleware(info)
+	current, err := client.Get(ctx, name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = client.Create(ctx, middleware, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	middleware.SetResourceVersion(current.GetResourceVersion())
+	_, err = client.Update(ctx, middleware, metav1.UpdateOptions{})
+	return err
+}
+
+// 删除路由时一并删除 Traefik 中间件，不存在时忽略
+func (u *RouteDataService) deleteAuthMiddleware(route2 *model.Route) error {
+	if route2.RouteAuthURL == "" {
+		return nil
+	}
+	return ignoreNotFound(u.K8sDynamicClient.Resource(traefikMiddlewareResource).Namespace(route2.RouteNamespace).Delete(context.TODO(), authMiddlewareName(route2.RouteName), metav1.DeleteOptions{}))
+}
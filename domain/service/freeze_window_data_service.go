@@ -0,0 +1,175 @@
+package service
+
+import (
+	"errors"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/i18n"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MethodEmergencyOverride 紧急变更跳过冻结窗口需要的权限，按操作名在 methodRoles 中配置角色
+const MethodEmergencyOverride = "EmergencyOverride"
+
+// IFreezeWindowDataService 冻结窗口接口
+type IFreezeWindowDataService interface {
+	AddFreezeWindow(*model.FreezeWindow) (int64, error)
+	DeleteFreezeWindow(int64) error
+	UpdateFreezeWindow(*model.FreezeWindow) error
+	FindFreezeWindowByID(int64) (*model.FreezeWindow, error)
+	FindAllFreezeWindow() ([]model.FreezeWindow, error)
+
+	CheckFrozen(string, time.Time) error
+}
+
+// NewFreezeWindowDataService 创建
+func NewFreezeWindowDataService(freezeWindowRepository repository.IFreezeWindowRepository) IFreezeWindowDataService {
+	return &FreezeWindowDataService{FreezeWindowRepository: freezeWindowRepository}
+}
+
+type FreezeWindowDataService struct {
+	FreezeWindowRepository repository.IFreezeWindowRepository
+}
+
+// AddFreezeWindow 插入
+func (u *FreezeWindowDataService) AddFreezeWindow(freezeWindow *model.FreezeWindow) (int64, error) {
+	if err := checkFreezeWindow(freezeWindow); err != nil {
+		return 0, err
+	}
+	return u.FreezeWindowRepository.CreateFreezeWindow(freezeWindow)
+}
+
+// DeleteFreezeWindow 删除
+func (u *FreezeWindowDataService) DeleteFreezeWindow(id int64) error {
+	return u.FreezeWindowRepository.DeleteFreezeWindowByID(id)
+}
+
+// UpdateFreezeWindow 更新
+func (u *FreezeWindowDataService) UpdateFreezeWindow(freezeWindow *model.FreezeWindow) error {
+	if err := checkFreezeWindow(freezeWindow); err != nil {
+		return err
+	}
+	return u.FreezeWindowRepository.UpdateFreezeWindow(freezeWindow)
+}
+
+// FindFreezeWindowByID 查找
+func (u *FreezeWindowDataService) FindFreezeWindowByID(id int64) (*model.FreezeWindow, error) {
+	return u.FreezeWindowRepository.FindFreezeWindowByID(id)
+}
+
+// FindAllFreezeWindow 查找
+func (u *FreezeWindowDataService) FindAllFreezeWindow() ([]model.FreezeWindow, error) {
+	return u.FreezeWindowRepository.FindAll()
+}
+
+// CheckFrozen 命名空间处于冻结窗口时返回错误
+func (u *FreezeWindowDataService) CheckFrozen(namespace string, now time.Time) error {
+	all, err := u.FreezeWindowRepository.FindAll()
+	if err != nil {
+		return err
+	}
+	for i := range all {
+		active, err := freezeWindowActive(&all[i], namespace, now)
+		if err != nil {
+			return err
+		}
+		if active {
+			return i18n.NewError(i18n.CodeChangeFreeze, "命名空间 "+namespace+" 处于变更冻结窗口 "+all[i].FreezeName+"（"+all[i].FreezeStart+" - "+all[i].FreezeEnd+"）："+all[i].FreezeReason)
+		}
+	}
+	return nil
+}
+
+func checkFreezeWindow(freezeWindow *model.FreezeWindow) error {
+	if _, err := parseWeekMinute(freezeWindow.FreezeStart); err != nil {
+		return err
+	}
+	if _, err := parseWeekMinute(freezeWindow.FreezeEnd); err != nil {
+		return err
+	}
+	if _, err := freezeLocation(freezeWindow); err != nil {
+		return err
+	}
+	for _, v := range freezeWindow.FreezeNamespaces {
+		if _, err := path.Match(v, ""); err != nil {
+			return errors.New("命名空间通配符 " + v + " 格式错误")
+		}
+	}
+	return nil
+}
+
+func freezeWindowActive(freezeWindow *model.FreezeWindow, namespace string, now time.Time) (bool, error) {
+	if len(freezeWindow.FreezeNamespaces) > 0 {
+		matched := false
+		for _, v := range freezeWindow.FreezeNamespaces {
+			if ok, _ := path.Match(v, namespace); ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	start, err := parseWeekMinute(freezeWindow.FreezeStart)
+	if err != nil {
+		return false, err
+	}
+	end, err := parseWeekMinute(freezeWindow.FreezeEnd)
+	if err != nil {
+		return false, err
+	}
+	location, err := freezeLocation(freezeWindow)
+	if err != nil {
+		return false, err
+	}
+	now = now.In(location)
+	current := int(now.Weekday())*24*60 + now.Hour()*60 + now.Minute()
+	//跨周的窗口，如周五到周一
+	if start > end {
+		return current >= start || current < end, nil
+	}
+	return current >= start && current < end, nil
+}
+
+// LoadLocation 传空返回 UTC，这里为空时使用服务所在时区
+func freezeLocation(freezeWindow *model.FreezeWindow) (*time.Location, error) {
+	if freezeWindow.FreezeTimezone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(freezeWindow.FreezeTimezone)
+}
+
+var weekdays = map[string]int{"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6}
+
+// 解析 "Fri 18:00" 为一周内的分钟数
+func parseWeekMinute(s string) (int, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return 0, errors.New("时间 " + s + " 格式错误，应为 Fri 18:00")
+	}
+	name := strings.ToLower(fields[0])
+	if len(name) > 3 {
+		name = name[:3]
+	}
+	day, ok := weekdays[name]
+	if !ok {
+		return 0, errors.New("时间 " + s + " 的星期错误")
+	}
+	clock := strings.Split(fields[1], ":")
+	if len(clock) != 2 {
+		return 0, errors.New("时间 " + s + " 格式错误，应为 Fri 18:00")
+	}
+	hour, err := strconv.Atoi(clock[0])
+	if err != nil || hour < 0 || hour > 23 {
+		return 0, errors.New("时间 " + s + " 的小时错误")
+	}
+	minute, err := strconv.Atoi(clock[1])
+	if err != nil || minute < 0 || minute > 59 {
+		return 0, errors.New("时间 " + s + " 的分钟错误")
+	}
+	return day*24*60 + hour*60 + minute, nil
+}
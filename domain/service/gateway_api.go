@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"strings"
+)
+
+// AdapterGatewayAPI 使用 Gateway API 的 HTTPRoute 实现路由
+const AdapterGatewayAPI = "gateway-api"
+
+var httpRouteResource = schema.GroupVersionResource{
+	Group:    "gateway.networking.k8s.io",
+	Version:  "v1",
+	Resource: "httproutes",
+}
+
+// gatewayAPIAdapter 使用 Gateway API 的 HTTPRoute，支持请求头和请求方法匹配
+type gatewayAPIAdapter struct {
+	u *RouteDataService
+}
+
+// 健康检查、流量提示和外部认证在 checkRoute 中已经拒绝
+func (a *gatewayAPIAdapter) Validate(info *route.RouteInfo) error {
+	return nil
+}
+
+func (a *gatewayAPIAdapter) Create(info *route.RouteInfo) error {
+	return a.u.createHTTPRouteToK8s(info)
+}
+
+func (a *gatewayAPIAdapter) Update(info *route.RouteInfo) error {
+	return a.u.updateHTTPRouteToK8s(info)
+}
+
+func (a *gatewayAPIAdapter) Delete(route2 *model.Route) error {
+	return a.u.K8sDynamicClient.Resource(httpRouteResource).Namespace(route2.RouteNamespace).Delete(context.TODO(), route2.RouteName, metav1.DeleteOptions{})
+}
+
+// 创建 HTTPRoute
+func (u *RouteDataService) createHTTPRouteToK8s(info *route.RouteInfo) (err error) {
+	httpRoute, err := u.setHTTPRoute(info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	client := u.K8sDynamicClient.Resource(httpRouteResource).Namespace(info.RouteNamespace)
+	current, err := client.Get(context.TODO(), info.RouteName, metav1.GetOptions{})
+	if err != nil {
+		if _, err = client.Create(context.TODO(), httpRoute, metav1.CreateOptions{}); err != nil {
+			common.Error(err)
+			return err
+		}
+		return nil
+	}
+	//已存在时按冲突策略处理，不在管理范围内的资源不能接管或覆盖
+	if info.RouteConflictPolicy == ConflictPolicyReplace || info.RouteConflictPolicy == ConflictPolicyAdopt {
+		if err = u.checkObjectScope("HTTPRoute", current.GetNamespace(), current.GetName(), current.GetLabels()); err != nil {
+			common.Error(err)
+			return err
+		}
+	}
+	switch info.RouteConflictPolicy {
+	case ConflictPolicyReplace:
+		httpRoute.SetResourceVersion(current.GetResourceVersion())
+		_, err = client.Update(context.TODO(), httpRoute, metav1.UpdateOptions{})
+	case ConflictPolicyAdopt:
+		current.SetLabels(mergeStringMap(current.GetLabels(), u.getStampLabels(info)))
+		current.SetAnnotations(mergeStringMap(current.GetAnnotations(), u.getStampAnnotations(info)))
+		_, err = client.Update(context.TODO(), current, metav1.UpdateOptions{})
+	default:
+		err = i18n.NewError(i18n.CodeRouteAlreadyExists, "路由 "+info.RouteName+" 已经存在")
+	}
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// 更新 HTTPRoute，CRD 不允许无条件更新，需要带上 resourceVersion
+func (u *RouteDataService) updateHTTPRouteToK8s(info *route.RouteInfo) (err error) {
+	httpRoute, err := u.setHTTPRoute(info)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	client := u.K8sDynamicClient.Resource(httpRouteResource).Namespace(info.RouteNamespace)
+	//基于线上对象合并标签和注解，冲突时重新读取
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		live, err := client.Get(context.TODO(), info.RouteName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		desired := httpRoute.DeepCopy()
+		u.mergeMetadata(live, desired)
+		_, err = client.Update(context.TODO(), desired, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (u *RouteDataService) setHTTPRoute(info *route.RouteInfo) (*unstructured.Unstructured, error) {
+	if info.RouteGateway == "" {
+		return nil, errors.New("路由 " + info.RouteName + " 使用 " + AdapterGatewayAPI + " 时必须指定 Gateway")
+	}
+	parentRef := map[string]interface{}{}
+	if i := strings.Index(info.RouteGateway, "/"); i >= 0 {
+		parentRef["namespace"] = info.RouteGateway[:i]
+		parentRef["name"] = info.RouteGateway[i+1:]
+	} else {
+		parentRef["name"] = info.RouteGateway
+	}
+	httpRoute := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "gateway.networking.k8s.io/v1",
+		"kind":       "HTTPRoute",
+		"spec": map[string]interface{}{
+			"parentRefs": []interface{}{parentRef},
+			"hostnames":  []interface{}{info.RouteHost},
+			"rules":      u.getHTTPRouteRules(info),
+		},
+	}}
+	httpRoute.SetName(info.RouteName)
+	httpRoute.SetNamespace(info.RouteNamespace)
+	httpRoute.SetLabels(u.getStampLabels(info))
+	httpRoute.SetAnnotations(u.getStampAnnotations(info))
+	u.recordLastApplied(httpRoute)
+	return httpRoute, nil
+}
+
+// 每个路径生成一条规则，多个请求方法拆成多个匹配条件
+func (u *RouteDataService) getHTTPRouteRules(info *route.RouteInfo) []interface{} {
+	rules := []interface{}{}
+	headerFilters := u.gatewayHeaderFilters(info)
+	for _, v := range info.RoutePath {
+		headers := []interface{}{}
+		for _, h := range v.RouteHeaderMatch {
+			matchType := h.Type
+			if matchType == "" {
+				matchType = "Exact"
+			}
+			headers = append(headers, map[string]interface{}{
+				"type":  matchType,
+				"name":  h.Name,
+				"value": h.Value,
+			})
+		}
+		newMatch := func() map[string]interface{} {
+			match := map[string]interface{}{
+				"path": map[string]interface{}{
+					"type":  "PathPrefix",
+					"value": v.RoutePathName,
+				},
+			}
+			if len(headers) > 0 {
+				match["headers"] = headers
+			}
+			return match
+		}
+		matches := []interface{}{}
+		if len(v.RouteMethod) == 0 {
+			matches = append(matches, newMatch())
+		}
+		for _, method := range v.RouteMethod {
+			match := newMatch()
+			match["method"] = strings.ToUpper(method)
+			matches = append(matches, match)
+		}
+		rule := map[string]interface{}{
+			"matches": matches,
+			"backendRefs": []interface{}{
+				map[string]interface{}{
+					"name": v.RouteBackendService,
+					"port": int64(v.RouteBackendServicePort),
+				},
+			},
+		}
+		filters := []interface{}{}
+		if v.RouteMirrorService != "" {
+			filters = append(filters, map[string]interface{}{
+				"type": "RequestMirror",
+				"requestMirror": map[string]interface{}{
+					"backendRef": map[string]interface{}{
+						"name": v.RouteMirrorService,
+						"port": int64(v.RouteMirrorServicePort),
+					},
+				},
+			})
+		}
+		filters = append(filters, headerFilters...)
+		if len(filters) > 0 {
+			rule["filters"] = filters
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
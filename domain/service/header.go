@@ -0,0 +1,186 @@
+package service
+
+import (
+	"errors"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	"sort"
+	"strings"
+)
+
+// SecurityHeaderPolicy 开启后所有路由都会带上这些响应头，且不允许删除
+type SecurityHeaderPolicy struct {
+	Enforce bool              `json:"enforce"`
+	Headers map[string]string `json:"headers"`
+}
+
+// 没有配置时使用的安全响应头
+var defaultSecurityHeaders = map[string]string{
+	"Strict-Transport-Security": "max-age=31536000; includeSubDomains",
+	"X-Frame-Options":           "SAMEORIGIN",
+	"X-Content-Type-Options":    "nosniff",
+}
+
+func (p SecurityHeaderPolicy) headers() map[string]string {
+	if len(p.Headers) > 0 {
+		return p.Headers
+	}
+	return defaultSecurityHeaders
+}
+
+// 校验请求头、响应头，生成的配置片段会直接写入 nginx 配置，必须严格校验
+func (u *RouteDataService) checkHeaders(info *route.RouteInfo) error {
+	//配置片段只能由请求头、响应头字段生成，不允许调用方直接传入
+	if !u.Config.AllowSnippetAnnotations {
+		for k := range info.RouteAnnotations {
+			if strings.HasSuffix(k, "-snippet") {
+				return i18n.NewError(i18n.CodeAnnotationNotAllowed, "不允许使用注解 "+k+"，请使用请求头、响应头字段")
+			}
+		}
+	}
+	for _, headers := range []map[string]string{info.RouteResponseHeaderSet, info.RouteRequestHeaderAdd, info.RouteRequestHeaderSet} {
+		for k, v := range headers {
+			if err := checkHeader(k, v); err != nil {
+				return err
+			}
+		}
+	}
+	for _, k := range info.RouteRequestHeaderRemove {
+		if err := checkHeader(k, ""); err != nil {
+			return err
+		}
+	}
+	for _, k := range info.RouteResponseHeaderRemove {
+		if err := checkHeader(k, ""); err != nil {
+			return err
+		}
+		if !u.Config.SecurityHeaders.Enforce {
+			continue
+		}
+		for mandatory := range u.Config.SecurityHeaders.headers() {
+			if strings.EqualFold(k, mandatory) {
+				return errors.New("响应头 " + k + " 为强制安全响应头，不允许删除")
+			}
+		}
+	}
+	return nil
+}
+
+func checkHeader(name, value string) error {
+	if name == "" {
+		return errors.New("请求头名称不能为空")
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.ContainsRune("!#%&'*+-.^_`|~", c)) {
+			return errors.New("请求头名称 " + name + " 不合法")
+		}
+	}
+	for _, c := range value {
+		if c < 0x20 || c == 0x7f || c == '"' || c == '\\' || c == '$' {
+			return errors.New("请求头 " + name + " 的值不合法")
+		}
+	}
+	return nil
+}
+
+// 实际生效的响应头，路由自身设置的值优先于强制安全响应头
+func (u *RouteDataService) getResponseHeaders(info *route.RouteInfo) map[string]string {
+	headers := map[string]string{}
+	if u.Config.SecurityHeaders.Enforce {
+		for k, v := range u.Config.SecurityHeaders.headers() {
+			headers[k] = v
+		}
+	}
+	for k, v := range info.RouteResponseHeaderSet {
+		for existing := range headers {
+			if strings.EqualFold(existing, k) {
+				delete(headers, existing)
+			}
+		}
+		headers[k] = v
+	}
+	return headers
+}
+
+// 是否设置了请求头或响应头
+func (u *RouteDataService) hasHeaders(info *route.RouteInfo) bool {
+	return len(u.getResponseHeaders(info)) > 0 || len(info.RouteResponseHeaderRemove) > 0 ||
+		len(info.RouteRequestHeaderAdd) > 0 || len(info.RouteRequestHeaderSet) > 0 || len(info.RouteRequestHeaderRemove) > 0
+}
+
+// nginx 配置片段，响应头依赖 ingress-nginx 自带的 headers-more 模块
+// nginx 无法追加同名请求头，add 与 set 效果相同
+func (u *RouteDataService) nginxHeaderSnippet(info *route.RouteInfo) string {
+	var b strings.Builder
+	requestHeaders := map[string]string{}
+	for k, v := range info.RouteRequestHeaderAdd {
+		requestHeaders[k] = v
+	}
+	for k, v := range info.RouteRequestHeaderSet {
+		requestHeaders[k] = v
+	}
+	for _, k := range sortedKeys(requestHeaders) {
+		b.WriteString("proxy_set_header " + k + " \"" + requestHeaders[k] + "\";\n")
+	}
+	for _, k := range info.RouteRequestHeaderRemove {
+		b.WriteString("proxy_set_header " + k + " \"\";\n")
+	}
+	responseHeaders := u.getResponseHeaders(info)
+	for _, k := range sortedKeys(responseHeaders) {
+		b.WriteString("more_set_headers \"" + k + ": " + responseHeaders[k] + "\";\n")
+	}
+	for _, k := range info.RouteResponseHeaderRemove {
+		b.WriteString("more_clear_headers \"" + k + "\";\n")
+	}
+	return b.String()
+}
+
+// Gateway API 的请求头、响应头过滤器
+func (u *RouteDataService) gatewayHeaderFilters(info *route.RouteInfo) []interface{} {
+	filters := []interface{}{}
+	if len(info.RouteRequestHeaderAdd) > 0 || len(info.RouteRequestHeaderSet) > 0 || len(info.RouteRequestHeaderRemove) > 0 {
+		filters = append(filters, map[string]interface{}{
+			"type":                  "RequestHeaderModifier",
+			"requestHeaderModifier": gatewayHeaderModifier(info.RouteRequestHeaderAdd, info.RouteRequestHeaderSet, info.RouteRequestHeaderRemove),
+		})
+	}
+	responseHeaders := u.getResponseHeaders(info)
+	if len(responseHeaders) > 0 || len(info.RouteResponseHeaderRemove) > 0 {
+		filters = append(filters, map[string]interface{}{
+			"type":                   "ResponseHeaderModifier",
+			"responseHeaderModifier": gatewayHeaderModifier(nil, responseHeaders, info.RouteResponseHeaderRemove),
+		})
+	}
+	return filters
+}
+
+func gatewayHeaderModifier(add, set map[string]string, remove []string) map[string]interface{} {
+	modifier := map[string]interface{}{}
+	for name, m := range map[string]map[string]string{"add": add, "set": set} {
+		if len(m) == 0 {
+			continue
+		}
+		headers := []interface{}{}
+		for _, k := range sortedKeys(m) {
+			headers = append(headers, map[string]interface{}{"name": k, "value": m[k]})
+		}
+		modifier[name] = headers
+	}
+	if len(remove) > 0 {
+		headers := []interface{}{}
+		for _, k := range remove {
+			headers = append(headers, k)
+		}
+		modifier["remove"] = headers
+	}
+	return modifier
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
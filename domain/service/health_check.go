@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/zxnlx/route/proto/route"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"strconv"
+)
+
+// HealthCheckAnnotationKeys 控制器的健康检查注解，为空的项表示不支持
+type HealthCheckAnnotationKeys struct {
+	Path     string `json:"path"`
+	Interval string `json:"interval"`
+}
+
+// 内置的 Ingress class 健康检查注解，可以通过配置 health_check_annotations 补充或覆盖
+var defaultHealthCheckAnnotations = map[string]HealthCheckAnnotationKeys{
+	"alb": {
+		Path:     "alb.ingress.kubernetes.io/healthcheck-path",
+		Interval: "alb.ingress.kubernetes.io/healthcheck-interval-seconds",
+	},
+}
+
+// 后端健康汇总
+const (
+	BackendHealthy   = "healthy"
+	BackendDegraded  = "degraded"
+	BackendUnhealthy = "unhealthy"
+	BackendUnknown   = "unknown"
+)
+
+func (u *RouteDataService) healthCheckAnnotationKeys(class string) (HealthCheckAnnotationKeys, bool) {
+	if keys, ok := u.Config.HealthCheckAnnotations[class]; ok {
+		return keys, true
+	}
+	keys, ok := defaultHealthCheckAnnotations[class]
+	return keys, ok
+}
+
+func hasHealthCheck(path *route.RoutePath) bool {
+	return path.RouteHealthcheckPath != "" || path.RouteHealthcheckIntervalSeconds != 0
+}
+
+// 健康检查注解作用于整个 Ingress，设置了健康检查的路径必须一致，未设置的路径沿用同一配置
+func (u *RouteDataService) checkHealthCheck(info *route.RouteInfo) error {
+	var first *route.RoutePath
+	for _, v := range info.RoutePath {
+		if !hasHealthCheck(v) {
+			continue
+		}
+		if !isIngressAdapter(info.RouteAdapter) {
+			return errors.New("路径 " + v.RoutePathName + " 设置了健康检查，" + info.RouteAdapter + " 不支持")
+		}
+		if v.RouteHealthcheckIntervalSeconds < 0 {
+			return errors.New("路径 " + v.RoutePathName + " 的健康检查间隔不能小于 0")
+		}
+		class := u.getIngressClassName(info)
+		keys, ok := u.healthCheckAnnotationKeys(class)
+		if !ok {
+			return errors.New("路径 " + v.RoutePathName + " 设置了健康检查，Ingress class " + class + " 不支持")
+		}
+		if v.RouteHealthcheckPath != "" && keys.Path == "" || v.RouteHealthcheckIntervalSeconds != 0 && keys.Interval == "" {
+			return errors.New("路径 " + v.RoutePathName + " 的健康检查配置 Ingress class " + class + " 不支持")
+		}
+		if first != nil && (first.RouteHealthcheckPath != v.RouteHealthcheckPath || first.RouteHealthcheckIntervalSeconds != v.RouteHealthcheckIntervalSeconds) {
+			return errors.New(class + " 下同一路由的所有路径必须使用相同的健康检查配置")
+		}
+		first = v
+	}
+	return nil
+}
+
+// 健康检查转换为控制器注解，checkHealthCheck 已保证各路径一致
+func (u *RouteDataService) getHealthCheckAnnotations(info *route.RouteInfo) map[string]string {
+	annotations := map[string]string{}
+	keys, ok := u.healthCheckAnnotationKeys(u.getIngressClassName(info))
+	if !ok {
+		return annotations
+	}
+	for _, v := range info.RoutePath {
+		if !hasHealthCheck(v) {
+			continue
+		}
+		if v.RouteHealthcheckPath != "" {
+			annotations[keys.Path] = v.RouteHealthcheckPath
+		}
+		if v.RouteHealthcheckIntervalSeconds != 0 {
+			annotations[keys.Interval] = strconv.FormatInt(int64(v.RouteHealthcheckIntervalSeconds), 10)
+		}
+		break
+	}
+	return annotations
+}
+
+// 根据后端 Service 的 Endpoints 推断健康状况：全部后端有就绪地址为 healthy，部分为 degraded，都没有为 unhealthy
+func (u *RouteDataService) fillBackendHealth(ctx context.Context, info *route.RouteInfo, status *route.RouteStatus) {
+	status.BackendHealth = BackendUnknown
+	seen := map[string]bool{}
+	healthy, total := 0, 0
+	for _, v := range info.RoutePath {
+		key := v.RouteBackendService + ":" + strconv.FormatInt(int64(v.RouteBackendServicePort), 10)
+		if v.RouteBackendService == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		endpoints, err := u.K8sClientSet.CoreV1().Endpoints(info.RouteNamespace).Get(ctx, v.RouteBackendService, metav1.GetOptions{})
+		if err != nil {
+			//无法读取任一后端时不给出结论
+			status.Backends = nil
+			return
+		}
+		backend := &route.BackendHealth{Service: v.RouteBackendService, Port: v.RouteBackendServicePort}
+		for _, subset := range endpoints.Subsets {
+			backend.Ready += int32(len(subset.Addresses))
+			backend.NotReady += int32(len(subset.NotReadyAddresses))
+		}
+		status.Backends = append(status.Backends, backend)
+		total++
+		if backend.Ready > 0 {
+			healthy++
+		}
+	}
+	switch {
+	case total == 0:
+	case healthy == total:
+		status.BackendHealth = BackendHealthy
+	case healthy == 0:
+		status.BackendHealth = BackendUnhealthy
+	default:
+		status.BackendHealth = BackendDegraded
+	}
+}
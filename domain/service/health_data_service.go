@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/i18n"
+	"sort"
+	"time"
+)
+
+// ErrUnknownDependency 检查了未注册的依赖
+var ErrUnknownDependency = i18n.NewError(i18n.CodeUnknownDependency, "未知的依赖")
+
+// HealthChecker 检查单个依赖是否可用
+type HealthChecker func(ctx context.Context) error
+
+// IHealthDataService 健康检查接口
+type IHealthDataService interface {
+	// Check name 为空时检查所有依赖
+	Check(ctx context.Context, name string) error
+}
+
+// NewHealthDataService 创建，checkers 以依赖名称为 key
+func NewHealthDataService(checkers map[string]HealthChecker) IHealthDataService {
+	return &HealthDataService{checkers: checkers, timeout: 3 * time.Second}
+}
+
+type HealthDataService struct {
+	checkers map[string]HealthChecker
+	timeout  time.Duration
+}
+
+// Check 检查依赖健康状态
+func (u *HealthDataService) Check(ctx context.Context, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+	if name != "" {
+		checker, ok := u.checkers[name]
+		if !ok {
+			return ErrUnknownDependency
+		}
+		return checker(ctx)
+	}
+	names := make([]string, 0, len(u.checkers))
+	for k := range u.checkers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		if err := u.checkers[k](ctx); err != nil {
+			common.Error("依赖 " + k + " 不可用：" + err.Error())
+			return err
+		}
+	}
+	return nil
+}
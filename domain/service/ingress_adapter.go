@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// AdapterIngress 使用 networking.k8s.io 的 Ingress 实现路由，RouteAdapter 为空时相同
+const AdapterIngress = "ingress"
+
+// IngressAdapter 路由在k8s中的实现方式，负责生成资源并写入、删除
+// 路由先经过 checkRoute 的通用校验，再由实现检查能否表达
+type IngressAdapter interface {
+	// Validate 检查路由能否用该实现表达
+	Validate(*route.RouteInfo) error
+	// Create 创建资源，已存在时按冲突策略处理
+	Create(*route.RouteInfo) error
+	// Update 基于线上对象更新资源
+	Update(*route.RouteInfo) error
+	// Delete 删除资源，不存在时返回 NotFound 错误
+	Delete(*model.Route) error
+}
+
+// IngressAdapterFactory 为路由服务创建实现
+type IngressAdapterFactory func(*RouteDataService) IngressAdapter
+
+var (
+	ingressAdaptersMu sync.RWMutex
+	ingressAdapters   = map[string]IngressAdapterFactory{}
+)
+
+// RegisterIngressAdapter 注册实现，name 为 RouteAdapter 的取值，使用 Ingress 时为 Ingress class，重复注册时覆盖
+func RegisterIngressAdapter(name string, factory IngressAdapterFactory) {
+	ingressAdaptersMu.Lock()
+	defer ingressAdaptersMu.Unlock()
+	ingressAdapters[name] = factory
+}
+
+func init() {
+	RegisterIngressAdapter(AdapterIngress, func(u *RouteDataService) IngressAdapter { return &ingressAdapter{u: u} })
+	RegisterIngressAdapter("nginx", func(u *RouteDataService) IngressAdapter { return &nginxIngressAdapter{ingressAdapter{u: u}} })
+	RegisterIngressAdapter("traefik", func(u *RouteDataService) IngressAdapter { return &traefikIngressAdapter{ingressAdapter{u: u}} })
+	RegisterIngressAdapter(AdapterGatewayAPI, func(u *RouteDataService) IngressAdapter { return &gatewayAPIAdapter{u: u} })
+	RegisterIngressAdapter(AdapterIstio, func(u *RouteDataService) IngressAdapter { return &istioAdapter{u: u} })
+}
+
+func isIngressAdapter(adapter string) bool {
+	return adapter == "" || adapter == AdapterIngress
+}
+
+// 使用 Ingress 时按 class 选择控制器的实现，没有专门实现的 class 使用通用 Ingress
+func (u *RouteDataService) ingressAdapter(info *route.RouteInfo) (IngressAdapter, error) {
+	name := info.RouteAdapter
+	ingressAdaptersMu.RLock()
+	defer ingressAdaptersMu.RUnlock()
+	if isIngressAdapter(name) {
+		name = AdapterIngress
+		if _, ok := ingressAdapters[u.getIngressClassName(info)]; ok {
+			name = u.getIngressClassName(info)
+		}
+	}
+	factory, ok := ingressAdapters[name]
+	if !ok {
+		return nil, i18n.NewError(i18n.CodeUnsupportedAdapter, "不支持的路由实现方式："+info.RouteAdapter+"，可选："+strings.Join(ingressAdapterNames(), "、"))
+	}
+	return factory(u), nil
+}
+
+// 调用方需要持有读锁
+func ingressAdapterNames() []string {
+	names := []string{}
+	for k := range ingressAdapters {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ingressAdapter 通用 Ingress，只使用各控制器都支持的字段
+type ingressAdapter struct {
+	u *RouteDataService
+}
+
+// Ingress 无法表达请求头和请求方法匹配，镜像流量、请求头、响应头只有 nginx 支持
+func (a *ingressAdapter) Validate(info *route.RouteInfo) error {
+	if a.u.hasHeaders(info) {
+		return errors.New("路由 " + info.RouteName + " 设置了请求头或响应头，Ingress 仅 nginx 支持")
+	}
+	for _, v := range info.RoutePath {
+		if len(v.RouteHeaderMatch) > 0 || len(v.RouteMethod) > 0 {
+			return errors.New("路径 " + v.RoutePathName + " 设置了请求头或请求方法匹配，仅 " + AdapterGatewayAPI + " 和 " + AdapterIstio + " 支持")
+		}
+		if v.RouteMirrorService != "" {
+			return errors.New("路径 " + v.RoutePathName + " 设置了镜像流量，Ingress 仅 nginx 支持")
+		}
+	}
+	return nil
+}
+
+func (a *ingressAdapter) Create(info *route.RouteInfo) (err error) {
+	u := a.u
+	ingress := u.setIngress(info)
+	client := u.ingresses(info.RouteNamespace)
+	//查找是否存在
+	current, err := client.Get(context.TODO(), info.RouteName, metav1.GetOptions{})
+	if err != nil {
+		if u.Config.ServerSideApply.Enabled {
+			return u.applyIngress(info)
+		}
+		_, err = client.Create(context.TODO(), ingress, metav1.CreateOptions{})
+		return err
+	}
+	//已存在时按冲突策略处理，不在管理范围内的资源不能接管或覆盖
+	if info.RouteConflictPolicy == ConflictPolicyReplace || info.RouteConflictPolicy == ConflictPolicyAdopt {
+		if err = u.checkObjectScope("Ingress", current.Namespace, current.Name, current.Labels); err != nil {
+			return err
+		}
+	}
+	switch info.RouteConflictPolicy {
+	case ConflictPolicyReplace:
+		ingress.ResourceVersion = current.ResourceVersion
+		_, err = client.Update(context.TODO(), ingress, metav1.UpdateOptions{})
+	case ConflictPolicyAdopt:
+		//保留现有规格，只补上管理标签和注解
+		current.Labels = mergeStringMap(current.Labels, u.getStampLabels(info))
+		current.Annotations = mergeStringMap(current.Annotations, u.getStampAnnotations(info))
+		_, err = client.Update(context.TODO(), current, metav1.UpdateOptions{})
+	default:
+		err = i18n.NewError(i18n.CodeRouteAlreadyExists, "路由 "+info.RouteName+" 已经存在")
+	}
+	return err
+}
+
+func (a *ingressAdapter) Update(info *route.RouteInfo) error {
+	u := a.u
+	//服务端应用按字段归属合并，不需要读取线上对象
+	if u.Config.ServerSideApply.Enabled {
+		return u.applyIngress(info)
+	}
+	client := u.ingresses(info.RouteNamespace)
+	//基于线上对象合并标签和注解，冲突时重新读取
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		live, err := client.Get(context.TODO(), info.RouteName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		ingress := u.setIngress(info)
+		u.mergeMetadata(live, ingress)
+		_, err = client.Update(context.TODO(), ingress, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (a *ingressAdapter) Delete(route2 *model.Route) error {
+	return a.u.ingresses(route2.RouteNamespace).Delete(context.TODO(), route2.RouteName, metav1.DeleteOptions{})
+}
+
+// nginxIngressAdapter ingress-nginx，请求头、响应头和镜像流量通过注解实现
+type nginxIngressAdapter struct {
+	ingressAdapter
+}
+
+// nginx 的镜像注解作用于整个 Ingress，所有路径必须使用同一个镜像服务
+func (a *nginxIngressAdapter) Validate(info *route.RouteInfo) error {
+	mirror := ""
+	for _, v := range info.RoutePath {
+		if len(v.RouteHeaderMatch) > 0 || len(v.RouteMethod) > 0 {
+			return errors.New("路径 " + v.RoutePathName + " 设置了请求头或请求方法匹配，仅 " + AdapterGatewayAPI + " 和 " + AdapterIstio + " 支持")
+		}
+		if v.RouteMirrorService == "" {
+			continue
+		}
+		target := v.RouteMirrorService + ":" + strconv.FormatInt(int64(v.RouteMirrorServicePort), 10)
+		if mirror != "" && mirror != target {
+			return errors.New("nginx 下同一路由的所有路径必须使用相同的镜像服务")
+		}
+		mirror = target
+	}
+	return nil
+}
+
+// traefikIngressAdapter Traefik，外部认证通过 ForwardAuth 中间件实现，与 Ingress 一起写入和删除
+type traefikIngressAdapter struct {
+	ingressAdapter
+}
+
+func (a *traefikIngressAdapter) Create(info *route.RouteInfo) error {
+	if err := a.u.syncAuthMiddleware(info); err != nil {
+		return err
+	}
+	return a.ingressAdapter.Create(info)
+}
+
+func (a *traefikIngressAdapter) Update(info *route.RouteInfo) error {
+	if err := a.u.syncAuthMiddleware(info); err != nil {
+		return err
+	}
+	return a.ingressAdapter.Update(info)
+}
+
+func (a *traefikIngressAdapter) Delete(route2 *model.Route) error {
+	if err := a.ingressAdapter.Delete(route2); err != nil {
+		return err
+	}
+	return a.u.deleteAuthMiddleware(route2)
+}
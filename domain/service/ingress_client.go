@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"github.com/zxnlx/common"
+	networkingv1 "k8s.io/api/networking/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	typednetworkingv1beta1 "k8s.io/client-go/kubernetes/typed/networking/v1beta1"
+)
+
+// Ingress API 版本
+const (
+	IngressAPIV1      = "networking.k8s.io/v1"
+	IngressAPIV1beta1 = "networking.k8s.io/v1beta1"
+)
+
+// ingressClient 统一使用 v1 结构操作 Ingress，v1beta1 集群在读写时转换
+type ingressClient interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*networkingv1.Ingress, error)
+	Create(ctx context.Context, ingress *networkingv1.Ingress, opts metav1.CreateOptions) (*networkingv1.Ingress, error)
+	Update(ctx context.Context, ingress *networkingv1.Ingress, opts metav1.UpdateOptions) (*networkingv1.Ingress, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	//服务端应用时 data 需要使用集群的 Ingress 版本
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*networkingv1.Ingress, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*networkingv1.IngressList, error)
+}
+
+// 启动时探测集群支持的 Ingress 版本，1.19 之前的集群没有 v1，探测失败时按 v1 处理
+func discoverIngressAPIVersion(clientSet kubernetes.Interface) string {
+	resources, err := clientSet.Discovery().ServerResourcesForGroupVersion(IngressAPIV1)
+	if err == nil {
+		for _, v := range resources.APIResources {
+			if v.Name == "ingresses" {
+				return IngressAPIV1
+			}
+		}
+	}
+	resources, err = clientSet.Discovery().ServerResourcesForGroupVersion(IngressAPIV1beta1)
+	if err != nil {
+		common.Error(err)
+		return IngressAPIV1
+	}
+	for _, v := range resources.APIResources {
+		if v.Name == "ingresses" {
+			return IngressAPIV1beta1
+		}
+	}
+	return IngressAPIV1
+}
+
+func (u *RouteDataService) ingresses(namespace string) ingressClient {
+	var client ingressClient = u.K8sClientSet.NetworkingV1().Ingresses(namespace)
+	if u.IngressAPIVersion == IngressAPIV1beta1 {
+		client = &v1beta1IngressClient{client: u.K8sClientSet.NetworkingV1beta1().Ingresses(namespace)}
+	}
+	//写入前检查权限
+	return &preflightIngressClient{ingressClient: client, service: u, namespace: namespace}
+}
+
+type v1beta1IngressClient struct {
+	client typednetworkingv1beta1.IngressInterface
+}
+
+func (c *v1beta1IngressClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*networkingv1.Ingress, error) {
+	ingress, err := c.client.Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return fromV1beta1Ingress(ingress), nil
+}
+
+func (c *v1beta1IngressClient) Create(ctx context.Context, ingress *networkingv1.Ingress, opts metav1.CreateOptions) (*networkingv1.Ingress, error) {
+	created, err := c.client.Create(ctx, toV1beta1Ingress(ingress), opts)
+	if err != nil {
+		return nil, err
+	}
+	return fromV1beta1Ingress(created), nil
+}
+
+func (c *v1beta1IngressClient) Update(ctx context.Context, ingress *networkingv1.Ingress, opts metav1.UpdateOptions) (*networkingv1.Ingress, error) {
+	updated, err := c.client.Update(ctx, toV1beta1Ingress(ingress), opts)
+	if err != nil {
+		return nil, err
+	}
+	return fromV1beta1Ingress(updated), nil
+}
+
+func (c *v1beta1IngressClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete(ctx, name, opts)
+}
+
+func (c *v1beta1IngressClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*networkingv1.Ingress, error) {
+	patched, err := c.client.Patch(ctx, name, pt, data, opts, subresources...)
+	if err != nil {
+		return nil, err
+	}
+	return fromV1beta1Ingress(patched), nil
+}
+
+func (c *v1beta1IngressClient) List(ctx context.Context, opts metav1.ListOptions) (*networkingv1.IngressList, error) {
+	list, err := c.client.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &networkingv1.IngressList{ListMeta: list.ListMeta}
+	for i := range list.Items {
+		out.Items = append(out.Items, *fromV1beta1Ingress(&list.Items[i]))
+	}
+	return out, nil
+}
+
+func toV1beta1Ingress(in *networkingv1.Ingress) *networkingv1beta1.Ingress {
+	out := &networkingv1beta1.Ingress{
+		TypeMeta:   metav1.TypeMeta{Kind: "Ingress", APIVersion: IngressAPIV1beta1},
+		ObjectMeta: in.ObjectMeta,
+		Spec:       networkingv1beta1.IngressSpec{IngressClassName: in.Spec.IngressClassName},
+	}
+	if in.Spec.DefaultBackend != nil {
+		backend := toV1beta1Backend(*in.Spec.DefaultBackend)
+		out.Spec.Backend = &backend
+	}
+	for _, v := range in.Spec.TLS {
+		out.Spec.TLS = append(out.Spec.TLS, networkingv1beta1.IngressTLS{Hosts: v.Hosts, SecretName: v.SecretName})
+	}
+	for _, v := range in.Spec.Rules {
+		rule := networkingv1beta1.IngressRule{Host: v.Host}
+		if v.HTTP != nil {
+			rule.HTTP = &networkingv1beta1.HTTPIngressRuleValue{}
+			for _, p := range v.HTTP.Paths {
+				path := networkingv1beta1.HTTPIngressPath{Path: p.Path, Backend: toV1beta1Backend(p.Backend)}
+				if p.PathType != nil {
+					pathType := networkingv1beta1.PathType(*p.PathType)
+					path.PathType = &pathType
+				}
+				rule.HTTP.Paths = append(rule.HTTP.Paths, path)
+			}
+		}
+		out.Spec.Rules = append(out.Spec.Rules, rule)
+	}
+	return out
+}
+
+func toV1beta1Backend(in networkingv1.IngressBackend) networkingv1beta1.IngressBackend {
+	out := networkingv1beta1.IngressBackend{Resource: in.Resource}
+	if in.Service != nil {
+		out.ServiceName = in.Service.Name
+		if in.Service.Port.Name != "" {
+			out.ServicePort = intstr.FromString(in.Service.Port.Name)
+		} else {
+			out.ServicePort = intstr.FromInt(int(in.Service.Port.Number))
+		}
+	}
+	return out
+}
+
+func fromV1beta1Ingress(in *networkingv1beta1.Ingress) *networkingv1.Ingress {
+	out := &networkingv1.Ingress{
+		TypeMeta:   metav1.TypeMeta{Kind: "Ingress", APIVersion: IngressAPIV1},
+		ObjectMeta: in.ObjectMeta,
+		Spec:       networkingv1.IngressSpec{IngressClassName: in.Spec.IngressClassName},
+	}
+	if in.Spec.Backend != nil {
+		backend := fromV1beta1Backend(*in.Spec.Backend)
+		out.Spec.DefaultBackend = &backend
+	}
+	for _, v := range in.Spec.TLS {
+		out.Spec.TLS = append(out.Spec.TLS, networkingv1.IngressTLS{Hosts: v.Hosts, SecretName: v.SecretName})
+	}
+	for _, v := range in.Spec.Rules {
+		rule := networkingv1.IngressRule{Host: v.Host}
+		if v.HTTP != nil {
+			rule.HTTP = &networkingv1.HTTPIngressRuleValue{}
+			for _, p := range v.HTTP.Paths {
+				path := networkingv1.HTTPIngressPath{Path: p.Path, Backend: fromV1beta1Backend(p.Backend)}
+				if p.PathType != nil {
+					pathType := networkingv1.PathType(*p.PathType)
+					path.PathType = &pathType
+				}
+				rule.HTTP.Paths = append(rule.HTTP.Paths, path)
+			}
+		}
+		out.Spec.Rules = append(out.Spec.Rules, rule)
+	}
+	for _, v := range in.Status.LoadBalancer.Ingress {
+		out.Status.LoadBalancer.Ingress = append(out.Status.LoadBalancer.Ingress, networkingv1.IngressLoadBalancerIngress{IP: v.IP, Hostname: v.Hostname})
+	}
+	return out
+}
+
+func fromV1beta1Backend(in networkingv1beta1.IngressBackend) networkingv1.IngressBackend {
+	out := networkingv1.IngressBackend{Resource: in.Resource}
+	if in.ServiceName != "" {
+		out.Service = &networkingv1.IngressServiceBackend{Name: in.ServiceName}
+		if in.ServicePort.Type == intstr.String {
+			out.Service.Port.Name = in.ServicePort.StrVal
+		} else {
+			out.Service.Port.Number = in.ServicePort.IntVal
+		}
+	}
+	return out
+}
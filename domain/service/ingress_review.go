@@ -0,0 +1,49 @@
+package service
+
+import (
+	"github.com/zxnlx/route/notify"
+	"github.com/zxnlx/route/proto/route"
+)
+
+// IngressChange 集群中绕过路由服务对 Ingress 的一次变更，由准入 webhook 传入
+type IngressChange struct {
+	// Operation 为 CREATE、UPDATE 或 DELETE
+	Operation string
+	Namespace string
+	Name      string
+	User      string
+	// Labels 变更前后的标签，任意一个带有本服务的管理标签即视为受管资源
+	Labels []map[string]string
+}
+
+// ReviewIngressChange 受管的 Ingress 只能通过路由服务修改，record 为 true 时放行并记录漂移事件
+// 返回是否放行和提示信息
+func (u *RouteDataService) ReviewIngressChange(change *IngressChange, record bool) (bool, string) {
+	if !u.isManagedIngress(change.Labels) {
+		return true, ""
+	}
+	target := "Ingress " + change.Namespace + "/" + change.Name
+	message := "用户 " + change.User + " 绕过路由服务 " + change.Operation + " " + target
+	if route2, err := u.RouteRepository.FindRouteByName(change.Namespace, change.Name); err == nil {
+		u.emitEvent(&route.RouteInfo{Id: route2.ID, RouteName: route2.RouteName, RouteNamespace: route2.RouteNamespace}, notify.EventDriftDetected, message)
+	}
+	if record {
+		return true, target + " 由路由服务管理，本次修改会在下次写入时被覆盖"
+	}
+	return false, target + " 由路由服务管理，请通过路由服务修改"
+}
+
+// 管理标签的值为本服务的标识
+func (u *RouteDataService) isManagedIngress(labels []map[string]string) bool {
+	identity := u.Config.Stamp.ServiceIdentity
+	if identity == "" {
+		identity = defaultStampServiceIdentity
+	}
+	key := u.Config.Stamp.prefix() + "managed-by"
+	for _, v := range labels {
+		if v[key] == identity {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"github.com/zxnlx/route/domain/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var inventoryHeader = []string{
+	"id", "name", "namespace", "host", "paths", "backends", "class", "tls",
+	"owner_team", "contact", "cost_center", "status", "last_applied",
+}
+
+// ExportInventory 导出所有路由清单为 CSV，带 BOM 方便 Excel 直接打开
+func (u *RouteDataService) ExportInventory() ([]byte, error) {
+	routes, err := u.RouteRepository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	buf.WriteString("\xef\xbb\xbf")
+	w := csv.NewWriter(buf)
+	if err := w.Write(inventoryHeader); err != nil {
+		return nil, err
+	}
+	for _, v := range routes {
+		if err := w.Write(inventoryRow(&v)); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func inventoryRow(route2 *model.Route) []string {
+	paths := make([]string, 0, len(route2.RoutePath))
+	backends := make([]string, 0, len(route2.RoutePath))
+	for _, p := range route2.RoutePath {
+		paths = append(paths, p.RoutePathName)
+		backends = append(backends, p.RouteBackendService+":"+strconv.FormatInt(int64(p.RouteBackendServicePort), 10))
+	}
+	class := route2.RouteClass
+	if !isIngressAdapter(route2.RouteAdapter) {
+		class = route2.RouteAdapter + "(" + route2.RouteGateway + ")"
+	}
+	status := "enabled"
+	if route2.RouteDisabled {
+		status = "disabled"
+	}
+	return []string{
+		strconv.FormatInt(route2.ID, 10),
+		route2.RouteName,
+		route2.RouteNamespace,
+		route2.RouteHost,
+		strings.Join(paths, ";"),
+		strings.Join(backends, ";"),
+		class,
+		route2.RouteTlsIssuer,
+		route2.RouteOwnerTeam,
+		route2.RouteContact,
+		route2.RouteCostCenter,
+		status,
+		route2.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"strings"
+)
+
+// AdapterIstio 使用 Istio 的 VirtualService 实现路由
+const AdapterIstio = "istio"
+
+var virtualServiceResource = schema.GroupVersionResource{
+	Group:    "networking.istio.io",
+	Version:  "v1beta1",
+	Resource: "virtualservices",
+}
+
+// istioAdapter 使用 Istio 的 VirtualService，支持请求头和请求方法匹配
+type istioAdapter struct {
+	u *RouteDataService
+}
+
+// 证书由 Gateway 配置，路由上不能签发
+func (a *istioAdapter) Validate(info *route.RouteInfo) error {
+	if info.RouteGateway == "" {
+		return errors.New("路由 " + info.RouteName + " 使用 " + AdapterIstio + " 时必须指定 Gateway")
+	}
+	if info.RouteTlsIssuer != "" {
+		return errors.New("路由 " + info.RouteName + " 设置了证书签发，" + AdapterIstio + " 请在 Gateway 中配置证书")
+	}
+	return nil
+}
+
+func (a *istioAdapter) Create(info *route.RouteInfo) error {
+	virtualService := a.u.setVirtualService(info)
+	client := a.u.K8sDynamicClient.Resource(virtualServiceResource).Namespace(info.RouteNamespace)
+	current, err := client.Get(context.TODO(), info.RouteName, metav1.GetOptions{})
+	if err != nil {
+		if _, err = client.Create(context.TODO(), virtualService, metav1.CreateOptions{}); err != nil {
+			common.Error(err)
+			return err
+		}
+		return nil
+	}
+	//已存在时按冲突策略处理，不在管理范围内的资源不能接管或覆盖
+	if info.RouteConflictPolicy == ConflictPolicyReplace || info.RouteConflictPolicy == ConflictPolicyAdopt {
+		if err = a.u.checkObjectScope("VirtualService", current.GetNamespace(), current.GetName(), current.GetLabels()); err != nil {
+			common.Error(err)
+			return err
+		}
+	}
+	switch info.RouteConflictPolicy {
+	case ConflictPolicyReplace:
+		virtualService.SetResourceVersion(current.GetResourceVersion())
+		_, err = client.Update(context.TODO(), virtualService, metav1.UpdateOptions{})
+	case ConflictPolicyAdopt:
+		current.SetLabels(mergeStringMap(current.GetLabels(), a.u.getStampLabels(info)))
+		current.SetAnnotations(mergeStringMap(current.GetAnnotations(), a.u.getStampAnnotations(info)))
+		_, err = client.Update(context.TODO(), current, metav1.UpdateOptions{})
+	default:
+		err = i18n.NewError(i18n.CodeRouteAlreadyExists, "路由 "+info.RouteName+" 已经存在")
+	}
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// 基于线上对象合并标签和注解，冲突时重新读取
+func (a *istioAdapter) Update(info *route.RouteInfo) error {
+	virtualService := a.u.setVirtualService(info)
+	client := a.u.K8sDynamicClient.Resource(virtualServiceResource).Namespace(info.RouteNamespace)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		live, err := client.Get(context.TODO(), info.RouteName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		desired := virtualService.DeepCopy()
+		a.u.mergeMetadata(live, desired)
+		_, err = client.Update(context.TODO(), desired, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (a *istioAdapter) Delete(route2 *model.Route) error {
+	return a.u.K8sDynamicClient.Resource(virtualServiceResource).Namespace(route2.RouteNamespace).Delete(context.TODO(), route2.RouteName, metav1.DeleteOptions{})
+}
+
+func (u *RouteDataService) setVirtualService(info *route.RouteInfo) *unstructured.Unstructured {
+	virtualService := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.istio.io/v1beta1",
+		"kind":       "VirtualService",
+		"spec": map[string]interface{}{
+			"hosts":    []interface{}{info.RouteHost},
+			"gateways": []interface{}{info.RouteGateway},
+			"http":     u.getVirtualServiceRoutes(info),
+		},
+	}}
+	virtualService.SetName(info.RouteName)
+	virtualService.SetNamespace(info.RouteNamespace)
+	virtualService.SetLabels(u.getStampLabels(info))
+	virtualService.SetAnnotations(u.getStampAnnotations(info))
+	u.recordLastApplied(virtualService)
+	return virtualService
+}
+
+// 每个路径生成一条 http 路由，多个请求方法拆成多个匹配条件
+func (u *RouteDataService) getVirtualServiceRoutes(info *route.RouteInfo) []interface{} {
+	routes := []interface{}{}
+	headers := istioHeaders(info.RouteRequestHeaderAdd, info.RouteRequestHeaderSet, info.RouteRequestHeaderRemove, u.getResponseHeaders(info), info.RouteResponseHeaderRemove)
+	for _, v := range info.RoutePath {
+		matchHeaders := map[string]interface{}{}
+		for _, h := range v.RouteHeaderMatch {
+			matchType := "exact"
+			if h.Type == "RegularExpression" {
+				matchType = "regex"
+			}
+			matchHeaders[strings.ToLower(h.Name)] = map[string]interface{}{matchType: h.Value}
+		}
+		newMatch := func() map[string]interface{} {
+			match := map[string]interface{}{
+				"uri": map[string]interface{}{"prefix": v.RoutePathName},
+			}
+			if len(matchHeaders) > 0 {
+				match["headers"] = matchHeaders
+			}
+			return match
+		}
+		matches := []interface{}{}
+		if len(v.RouteMethod) == 0 {
+			matches = append(matches, newMatch())
+		}
+		for _, method := range v.RouteMethod {
+			match := newMatch()
+			match["method"] = map[string]interface{}{"exact": strings.ToUpper(method)}
+			matches = append(matches, match)
+		}
+		httpRoute := map[string]interface{}{
+			"match": matches,
+			"route": []interface{}{
+				map[string]interface{}{
+					"destination": istioDestination(v.RouteBackendService, info.RouteNamespace, v.RouteBackendServicePort),
+				},
+			},
+		}
+		if v.RouteMirrorService != "" {
+			httpRoute["mirror"] = istioDestination(v.RouteMirrorService, info.RouteNamespace, v.RouteMirrorServicePort)
+		}
+		if len(headers) > 0 {
+			httpRoute["headers"] = headers
+		}
+		routes = append(routes, httpRoute)
+	}
+	return routes
+}
+
+func istioDestination(service string, namespace string, port int32) map[string]interface{} {
+	return map[string]interface{}{
+		"host": service + "." + namespace + ".svc.cluster.local",
+		"port": map[string]interface{}{"number": int64(port)},
+	}
+}
+
+func istioHeaders(requestAdd, requestSet map[string]string, requestRemove []string, responseSet map[string]string, responseRemove []string) map[string]interface{} {
+	headers := map[string]interface{}{}
+	if len(requestAdd) > 0 || len(requestSet) > 0 || len(requestRemove) > 0 {
+		headers["request"] = istioHeaderOperations(requestAdd, requestSet, requestRemove)
+	}
+	if len(responseSet) > 0 || len(responseRemove) > 0 {
+		headers["response"] = istioHeaderOperations(nil, responseSet, responseRemove)
+	}
+	return headers
+}
+
+func istioHeaderOperations(add, set map[string]string, remove []string) map[string]interface{} {
+	operations := map[string]interface{}{}
+	for name, m := range map[string]map[string]string{"add": add, "set": set} {
+		if len(m) == 0 {
+			continue
+		}
+		headers := map[string]interface{}{}
+		for k, v := range m {
+			headers[k] = v
+		}
+		operations[name] = headers
+	}
+	if len(remove) > 0 {
+		headers := []interface{}{}
+		for _, k := range remove {
+			headers = append(headers, k)
+		}
+		operations["remove"] = headers
+	}
+	return operations
+}
+
+// VirtualService 没有标准的就绪状态，存在且没有错误级别的校验信息时视为就绪
+func virtualServiceStatus(virtualService *unstructured.Unstructured) *route.RouteStatus {
+	status := &route.RouteStatus{Ready: true}
+	messages, _, _ := unstructured.NestedSlice(virtualService.Object, "status", "validationMessages")
+	for _, v := range messages {
+		message, _ := v.(map[string]interface{})
+		level, _, _ := unstructured.NestedString(message, "level")
+		if level != "ERROR" {
+			continue
+		}
+		status.Ready = false
+		status.Message, _, _ = unstructured.NestedString(message, "description")
+		return status
+	}
+	return status
+}
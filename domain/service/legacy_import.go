@@ -0,0 +1,30 @@
+package service
+
+import (
+	"errors"
+	"github.com/zxnlx/route/legacyconf"
+	"github.com/zxnlx/route/proto/route"
+)
+
+// 旧配置的最大长度
+const maxLegacyConfigSize = 4 << 20
+
+// ImportLegacyConfig 解析旧的 nginx、HAProxy 配置为路由草稿，只返回草稿和无法转换的警告，不写入
+func (u *RouteDataService) ImportLegacyConfig(req *route.LegacyConfigRequest) (*route.LegacyImportResult, error) {
+	if len(req.Content) == 0 {
+		return nil, errors.New("配置内容不能为空")
+	}
+	if len(req.Content) > maxLegacyConfigSize {
+		return nil, errors.New("配置内容超过 4MB")
+	}
+	result, err := legacyconf.Parse(req.Format, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &route.LegacyImportResult{Warnings: result.Warnings}
+	for _, v := range result.Drafts {
+		v.Route.RouteNamespace = req.Namespace
+		rsp.Drafts = append(rsp.Drafts, &route.LegacyRouteDraft{Route: v.Route, Source: v.Source, Warnings: v.Warnings})
+	}
+	return rsp, nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"github.com/zxnlx/route/proto/route"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 检查规则
+const (
+	LintMissingTLS           = "missing-tls"
+	LintBroadPath            = "broad-path"
+	LintDeprecatedAnnotation = "deprecated-annotation"
+	LintNonLowercaseName     = "non-lowercase-name"
+	LintHostNotAllowed       = "host-not-allowed"
+)
+
+// 警告级别
+const (
+	LintSeverityWarning = "warning"
+	LintSeverityInfo    = "info"
+)
+
+// LintConfig 规格检查配置
+type LintConfig struct {
+	// InternalHostSuffixes 内网域名后缀，匹配的域名不要求 TLS，默认 .local、.internal、.svc、.cluster.local、localhost
+	InternalHostSuffixes []string `json:"internal_host_suffixes"`
+	// DeprecatedAnnotations 废弃的注解和替代说明，补充内置的规则
+	DeprecatedAnnotations map[string]string `json:"deprecated_annotations"`
+}
+
+var defaultInternalHostSuffixes = []string{".local", ".internal", ".svc", ".cluster.local", "localhost"}
+
+// 内置的废弃注解
+var defaultDeprecatedAnnotations = map[string]string{
+	"kubernetes.io/ingress.class":                 "请使用 route_class",
+	"nginx.ingress.kubernetes.io/secure-backends": "请使用 nginx.ingress.kubernetes.io/backend-protocol: HTTPS",
+	"nginx.ingress.kubernetes.io/grpc-backend":    "请使用 nginx.ingress.kubernetes.io/backend-protocol: GRPC",
+	"nginx.ingress.kubernetes.io/add-base-url":    "ingress-nginx 0.22 起已移除",
+	"certmanager.k8s.io/cluster-issuer":           "请使用 route_tls_issuer",
+	"certmanager.k8s.io/issuer":                   "请使用 route_tls_issuer",
+}
+
+// LintRoute 检查路由规格，只返回警告，不校验也不写入
+func (u *RouteDataService) LintRoute(info *route.RouteInfo) []*route.LintWarning {
+	warnings := []*route.LintWarning{}
+	if info.RouteName != strings.ToLower(info.RouteName) {
+		warnings = append(warnings, &route.LintWarning{
+			Rule:     LintNonLowercaseName,
+			Severity: LintSeverityWarning,
+			Field:    "route_name",
+			Message:  "路由名称 " + info.RouteName + " 包含大写字母，k8s 资源名称只允许小写",
+		})
+	}
+	if info.RouteHost != "" && info.RouteTlsIssuer == "" && !u.isInternalHost(info.RouteHost) {
+		warnings = append(warnings, &route.LintWarning{
+			Rule:     LintMissingTLS,
+			Severity: LintSeverityWarning,
+			Field:    "route_tls_issuer",
+			Message:  "公网域名 " + info.RouteHost + " 没有配置 TLS",
+		})
+	}
+	for i, v := range info.RoutePath {
+		if v.RoutePathName == "/" || v.RoutePathName == "/*" {
+			warnings = append(warnings, &route.LintWarning{
+				Rule:     LintBroadPath,
+				Severity: LintSeverityInfo,
+				Field:    "route_path[" + strconv.Itoa(i) + "].route_path_name",
+				Message:  "路径 " + v.RoutePathName + " 会匹配域名下的所有请求，确认是否需要更具体的前缀",
+			})
+		}
+	}
+	deprecated := u.deprecatedAnnotations()
+	keys := make([]string, 0, len(info.RouteAnnotations))
+	for k := range info.RouteAnnotations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if hint, ok := deprecated[k]; ok {
+			warnings = append(warnings, &route.LintWarning{
+				Rule:     LintDeprecatedAnnotation,
+				Severity: LintSeverityWarning,
+				Field:    "route_annotations." + k,
+				Message:  "注解 " + k + " 已废弃，" + hint,
+			})
+		}
+	}
+	return warnings
+}
+
+func (u *RouteDataService) isInternalHost(host string) bool {
+	host = strings.ToLower(host)
+	//Ingress 不允许使用 IP 作为域名，按内网处理
+	if net.ParseIP(host) != nil {
+		return true
+	}
+	suffixes := u.Config.Lint.InternalHostSuffixes
+	if len(suffixes) == 0 {
+		suffixes = defaultInternalHostSuffixes
+	}
+	for _, v := range suffixes {
+		if host == strings.TrimPrefix(v, ".") || strings.HasSuffix(host, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *RouteDataService) deprecatedAnnotations() map[string]string {
+	annotations := map[string]string{}
+	for k, v := range defaultDeprecatedAnnotations {
+		annotations[k] = v
+	}
+	for k, v := range u.Config.Lint.DeprecatedAnnotations {
+		annotations[k] = v
+	}
+	return annotations
+}
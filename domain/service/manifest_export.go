@@ -0,0 +1,397 @@
+package service
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 导出清单的格式
+const (
+	ManifestFormatKustomize = "kustomize"
+	ManifestFormatHelm      = "helm"
+	ManifestFormatTerraform = "terraform"
+)
+
+// 导出的一个k8s对象
+type exportedManifest struct {
+	kind   string
+	name   string
+	object map[string]interface{}
+}
+
+// ExportManifests 导出命名空间中的路由为 Kustomize base 和 overlays 或 Helm chart，打包为 tar.gz，或者导出为 Terraform JSON
+// 导出的对象去掉了本服务的管理标签，交给 GitOps 工具接管后不再由本服务写入
+func (u *RouteDataService) ExportManifests(req *route.ExportManifestsRequest) (*route.InventoryFile, error) {
+	if req.Namespace == "" {
+		return nil, i18n.NewError(i18n.CodeNamespaceRequired, "必须指定命名空间")
+	}
+	format := req.Format
+	if format == "" {
+		format = ManifestFormatKustomize
+	}
+	switch format {
+	case ManifestFormatKustomize, ManifestFormatHelm, ManifestFormatTerraform:
+	default:
+		return nil, errors.New("不支持的导出格式：" + format)
+	}
+	manifests, err := u.exportedManifests(req.Namespace, req.RouteNames)
+	if err != nil {
+		return nil, err
+	}
+	if len(manifests) == 0 {
+		return nil, errors.New("命名空间 " + req.Namespace + " 中没有可导出的路由")
+	}
+	if format == ManifestFormatTerraform {
+		content, err := terraformJSON(req.Namespace, manifests)
+		if err != nil {
+			return nil, err
+		}
+		return &route.InventoryFile{
+			FileName:    req.Namespace + "-routes.tf.json",
+			ContentType: "application/json",
+			Content:     content,
+		}, nil
+	}
+	var files map[string][]byte
+	if format == ManifestFormatHelm {
+		files, err = helmChartFiles(req.Namespace, manifests)
+	} else {
+		files, err = u.kustomizeFiles(req.Namespace, req.Overlays, manifests)
+	}
+	if err != nil {
+		return nil, err
+	}
+	content, err := tarGzip(req.Namespace, files)
+	if err != nil {
+		return nil, err
+	}
+	return &route.InventoryFile{
+		FileName:    req.Namespace + "-" + format + "-" + time.Now().Format("20060102") + ".tar.gz",
+		ContentType: "application/gzip",
+		Content:     content,
+	}, nil
+}
+
+// 按路由规格渲染k8s对象，已禁用和正在删除的路由不导出
+func (u *RouteDataService) exportedManifests(namespace string, names []string) ([]exportedManifest, error) {
+	routes, err := u.RouteRepository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	wanted := map[string]bool{}
+	for _, v := range names {
+		wanted[v] = true
+	}
+	manifests := []exportedManifest{}
+	for i := range routes {
+		route2 := &routes[i]
+		if route2.RouteNamespace != namespace || route2.RouteDisabled || route2.RouteDeleting {
+			continue
+		}
+		if len(wanted) > 0 && !wanted[route2.RouteName] {
+			continue
+		}
+		delete(wanted, route2.RouteName)
+		info := &route.RouteInfo{}
+		if err := common.SwapTo(route2, info); err != nil {
+			return nil, err
+		}
+		info.Id = route2.ID
+		manifest, err := u.renderManifest(info)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, manifest)
+	}
+	if len(wanted) > 0 {
+		missing := make([]string, 0, len(wanted))
+		for k := range wanted {
+			missing = append(missing, k)
+		}
+		sort.Strings(missing)
+		return nil, errors.New("命名空间 " + namespace + " 中没有可导出的路由：" + strings.Join(missing, ", "))
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].name < manifests[j].name
+	})
+	return manifests, nil
+}
+
+// 和写入k8s时的对象相同，去掉状态、命名空间和本服务的管理标记
+func (u *RouteDataService) renderManifest(info *route.RouteInfo) (exportedManifest, error) {
+	object, err := u.renderObject(info)
+	if err != nil {
+		return exportedManifest{}, err
+	}
+	delete(object, "status")
+	prefix := u.Config.Stamp.prefix()
+	if metadata, ok := object["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "namespace")
+		delete(metadata, "creationTimestamp")
+		if labels, ok := metadata["labels"].(map[string]interface{}); ok {
+			delete(labels, prefix+"managed-by")
+			delete(labels, prefix+"route-id")
+			delete(labels, prefix+"environment")
+		}
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			delete(annotations, prefix+lastAppliedAnnotation)
+			if len(annotations) == 0 {
+				delete(metadata, "annotations")
+			}
+		}
+	}
+	kind, _ := object["kind"].(string)
+	return exportedManifest{kind: kind, name: info.RouteName, object: object}, nil
+}
+
+// 渲染即将写入k8s的资源，Ingress 统一使用 v1 的格式
+func (u *RouteDataService) renderObject(info *route.RouteInfo) (map[string]interface{}, error) {
+	var object map[string]interface{}
+	switch {
+	case isIngressAdapter(info.RouteAdapter):
+		ingress := u.setIngress(info)
+		ingress.APIVersion = "networking.k8s.io/v1"
+		converted, err := runtime.DefaultUnstructuredConverter.ToUnstructured(ingress)
+		if err != nil {
+			return nil, err
+		}
+		object = converted
+	case info.RouteAdapter == AdapterGatewayAPI:
+		httpRoute, err := u.setHTTPRoute(info)
+		if err != nil {
+			return nil, err
+		}
+		object = httpRoute.Object
+	case info.RouteAdapter == AdapterIstio:
+		object = u.setVirtualService(info).Object
+	default:
+		return nil, errors.New("路由 " + info.RouteName + " 使用了不支持的实现 " + info.RouteAdapter)
+	}
+	normalized, err := normalizeDiffValue(object)
+	if err != nil {
+		return nil, err
+	}
+	object, _ = normalized.(map[string]interface{})
+	return object, nil
+}
+
+func (m exportedManifest) fileName() string {
+	return strings.ToLower(m.kind) + "-" + m.name + ".yaml"
+}
+
+// base 中是命名空间里的对象，每个环境一个 overlay，替换命名空间后缀和域名后缀
+func (u *RouteDataService) kustomizeFiles(namespace string, overlays []string, manifests []exportedManifest) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	resources := make([]interface{}, 0, len(manifests))
+	for _, m := range manifests {
+		data, err := yaml.Marshal(m.object)
+		if err != nil {
+			return nil, err
+		}
+		files["base/"+m.fileName()] = data
+		resources = append(resources, m.fileName())
+	}
+	base, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"namespace":  namespace,
+		"resources":  resources,
+	})
+	if err != nil {
+		return nil, err
+	}
+	files["base/kustomization.yaml"] = base
+	if len(overlays) == 0 {
+		for k := range u.Config.Environments {
+			overlays = append(overlays, k)
+		}
+		sort.Strings(overlays)
+	}
+	_, source, hasSource := u.environmentOf(namespace)
+	for _, env := range overlays {
+		kustomization := map[string]interface{}{
+			"apiVersion": "kustomize.config.k8s.io/v1beta1",
+			"kind":       "Kustomization",
+			"namespace":  namespace,
+			"resources":  []interface{}{"../../base"},
+		}
+		//未配置的环境只生成空 overlay，由使用者自行补充
+		target, ok := u.Config.Environments[env]
+		if ok && hasSource {
+			kustomization["namespace"] = strings.TrimSuffix(namespace, source.NamespaceSuffix) + target.NamespaceSuffix
+			patches := []interface{}{}
+			for _, m := range manifests {
+				ops := hostPatches(m.object, source.HostSuffix, target.HostSuffix)
+				if len(ops) == 0 {
+					continue
+				}
+				patch, err := yaml.Marshal(ops)
+				if err != nil {
+					return nil, err
+				}
+				patches = append(patches, map[string]interface{}{
+					"patch": string(patch),
+					"target": map[string]interface{}{
+						"kind": m.kind,
+						"name": m.name,
+					},
+				})
+			}
+			if len(patches) > 0 {
+				kustomization["patches"] = patches
+			}
+		}
+		data, err := yaml.Marshal(kustomization)
+		if err != nil {
+			return nil, err
+		}
+		files["overlays/"+env+"/kustomization.yaml"] = data
+	}
+	return files, nil
+}
+
+// 找出对象中以源环境域名后缀结尾的域名，生成替换为目标环境后缀的 JSON patch
+func hostPatches(object map[string]interface{}, sourceSuffix string, targetSuffix string) []interface{} {
+	if sourceSuffix == targetSuffix || sourceSuffix == "" {
+		return nil
+	}
+	ops := []interface{}{}
+	var walk func(path string, v interface{})
+	walk = func(path string, v interface{}) {
+		switch t := v.(type) {
+		case map[string]interface{}:
+			keys := make([]string, 0, len(t))
+			for k := range t {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				//注解和标签中的值不是域名
+				if path == "/metadata" && (k == "annotations" || k == "labels") {
+					continue
+				}
+				walk(path+"/"+strings.NewReplacer("~", "~0", "/", "~1").Replace(k), t[k])
+			}
+		case []interface{}:
+			for i, child := range t {
+				walk(path+"/"+strconv.Itoa(i), child)
+			}
+		case string:
+			if isHostName(t) && strings.HasSuffix(t, sourceSuffix) {
+				ops = append(ops, map[string]interface{}{
+					"op":    "replace",
+					"path":  path,
+					"value": strings.TrimSuffix(t, sourceSuffix) + targetSuffix,
+				})
+			}
+		}
+	}
+	walk("", object)
+	return ops
+}
+
+// 只替换形如 a.example.com 的值，避免替换到路径等字段
+func isHostName(v string) bool {
+	return strings.Contains(v, ".") && !strings.ContainsAny(v, "/: ")
+}
+
+// values.yaml 中按名称保存每个对象，模板原样输出，需要按环境修改时覆盖 values
+func helmChartFiles(namespace string, manifests []exportedManifest) (map[string][]byte, error) {
+	chart, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion":  "v2",
+		"name":        namespace + "-routes",
+		"description": "Routes exported from namespace " + namespace,
+		"type":        "application",
+		"version":     "0.1.0",
+	})
+	if err != nil {
+		return nil, err
+	}
+	routes := map[string]interface{}{}
+	for _, m := range manifests {
+		routes[strings.ToLower(m.kind)+"-"+m.name] = m.object
+	}
+	values, err := yaml.Marshal(map[string]interface{}{"routes": routes})
+	if err != nil {
+		return nil, err
+	}
+	template := "{{- range $name, $manifest := .Values.routes }}\n---\n{{ toYaml $manifest }}\n{{- end }}\n"
+	return map[string][]byte{
+		"Chart.yaml":            chart,
+		"values.yaml":           values,
+		"templates/routes.yaml": []byte(template),
+	}, nil
+}
+
+// 每个对象一个 kubernetes_manifest 资源，资源名为 <kind>_<name>，名称中 Terraform 不允许的字符替换为下划线
+// 格式：{"terraform":{"required_providers":{"kubernetes":{...}}},"resource":{"kubernetes_manifest":{"<kind>_<name>":{"manifest":{...}}}}}
+func terraformJSON(namespace string, manifests []exportedManifest) ([]byte, error) {
+	resources := map[string]interface{}{}
+	for _, m := range manifests {
+		object := m.object
+		if metadata, ok := object["metadata"].(map[string]interface{}); ok {
+			metadata["namespace"] = namespace
+		}
+		resources[terraformName(strings.ToLower(m.kind)+"_"+m.name)] = map[string]interface{}{"manifest": object}
+	}
+	return json.MarshalIndent(map[string]interface{}{
+		"terraform": map[string]interface{}{
+			"required_providers": map[string]interface{}{
+				"kubernetes": map[string]interface{}{"source": "hashicorp/kubernetes"},
+			},
+		},
+		"resource": map[string]interface{}{
+			"kubernetes_manifest": resources,
+		},
+	}, "", "  ")
+}
+
+func terraformName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r == '-' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// 所有文件放在以命名空间命名的目录下
+func tarGzip(dir string, files map[string][]byte) ([]byte, error) {
+	names := make([]string, 0, len(files))
+	for k := range files {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	for _, name := range names {
+		header := &tar.Header{Name: dir + "/" + name, Mode: 0644, Size: int64(len(files[name])), ModTime: now}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
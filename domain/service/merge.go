@@ -0,0 +1,71 @@
+package service
+
+import (
+	"encoding/json"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sort"
+	"strings"
+)
+
+// 记录上次写入的标签、注解 key，更新时据此区分哪些是其他控制器添加的
+const lastAppliedAnnotation = "last-applied-metadata"
+
+type lastAppliedMetadata struct {
+	Labels      []string `json:"labels"`
+	Annotations []string `json:"annotations"`
+}
+
+// 把本次写入的标签、注解 key 记录到注解中，创建和更新前调用
+func (u *RouteDataService) recordLastApplied(obj metav1.Object) {
+	data, err := json.Marshal(lastAppliedMetadata{
+		Labels:      sortedKeys(obj.GetLabels()),
+		Annotations: sortedKeys(obj.GetAnnotations()),
+	})
+	if err != nil {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[u.Config.Stamp.prefix()+lastAppliedAnnotation] = string(data)
+	obj.SetAnnotations(annotations)
+}
+
+// 三方合并标签和注解：保留线上由其他控制器添加的 key，删除上次写入而本次不再需要的 key
+// 规格字段完全由本服务管理，仍然整体覆盖
+func (u *RouteDataService) mergeMetadata(live metav1.Object, desired metav1.Object) {
+	prefix := u.Config.Stamp.prefix()
+	last := lastAppliedMetadata{}
+	found := false
+	if data, ok := live.GetAnnotations()[prefix+lastAppliedAnnotation]; ok {
+		found = json.Unmarshal([]byte(data), &last) == nil
+	}
+	//没有记录时只认为带管理前缀的 key 是本服务写入的
+	owned := func(keys []string, k string) bool {
+		if !found {
+			return strings.HasPrefix(k, prefix)
+		}
+		i := sort.SearchStrings(keys, k)
+		return i < len(keys) && keys[i] == k
+	}
+	sort.Strings(last.Labels)
+	sort.Strings(last.Annotations)
+	desired.SetLabels(threeWayMerge(live.GetLabels(), desired.GetLabels(), func(k string) bool { return owned(last.Labels, k) }))
+	desired.SetAnnotations(threeWayMerge(live.GetAnnotations(), desired.GetAnnotations(), func(k string) bool { return owned(last.Annotations, k) }))
+	desired.SetResourceVersion(live.GetResourceVersion())
+}
+
+func threeWayMerge(live map[string]string, desired map[string]string, owned func(string) bool) map[string]string {
+	merged := map[string]string{}
+	for k, v := range live {
+		if _, ok := desired[k]; !ok && owned(k) {
+			continue
+		}
+		merged[k] = v
+	}
+	for k, v := range desired {
+		merged[k] = v
+	}
+	return merged
+}
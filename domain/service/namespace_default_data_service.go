@@ -0,0 +1,121 @@
+package service
+
+import (
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	"gorm.io/gorm"
+	"regexp"
+)
+
+// INamespaceDefaultDataService 命名空间默认配置接口
+type INamespaceDefaultDataService interface {
+	AddNamespaceDefault(*model.NamespaceDefault) (int64, error)
+	DeleteNamespaceDefault(int64) error
+	UpdateNamespaceDefault(*model.NamespaceDefault) error
+	FindNamespaceDefaultByID(int64) (*model.NamespaceDefault, error)
+	FindAllNamespaceDefault() ([]model.NamespaceDefault, error)
+
+	ApplyDefaults(*route.RouteInfo) error
+	CheckHost(*route.RouteInfo) error
+}
+
+// NewNamespaceDefaultDataService 创建
+func NewNamespaceDefaultDataService(namespaceDefaultRepository repository.INamespaceDefaultRepository) INamespaceDefaultDataService {
+	return &NamespaceDefaultDataService{NamespaceDefaultRepository: namespaceDefaultRepository}
+}
+
+type NamespaceDefaultDataService struct {
+	NamespaceDefaultRepository repository.INamespaceDefaultRepository
+}
+
+// ApplyDefaults 把命名空间的默认配置补全到 route 上，route 自身设置的值优先
+func (u *NamespaceDefaultDataService) ApplyDefaults(info *route.RouteInfo) error {
+	namespaceDefault, err := u.NamespaceDefaultRepository.FindNamespaceDefaultByNamespace(info.RouteNamespace)
+	if err != nil {
+		//没有配置默认值直接跳过
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		common.Error(err)
+		return err
+	}
+	if info.RouteClass == "" {
+		info.RouteClass = namespaceDefault.DefaultClass
+	}
+	if info.RouteTlsIssuer == "" {
+		info.RouteTlsIssuer = namespaceDefault.DefaultTlsIssuer
+	}
+	for k, v := range namespaceDefault.DefaultAnnotations {
+		if info.RouteAnnotations == nil {
+			info.RouteAnnotations = map[string]string{}
+		}
+		if _, ok := info.RouteAnnotations[k]; !ok {
+			info.RouteAnnotations[k] = v
+		}
+	}
+	return nil
+}
+
+// CheckHost 校验域名是否符合命名空间的 AllowedHostsPattern，注册为校验阶段的钩子，创建、修改、发布、导入都会经过
+func (u *NamespaceDefaultDataService) CheckHost(info *route.RouteInfo) error {
+	namespaceDefault, err := u.NamespaceDefaultRepository.FindNamespaceDefaultByNamespace(info.RouteNamespace)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		common.Error(err)
+		return err
+	}
+	if namespaceDefault.AllowedHostsPattern == "" {
+		return nil
+	}
+	pattern, err := allowedHostsRegexp(namespaceDefault.AllowedHostsPattern)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	if !pattern.MatchString(info.RouteHost) {
+		return i18n.NewError(i18n.CodeHostNotAllowed, "域名 "+info.RouteHost+" 不允许在命名空间 "+info.RouteNamespace+" 中使用")
+	}
+	return nil
+}
+
+// 规则需要匹配整个域名，否则 example\.com 也能匹配 example.com.evil.io
+func allowedHostsRegexp(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+// AddNamespaceDefault 插入
+func (u *NamespaceDefaultDataService) AddNamespaceDefault(namespaceDefault *model.NamespaceDefault) (int64, error) {
+	if _, err := allowedHostsRegexp(namespaceDefault.AllowedHostsPattern); err != nil {
+		return 0, err
+	}
+	return u.NamespaceDefaultRepository.CreateNamespaceDefault(namespaceDefault)
+}
+
+// DeleteNamespaceDefault 删除
+func (u *NamespaceDefaultDataService) DeleteNamespaceDefault(id int64) error {
+	return u.NamespaceDefaultRepository.DeleteNamespaceDefaultByID(id)
+}
+
+// UpdateNamespaceDefault 更新
+func (u *NamespaceDefaultDataService) UpdateNamespaceDefault(namespaceDefault *model.NamespaceDefault) error {
+	if _, err := allowedHostsRegexp(namespaceDefault.AllowedHostsPattern); err != nil {
+		return err
+	}
+	return u.NamespaceDefaultRepository.UpdateNamespaceDefault(namespaceDefault)
+}
+
+// FindNamespaceDefaultByID 查找
+func (u *NamespaceDefaultDataService) FindNamespaceDefaultByID(id int64) (*model.NamespaceDefault, error) {
+	return u.NamespaceDefaultRepository.FindNamespaceDefaultByID(id)
+}
+
+// FindAllNamespaceDefault 查找
+func (u *NamespaceDefaultDataService) FindAllNamespaceDefault() ([]model.NamespaceDefault, error) {
+	return u.NamespaceDefaultRepository.FindAll()
+}
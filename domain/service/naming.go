@@ -0,0 +1,128 @@
+package service
+
+import (
+	"errors"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// NamingConfig 路由命名规则，创建时校验，已有的路由通过 ListNamingViolations 检查
+type NamingConfig struct {
+	Rules []NamingRule `json:"rules"`
+}
+
+// NamingRule 一条命名规则，Pattern、TeamPrefixes、MaxLength 为空时不检查对应的项
+type NamingRule struct {
+	// Name 规则名称，用于错误提示和报告
+	Name string `json:"name"`
+	// Namespaces 适用的命名空间，支持通配符，为空时适用全部命名空间
+	Namespaces []string `json:"namespaces"`
+	// Pattern 路由名称需要匹配的正则，如 ^[a-z][a-z0-9-]*$
+	Pattern string `json:"pattern"`
+	// TeamPrefixes 负责团队的路由名称需要的前缀，key 为团队，如 payments: pay-
+	TeamPrefixes map[string]string `json:"team_prefixes"`
+	// MaxLength 路由名称的最大长度
+	MaxLength int `json:"max_length"`
+	// Hint 不满足规则时附加的说明，如命名规范的文档地址
+	Hint string `json:"hint"`
+}
+
+type namingRule struct {
+	NamingRule
+	pattern *regexp.Regexp
+}
+
+// 编译配置中的正则，rule 不为空时只返回该规则
+func (u *RouteDataService) namingRules(rule string) ([]namingRule, error) {
+	rules := []namingRule{}
+	for _, v := range u.Config.Naming.Rules {
+		if rule != "" && v.Name != rule {
+			continue
+		}
+		compiled := namingRule{NamingRule: v}
+		if v.Pattern != "" {
+			pattern, err := regexp.Compile(v.Pattern)
+			if err != nil {
+				return nil, errors.New("命名规则 " + v.Name + " 的正则不合法：" + err.Error())
+			}
+			compiled.pattern = pattern
+		}
+		rules = append(rules, compiled)
+	}
+	if rule != "" && len(rules) == 0 {
+		return nil, errors.New("未配置命名规则 " + rule)
+	}
+	return rules, nil
+}
+
+// 路由不满足规则的原因，满足时为空
+func (r namingRule) check(namespace string, name string, team string) []string {
+	if len(r.Namespaces) > 0 && !matchAny(r.Namespaces, namespace) {
+		return nil
+	}
+	var messages []string
+	if r.pattern != nil && !r.pattern.MatchString(name) {
+		messages = append(messages, "路由名称 "+name+" 不符合命名规则 "+r.Name+"：需要匹配 "+r.Pattern)
+	}
+	if prefix, ok := r.TeamPrefixes[team]; ok && !strings.HasPrefix(name, prefix) {
+		messages = append(messages, "路由名称 "+name+" 不符合命名规则 "+r.Name+"：负责团队 "+team+" 的路由需要以 "+prefix+" 开头")
+	}
+	if r.MaxLength > 0 && len(name) > r.MaxLength {
+		messages = append(messages, "路由名称 "+name+" 不符合命名规则 "+r.Name+"：长度不能超过 "+strconv.Itoa(r.MaxLength))
+	}
+	if r.Hint != "" {
+		for i := range messages {
+			messages[i] += "（" + r.Hint + "）"
+		}
+	}
+	return messages
+}
+
+// 创建路由时校验命名规则，返回第一条不满足的
+func (u *RouteDataService) checkNaming(info *route.RouteInfo) error {
+	rules, err := u.namingRules("")
+	if err != nil {
+		return err
+	}
+	for _, v := range rules {
+		if messages := v.check(info.RouteNamespace, info.RouteName, info.RouteOwnerTeam); len(messages) > 0 {
+			return i18n.NewError(i18n.CodeNamingRuleViolated, messages[0])
+		}
+	}
+	return nil
+}
+
+// ListNamingViolations 按当前的命名规则检查已有的路由，命名空间支持通配符
+func (u *RouteDataService) ListNamingViolations(req *route.NamingViolationsRequest) (*route.NamingViolationReport, error) {
+	rules, err := u.namingRules(req.Rule)
+	if err != nil {
+		return nil, err
+	}
+	routes, err := u.RouteRepository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	report := &route.NamingViolationReport{Violations: []*route.NamingViolation{}}
+	for _, v := range routes {
+		if req.RouteNamespace != "" && !matchAny([]string{req.RouteNamespace}, v.RouteNamespace) {
+			continue
+		}
+		report.Checked++
+		for _, rule := range rules {
+			for _, message := range rule.check(v.RouteNamespace, v.RouteName, v.RouteOwnerTeam) {
+				report.Violations = append(report.Violations, &route.NamingViolation{
+					Id:             v.ID,
+					RouteNamespace: v.RouteNamespace,
+					RouteName:      v.RouteName,
+					RouteOwnerTeam: v.RouteOwnerTeam,
+					Rule:           rule.Name,
+					Message:        message,
+				})
+			}
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/notify"
+	"strconv"
+	"time"
+)
+
+const (
+	outboxPollingInterval = 2 * time.Second
+	outboxBatchSize       = 100
+	outboxMaxBackoff      = 5 * time.Minute
+	//领取后在这段时间内没有标记结果时，其他副本可以重新领取，需要大于一批消息的发送时间
+	outboxClaimLease = 10 * time.Minute
+	//按最大退避计算约一天，之后进入死信不再重试
+	outboxMaxAttempts = 300
+)
+
+// OutboxRelay 把 outbox 中的事件发布到通知渠道，发布成功后才标记，至少发布一次
+// 多副本各自运行，消息领取后才发布，按渠道记录发送结果，重试时只发送失败的渠道
+type OutboxRelay struct {
+	OutboxRepository repository.IOutboxRepository
+	Notifier         notify.ChannelNotifier
+}
+
+// NewOutboxRelay 创建
+func NewOutboxRelay(outboxRepository repository.IOutboxRepository, notifier notify.ChannelNotifier) *OutboxRelay {
+	return &OutboxRelay{OutboxRepository: outboxRepository, Notifier: notifier}
+}
+
+// Run 轮询发布，ctx 结束时退出
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(outboxPollingInterval)
+	defer ticker.Stop()
+	for {
+		r.publishPending()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *OutboxRelay) publishPending() {
+	now := time.Now()
+	messages, err := r.OutboxRepository.ClaimPendingOutbox(now, outboxClaimLease, outboxBatchSize)
+	if err != nil {
+		common.Error(err)
+		return
+	}
+	for _, v := range messages {
+		delivered := v.DeliveredChannels
+		event := notify.Event{}
+		if err = json.Unmarshal([]byte(v.Payload), &event); err == nil {
+			delivered, err = r.Notifier.NotifyChannels(event, v.DeliveredChannels)
+		}
+		if err != nil {
+			common.Error(err)
+			if v.Attempts+1 >= outboxMaxAttempts {
+				common.Error("outbox 消息 " + strconv.FormatInt(v.ID, 10) + " 发布失败 " + strconv.FormatInt(v.Attempts+1, 10) + " 次，不再重试")
+				err = r.OutboxRepository.MarkOutboxDeadLetter(v.ID, err.Error(), delivered)
+			} else {
+				err = r.OutboxRepository.MarkOutboxFailed(v.ID, err.Error(), delivered, time.Now().Add(outboxBackoff(v.Attempts)))
+			}
+			if err != nil {
+				common.Error(err)
+			}
+			continue
+		}
+		if err := r.OutboxRepository.MarkOutboxPublished(v.ID, time.Now()); err != nil {
+			common.Error(err)
+		}
+	}
+}
+
+// 按失败次数指数退避
+func outboxBackoff(attempts int64) time.Duration {
+	backoff := time.Second
+	for i := int64(0); i < attempts && backoff < outboxMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > outboxMaxBackoff {
+		return outboxMaxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"errors"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"strings"
+)
+
+// OwnershipConfig 负责团队、成本中心白名单，为空时不限制
+type OwnershipConfig struct {
+	// Required 为 true 时必须填写负责团队和联系方式
+	Required    bool     `json:"required"`
+	Teams       []string `json:"teams"`
+	CostCenters []string `json:"cost_centers"`
+}
+
+// 校验归属信息，团队和成本中心会写入标签，需要满足标签值的格式
+func (u *RouteDataService) checkOwnership(info *route.RouteInfo) error {
+	ownership := u.Config.Ownership
+	if ownership.Required && (info.RouteOwnerTeam == "" || info.RouteContact == "") {
+		return i18n.NewError(i18n.CodeRouteOwnerRequired, "路由 "+info.RouteName+" 必须填写负责团队和联系方式")
+	}
+	if info.RouteOwnerTeam != "" {
+		if errs := validation.IsValidLabelValue(info.RouteOwnerTeam); len(errs) > 0 {
+			return errors.New("负责团队 " + info.RouteOwnerTeam + " 不合法：" + strings.Join(errs, "；"))
+		}
+		if !inAllowlist(ownership.Teams, info.RouteOwnerTeam) {
+			return errors.New("负责团队 " + info.RouteOwnerTeam + " 不在白名单中")
+		}
+	}
+	if info.RouteCostCenter != "" {
+		if errs := validation.IsValidLabelValue(info.RouteCostCenter); len(errs) > 0 {
+			return errors.New("成本中心 " + info.RouteCostCenter + " 不合法：" + strings.Join(errs, "；"))
+		}
+		if !inAllowlist(ownership.CostCenters, info.RouteCostCenter) {
+			return errors.New("成本中心 " + info.RouteCostCenter + " 不在白名单中")
+		}
+	}
+	return nil
+}
+
+func inAllowlist(allowlist []string, value string) bool {
+	if len(allowlist) == 0 {
+		return true
+	}
+	for _, v := range allowlist {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
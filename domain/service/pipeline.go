@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/logsample"
+	"github.com/zxnlx/route/metrics"
+	"github.com/zxnlx/route/proto/route"
+	"sync"
+)
+
+// 路由写入依次经过校验、渲染、写入k8s、写入数据库四个阶段
+const (
+	StageValidate = "validate"
+	StageRender   = "render"
+	StageApply    = "apply"
+	StagePersist  = "persist"
+)
+
+// 路由写入的操作类型
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+)
+
+// RouteOperation 一次路由写入，在各阶段之间传递
+type RouteOperation struct {
+	// Action 为 create 或 update
+	Action string
+	// Info 各阶段处理的路由，渲染阶段补全 ID、版本号和规格哈希
+	Info *route.RouteInfo
+	// Adapter 校验阶段选出的实现
+	Adapter IngressAdapter
+	// Changed 渲染阶段得出，规格和上次写入的相同时为 false，不再写入k8s和数据库
+	Changed bool
+	//接管时数据库中已有同名记录，失败时不删除
+	existed bool
+	//后台任务发起的写入，写入队列已满时排队等待而不是拒绝
+	background bool
+	//发起请求的 ctx，调用方断开后不再排队等待写入
+	ctx context.Context
+}
+
+func (op *RouteOperation) context() context.Context {
+	if op.ctx == nil {
+		return context.Background()
+	}
+	return op.ctx
+}
+
+// RouteHook 在阶段开始前调用，可以修改 Info，返回错误时中止本次操作，和阶段本身失败的处理相同
+type RouteHook func(*RouteOperation) error
+
+type routeHooks struct {
+	mu    sync.RWMutex
+	hooks map[string][]RouteHook
+}
+
+// AddRouteHook 注册阶段开始前调用的钩子，同一阶段按注册顺序执行
+func (u *RouteDataService) AddRouteHook(stage string, hook RouteHook) error {
+	switch stage {
+	case StageValidate, StageRender, StageApply, StagePersist:
+	default:
+		return errors.New("不支持的阶段：" + stage)
+	}
+	u.hooks.mu.Lock()
+	defer u.hooks.mu.Unlock()
+	if u.hooks.hooks == nil {
+		u.hooks.hooks = map[string][]RouteHook{}
+	}
+	u.hooks.hooks[stage] = append(u.hooks.hooks[stage], hook)
+	return nil
+}
+
+// 先调用钩子再执行阶段
+func (u *RouteDataService) runStage(stage string, op *RouteOperation, fn func(*RouteOperation) error) error {
+	u.hooks.mu.RLock()
+	hooks := append([]RouteHook(nil), u.hooks.hooks[stage]...)
+	u.hooks.mu.RUnlock()
+	//k8s 故障时大量路由报相同的错误，聚合后输出
+	for _, hook := range hooks {
+		if err := hook(op); err != nil {
+			logsample.Error(err)
+			return err
+		}
+	}
+	if err := fn(op); err != nil {
+		logsample.Error(err)
+		return err
+	}
+	return nil
+}
+
+// 通用校验后选出实现，由实现检查能否表达，命名规则只在创建时校验，已有的路由改名需要重建
+func (u *RouteDataService) validateRoute(op *RouteOperation) error {
+	if err := u.checkRoute(op.Info); err != nil {
+		return err
+	}
+	if op.Action == ActionCreate {
+		if err := u.checkNaming(op.Info); err != nil {
+			return err
+		}
+	}
+	adapter, err := u.ingressAdapter(op.Info)
+	if err != nil {
+		return err
+	}
+	if err := adapter.Validate(op.Info); err != nil {
+		return err
+	}
+	op.Adapter = adapter
+	return nil
+}
+
+// 创建时先写入数据库拿到ID，写入k8s的标签需要用到，写入k8s失败时删除
+func (u *RouteDataService) renderCreate(op *RouteOperation) error {
+	//接管已存在的资源时以k8s中的规格为准，重新校验
+	if op.Info.RouteConflictPolicy == ConflictPolicyAdopt {
+		imported, err := u.importFromK8s(op.Info)
+		if err != nil {
+			return err
+		}
+		if imported {
+			if err := u.validateRoute(op); err != nil {
+				return err
+			}
+		}
+	}
+	hash, err := appliedSpecHash(op.Info)
+	if err != nil {
+		return err
+	}
+	op.Info.RouteSpecHash = hash
+	route2 := &model.Route{}
+	if err := common.SwapTo(op.Info, route2); err != nil {
+		return err
+	}
+	route2.RouteRevision = 1
+	if op.Info.RouteConflictPolicy == ConflictPolicyAdopt {
+		//并发接管同一资源时按命名空间和名称合并为一条记录
+		_, created, err := u.RouteRepository.UpsertRoute(route2)
+		if err != nil {
+			return err
+		}
+		op.existed = !created
+	} else if _, err := u.AddRoute(route2); err != nil {
+		return err
+	}
+	op.Info.Id = route2.ID
+	op.Info.RouteRevision = route2.RouteRevision
+	op.Changed = true
+	return nil
+}
+
+// 版本号以数据库为准递增，规格哈希与上次写入的相同时不再写入
+func (u *RouteDataService) renderUpdate(op *RouteOperation) error {
+	current, err := u.RouteRepository.FindRouteByID(op.Info.Id)
+	if err != nil {
+		return err
+	}
+	if current.RouteDeleting {
+		return i18n.NewError(i18n.CodeRouteDeleting, "路由 "+current.RouteName+" 正在删除")
+	}
+	if op.Info.RouteSpecHash, err = appliedSpecHash(op.Info); err != nil {
+		return err
+	}
+	op.Changed = op.Info.RouteSpecHash != current.RouteSpecHash
+	op.Info.RouteRevision = current.RouteRevision
+	if op.Changed {
+		op.Info.RouteRevision++
+	}
+	return nil
+}
+
+func (u *RouteDataService) applyRoute(op *RouteOperation) error {
+	if err := u.admit(op); err != nil {
+		return err
+	}
+	release, err := u.acquireApply(op)
+	if err != nil {
+		return err
+	}
+	defer release()
+	if op.Action == ActionCreate {
+		return op.Adapter.Create(op.Info)
+	}
+	return op.Adapter.Update(op.Info)
+}
+
+// 创建时数据库记录已在渲染阶段写入，只记录配额
+func (u *RouteDataService) persistRoute(op *RouteOperation) error {
+	if op.Action == ActionCreate {
+		u.recordQuota(op.Info.RouteNamespace)
+		return nil
+	}
+	return u.saveRoute(op.Info)
+}
+
+// 创建失败时删除已写入的资源和数据库记录，接管的资源原本就存在，不删除
+func (u *RouteDataService) rollbackCreate(op *RouteOperation, applied bool) {
+	if applied && op.Info.RouteConflictPolicy != ConflictPolicyAdopt {
+		route2 := &model.Route{}
+		if err := common.SwapTo(op.Info, route2); err != nil {
+			common.Error(err)
+		} else if err := op.Adapter.Delete(route2); err != nil {
+			common.Error(err)
+		}
+	}
+	if op.existed {
+		return
+	}
+	if err := u.DeleteRoute(op.Info.Id); err != nil {
+		common.Error(err)
+	}
+	metrics.ForgetRoute(op.Info.RouteNamespace, op.Info.RouteName)
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/notify"
+	"github.com/zxnlx/route/proto/route"
+	"gorm.io/gorm"
+	"sort"
+	"strings"
+)
+
+// EnvironmentConfig 环境配置，路由晋级时按目标环境替换命名空间、域名、class 和证书签发者
+type EnvironmentConfig struct {
+	// NamespaceSuffix 环境的命名空间后缀，如 -dev、-prod，晋级时 shop-dev 替换为 shop-prod
+	NamespaceSuffix string `json:"namespace_suffix"`
+	// Cluster 为空时使用当前集群，目前只支持当前集群
+	Cluster string `json:"cluster"`
+	// HostSuffix 环境的域名后缀，如 .dev.example.com，晋级时替换为目标环境的后缀
+	HostSuffix string `json:"host_suffix"`
+	// Class、TlsIssuer 为空时沿用源路由的配置
+	Class     string `json:"class"`
+	TlsIssuer string `json:"tls_issuer"`
+}
+
+// 按命名空间后缀找到路由所在的环境，多个匹配时使用最长的后缀
+func (u *RouteDataService) environmentOf(namespace string) (string, EnvironmentConfig, bool) {
+	return environmentOf(u.Config.Environments, namespace)
+}
+
+func environmentOf(environments map[string]EnvironmentConfig, namespace string) (string, EnvironmentConfig, bool) {
+	names := make([]string, 0, len(environments))
+	for k := range environments {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	found, best := "", -1
+	for _, k := range names {
+		suffix := environments[k].NamespaceSuffix
+		if strings.HasSuffix(namespace, suffix) && len(suffix) > best {
+			found, best = k, len(suffix)
+		}
+	}
+	if found == "" {
+		return "", EnvironmentConfig{}, false
+	}
+	return found, environments[found], true
+}
+
+// 晋级后的命名空间，替换环境后缀
+func promotedNamespace(namespace string, source EnvironmentConfig, target EnvironmentConfig) string {
+	return strings.TrimSuffix(namespace, source.NamespaceSuffix) + target.NamespaceSuffix
+}
+
+// 生成目标环境的路由规格
+func promotedRoute(info *route.RouteInfo, source EnvironmentConfig, target EnvironmentConfig) (*route.RouteInfo, error) {
+	promoted := &route.RouteInfo{
+		RouteName:                    info.RouteName,
+		RouteNamespace:               promotedNamespace(info.RouteNamespace, source, target),
+		RouteHost:                    info.RouteHost,
+		RoutePath:                    info.RoutePath,
+		RouteClass:                   info.RouteClass,
+		RouteAnnotations:             info.RouteAnnotations,
+		RouteTlsIssuer:               info.RouteTlsIssuer,
+		RouteApplicationId:           info.RouteApplicationId,
+		RouteAdapter:                 info.RouteAdapter,
+		RouteGateway:                 info.RouteGateway,
+		RouteResponseHeaderSet:       info.RouteResponseHeaderSet,
+		RouteResponseHeaderRemove:    info.RouteResponseHeaderRemove,
+		RouteRequestHeaderAdd:        info.RouteRequestHeaderAdd,
+		RouteRequestHeaderSet:        info.RouteRequestHeaderSet,
+		RouteRequestHeaderRemove:     info.RouteRequestHeaderRemove,
+		RouteOwnerTeam:               info.RouteOwnerTeam,
+		RouteContact:                 info.RouteContact,
+		RouteCostCenter:              info.RouteCostCenter,
+		RouteCompression:             info.RouteCompression,
+		RouteWebsocket:               info.RouteWebsocket,
+		RouteWebsocketTimeoutSeconds: info.RouteWebsocketTimeoutSeconds,
+		RouteBackendHttp2:            info.RouteBackendHttp2,
+		RouteAuthUrl:                 info.RouteAuthUrl,
+		RouteAuthSignin:              info.RouteAuthSignin,
+		RouteAuthResponseHeaders:     info.RouteAuthResponseHeaders,
+	}
+	for _, v := range promoted.RoutePath {
+		v.Id = 0
+		v.RouteId = 0
+	}
+	if source.HostSuffix != "" || target.HostSuffix != "" {
+		if !strings.HasSuffix(info.RouteHost, source.HostSuffix) {
+			return nil, errors.New("域名 " + info.RouteHost + " 不是以源环境的后缀 " + source.HostSuffix + " 结尾")
+		}
+		promoted.RouteHost = strings.TrimSuffix(info.RouteHost, source.HostSuffix) + target.HostSuffix
+	}
+	if target.Class != "" {
+		promoted.RouteClass = target.Class
+	}
+	if target.TlsIssuer != "" {
+		promoted.RouteTlsIssuer = target.TlsIssuer
+	}
+	return promoted, nil
+}
+
+// 按目标环境生成晋级后的路由规格，同时返回源路由和源环境
+func (u *RouteDataService) promotion(id int64, targetEnv string) (*route.RouteInfo, *route.RouteInfo, string, error) {
+	target, ok := u.Config.Environments[targetEnv]
+	if !ok {
+		return nil, nil, "", errors.New("未配置环境 " + targetEnv)
+	}
+	if target.Cluster != "" && target.Cluster != DefaultCluster {
+		return nil, nil, "", errors.New("环境 " + targetEnv + " 位于集群 " + target.Cluster + "，目前只能晋级到当前集群")
+	}
+	route2, err := u.RouteRepository.FindRouteByID(id)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	sourceEnv, source, ok := u.environmentOf(route2.RouteNamespace)
+	if !ok {
+		return nil, nil, "", errors.New("命名空间 " + route2.RouteNamespace + " 不属于任何已配置的环境")
+	}
+	if sourceEnv == targetEnv {
+		return nil, nil, "", errors.New("路由已经在环境 " + targetEnv + " 中")
+	}
+	info := &route.RouteInfo{}
+	if err := common.SwapTo(route2, info); err != nil {
+		return nil, nil, "", err
+	}
+	promoted, err := promotedRoute(info, source, target)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return info, promoted, sourceEnv, nil
+}
+
+// PromotionNamespace 路由晋级到目标环境后所在的命名空间，用于提前检查冻结窗口
+func (u *RouteDataService) PromotionNamespace(id int64, targetEnv string) (string, error) {
+	_, promoted, _, err := u.promotion(id, targetEnv)
+	if err != nil {
+		return "", err
+	}
+	return promoted.RouteNamespace, nil
+}
+
+// PromoteRoute 把路由规格复制到目标环境，目标环境已存在同名路由时更新
+func (u *RouteDataService) PromoteRoute(id int64, targetEnv string, actor string) (*route.PromoteRouteResponse, error) {
+	if err := u.checkClusterScope(DefaultCluster); err != nil {
+		return nil, err
+	}
+	info, promoted, sourceEnv, err := u.promotion(id, targetEnv)
+	if err != nil {
+		return nil, err
+	}
+	promoted.RouteUpdatedBy = actor
+	rsp := &route.PromoteRouteResponse{SourceEnv: sourceEnv, TargetEnv: targetEnv, RouteNamespace: promoted.RouteNamespace, RouteName: promoted.RouteName, RouteHost: promoted.RouteHost}
+	existing, err := u.RouteRepository.FindRouteByName(promoted.RouteNamespace, promoted.RouteName)
+	switch {
+	case err == nil:
+		promoted.Id = existing.ID
+		if _, err := u.UpdateRouteToK8s(promoted); err != nil {
+			return nil, err
+		}
+		rsp.Id = existing.ID
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		promoted.RouteCreatedBy = actor
+		if rsp.Id, err = u.CreateRoute(promoted); err != nil {
+			return nil, err
+		}
+		rsp.Created = true
+		promoted.Id = rsp.Id
+	default:
+		return nil, err
+	}
+	message := "从环境 " + sourceEnv + " 的 " + info.RouteNamespace + "/" + info.RouteName + " 晋级到 " + targetEnv + "，操作人 " + actor
+	u.emitEvent(info, notify.EventRoutePromoted, message)
+	u.emitEvent(promoted, notify.EventRoutePromoted, message)
+	return rsp, nil
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	"gorm.io/gorm"
+	"strconv"
+	"time"
+)
+
+// DefaultQuotaNamespace 默认配额，对没有单独设置配额的命名空间生效
+const DefaultQuotaNamespace = "*"
+
+// IQuotaDataService 配额接口
+type IQuotaDataService interface {
+	SetQuota(*model.Quota) error
+	DeleteQuota(string) error
+	FindAllQuota() ([]model.Quota, error)
+	GetQuotaUsage(string) (*route.QuotaUsage, error)
+}
+
+// NewQuotaDataService 创建
+func NewQuotaDataService(quotaRepository repository.IQuotaRepository, routeRepository repository.IRouteRepository) IQuotaDataService {
+	return &QuotaDataService{QuotaRepository: quotaRepository, RouteRepository: routeRepository}
+}
+
+type QuotaDataService struct {
+	QuotaRepository repository.IQuotaRepository
+	RouteRepository repository.IRouteRepository
+}
+
+// SetQuota 设置命名空间的配额，已有配额时覆盖，已超出的部分不影响存量路由
+func (u *QuotaDataService) SetQuota(quota *model.Quota) error {
+	if quota.QuotaNamespace == "" {
+		return i18n.NewError(i18n.CodeNamespaceRequired, "命名空间不能为空，默认配额使用 "+DefaultQuotaNamespace)
+	}
+	if quota.QuotaMaxRoutes < 0 || quota.QuotaMaxHosts < 0 || quota.QuotaMaxRoutesPerDay < 0 {
+		return i18n.NewError(i18n.CodeInvalidArgument, "配额不能为负数")
+	}
+	return u.QuotaRepository.SaveQuota(quota)
+}
+
+// DeleteQuota 删除后使用默认配额
+func (u *QuotaDataService) DeleteQuota(namespace string) error {
+	return u.QuotaRepository.DeleteQuota(namespace)
+}
+
+// FindAllQuota 查找
+func (u *QuotaDataService) FindAllQuota() ([]model.Quota, error) {
+	return u.QuotaRepository.FindAll()
+}
+
+// GetQuotaUsage 命名空间的用量和生效的配额
+func (u *QuotaDataService) GetQuotaUsage(namespace string) (*route.QuotaUsage, error) {
+	if namespace == "" {
+		return nil, i18n.NewError(i18n.CodeNamespaceRequired, "命名空间不能为空")
+	}
+	return quotaUsage(u.QuotaRepository, u.RouteRepository, namespace, time.Now())
+}
+
+// 统计命名空间的路由数、域名数和当天创建数，配额优先使用命名空间自己的，没有时使用默认配额
+func quotaUsage(quotaRepository repository.IQuotaRepository, routeRepository repository.IRouteRepository, namespace string, now time.Time) (*route.QuotaUsage, error) {
+	usage := &route.QuotaUsage{RouteNamespace: namespace, Hosts: []string{}}
+	var err error
+	if usage.Quota, err = effectiveQuota(quotaRepository, namespace); err != nil {
+		return nil, err
+	}
+	routes, err := routeRepository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	for _, v := range routes {
+		if v.RouteNamespace != namespace {
+			continue
+		}
+		usage.Routes++
+		if v.RouteHost != "" && !seen[v.RouteHost] {
+			seen[v.RouteHost] = true
+			usage.Hosts = append(usage.Hosts, v.RouteHost)
+		}
+	}
+	usage.HostCount = int64(len(usage.Hosts))
+	if usage.RoutesCreatedToday, err = quotaRepository.FindCreated(namespace, quotaDay(now)); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+func quotaDay(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+// 创建前检查配额，未配置配额仓库时不限制
+func (u *RouteDataService) checkQuota(info *route.RouteInfo) error {
+	if u.QuotaRepository == nil {
+		return nil
+	}
+	quota, err := effectiveQuota(u.QuotaRepository, info.RouteNamespace)
+	if err != nil || quota == nil {
+		return err
+	}
+	if quota.QuotaMaxRoutes > 0 {
+		routes, err := u.RouteRepository.Count(repository.RouteFilter{Namespace: info.RouteNamespace})
+		if err != nil {
+			return err
+		}
+		if routes >= quota.QuotaMaxRoutes {
+			return i18n.NewError(i18n.CodeQuotaExceeded, "命名空间 "+info.RouteNamespace+" 的路由数已达到配额 "+strconv.FormatInt(quota.QuotaMaxRoutes, 10))
+		}
+	}
+	if quota.QuotaMaxRoutesPerDay > 0 {
+		created, err := u.QuotaRepository.FindCreated(info.RouteNamespace, quotaDay(time.Now()))
+		if err != nil {
+			return err
+		}
+		if created >= quota.QuotaMaxRoutesPerDay {
+			return i18n.NewError(i18n.CodeQuotaExceeded, "命名空间 "+info.RouteNamespace+" 今天创建的路由数已达到配额 "+strconv.FormatInt(quota.QuotaMaxRoutesPerDay, 10))
+		}
+	}
+	if quota.QuotaMaxHosts > 0 {
+		//已使用的域名不占新的配额
+		known, err := u.RouteRepository.Count(repository.RouteFilter{Namespace: info.RouteNamespace, Host: info.RouteHost})
+		if err != nil || known > 0 {
+			return err
+		}
+		aggregates, err := u.RouteRepository.AggregateByNamespace()
+		if err != nil {
+			return err
+		}
+		for _, v := range aggregates {
+			if v.Namespace == info.RouteNamespace && v.Hosts >= quota.QuotaMaxHosts {
+				return i18n.NewError(i18n.CodeQuotaExceeded, "命名空间 "+info.RouteNamespace+" 的域名数已达到配额 "+strconv.FormatInt(quota.QuotaMaxHosts, 10))
+			}
+		}
+	}
+	return nil
+}
+
+// 命名空间没有配额时使用默认配额，都没有时返回 nil
+func effectiveQuota(quotaRepository repository.IQuotaRepository, namespace string) (*route.QuotaInfo, error) {
+	quota, err := quotaRepository.FindQuota(namespace)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		quota, err = quotaRepository.FindQuota(DefaultQuotaNamespace)
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &route.QuotaInfo{
+		Id:                   quota.ID,
+		QuotaNamespace:       quota.QuotaNamespace,
+		QuotaMaxRoutes:       quota.QuotaMaxRoutes,
+		QuotaMaxHosts:        quota.QuotaMaxHosts,
+		QuotaMaxRoutesPerDay: quota.QuotaMaxRoutesPerDay,
+		QuotaUpdatedBy:       quota.QuotaUpdatedBy,
+	}, nil
+}
+
+// 创建成功后计数，计数失败不影响创建
+func (u *RouteDataService) recordQuota(namespace string) {
+	if u.QuotaRepository == nil {
+		return
+	}
+	if err := u.QuotaRepository.IncrementCreated(namespace, quotaDay(time.Now())); err != nil {
+		common.Error(err)
+	}
+}
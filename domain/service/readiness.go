@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultWaitTimeout  = 60 * time.Second
+	maxWaitTimeout      = 600 * time.Second
+	waitPollingInterval = 2 * time.Second
+)
+
+// WaitForReady 等待路由就绪：Ingress 分配了负载均衡地址，HTTPRoute 被所有 Gateway 接受
+// 超时返回最后一次的状态和错误
+func (u *RouteDataService) WaitForReady(info *route.RouteInfo) (*route.RouteStatus, error) {
+	timeout := time.Duration(info.RouteWaitTimeout) * time.Second
+	if timeout <= 0 {
+		timeout = defaultWaitTimeout
+	}
+	if timeout > maxWaitTimeout {
+		timeout = maxWaitTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	ticker := time.NewTicker(waitPollingInterval)
+	defer ticker.Stop()
+	for {
+		status, err := u.getRouteStatus(ctx, info)
+		if err == nil && status.Ready {
+			if info.RouteWaitProbe {
+				u.probeRoute(ctx, info, status)
+			}
+			u.fillBackendHealth(ctx, info, status)
+			return status, nil
+		}
+		select {
+		case <-ctx.Done():
+			if status == nil {
+				status = &route.RouteStatus{}
+			}
+			if err != nil {
+				status.Message = err.Error()
+			}
+			//等待超时后仍然给出后端健康状况，便于判断是否是后端问题
+			u.fillBackendHealth(context.Background(), info, status)
+			return status, i18n.NewError(i18n.CodeRouteNotReady, "等待路由 "+info.RouteName+" 就绪超时")
+		case <-ticker.C:
+		}
+	}
+}
+
+func (u *RouteDataService) getRouteStatus(ctx context.Context, info *route.RouteInfo) (*route.RouteStatus, error) {
+	if info.RouteAdapter == AdapterIstio {
+		virtualService, err := u.K8sDynamicClient.Resource(virtualServiceResource).Namespace(info.RouteNamespace).Get(ctx, info.RouteName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return virtualServiceStatus(virtualService), nil
+	}
+	if info.RouteAdapter == AdapterGatewayAPI {
+		httpRoute, err := u.K8sDynamicClient.Resource(httpRouteResource).Namespace(info.RouteNamespace).Get(ctx, info.RouteName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return httpRouteStatus(httpRoute), nil
+	}
+	ingress, err := u.ingresses(info.RouteNamespace).Get(ctx, info.RouteName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	status := &route.RouteStatus{IngressApiVersion: u.IngressAPIVersion}
+	for _, v := range ingress.Status.LoadBalancer.Ingress {
+		if v.IP != "" {
+			status.Address = append(status.Address, v.IP)
+		} else if v.Hostname != "" {
+			status.Address = append(status.Address, v.Hostname)
+		}
+	}
+	status.Ready = len(status.Address) > 0
+	if !status.Ready {
+		status.Message = "等待分配负载均衡地址"
+	}
+	return status, nil
+}
+
+// 所有 parent 的 Accepted 和 ResolvedRefs 条件为 True 时就绪
+func httpRouteStatus(httpRoute *unstructured.Unstructured) *route.RouteStatus {
+	status := &route.RouteStatus{Message: "等待 Gateway 接受"}
+	parents, _, _ := unstructured.NestedSlice(httpRoute.Object, "status", "parents")
+	if len(parents) == 0 {
+		return status
+	}
+	for _, v := range parents {
+		parent, _ := v.(map[string]interface{})
+		conditions, _, _ := unstructured.NestedSlice(parent, "conditions")
+		accepted, resolved := false, false
+		for _, c := range conditions {
+			condition, _ := c.(map[string]interface{})
+			conditionType, _, _ := unstructured.NestedString(condition, "type")
+			conditionStatus, _, _ := unstructured.NestedString(condition, "status")
+			if conditionStatus != "True" {
+				continue
+			}
+			accepted = accepted || conditionType == "Accepted"
+			resolved = resolved || conditionType == "ResolvedRefs"
+		}
+		if !accepted || !resolved {
+			return status
+		}
+	}
+	status.Ready = true
+	status.Message = ""
+	return status
+}
+
+// 通过负载均衡地址请求第一个路径，收到非 5xx 响应视为探测通过
+func (u *RouteDataService) probeRoute(ctx context.Context, info *route.RouteInfo, status *route.RouteStatus) {
+	if len(status.Address) == 0 || len(info.RoutePath) == 0 {
+		return
+	}
+	scheme := "http://"
+	if info.RouteTlsIssuer != "" {
+		scheme = "https://"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, scheme+status.Address[0]+info.RoutePath[0].RoutePathName, nil)
+	if err != nil {
+		status.Ready = false
+		status.Message = err.Error()
+		return
+	}
+	req.Host = info.RouteHost
+	rsp, err := probeClient.Do(req)
+	if err != nil {
+		status.Ready = false
+		status.Message = "探测失败：" + err.Error()
+		return
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode >= 500 {
+		status.Ready = false
+		status.Message = "探测失败：" + rsp.Status
+		return
+	}
+	status.Message = "探测通过：" + rsp.Status
+}
+
+var probeClient = &http.Client{Timeout: 10 * time.Second}
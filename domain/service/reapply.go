@@ -0,0 +1,223 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	"google.golang.org/protobuf/proto"
+	"sync"
+	"time"
+)
+
+// 批量重新写入的状态
+const (
+	ReapplyRunning   = "running"
+	ReapplySucceeded = "succeeded"
+	ReapplyFailed    = "failed"
+)
+
+const (
+	defaultReapplyConcurrency = 4
+	maxReapplyConcurrency     = 16
+	//保留最近的任务供查询进度
+	maxReapplyJobs = 20
+)
+
+// ErrReapplyJobNotFound 任务不存在或已过期，任务只保存在处理它的实例内存中
+var ErrReapplyJobNotFound = i18n.NewError(i18n.CodeReapplyJobNotFound, "重新写入任务不存在")
+
+// reapplyJobs 记录批量重新写入任务的进度
+type reapplyJobs struct {
+	mu    sync.Mutex
+	jobs  map[string]*route.ReapplyJob
+	order []string
+}
+
+func (j *reapplyJobs) add(job *route.ReapplyJob) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.jobs == nil {
+		j.jobs = map[string]*route.ReapplyJob{}
+	}
+	j.jobs[job.JobId] = job
+	j.order = append(j.order, job.JobId)
+	for len(j.order) > maxReapplyJobs {
+		delete(j.jobs, j.order[0])
+		j.order = j.order[1:]
+	}
+}
+
+// 在锁内修改任务
+func (j *reapplyJobs) update(id string, fn func(job *route.ReapplyJob)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if job, ok := j.jobs[id]; ok {
+		fn(job)
+	}
+}
+
+// 返回副本，避免调用方读取时任务仍在修改
+func (j *reapplyJobs) get(id string) (*route.ReapplyJob, bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	job, ok := j.jobs[id]
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(job).(*route.ReapplyJob), true
+}
+
+// FreezeCheck 检查命名空间是否处于冻结窗口，冻结时返回错误
+type FreezeCheck func(namespace string) error
+
+// ReapplyAll 按条件重新渲染并写入路由，立即返回任务，后台通过队列处理
+// 每个路由写入前按所在命名空间检查冻结窗口，冻结的路由记为失败，不写入集群
+func (u *RouteDataService) ReapplyAll(filter *route.ReapplyFilter, actor string, checkFreeze FreezeCheck) (*route.ReapplyJob, error) {
+	concurrency := int(filter.Concurrency)
+	if concurrency <= 0 {
+		concurrency = defaultReapplyConcurrency
+	}
+	if concurrency > maxReapplyConcurrency {
+		return nil, errors.New("并发数不能超过 16")
+	}
+	routes, err := u.RouteRepository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	matched := []model.Route{}
+	for _, v := range routes {
+		if matchReapplyFilter(filter, &v) {
+			matched = append(matched, v)
+		}
+	}
+	id, err := newReapplyJobID()
+	if err != nil {
+		return nil, err
+	}
+	job := &route.ReapplyJob{
+		JobId:     id,
+		State:     ReapplyRunning,
+		Total:     int64(len(matched)),
+		StartedAt: time.Now().Unix(),
+		CreatedBy: actor,
+	}
+	u.reapplyJobs.add(job)
+	common.Info("开始重新写入路由，任务 " + id + "，操作人 " + actor)
+	queue := make(chan model.Route)
+	go func() {
+		defer close(queue)
+		for _, v := range matched {
+			queue <- v
+		}
+	}()
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range queue {
+				skipped, err := u.reapplyRoute(v.ID, checkFreeze)
+				u.reapplyJobs.update(id, func(job *route.ReapplyJob) {
+					job.Processed++
+					switch {
+					case err != nil:
+						job.Failed++
+						job.Failures = append(job.Failures, &route.ReapplyFailure{Id: v.ID, RouteNamespace: v.RouteNamespace, RouteName: v.RouteName, Error: err.Error()})
+					case skipped:
+						job.Skipped++
+					default:
+						job.Succeeded++
+					}
+				})
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		u.reapplyJobs.update(id, func(job *route.ReapplyJob) {
+			job.State = ReapplySucceeded
+			if job.Failed > 0 {
+				job.State = ReapplyFailed
+			}
+			job.FinishedAt = time.Now().Unix()
+		})
+		common.Info("重新写入路由任务 " + id + " 已完成")
+	}()
+	return proto.Clone(job).(*route.ReapplyJob), nil
+}
+
+// GetReapplyJob 查询任务进度
+func (u *RouteDataService) GetReapplyJob(id string) (*route.ReapplyJob, error) {
+	job, ok := u.reapplyJobs.get(id)
+	if !ok {
+		return nil, ErrReapplyJobNotFound
+	}
+	return job, nil
+}
+
+// 重新写入单个路由，加锁后以数据库记录为准，已禁用的路由跳过
+func (u *RouteDataService) reapplyRoute(id int64, checkFreeze FreezeCheck) (bool, error) {
+	route2, err := u.RouteRepository.FindRouteByID(id)
+	if err != nil {
+		return false, err
+	}
+	unlock, err := u.lockRoute(route2.RouteNamespace, route2.RouteName)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+	if route2, err = u.RouteRepository.FindRouteByID(id); err != nil {
+		return false, err
+	}
+	if route2.RouteDisabled || route2.RouteDeleting {
+		return true, nil
+	}
+	if checkFreeze != nil {
+		if err := checkFreeze(route2.RouteNamespace); err != nil {
+			return false, err
+		}
+	}
+	info := &route.RouteInfo{}
+	if err := common.SwapTo(route2, info); err != nil {
+		return false, err
+	}
+	return false, u.applyUpdateToK8s(info)
+}
+
+// ReencryptRoutes 用当前主密钥重新加密路由和归档中的敏感字段
+func (u *RouteDataService) ReencryptRoutes(actor string) (*route.ReencryptResult, error) {
+	common.Info("开始重新加密路由敏感字段，操作人 " + actor)
+	routes, archives, err := u.RouteRepository.ReencryptAll()
+	if err != nil {
+		return nil, err
+	}
+	return &route.ReencryptResult{Routes: routes, Archives: archives}, nil
+}
+
+func matchReapplyFilter(filter *route.ReapplyFilter, route2 *model.Route) bool {
+	if filter.RouteNamespace != "" && !matchAny([]string{filter.RouteNamespace}, route2.RouteNamespace) {
+		return false
+	}
+	if filter.RouteApplicationId != 0 && filter.RouteApplicationId != route2.RouteApplicationID {
+		return false
+	}
+	if filter.RouteClass != "" && filter.RouteClass != route2.RouteClass {
+		return false
+	}
+	if filter.RouteAdapter != "" && filter.RouteAdapter != route2.RouteAdapter {
+		return false
+	}
+	return true
+}
+
+func newReapplyJobID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
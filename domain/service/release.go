@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/metrics"
+	"github.com/zxnlx/route/notify"
+	"github.com/zxnlx/route/proto/route"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"strings"
+)
+
+// ReleaseRoute 把路由交还手动管理：移除线上资源的管理标签和注解，归档并删除数据库记录，k8s中的资源保留
+func (u *RouteDataService) ReleaseRoute(routeID int64, actor string) error {
+	route2, err := u.FindRouteByID(routeID)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	unlock, err := u.lockRoute(route2.RouteNamespace, route2.RouteName)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	defer unlock()
+	info := &route.RouteInfo{}
+	if err := common.SwapTo(route2, info); err != nil {
+		return err
+	}
+	if err := u.checkScope(info); err != nil {
+		return err
+	}
+	//禁用的路由k8s中没有资源
+	if !route2.RouteDisabled {
+		if err := u.unstampFromK8s(info); err != nil {
+			common.Error(err)
+			return err
+		}
+	}
+	spec, err := json.Marshal(route2)
+	if err != nil {
+		return err
+	}
+	err = u.RouteRepository.Transaction(func(repo repository.IRouteRepository) error {
+		if err := repo.ArchiveRoute(&model.RouteArchive{
+			RouteID:        route2.ID,
+			RouteNamespace: route2.RouteNamespace,
+			RouteName:      route2.RouteName,
+			RouteSpec:      string(spec),
+			ArchivedBy:     actor,
+		}); err != nil {
+			return err
+		}
+		return u.createOutbox(repo, info, notify.EventRouteReleased, "已交还手动管理，操作人 "+actor)
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	metrics.ForgetRoute(route2.RouteNamespace, route2.RouteName)
+	return nil
+}
+
+// 移除本服务写入的标签和注解，保留规格和其他控制器添加的 key
+func (u *RouteDataService) unstampFromK8s(info *route.RouteInfo) error {
+	if !isIngressAdapter(info.RouteAdapter) {
+		resource := httpRouteResource
+		if info.RouteAdapter == AdapterIstio {
+			resource = virtualServiceResource
+		}
+		client := u.K8sDynamicClient.Resource(resource).Namespace(info.RouteNamespace)
+		return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			live, err := client.Get(context.TODO(), info.RouteName, metav1.GetOptions{})
+			if err != nil {
+				return ignoreNotFound(err)
+			}
+			u.removeStamp(live, info)
+			_, err = client.Update(context.TODO(), live, metav1.UpdateOptions{})
+			return err
+		})
+	}
+	client := u.ingresses(info.RouteNamespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		live, err := client.Get(context.TODO(), info.RouteName, metav1.GetOptions{})
+		if err != nil {
+			return ignoreNotFound(err)
+		}
+		u.removeStamp(live, info)
+		_, err = client.Update(context.TODO(), live, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (u *RouteDataService) removeStamp(obj metav1.Object, info *route.RouteInfo) {
+	prefix := u.Config.Stamp.prefix()
+	labels := obj.GetLabels()
+	stampLabels := u.getStampLabels(info)
+	for k := range labels {
+		if _, ok := stampLabels[k]; ok || strings.HasPrefix(k, prefix) {
+			delete(labels, k)
+		}
+	}
+	obj.SetLabels(labels)
+	annotations := obj.GetAnnotations()
+	stampAnnotations := u.getStampAnnotations(info)
+	for k := range annotations {
+		if _, ok := stampAnnotations[k]; ok || strings.HasPrefix(k, prefix) {
+			delete(annotations, k)
+		}
+	}
+	obj.SetAnnotations(annotations)
+}
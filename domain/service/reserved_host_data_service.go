@@ -0,0 +1,157 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/i18n"
+	"path"
+	"strings"
+)
+
+// MethodOverrideReservedHost 使用其他命名空间的保留域名需要的权限，按操作名在 methodRoles 中配置角色
+const MethodOverrideReservedHost = "OverrideReservedHost"
+
+// ErrHostReserved 域名已保留给其他命名空间，使用 errors.Is 判断
+var ErrHostReserved = i18n.NewError(i18n.CodeHostReserved, "域名已保留")
+
+// IReservedHostDataService 保留域名接口
+type IReservedHostDataService interface {
+	AddReservedHost(*model.ReservedHost) (int64, error)
+	DeleteReservedHost(int64) error
+	UpdateReservedHost(*model.ReservedHost) error
+	FindReservedHostByID(int64) (*model.ReservedHost, error)
+	FindAllReservedHost() ([]model.ReservedHost, error)
+
+	CheckReserved(string, string) error
+	CheckRoute(*RouteOperation) error
+}
+
+// NewReservedHostDataService 创建，routeRepository 用于修改时对比原域名，authorizer 检查使用保留域名的权限
+func NewReservedHostDataService(reservedHostRepository repository.IReservedHostRepository, routeRepository repository.IRouteRepository, authorizer IRoleBindingDataService) IReservedHostDataService {
+	return &ReservedHostDataService{ReservedHostRepository: reservedHostRepository, RouteRepository: routeRepository, Authorizer: authorizer}
+}
+
+type ReservedHostDataService struct {
+	ReservedHostRepository repository.IReservedHostRepository
+	RouteRepository        repository.IRouteRepository
+	Authorizer             IRoleBindingDataService
+}
+
+// AddReservedHost 插入
+func (u *ReservedHostDataService) AddReservedHost(reservedHost *model.ReservedHost) (int64, error) {
+	if err := checkReservedHost(reservedHost); err != nil {
+		return 0, err
+	}
+	return u.ReservedHostRepository.CreateReservedHost(reservedHost)
+}
+
+// DeleteReservedHost 删除
+func (u *ReservedHostDataService) DeleteReservedHost(id int64) error {
+	return u.ReservedHostRepository.DeleteReservedHostByID(id)
+}
+
+// UpdateReservedHost 更新
+func (u *ReservedHostDataService) UpdateReservedHost(reservedHost *model.ReservedHost) error {
+	if err := checkReservedHost(reservedHost); err != nil {
+		return err
+	}
+	return u.ReservedHostRepository.UpdateReservedHost(reservedHost)
+}
+
+// FindReservedHostByID 查找
+func (u *ReservedHostDataService) FindReservedHostByID(id int64) (*model.ReservedHost, error) {
+	return u.ReservedHostRepository.FindReservedHostByID(id)
+}
+
+// FindAllReservedHost 查找
+func (u *ReservedHostDataService) FindAllReservedHost() ([]model.ReservedHost, error) {
+	return u.ReservedHostRepository.FindAll()
+}
+
+// CheckReserved 域名和保留域名重叠且命名空间不在白名单中时返回 ErrHostReserved
+func (u *ReservedHostDataService) CheckReserved(host string, namespace string) error {
+	host = normalizeHost(host)
+	if host == "" {
+		return nil
+	}
+	all, err := u.ReservedHostRepository.FindAll()
+	if err != nil {
+		return err
+	}
+	for _, v := range all {
+		if !hostsOverlap(v.ReservedHost, host) || matchAny(v.ReservedNamespaces, namespace) {
+			continue
+		}
+		if v.ReservedReason == "" {
+			return fmt.Errorf("%w：%s", ErrHostReserved, v.ReservedHost)
+		}
+		return fmt.Errorf("%w：%s（%s）", ErrHostReserved, v.ReservedHost, v.ReservedReason)
+	}
+	return nil
+}
+
+// CheckRoute 注册为校验阶段的钩子，创建、修改、晋级、接管都会经过，修改时域名和命名空间没有变化不再检查
+// 命名空间不在白名单中时需要设置 route_reserved_host_override，且操作人有 OverrideReservedHost 的角色，未开启 RBAC 时不能使用
+func (u *ReservedHostDataService) CheckRoute(op *RouteOperation) error {
+	info := op.Info
+	if op.Action == ActionUpdate {
+		current, err := u.RouteRepository.FindRouteByID(info.Id)
+		if err != nil {
+			return err
+		}
+		if current.RouteHost == info.RouteHost && current.RouteNamespace == info.RouteNamespace {
+			return nil
+		}
+	}
+	err := u.CheckReserved(info.RouteHost, info.RouteNamespace)
+	if err == nil || !errors.Is(err, ErrHostReserved) || !info.RouteReservedHostOverride {
+		return err
+	}
+	//未开启 RBAC 时 Authorize 总是通过，不能用于放行
+	if !u.Authorizer.Enabled() {
+		return err
+	}
+	actor := info.RouteUpdatedBy
+	if actor == "" {
+		actor = info.RouteCreatedBy
+	}
+	if err := u.Authorizer.Authorize(actor, MethodOverrideReservedHost, info); err != nil {
+		return err
+	}
+	common.Info(actor + " 使用保留域名 " + info.RouteHost + "，命名空间：" + info.RouteNamespace)
+	return nil
+}
+
+func checkReservedHost(reservedHost *model.ReservedHost) error {
+	reservedHost.ReservedHost = normalizeHost(reservedHost.ReservedHost)
+	if reservedHost.ReservedHost == "" {
+		return errors.New("保留域名不能为空")
+	}
+	if strings.Contains(strings.TrimPrefix(reservedHost.ReservedHost, "*."), "*") {
+		return errors.New("保留域名 " + reservedHost.ReservedHost + " 格式错误，通配符只能是开头的 *.")
+	}
+	for _, v := range reservedHost.ReservedNamespaces {
+		if _, err := path.Match(v, ""); err != nil {
+			return errors.New("命名空间通配符 " + v + " 格式错误")
+		}
+	}
+	return nil
+}
+
+func normalizeHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+}
+
+// 保留域名和路由的域名都可能是 *. 开头的通配符，能匹配到同一个域名时视为重叠
+func hostsOverlap(reserved string, host string) bool {
+	if reserved == host {
+		return true
+	}
+	if strings.HasPrefix(reserved, "*.") && strings.HasSuffix(host, reserved[1:]) {
+		return true
+	}
+	return strings.HasPrefix(host, "*.") && strings.HasSuffix(reserved, host[1:])
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/repository"
+	"time"
+)
+
+const defaultRetentionIntervalMinutes = 60
+
+// RetentionConfig 历史数据保留策略，未开启时不清理
+type RetentionConfig struct {
+	Enabled bool `json:"enabled"`
+	// IntervalMinutes 清理间隔，默认 60 分钟
+	IntervalMinutes int `json:"interval_minutes"`
+	// Events 审计事件
+	Events RetentionPolicy `json:"events"`
+	// Outbox 已发布的 outbox 消息，未发布的不清理
+	Outbox RetentionPolicy `json:"outbox"`
+	// Archives 路由删除时的归档
+	Archives RetentionPolicy `json:"archives"`
+	// UsageSnapshots 用量快照
+	UsageSnapshots RetentionPolicy `json:"usage_snapshots"`
+}
+
+// RetentionPolicy 超过天数或超出行数的记录被清理，都为 0 时不清理
+type RetentionPolicy struct {
+	Days    int   `json:"days"`
+	MaxRows int64 `json:"max_rows"`
+	// Archive 清理前复制到冷表，冷表为原表名加 _cold
+	Archive bool `json:"archive"`
+}
+
+// RetentionJob 按保留策略定时清理历史数据，避免主库随时间膨胀
+type RetentionJob struct {
+	RetentionRepository repository.IRetentionRepository
+	Config              RetentionConfig
+}
+
+// NewRetentionJob 创建
+func NewRetentionJob(retentionRepository repository.IRetentionRepository, config RetentionConfig) *RetentionJob {
+	return &RetentionJob{RetentionRepository: retentionRepository, Config: config}
+}
+
+// Run 定时清理，未开启时直接返回，ctx 结束时退出
+func (r *RetentionJob) Run(ctx context.Context) {
+	if !r.Config.Enabled {
+		return
+	}
+	interval := r.Config.IntervalMinutes
+	if interval <= 0 {
+		interval = defaultRetentionIntervalMinutes
+	}
+	ticker := time.NewTicker(time.Duration(interval) * time.Minute)
+	defer ticker.Stop()
+	for {
+		r.Prune(time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Prune 依次清理各表，某张表失败不影响其他表
+func (r *RetentionJob) Prune(now time.Time) {
+	policies := []struct {
+		table  string
+		policy RetentionPolicy
+	}{
+		{repository.RetentionEvents, r.Config.Events},
+		{repository.RetentionOutbox, r.Config.Outbox},
+		{repository.RetentionArchives, r.Config.Archives},
+		{repository.RetentionUsageSnapshots, r.Config.UsageSnapshots},
+	}
+	for _, v := range policies {
+		if v.policy.Days <= 0 && v.policy.MaxRows <= 0 {
+			continue
+		}
+		before := time.Time{}
+		if v.policy.Days > 0 {
+			before = now.AddDate(0, 0, -v.policy.Days)
+		}
+		pruned, err := r.RetentionRepository.Prune(v.table, before, v.policy.MaxRows, v.policy.Archive)
+		if err != nil {
+			common.Error(err)
+		}
+		if pruned > 0 {
+			common.Infof("按保留策略清理 %s %d 条", v.table, pruned)
+		}
+	}
+}
@@ -0,0 +1,383 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	"sort"
+	"strings"
+)
+
+// 角色，权限依次递增
+const (
+	RoleViewer         = "viewer"
+	RoleEditor         = "editor"
+	RoleNamespaceAdmin = "namespace-admin"
+	RoleGlobalAdmin    = "global-admin"
+)
+
+// RBACConfig 未开启时不检查调用方角色，BootstrapAdmins 始终拥有 global-admin，用于授予第一批角色
+type RBACConfig struct {
+	Enabled         bool     `json:"enabled"`
+	BootstrapAdmins []string `json:"bootstrap_admins"`
+}
+
+// ErrAccessDenied 调用方没有执行该操作的角色，使用 errors.Is 判断
+var ErrAccessDenied = i18n.NewError(i18n.CodeAccessDenied, "没有操作权限")
+
+// 需要 editor 以上角色的操作不在此列出，查询类操作按前缀判断
+var methodRoles = map[string]string{
+	"GrantRole":                RoleNamespaceAdmin,
+	"RevokeRole":               RoleNamespaceAdmin,
+	"AddNamespaceDefault":      RoleNamespaceAdmin,
+	"DeleteNamespaceDefault":   RoleNamespaceAdmin,
+	"UpdateNamespaceDefault":   RoleNamespaceAdmin,
+	"AddFreezeWindow":          RoleGlobalAdmin,
+	"DeleteFreezeWindow":       RoleGlobalAdmin,
+	"UpdateFreezeWindow":       RoleGlobalAdmin,
+	"AddAnnotationTemplate":    RoleGlobalAdmin,
+	"DeleteAnnotationTemplate": RoleGlobalAdmin,
+	"UpdateAnnotationTemplate": RoleGlobalAdmin,
+	"Diagnose":                 RoleGlobalAdmin,
+	"ReencryptRoutes":          RoleGlobalAdmin,
+	"CreateAPIKey":             RoleNamespaceAdmin,
+	"RevokeAPIKey":             RoleNamespaceAdmin,
+	"SetQuota":                 RoleGlobalAdmin,
+	"DeleteQuota":              RoleGlobalAdmin,
+	"GetControllerConfig":      RoleGlobalAdmin,
+	"PatchControllerConfig":    RoleGlobalAdmin,
+	"StreamAllRoutes":          RoleViewer,
+	"AddReservedHost":          RoleGlobalAdmin,
+	"DeleteReservedHost":       RoleGlobalAdmin,
+	"UpdateReservedHost":       RoleGlobalAdmin,
+	MethodOverrideReservedHost: RoleGlobalAdmin,
+	MethodEmergencyOverride:    RoleGlobalAdmin,
+}
+
+var readMethodPrefixes = []string{"Find", "Get", "List", "Search", "Lint", "Diff", "Compare", "Export"}
+
+func roleLevel(role string) int {
+	switch role {
+	case RoleViewer:
+		return 1
+	case RoleEditor:
+		return 2
+	case RoleNamespaceAdmin:
+		return 3
+	case RoleGlobalAdmin:
+		return 4
+	}
+	return 0
+}
+
+// RequiredRole 操作需要的最低角色
+func RequiredRole(method string) string {
+	if role, ok := methodRoles[method]; ok {
+		return role
+	}
+	for _, v := range readMethodPrefixes {
+		if strings.HasPrefix(method, v) {
+			return RoleViewer
+		}
+	}
+	return RoleEditor
+}
+
+// IRoleBindingDataService 角色绑定接口
+type IRoleBindingDataService interface {
+	GrantRole(*model.RoleBinding, string) (int64, error)
+	RevokeRole(*model.RoleBinding, string) error
+	ListBindings(string, string) ([]model.RoleBinding, error)
+
+	Authorize(string, string, interface{}) error
+	Enabled() bool
+	Scope(string) (string, error)
+}
+
+// NewRoleBindingDataService 创建，routeRepository、apiKeyRepository、namespaceDefaultRepository 用于按ID确定操作的命名空间，environments 用于确定晋级的目标命名空间
+func NewRoleBindingDataService(roleBindingRepository repository.IRoleBindingRepository, routeRepository repository.IRouteRepository, apiKeyRepository repository.IAPIKeyRepository, namespaceDefaultRepository repository.INamespaceDefaultRepository, config RBACConfig, environments map[string]EnvironmentConfig) IRoleBindingDataService {
+	return &RoleBindingDataService{RoleBindingRepository: roleBindingRepository, Config: config, resolver: namespaceResolver{routes: routeRepository, apiKeys: apiKeyRepository, namespaceDefaults: namespaceDefaultRepository, environments: environments}}
+}
+
+type RoleBindingDataService struct {
+	RoleBindingRepository repository.IRoleBindingRepository
+	Config                RBACConfig
+	resolver              namespaceResolver
+}
+
+func checkRoleBinding(roleBinding *model.RoleBinding) error {
+	if roleBinding.BindingSubject == "" {
+		return errors.New("授权对象不能为空")
+	}
+	switch roleBinding.BindingRole {
+	case RoleViewer, RoleEditor:
+	case RoleNamespaceAdmin:
+		if roleBinding.BindingNamespace == "" {
+			return i18n.NewError(i18n.CodeNamespaceRequired, RoleNamespaceAdmin+" 必须指定命名空间")
+		}
+	case RoleGlobalAdmin:
+		if roleBinding.BindingNamespace != "" {
+			return errors.New(RoleGlobalAdmin + " 不能指定命名空间")
+		}
+	default:
+		return i18n.NewError(i18n.CodeInvalidArgument, "不支持的角色："+roleBinding.BindingRole)
+	}
+	return nil
+}
+
+// namespace-admin 只能在自己的命名空间授予 viewer、editor，其余需要 global-admin
+func (u *RoleBindingDataService) checkGrantor(roleBinding *model.RoleBinding, actor string) error {
+	if !u.Config.Enabled {
+		return nil
+	}
+	if roleLevel(roleBinding.BindingRole) >= roleLevel(RoleNamespaceAdmin) || roleBinding.BindingNamespace == "" {
+		return u.authorize(actor, RoleGlobalAdmin, "")
+	}
+	return u.authorize(actor, RoleNamespaceAdmin, roleBinding.BindingNamespace)
+}
+
+// GrantRole 授予角色，相同的绑定已存在时直接返回
+func (u *RoleBindingDataService) GrantRole(roleBinding *model.RoleBinding, actor string) (int64, error) {
+	if err := checkRoleBinding(roleBinding); err != nil {
+		return 0, err
+	}
+	if err := u.checkGrantor(roleBinding, actor); err != nil {
+		return 0, err
+	}
+	existing, err := u.findBinding(roleBinding)
+	if err != nil {
+		return 0, err
+	}
+	if existing != nil {
+		return existing.ID, nil
+	}
+	roleBinding.BindingCreatedBy = actor
+	return u.RoleBindingRepository.CreateRoleBinding(roleBinding)
+}
+
+// RevokeRole 收回角色
+func (u *RoleBindingDataService) RevokeRole(roleBinding *model.RoleBinding, actor string) error {
+	if err := u.checkGrantor(roleBinding, actor); err != nil {
+		return err
+	}
+	existing, err := u.findBinding(roleBinding)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return errors.New(roleBinding.BindingSubject + " 没有角色 " + roleBinding.BindingRole)
+	}
+	return u.RoleBindingRepository.DeleteRoleBindingByID(existing.ID)
+}
+
+func (u *RoleBindingDataService) findBinding(roleBinding *model.RoleBinding) (*model.RoleBinding, error) {
+	bindings, err := u.RoleBindingRepository.FindBySubject(roleBinding.BindingSubject)
+	if err != nil {
+		return nil, err
+	}
+	for i := range bindings {
+		if bindings[i].BindingRole == roleBinding.BindingRole && bindings[i].BindingNamespace == roleBinding.BindingNamespace {
+			return &bindings[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// ListBindings 按授权对象和命名空间过滤，为空时不过滤
+func (u *RoleBindingDataService) ListBindings(subject string, namespace string) ([]model.RoleBinding, error) {
+	var bindings []model.RoleBinding
+	var err error
+	if subject != "" {
+		bindings, err = u.RoleBindingRepository.FindBySubject(subject)
+	} else {
+		bindings, err = u.RoleBindingRepository.FindAll()
+	}
+	if err != nil || namespace == "" {
+		return bindings, err
+	}
+	result := []model.RoleBinding{}
+	for _, v := range bindings {
+		if v.BindingNamespace == namespace {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// Authorize 检查调用方能否执行操作，method 为不带服务名的方法名，req 用于确定操作的命名空间
+func (u *RoleBindingDataService) Authorize(subject string, method string, req interface{}) error {
+	if !u.Config.Enabled {
+		return nil
+	}
+	required := RequiredRole(method)
+	for _, namespace := range u.resolver.requestNamespaces(req) {
+		if err := u.authorize(subject, required, namespace); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Enabled 是否开启了 RBAC，未开启时 Authorize 总是通过，需要明确授权的操作应先检查
+func (u *RoleBindingDataService) Enabled() bool {
+	return u.Config.Enabled
+}
+
+// Scope 调用方的角色和命名空间，按顺序拼接，授予或撤销角色后改变，未开启 RBAC 时为空
+func (u *RoleBindingDataService) Scope(subject string) (string, error) {
+	if !u.Config.Enabled || subject == "" {
+		return "", nil
+	}
+	for _, v := range u.Config.BootstrapAdmins {
+		if v == subject {
+			return RoleGlobalAdmin + "@", nil
+		}
+	}
+	bindings, err := u.RoleBindingRepository.FindBySubject(subject)
+	if err != nil {
+		return "", err
+	}
+	scopes := make([]string, 0, len(bindings))
+	for _, v := range bindings {
+		scopes = append(scopes, v.BindingRole+"@"+v.BindingNamespace)
+	}
+	sort.Strings(scopes)
+	return strings.Join(scopes, ","), nil
+}
+
+// 命名空间未知时只有不限命名空间的角色生效
+func (u *RoleBindingDataService) authorize(subject string, required string, namespace string) error {
+	if subject == "" {
+		return ErrAccessDenied
+	}
+	for _, v := range u.Config.BootstrapAdmins {
+		if v == subject {
+			return nil
+		}
+	}
+	bindings, err := u.RoleBindingRepository.FindBySubject(subject)
+	if err != nil {
+		return err
+	}
+	for _, v := range bindings {
+		if roleLevel(v.BindingRole) < roleLevel(required) {
+			continue
+		}
+		if v.BindingNamespace == "" || namespace != "" && matchAny([]string{v.BindingNamespace}, namespace) {
+			return nil
+		}
+	}
+	scope := "所有命名空间"
+	if namespace != "" {
+		scope = "命名空间 " + namespace + " "
+	}
+	return fmt.Errorf("%w：%s 在%s没有 %s 角色", ErrAccessDenied, subject, scope, required)
+}
+
+// namespaceResolver 按请求内容确定操作的命名空间
+type namespaceResolver struct {
+	routes            repository.IRouteRepository
+	apiKeys           repository.IAPIKeyRepository
+	namespaceDefaults repository.INamespaceDefaultRepository
+	//晋级时按环境配置计算目标命名空间
+	environments map[string]EnvironmentConfig
+}
+
+// 涉及多个命名空间的操作返回全部，调用方需要在每个命名空间都有权限
+func (r namespaceResolver) requestNamespaces(req interface{}) []string {
+	switch v := req.(type) {
+	case *route.RouteInfo:
+		//修改时原命名空间也需要权限，否则可以把其他命名空间的路由改到自己的命名空间
+		if v.Id != 0 {
+			if stored := r.routeNamespace(v.Id); stored != v.RouteNamespace {
+				return []string{stored, v.RouteNamespace}
+			}
+		}
+		return []string{v.RouteNamespace}
+	case *route.NamespaceDefaultInfo:
+		//同上，按ID修改时原记录的命名空间也需要权限
+		if v.Id != 0 {
+			if stored := r.namespaceDefaultNamespace(v.Id); stored != v.Namespace {
+				return []string{stored, v.Namespace}
+			}
+		}
+		return []string{v.Namespace}
+	case *route.PromoteRouteRequest:
+		namespace := r.routeNamespace(v.Id)
+		return []string{namespace, r.promotionNamespace(namespace, v.TargetEnv)}
+	}
+	return []string{r.requestNamespace(req)}
+}
+
+// 目标环境或源环境未配置时为空，只有不限命名空间的角色生效
+func (r namespaceResolver) promotionNamespace(namespace string, targetEnv string) string {
+	target, ok := r.environments[targetEnv]
+	if !ok {
+		return ""
+	}
+	_, source, ok := environmentOf(r.environments, namespace)
+	if !ok {
+		return ""
+	}
+	return promotedNamespace(namespace, source, target)
+}
+
+// 只带ID的请求查询路由或 API 密钥所在的命名空间，不存在时交给后续处理返回错误
+func (r namespaceResolver) requestNamespace(req interface{}) string {
+	switch v := req.(type) {
+	case interface{ GetRouteNamespace() string }:
+		return v.GetRouteNamespace()
+	case *route.NamespaceDefaultInfo:
+		return v.Namespace
+	case *route.RoleBindingInfo:
+		return v.BindingNamespace
+	case *route.ListBindingsRequest:
+		return v.BindingNamespace
+	case *route.APIKeyInfo:
+		return singleNamespace(v.KeyNamespaces)
+	case *route.APIKeyId:
+		apiKey, err := r.apiKeys.FindAPIKeyByID(v.Id)
+		if err != nil {
+			return ""
+		}
+		return singleNamespace(apiKey.KeyNamespaces)
+	case *route.RouteId:
+		return r.routeNamespace(v.Id)
+	case *route.NamespaceDefaultId:
+		return r.namespaceDefaultNamespace(v.Id)
+	case *route.ListEventsRequest:
+		if v.RouteId != 0 {
+			return r.routeNamespace(v.RouteId)
+		}
+	case *route.ExportManifestsRequest:
+		return v.Namespace
+	}
+	return ""
+}
+
+func (r namespaceResolver) routeNamespace(id int64) string {
+	route2, err := r.routes.FindRouteByID(id)
+	if err != nil {
+		return ""
+	}
+	return route2.RouteNamespace
+}
+
+func (r namespaceResolver) namespaceDefaultNamespace(id int64) string {
+	namespaceDefault, err := r.namespaceDefaults.FindNamespaceDefaultByID(id)
+	if err != nil {
+		return ""
+	}
+	return namespaceDefault.Namespace
+}
+
+// 多个命名空间时只有不限命名空间的角色生效
+func singleNamespace(namespaces []string) string {
+	if len(namespaces) == 1 {
+		return namespaces[0]
+	}
+	return ""
+}
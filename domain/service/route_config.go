@@ -0,0 +1,53 @@
+package service
+
+// RouteConfig 路由服务配置，从配置中心的 route 节点读取
+type RouteConfig struct {
+	// ClusterName 注册服务时写入的集群名称，和 kubeconfig 中的集群名称相同时 routectl 按当前 context 选择实例
+	ClusterName string `json:"cluster_name"`
+	// SecurityHeaders 强制的安全响应头
+	SecurityHeaders SecurityHeaderPolicy `json:"security_headers"`
+	// AllowSnippetAnnotations 是否允许调用方直接传入 nginx 配置片段注解，默认禁止
+	AllowSnippetAnnotations bool `json:"allow_snippet_annotations"`
+	// Stamp 写入 Ingress 的管理标签和注解
+	Stamp StampConfig `json:"stamp"`
+	// Ownership 负责团队、成本中心白名单
+	Ownership OwnershipConfig `json:"ownership"`
+	// Naming 路由命名规则
+	Naming NamingConfig `json:"naming"`
+	// Scope 允许操作的命名空间和集群
+	Scope ScopeConfig `json:"scope"`
+	// DistributedLock 多副本部署时的分布式锁
+	DistributedLock DistributedLockConfig `json:"distributed_lock"`
+	// HealthCheckAnnotations 按 Ingress class 配置健康检查注解，补充内置的 alb
+	HealthCheckAnnotations map[string]HealthCheckAnnotationKeys `json:"health_check_annotations"`
+	// TrafficHintAnnotations 按 Ingress class 配置压缩、WebSocket、HTTP/2 后端注解，补充内置的 nginx、alb、traefik
+	TrafficHintAnnotations map[string]TrafficHintAnnotations `json:"traffic_hint_annotations"`
+	// PermissionCheck 写入 Ingress 前的权限预检
+	PermissionCheck PermissionCheckConfig `json:"permission_check"`
+	// ServerSideApply 使用服务端应用写入 Ingress
+	ServerSideApply ServerSideApplyConfig `json:"server_side_apply"`
+	// Lint 规格检查
+	Lint LintConfig `json:"lint"`
+	// Environment 当前部署的环境，如 dev、staging、prod，命名空间不属于 Environments 中的环境时作为注解模板中的 {{.Env}}
+	Environment string `json:"environment"`
+	// Environments 路由晋级使用的环境，key 为环境名
+	Environments map[string]EnvironmentConfig `json:"environments"`
+	// RBAC 按角色控制调用方可以执行的操作
+	RBAC RBACConfig `json:"rbac"`
+	// ConsistencyCheck 启动时的一致性检查
+	ConsistencyCheck ConsistencyCheckConfig `json:"consistency_check"`
+	// Retention 历史数据保留策略
+	Retention RetentionConfig `json:"retention"`
+	// Retry 写入k8s失败后的自动重试
+	Retry ApplyRetryConfig `json:"retry"`
+	// ApplyQueue 写入k8s的并发和排队限制
+	ApplyQueue ApplyQueueConfig `json:"apply_queue"`
+	// AdmissionWebhook 写入k8s前调用的外部校验
+	AdmissionWebhook AdmissionWebhookConfig `json:"admission_webhook"`
+	// ControllerConfigMaps Ingress 控制器的全局配置，key 为控制器名称，nginx 默认为 ingress-nginx/ingress-nginx-controller
+	ControllerConfigMaps map[string]ControllerConfigMap `json:"controller_configmaps"`
+	// ControllerWorkloads 按 IngressClass 的 spec.controller 查找控制器的 Deployment/DaemonSet，补充内置的 ingress-nginx、traefik、alb
+	ControllerWorkloads map[string]ControllerWorkload `json:"controller_workloads"`
+	// Mutators 启用的路由修改插件，按顺序在校验之前执行
+	Mutators []RouteMutatorConfig `json:"mutators"`
+}
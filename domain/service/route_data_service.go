@@ -6,12 +6,20 @@ import (
 	"github.com/zxnlx/common"
 	"github.com/zxnlx/route/domain/model"
 	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/logsample"
+	"github.com/zxnlx/route/metrics"
+	"github.com/zxnlx/route/notify"
 	"github.com/zxnlx/route/proto/route"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
 	v1 "k8s.io/api/apps/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // IRouteDataService 这里是接口类型
@@ -20,71 +28,269 @@ type IRouteDataService interface {
 	DeleteRoute(int64) error
 	UpdateRoute(*model.Route) error
 	FindRouteByID(int64) (*model.Route, error)
-	FindAllRoute() ([]model.Route, error)
+	FindAllRoute([]string) ([]model.Route, error)
+	RouteCollectionVersion() (int64, time.Time, error)
+	SearchRoutes(*route.SearchRoutesRequest) ([]model.Route, string, error)
+	FindRouteByApplicationID(int64) ([]model.Route, error)
+	FindRouteByName(string, string) (*model.Route, error)
+	ExportInventory() ([]byte, error)
+	ExportManifests(*route.ExportManifestsRequest) (*route.InventoryFile, error)
 
+	CreateRoute(*route.RouteInfo) (int64, error)
+	CreateRouteContext(context.Context, *route.RouteInfo) (int64, error)
 	CreateRouteToK8s(*route.RouteInfo) error
-	DeleteRouteFromK8s(*model.Route) error
-	UpdateRouteToK8s(*route.RouteInfo) error
+	DeleteRouteFromK8s(*model.Route, string) error
+	DeleteRouteByName(string, string, string) error
+	ReconcileDeletions() (int, error)
+	RunDeleteReconciler(context.Context)
+	RetryDueRoutes() (int, error)
+	RunApplyRetrier(context.Context)
+	CheckConsistency(ConsistencyCheckConfig) (*ConsistencyReport, error)
+	UpdateRouteToK8s(*route.RouteInfo) (bool, error)
+	UpdateRouteToK8sContext(context.Context, *route.RouteInfo) (bool, error)
+	DisableRouteFromK8s(*model.Route) error
+	EnableRouteToK8s(*model.Route) error
+	WaitForReady(*route.RouteInfo) (*route.RouteStatus, error)
+	GetClusterCapabilities(string) (*route.ClusterCapabilities, error)
+	GetControllerConfig(string) (*route.ControllerConfig, error)
+	PatchControllerConfig(*route.ControllerConfigPatch, string) (*route.ControllerConfig, error)
+	GetControllerInfo(*route.ControllerInfoRequest) (*route.ControllerInfo, error)
+	AdoptIngresses(string, string, string) (*route.AdoptIngressesResponse, error)
+	ReleaseRoute(int64, string) error
+	DiagnoseCluster(context.Context) *route.ClusterDiagnosis
+	LintRoute(*route.RouteInfo) []*route.LintWarning
+	ListNamingViolations(*route.NamingViolationsRequest) (*route.NamingViolationReport, error)
+	ImportLegacyConfig(*route.LegacyConfigRequest) (*route.LegacyImportResult, error)
+	ConvertManifest(*route.ConvertManifestRequest) (*route.LegacyImportResult, error)
+	DiffRoute(int64) (*route.RouteDiff, error)
+	ReapplyAll(*route.ReapplyFilter, string, FreezeCheck) (*route.ReapplyJob, error)
+	GetReapplyJob(string) (*route.ReapplyJob, error)
+	ListFailedRoutes(*route.FailedRoutesRequest) ([]model.Route, error)
+	RetryRoute(int64, string) error
+	ReencryptRoutes(string) (*route.ReencryptResult, error)
+	PromotionNamespace(int64, string) (string, error)
+	PromoteRoute(int64, string, string) (*route.PromoteRouteResponse, error)
+	CompareEnvironments(*route.CompareEnvironmentsRequest) (*route.EnvironmentComparison, error)
+	AddRouteHook(string, RouteHook) error
+	InstallRouteMutators([]RouteMutatorConfig) error
+	ReviewIngressChange(*IngressChange, bool) (bool, string)
+	DebugStats() *DebugStats
 }
 
 // NewRouteDataService 创建  注意：返回值 IRouteDataService 接口类型
-func NewRouteDataService(routeRepository repository.IRouteRepository, clientSet *kubernetes.Clientset) IRouteDataService {
-	return &RouteDataService{RouteRepository: routeRepository, K8sClientSet: clientSet, deployment: &v1.Deployment{}}
+func NewRouteDataService(routeRepository repository.IRouteRepository, annotationTemplateRepository repository.IAnnotationTemplateRepository, quotaRepository repository.IQuotaRepository, clientSet kubernetes.Interface, dynamicClient dynamic.Interface, config *RouteConfig, locker RouteLocker) IRouteDataService {
+	ingressAPIVersion := discoverIngressAPIVersion(clientSet)
+	common.Info("Ingress API 版本：" + ingressAPIVersion)
+	return &RouteDataService{RouteRepository: routeRepository, AnnotationTemplateRepository: annotationTemplateRepository, QuotaRepository: quotaRepository, K8sClientSet: clientSet, K8sDynamicClient: dynamicClient, Config: config, Locker: locker, IngressAPIVersion: ingressAPIVersion, deployment: &v1.Deployment{}}
 }
 
 type RouteDataService struct {
 	//注意：这里是 IRouteRepository 类型
 	RouteRepository repository.IRouteRepository
-	K8sClientSet    *kubernetes.Clientset
-	deployment      *v1.Deployment
+	//渲染 Ingress 时读取注解模板，为空时不使用模板
+	AnnotationTemplateRepository repository.IAnnotationTemplateRepository
+	//创建时检查配额，为空时不限制
+	QuotaRepository repository.IQuotaRepository
+	//接口类型，测试时可以使用 fake clientset
+	K8sClientSet kubernetes.Interface
+	//操作 Gateway API 等 CRD 资源
+	K8sDynamicClient dynamic.Interface
+	Config           *RouteConfig
+	//启动时探测，旧集群使用 v1beta1
+	IngressAPIVersion string
+	deployment        *v1.Deployment
+	//合并相同的并发操作
+	group singleflight.Group
+	//同一路由的操作串行执行
+	locks keyedMutex
+	//分布式锁，为空时只使用本地锁
+	Locker RouteLocker
+	//权限预检结果缓存
+	permissions permissionCache
+	//批量重新写入任务
+	reapplyJobs reapplyJobs
+	//各阶段开始前调用的钩子
+	hooks routeHooks
+	//外部校验使用的 HTTP 客户端
+	admission admissionClient
+	//写入k8s的并发和排队限制
+	applyQueue applyQueue
 }
 
-// CreateRouteToK8s 创建k8s（把proto 属性补全）
-func (u *RouteDataService) CreateRouteToK8s(info *route.RouteInfo) (err error) {
-	ingress := u.setIngress(info)
-	//查找是否存在
-	if _, err = u.K8sClientSet.NetworkingV1().Ingresses(info.RouteNamespace).Get(context.TODO(), info.RouteName, metav1.GetOptions{}); err != nil {
-		if _, err = u.K8sClientSet.NetworkingV1().Ingresses(info.RouteNamespace).Create(context.TODO(), ingress, metav1.CreateOptions{}); err != nil {
-			//创建不成功记录错误
-			common.Error(err)
+// CreateRoute 创建route到k8s并写入数据库，相同内容的并发创建只执行一次并共享结果
+func (u *RouteDataService) CreateRoute(info *route.RouteInfo) (int64, error) {
+	return u.CreateRouteContext(context.Background(), info)
+}
+
+// CreateRouteContext 同 CreateRoute，ctx 取消后不再排队等待写入，合并的并发创建使用第一个调用方的 ctx
+func (u *RouteDataService) CreateRouteContext(ctx context.Context, info *route.RouteInfo) (int64, error) {
+	key, err := routeOperationKey(ActionCreate, info)
+	if err != nil {
+		return 0, err
+	}
+	if err = checkConflictPolicy(info.RouteConflictPolicy); err != nil {
+		return 0, err
+	}
+	v, err, _ := u.group.Do(key, func() (interface{}, error) {
+		unlock, err := u.lockRoute(info.RouteNamespace, info.RouteName)
+		if err != nil {
+			return int64(0), err
+		}
+		defer unlock()
+		op := &RouteOperation{Action: ActionCreate, Info: proto.Clone(info).(*route.RouteInfo), ctx: ctx}
+		if err := u.runStage(StageValidate, op, u.validateRoute); err != nil {
+			return int64(0), err
+		}
+		if err := u.checkQuota(op.Info); err != nil {
+			return int64(0), err
+		}
+		if err := u.runStage(StageRender, op, u.renderCreate); err != nil {
+			return int64(0), err
+		}
+		start := time.Now()
+		if err := u.runStage(StageApply, op, u.applyRoute); err != nil {
+			//数据库记录随后删除，只记录耗时和事件，不留下按路由的指标
+			metrics.ObserveApplyDuration(time.Since(start), err)
+			u.emitEvent(op.Info, notify.EventApplyFailed, err.Error())
+			u.rollbackCreate(op, false)
+			return int64(0), err
+		}
+		if err := u.runStage(StagePersist, op, u.persistRoute); err != nil {
+			u.rollbackCreate(op, true)
+			return int64(0), err
+		}
+		u.recordApply(op.Info, start, nil, "创建成功，版本 "+strconv.FormatInt(op.Info.RouteRevision, 10))
+		return op.Info.Id, nil
+	})
+	return v.(int64), err
+}
+
+// 写入k8s前的校验
+func (u *RouteDataService) checkRoute(info *route.RouteInfo) error {
+	if err := u.checkScope(info); err != nil {
+		return err
+	}
+	if err := u.checkHeaders(info); err != nil {
+		return err
+	}
+	if err := checkDuplicatePaths(info); err != nil {
+		return err
+	}
+	if err := u.checkHealthCheck(info); err != nil {
+		return err
+	}
+	if err := u.checkTrafficHints(info); err != nil {
+		return err
+	}
+	if err := u.checkExternalAuth(info); err != nil {
+		return err
+	}
+	if err := u.checkApplyOptions(info); err != nil {
+		return err
+	}
+	if _, err := u.ingressAdapter(info); err != nil {
+		return err
+	}
+	if isIngressAdapter(info.RouteAdapter) {
+		if _, err := u.renderTemplateAnnotations(info); err != nil {
 			return err
 		}
-		return nil
-	} else {
-		common.Error("路由 " + info.RouteName + " 已经存在")
-		return errors.New("路由 " + info.RouteName + " 已经存在")
 	}
+	return u.checkOwnership(info)
+}
+
+// CreateRouteToK8s 创建k8s（把proto 属性补全）
+func (u *RouteDataService) CreateRouteToK8s(info *route.RouteInfo) error {
+	op := &RouteOperation{Action: ActionCreate, Info: info, Changed: true}
+	if err := u.runStage(StageValidate, op, u.validateRoute); err != nil {
+		return err
+	}
+	return u.runStage(StageApply, op, u.applyRoute)
 }
 
 func (u *RouteDataService) setIngress(info *route.RouteInfo) *networkingv1.Ingress {
-	className := "nginx"
-	return &networkingv1.Ingress{
+	className := u.getIngressClassName(info)
+	ingress := &networkingv1.Ingress{
 		//设置路由
 		TypeMeta: metav1.TypeMeta{Kind: "Ingress",
 			APIVersion: "v1",
 		},
 		//设置路由基础信息
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      info.RouteName,
-			Namespace: info.RouteNamespace,
-			Labels: map[string]string{
-				"app-name": info.RouteName,
-				"author":   "Caplost",
-			},
-			Annotations: map[string]string{
-				"k8s/generated-by-cap": "由Cap老师代码创建",
-			},
+			Name:        info.RouteName,
+			Namespace:   info.RouteNamespace,
+			Labels:      u.getStampLabels(info),
+			Annotations: u.getIngressAnnotations(info),
 		},
 		Spec: networkingv1.IngressSpec{
 			IngressClassName: &className,
 			//默认访问服务
 			DefaultBackend: nil,
 			//如果开启https这里要设置
-			TLS:   nil,
+			TLS:   u.getIngressTLS(info),
 			Rules: u.getIngressPath(info),
 		},
 		Status: networkingv1.IngressStatus{},
 	}
+	u.recordLastApplied(ingress)
+	return ingress
+}
+
+func (u *RouteDataService) getIngressClassName(info *route.RouteInfo) string {
+	if info.RouteClass != "" {
+		return info.RouteClass
+	}
+	return "nginx"
+}
+
+// 合并注解模板和自定义注解，证书签发者通过 cert-manager 注解设置
+func (u *RouteDataService) getIngressAnnotations(info *route.RouteInfo) map[string]string {
+	annotations := u.getTemplateAnnotations(info)
+	for k, v := range info.RouteAnnotations {
+		annotations[k] = v
+	}
+	if info.RouteTlsIssuer != "" {
+		annotations["cert-manager.io/cluster-issuer"] = info.RouteTlsIssuer
+	}
+	//请求头、响应头通过配置片段实现，追加在用户自定义片段之后
+	if u.hasHeaders(info) {
+		snippet := annotations["nginx.ingress.kubernetes.io/configuration-snippet"]
+		if snippet != "" && !strings.HasSuffix(snippet, "\n") {
+			snippet += "\n"
+		}
+		annotations["nginx.ingress.kubernetes.io/configuration-snippet"] = snippet + u.nginxHeaderSnippet(info)
+	}
+	//nginx 的镜像注解作用于整个 Ingress，checkIngressPath 已保证各路径镜像一致
+	for _, v := range info.RoutePath {
+		if v.RouteMirrorService != "" {
+			annotations["nginx.ingress.kubernetes.io/mirror-target"] = "http://" + v.RouteMirrorService + "." + info.RouteNamespace + ".svc.cluster.local:" + strconv.FormatInt(int64(v.RouteMirrorServicePort), 10) + "$request_uri"
+			break
+		}
+	}
+	for k, v := range u.getHealthCheckAnnotations(info) {
+		annotations[k] = v
+	}
+	u.applyTrafficHintAnnotations(info, annotations)
+	for k, v := range u.getExternalAuthAnnotations(info) {
+		annotations[k] = v
+	}
+	for k, v := range u.getStampAnnotations(info) {
+		annotations[k] = v
+	}
+	return annotations
+}
+
+// 设置了证书签发者才开启https，证书由 cert-manager 写入 secret
+func (u *RouteDataService) getIngressTLS(info *route.RouteInfo) []networkingv1.IngressTLS {
+	if info.RouteTlsIssuer == "" {
+		return nil
+	}
+	return []networkingv1.IngressTLS{
+		{
+			Hosts:      []string{info.RouteHost},
+			SecretName: info.RouteName + "-tls",
+		},
+	}
 }
 
 // 根据info信息获取path路径
@@ -115,33 +321,267 @@ func (u *RouteDataService) getIngressPath(info *route.RouteInfo) (path []network
 	return
 }
 
-// UpdateRouteToK8s 更新route
-func (u *RouteDataService) UpdateRouteToK8s(info *route.RouteInfo) (err error) {
-	ingress := u.setIngress(info)
-	if _, err = u.K8sClientSet.NetworkingV1().Ingresses(info.RouteNamespace).Update(context.TODO(), ingress, metav1.UpdateOptions{}); err != nil {
+// UpdateRouteToK8s 更新route到k8s并写回数据库，相同内容的并发更新只执行一次
+// 规格哈希与上次写入的相同时不写k8s和数据库，返回 false
+func (u *RouteDataService) UpdateRouteToK8s(info *route.RouteInfo) (bool, error) {
+	return u.UpdateRouteToK8sContext(context.Background(), info)
+}
+
+// UpdateRouteToK8sContext 同 UpdateRouteToK8s，ctx 取消后不再排队等待写入，合并的并发修改使用第一个调用方的 ctx
+func (u *RouteDataService) UpdateRouteToK8sContext(ctx context.Context, info *route.RouteInfo) (bool, error) {
+	key, err := routeOperationKey(ActionUpdate, info)
+	if err != nil {
+		return false, err
+	}
+	v, err, _ := u.group.Do(key, func() (interface{}, error) {
+		unlock, err := u.lockRoute(info.RouteNamespace, info.RouteName)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+		op := &RouteOperation{Action: ActionUpdate, Info: proto.Clone(info).(*route.RouteInfo), ctx: ctx}
+		err = u.updateRoute(op)
+		return &updateResult{revision: op.Info.RouteRevision, specHash: op.Info.RouteSpecHash, changed: op.Changed}, err
+	})
+	if err != nil {
+		return false, err
+	}
+	//合并的调用共享同一个版本号
+	result := v.(*updateResult)
+	info.RouteRevision = result.revision
+	info.RouteSpecHash = result.specHash
+	return result.changed, nil
+}
+
+// 把更新后的 info 合并到数据库记录，空字段不覆盖
+func (u *RouteDataService) saveRoute(info *route.RouteInfo) error {
+	route2, err := u.RouteRepository.FindRouteByID(info.Id)
+	if err != nil {
 		common.Error(err)
 		return err
 	}
-	return nil
+	if err := common.SwapTo(info, route2); err != nil {
+		common.Error(err)
+		return err
+	}
+	mergeClearableFields(info, route2)
+	return u.UpdateRoute(route2)
+}
+
+// 可以关闭或清空的字段，SwapTo 经过 JSON 转换时 false 和空值被 omitempty 跳过，保留了数据库中的旧值，需要单独赋值
+// 字段和仓库 UpdateRoute 中总是写入的字段一致
+func mergeClearableFields(info *route.RouteInfo, route2 *model.Route) {
+	route2.RouteCompression = info.RouteCompression
+	route2.RouteWebsocket = info.RouteWebsocket
+	route2.RouteWebsocketTimeoutSeconds = info.RouteWebsocketTimeoutSeconds
+	route2.RouteBackendHTTP2 = info.RouteBackendHttp2
+	route2.RouteAuthURL = info.RouteAuthUrl
+	route2.RouteAuthSignin = info.RouteAuthSignin
+	route2.RouteAuthResponseHeaders = info.RouteAuthResponseHeaders
+}
+
+type updateResult struct {
+	revision int64
+	specHash string
+	changed  bool
+}
+
+// 在锁内写入k8s并写回数据库，避免与其他操作交错
+func (u *RouteDataService) updateRoute(op *RouteOperation) error {
+	if err := u.runStage(StageValidate, op, u.validateRoute); err != nil {
+		return err
+	}
+	if err := u.runStage(StageRender, op, u.renderUpdate); err != nil {
+		return err
+	}
+	if !op.Changed {
+		logsample.Info("route_unchanged", "路由 "+op.Info.RouteNamespace+"/"+op.Info.RouteName+" 规格未变化，跳过写入")
+		return nil
+	}
+	if err := u.applyUpdate(op); err != nil {
+		return err
+	}
+	return u.runStage(StagePersist, op, u.persistRoute)
+}
+
+// 按数据库记录重新写入k8s，不改变版本号，用于批量重新写入和自动重试等后台任务
+func (u *RouteDataService) applyUpdateToK8s(info *route.RouteInfo) error {
+	op := &RouteOperation{Action: ActionUpdate, Info: info, Changed: true, background: true}
+	if err := u.runStage(StageValidate, op, u.validateRoute); err != nil {
+		return err
+	}
+	return u.applyUpdate(op)
+}
+
+// 校验通过后才算一次写入，队列已满被拒绝的不算
+func (u *RouteDataService) applyUpdate(op *RouteOperation) error {
+	start := time.Now()
+	err := u.runStage(StageApply, op, u.applyRoute)
+	if errors.Is(err, ErrApplyQueueFull) {
+		return err
+	}
+	u.recordApply(op.Info, start, err, "更新成功，版本 "+strconv.FormatInt(op.Info.RouteRevision, 10))
+	return err
+}
+
+// DeleteRouteFromK8s 按删除策略删除route，同一路由的并发删除只执行一次
+func (u *RouteDataService) DeleteRouteFromK8s(route2 *model.Route, policy string) error {
+	if err := checkDeletePolicy(policy); err != nil {
+		return err
+	}
+	key := "delete/" + route2.RouteNamespace + "/" + route2.RouteName + "/" + strconv.FormatInt(route2.ID, 10) + "/" + policy
+	_, err, _ := u.group.Do(key, func() (interface{}, error) {
+		unlock, err := u.lockRoute(route2.RouteNamespace, route2.RouteName)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+		return nil, u.deleteRouteFromK8s(route2, policy)
+	})
+	return err
 }
 
-// DeleteRouteFromK8s 删除route
-func (u *RouteDataService) DeleteRouteFromK8s(route2 *model.Route) (err error) {
-	//删除Ingress
-	if err = u.K8sClientSet.NetworkingV1().Ingresses(route2.RouteNamespace).Delete(context.TODO(), route2.RouteName, metav1.DeleteOptions{}); err != nil {
-		//如果删除失败记录下
+// 先在事务中标记删除中，再删除k8s中的资源，最后删除记录；中途失败时记录保留，由 ReconcileDeletions 继续
+func (u *RouteDataService) deleteRouteFromK8s(route2 *model.Route, policy string) (err error) {
+	if err = u.checkScope(&route.RouteInfo{RouteNamespace: route2.RouteNamespace}); err != nil {
+		common.Error(err)
+		return err
+	}
+	//加锁后重新读取，已在删除中时沿用记录的删除策略
+	if route2, err = u.RouteRepository.FindRouteByID(route2.ID); err != nil {
 		common.Error(err)
 		return err
+	}
+	info := &route.RouteInfo{Id: route2.ID, RouteName: route2.RouteName, RouteNamespace: route2.RouteNamespace}
+	if route2.RouteDeleting {
+		policy = route2.RouteDeletePolicy
 	} else {
-		if err := u.DeleteRoute(route2.ID); err != nil {
+		err = u.RouteRepository.Transaction(func(repo repository.IRouteRepository) error {
+			if err := repo.MarkRouteDeleting(route2.ID, policy); err != nil {
+				return err
+			}
+			return u.createOutbox(repo, info, notify.EventRouteDeleting, "删除策略："+deletePolicyName(policy))
+		})
+		if err != nil {
 			common.Error(err)
 			return err
 		}
-		common.Info("删除 ingress ID：" + strconv.FormatInt(route2.ID, 10) + " 成功！")
 	}
+	//已禁用的路由k8s中不存在，orphan 保留k8s中的资源，继续中断的删除时资源可能已经删除
+	if !route2.RouteDisabled && policy != DeletePolicyOrphan {
+		if err = ignoreNotFound(u.deleteFromK8s(route2)); err != nil {
+			//如果删除失败记录下
+			common.Error(err)
+			return err
+		}
+	}
+	if policy == DeletePolicyCascade {
+		if err = u.deleteAssociatedFromK8s(route2); err != nil {
+			common.Error(err)
+			return err
+		}
+	}
+	err = u.RouteRepository.Transaction(func(repo repository.IRouteRepository) error {
+		if err := repo.DeleteRoutesByID([]int64{route2.ID}); err != nil {
+			return err
+		}
+		return u.createOutbox(repo, info, notify.EventRouteDeleted, "删除策略："+deletePolicyName(policy))
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	metrics.ForgetRoute(route2.RouteNamespace, route2.RouteName)
+	common.Info("删除 ingress ID：" + strconv.FormatInt(route2.ID, 10) + " 成功！")
 	return
 }
 
+// DeleteRouteByName 根据命名空间和名称删除route
+func (u *RouteDataService) DeleteRouteByName(namespace string, name string, policy string) error {
+	route2, err := u.RouteRepository.FindRouteByName(namespace, name)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return u.DeleteRouteFromK8s(route2, policy)
+}
+
+// 根据路由实现方式删除 Ingress、HTTPRoute 等资源
+func (u *RouteDataService) deleteFromK8s(route2 *model.Route) error {
+	adapter, err := u.ingressAdapter(&route.RouteInfo{RouteAdapter: route2.RouteAdapter, RouteClass: route2.RouteClass})
+	if err != nil {
+		return err
+	}
+	return adapter.Delete(route2)
+}
+
+// DisableRouteFromK8s 禁用route，只删除Ingress，保留数据库数据
+func (u *RouteDataService) DisableRouteFromK8s(route2 *model.Route) (err error) {
+	unlock, err := u.lockRoute(route2.RouteNamespace, route2.RouteName)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	defer unlock()
+	//加锁后重新读取，避免使用过期的状态
+	if route2, err = u.RouteRepository.FindRouteByID(route2.ID); err != nil {
+		common.Error(err)
+		return err
+	}
+	if route2.RouteDisabled {
+		return nil
+	}
+	if err = u.checkScope(&route.RouteInfo{RouteNamespace: route2.RouteNamespace}); err != nil {
+		common.Error(err)
+		return err
+	}
+	if err = u.deleteFromK8s(route2); err != nil {
+		common.Error(err)
+		return err
+	}
+	if err = u.RouteRepository.UpdateRouteDisabled(route2.ID, true); err != nil {
+		common.Error(err)
+		return err
+	}
+	common.Info("禁用 ingress ID：" + strconv.FormatInt(route2.ID, 10) + " 成功！")
+	return nil
+}
+
+// EnableRouteToK8s 启用route，根据数据库数据重新创建Ingress
+func (u *RouteDataService) EnableRouteToK8s(route2 *model.Route) (err error) {
+	unlock, err := u.lockRoute(route2.RouteNamespace, route2.RouteName)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	defer unlock()
+	//加锁后重新读取，避免使用过期的状态
+	if route2, err = u.RouteRepository.FindRouteByID(route2.ID); err != nil {
+		common.Error(err)
+		return err
+	}
+	if !route2.RouteDisabled {
+		return nil
+	}
+	info := &route.RouteInfo{}
+	if err = common.SwapTo(route2, info); err != nil {
+		common.Error(err)
+		return err
+	}
+	start := time.Now()
+	err = u.CreateRouteToK8s(info)
+	u.recordApply(info, start, err, "启用成功")
+	if err != nil {
+		return err
+	}
+	if err = u.RouteRepository.UpdateRouteDisabled(route2.ID, false); err != nil {
+		common.Error(err)
+		return err
+	}
+	common.Info("启用 ingress ID：" + strconv.FormatInt(route2.ID, 10) + " 成功！")
+	return nil
+}
+
 // AddRoute 插入
 func (u *RouteDataService) AddRoute(route *model.Route) (int64, error) {
 	return u.RouteRepository.CreateRoute(route)
@@ -162,7 +602,29 @@ func (u *RouteDataService) FindRouteByID(routeID int64) (*model.Route, error) {
 	return u.RouteRepository.FindRouteByID(routeID)
 }
 
-// FindAllRoute 查找
-func (u *RouteDataService) FindAllRoute() ([]model.Route, error) {
-	return u.RouteRepository.FindAll()
+// FindAllRoute 查找，指定字段时只查询对应的列
+func (u *RouteDataService) FindAllRoute(fields []string) ([]model.Route, error) {
+	if len(fields) == 0 {
+		return u.RouteRepository.FindAll()
+	}
+	fields, err := expandRouteFields(fields)
+	if err != nil {
+		return nil, err
+	}
+	return u.RouteRepository.FindPage(repository.RouteFilter{}, repository.RouteOrderID, nil, 0, fields)
+}
+
+// RouteCollectionVersion 路由数和最后修改时间，网关据此生成列表的 ETag
+func (u *RouteDataService) RouteCollectionVersion() (int64, time.Time, error) {
+	return u.RouteRepository.Version()
+}
+
+// FindRouteByName 根据命名空间和名称查找
+func (u *RouteDataService) FindRouteByName(namespace string, name string) (*model.Route, error) {
+	return u.RouteRepository.FindRouteByName(namespace, name)
+}
+
+// FindRouteByApplicationID 根据应用查找
+func (u *RouteDataService) FindRouteByApplicationID(applicationID int64) ([]model.Route, error) {
+	return u.RouteRepository.FindRouteByApplicationID(applicationID)
 }
@@ -0,0 +1,59 @@
+package service
+
+import "sync"
+
+// keyedMutex 按 key 加锁，同一路由的操作串行执行，不同路由互不影响
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	sync.Mutex
+	refs int
+}
+
+// Lock 返回解锁函数，没有等待者时释放 key
+func (m *keyedMutex) Lock(key string) func() {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = map[string]*keyedLock{}
+	}
+	lock, ok := m.locks[key]
+	if !ok {
+		lock = &keyedLock{}
+		m.locks[key] = lock
+	}
+	lock.refs++
+	m.mu.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		m.mu.Lock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(m.locks, key)
+		}
+		m.mu.Unlock()
+	}
+}
+
+// 同一路由的创建、更新、删除、禁用、启用串行执行，防止k8s和数据库写入交错
+// 配置了分布式锁时先拿本地锁再拿分布式锁，减少对 Consul 的争抢
+func (u *RouteDataService) lockRoute(namespace string, name string) (func(), error) {
+	key := namespace + "/" + name
+	unlock := u.locks.Lock(key)
+	if u.Locker == nil {
+		return unlock, nil
+	}
+	distributedUnlock, err := u.Locker.Lock(key)
+	if err != nil {
+		unlock()
+		return nil, err
+	}
+	return func() {
+		distributedUnlock()
+		unlock()
+	}, nil
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RouteMutator 路由修改插件，在校验阶段之前修改路由规格，用于补全或统一平台约定
+// 同一规格可能多次经过插件（更新、修复漂移、接管），修改需要幂等；返回错误时拒绝本次操作
+type RouteMutator interface {
+	Mutate(*RouteOperation) error
+}
+
+// RouteMutatorFactory 按配置创建插件，config 为配置中心里该插件的 config 节点，未配置时为空
+type RouteMutatorFactory func(config json.RawMessage) (RouteMutator, error)
+
+// RouteMutatorConfig 启用的插件
+type RouteMutatorConfig struct {
+	Name   string          `json:"name"`
+	Config json.RawMessage `json:"config"`
+}
+
+var (
+	routeMutatorsMu sync.RWMutex
+	routeMutators   = map[string]RouteMutatorFactory{}
+)
+
+// RegisterRouteMutator 注册插件，一般在插件包的 init 中调用，重复注册时覆盖
+// 注册后还需要在 route.mutators 中启用才会生效
+func RegisterRouteMutator(name string, factory RouteMutatorFactory) {
+	routeMutatorsMu.Lock()
+	defer routeMutatorsMu.Unlock()
+	routeMutators[name] = factory
+}
+
+func init() {
+	RegisterRouteMutator(MutatorHostSuffix, newHostSuffixMutator)
+}
+
+// 调用方需要持有读锁
+func routeMutatorNames() []string {
+	names := []string{}
+	for k := range routeMutators {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// InstallRouteMutators 按配置的顺序创建插件，注册为校验阶段的钩子
+func (u *RouteDataService) InstallRouteMutators(configs []RouteMutatorConfig) error {
+	routeMutatorsMu.RLock()
+	defer routeMutatorsMu.RUnlock()
+	for _, v := range configs {
+		factory, ok := routeMutators[v.Name]
+		if !ok {
+			return errors.New("未注册的路由插件：" + v.Name + "，可选：" + strings.Join(routeMutatorNames(), "、"))
+		}
+		mutator, err := factory(v.Config)
+		if err != nil {
+			return errors.New("路由插件 " + v.Name + " 配置错误：" + err.Error())
+		}
+		name := v.Name
+		if err := u.AddRouteHook(StageValidate, func(op *RouteOperation) error {
+			return mutate(name, mutator, op)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 路由以 ID、命名空间和名称定位，插件不能修改
+func mutate(name string, mutator RouteMutator, op *RouteOperation) error {
+	id, namespace, routeName := op.Info.Id, op.Info.RouteNamespace, op.Info.RouteName
+	if err := mutator.Mutate(op); err != nil {
+		return errors.New("路由插件 " + name + "：" + err.Error())
+	}
+	if op.Info.Id != id || op.Info.RouteNamespace != namespace || op.Info.RouteName != routeName {
+		return errors.New("路由插件 " + name + " 不能修改路由的 ID、命名空间和名称")
+	}
+	return nil
+}
+
+// MutatorHostSuffix 内置插件：没有后缀的短域名补全为公司域名，如 shop 补全为 shop.corp.example.com
+const MutatorHostSuffix = "host-suffix"
+
+type hostSuffixMutator struct {
+	// Suffix 补全的后缀，以 . 开头
+	Suffix string `json:"suffix"`
+}
+
+func newHostSuffixMutator(config json.RawMessage) (RouteMutator, error) {
+	m := &hostSuffixMutator{}
+	if len(config) > 0 {
+		if err := json.Unmarshal(config, m); err != nil {
+			return nil, err
+		}
+	}
+	if !strings.HasPrefix(m.Suffix, ".") || len(m.Suffix) < 2 {
+		return nil, errors.New("suffix 需要以 . 开头，如 .corp.example.com")
+	}
+	return m, nil
+}
+
+// 只补全不含 . 的域名，已经是完整域名的不修改
+func (m *hostSuffixMutator) Mutate(op *RouteOperation) error {
+	if op.Info.RouteHost != "" && !strings.Contains(op.Info.RouteHost, ".") {
+		op.Info.RouteHost += m.Suffix
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultRoutePageSize = 100
+	maxRoutePageSize     = 1000
+)
+
+// ErrInvalidPageToken 游标无法解析，或和本次查询的条件、排序不一致
+var ErrInvalidPageToken = i18n.NewError(i18n.CodeInvalidPageToken, "分页游标无效，请从第一页重新查询")
+
+// 游标内容，对调用方不透明，条件摘要用于拒绝换了条件的翻页
+type pageToken struct {
+	OrderBy string   `json:"o"`
+	Keys    []string `json:"k,omitempty"`
+	ID      int64    `json:"i"`
+	Filter  string   `json:"f"`
+}
+
+// SearchRoutes 按条件查询一页路由，返回下一页的游标，没有更多时为空
+func (u *RouteDataService) SearchRoutes(req *route.SearchRoutesRequest) ([]model.Route, string, error) {
+	filter := repository.RouteFilter{
+		Namespace:     req.RouteNamespace,
+		Host:          req.RouteHost,
+		ApplicationID: req.RouteApplicationId,
+		Adapter:       req.RouteAdapter,
+	}
+	orderBy, pageSize, token := req.OrderBy, req.PageSize, req.PageToken
+	switch orderBy {
+	case "":
+		orderBy = repository.RouteOrderID
+	case repository.RouteOrderID, repository.RouteOrderName, repository.RouteOrderHost:
+	default:
+		return nil, "", i18n.NewError(i18n.CodeInvalidArgument, "不支持的排序方式："+orderBy)
+	}
+	if pageSize <= 0 {
+		pageSize = defaultRoutePageSize
+	}
+	if pageSize > maxRoutePageSize {
+		return nil, "", i18n.NewError(i18n.CodePageSizeTooLarge, "每页条数不能超过 "+strconv.Itoa(maxRoutePageSize))
+	}
+	digest := filterDigest(filter)
+	var after *repository.RouteCursor
+	if token != "" {
+		cursor, err := decodePageToken(token, orderBy, digest)
+		if err != nil {
+			return nil, "", err
+		}
+		after = cursor
+	}
+	fields, err := expandRouteFields(req.Fields)
+	if err != nil {
+		return nil, "", err
+	}
+	//多查一条判断是否还有下一页
+	routes, err := u.RouteRepository.FindPage(filter, orderBy, after, int(pageSize)+1, fields)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(routes) <= int(pageSize) {
+		return routes, "", nil
+	}
+	routes = routes[:pageSize]
+	next, err := encodePageToken(repository.RouteCursor{OrderBy: orderBy}.After(&routes[len(routes)-1]), digest)
+	if err != nil {
+		return nil, "", err
+	}
+	return routes, next, nil
+}
+
+func encodePageToken(cursor *repository.RouteCursor, digest string) (string, error) {
+	data, err := json.Marshal(pageToken{OrderBy: cursor.OrderBy, Keys: cursor.Keys, ID: cursor.ID, Filter: digest})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodePageToken(token string, orderBy string, digest string) (*repository.RouteCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	v := pageToken{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if v.OrderBy != orderBy || v.Filter != digest {
+		return nil, ErrInvalidPageToken
+	}
+	cursor := repository.RouteCursor{OrderBy: v.OrderBy}
+	if len(cursor.After(&model.Route{}).Keys) != len(v.Keys) {
+		return nil, ErrInvalidPageToken
+	}
+	cursor.Keys = v.Keys
+	cursor.ID = v.ID
+	return &cursor, nil
+}
+
+func filterDigest(filter repository.RouteFilter) string {
+	disabled := ""
+	if filter.Disabled != nil {
+		disabled = strconv.FormatBool(*filter.Disabled)
+	}
+	sum := sha256.Sum256([]byte(filter.Namespace + "\x00" + filter.Host + "\x00" + strconv.FormatInt(filter.ApplicationID, 10) + "\x00" + filter.Adapter + "\x00" + disabled))
+	return hex.EncodeToString(sum[:4])
+}
+
+// status 字段组，列表页显示状态时使用
+var routeStatusFields = []string{
+	"route_disabled",
+	"route_last_apply_time",
+	"route_last_error",
+	"route_consecutive_failures",
+	"route_next_retry_at",
+	"route_dead_letter",
+}
+
+// 检查字段是否为 RouteInfo 的字段并展开 status，总是包含 id
+func expandRouteFields(fields []string) ([]string, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	descriptor := (&route.RouteInfo{}).ProtoReflect().Descriptor().Fields()
+	expanded := []string{"id"}
+	for _, v := range fields {
+		v = strings.TrimSpace(v)
+		switch {
+		case v == "status":
+			expanded = append(expanded, routeStatusFields...)
+		case descriptor.ByName(protoreflect.Name(v)) != nil:
+			expanded = append(expanded, v)
+		default:
+			return nil, i18n.NewError(i18n.CodeInvalidArgument, "不支持的字段："+v)
+		}
+	}
+	return expanded, nil
+}
+
+// ProjectRouteInfo 清除没有请求的字段，减少返回的数据量，fields 为空时不处理
+func ProjectRouteInfo(info *route.RouteInfo, fields []string) error {
+	fields, err := expandRouteFields(fields)
+	if err != nil || len(fields) == 0 {
+		return err
+	}
+	keep := map[protoreflect.Name]bool{}
+	for _, v := range fields {
+		keep[protoreflect.Name(v)] = true
+	}
+	message := info.ProtoReflect()
+	message.Range(func(field protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !keep[field.Name()] {
+			message.Clear(field)
+		}
+		return true
+	})
+	return nil
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"errors"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	"k8s.io/apimachinery/pkg/labels"
+	"path"
+)
+
+// ScopeConfig 限制本服务可以操作的命名空间和集群，支持通配符，黑名单优先
+type ScopeConfig struct {
+	// AllowedNamespaces 为空时不限制
+	AllowedNamespaces []string `json:"allowed_namespaces"`
+	// DeniedNamespaces 未配置时默认禁止系统命名空间
+	DeniedNamespaces []string `json:"denied_namespaces"`
+	AllowedClusters  []string `json:"allowed_clusters"`
+	DeniedClusters   []string `json:"denied_clusters"`
+	// LabelSelector 导入、接管已有资源时只处理匹配的资源，为空时不限制
+	LabelSelector string `json:"label_selector"`
+	// ExcludeLabelSelector 匹配的资源由其他工具管理，不导入也不覆盖
+	ExcludeLabelSelector string `json:"exclude_label_selector"`
+}
+
+var defaultDeniedNamespaces = []string{"kube-system", "kube-public", "kube-node-lease"}
+
+func matchAny(patterns []string, value string) bool {
+	for _, v := range patterns {
+		if ok, _ := path.Match(v, value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// 校验命名空间是否允许操作，所有写入、删除、导入和对账都需要经过
+func (u *RouteDataService) checkNamespaceScope(namespace string) error {
+	scope := u.Config.Scope
+	denied := scope.DeniedNamespaces
+	if denied == nil {
+		denied = defaultDeniedNamespaces
+	}
+	if matchAny(denied, namespace) {
+		return i18n.NewError(i18n.CodeNamespaceForbidden, "命名空间 "+namespace+" 禁止由本服务操作")
+	}
+	if len(scope.AllowedNamespaces) > 0 && !matchAny(scope.AllowedNamespaces, namespace) {
+		return i18n.NewError(i18n.CodeNamespaceNotAllowed, "命名空间 "+namespace+" 不在允许操作的范围内")
+	}
+	return nil
+}
+
+// 校验集群是否允许操作
+func (u *RouteDataService) checkClusterScope(cluster string) error {
+	scope := u.Config.Scope
+	if matchAny(scope.DeniedClusters, cluster) {
+		return i18n.NewError(i18n.CodeClusterForbidden, "集群 "+cluster+" 禁止由本服务操作")
+	}
+	if len(scope.AllowedClusters) > 0 && !matchAny(scope.AllowedClusters, cluster) {
+		return i18n.NewError(i18n.CodeClusterNotAllowed, "集群 "+cluster+" 不在允许操作的范围内")
+	}
+	return nil
+}
+
+// 校验k8s中已存在的资源是否在本服务的管理范围内，导入、接管和覆盖前调用
+func (u *RouteDataService) checkObjectScope(kind string, namespace string, name string, objLabels map[string]string) error {
+	if err := u.checkNamespaceScope(namespace); err != nil {
+		return err
+	}
+	scope := u.Config.Scope
+	set := labels.Set(objLabels)
+	if scope.ExcludeLabelSelector != "" {
+		selector, err := labels.Parse(scope.ExcludeLabelSelector)
+		if err != nil {
+			return errors.New("exclude_label_selector 配置错误：" + err.Error())
+		}
+		if selector.Matches(set) {
+			return i18n.NewError(i18n.CodeNotManaged, kind+" "+namespace+"/"+name+" 由其他工具管理，不能由本服务操作")
+		}
+	}
+	if scope.LabelSelector != "" {
+		selector, err := labels.Parse(scope.LabelSelector)
+		if err != nil {
+			return errors.New("label_selector 配置错误：" + err.Error())
+		}
+		if !selector.Matches(set) {
+			return i18n.NewError(i18n.CodeNotManaged, kind+" "+namespace+"/"+name+" 不匹配 label_selector，不在本服务的管理范围内")
+		}
+	}
+	return nil
+}
+
+func (u *RouteDataService) checkScope(info *route.RouteInfo) error {
+	if err := u.checkClusterScope(DefaultCluster); err != nil {
+		return err
+	}
+	return u.checkNamespaceScope(info.RouteNamespace)
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/zxnlx/route/proto/route"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// DefaultFieldManager 服务端应用默认使用的 field manager
+const DefaultFieldManager = "route-service"
+
+// 服务端应用字段冲突时的处理
+const (
+	ApplyConflictsForce = "force"
+	ApplyConflictsFail  = "fail"
+)
+
+// ServerSideApplyConfig 服务端应用配置，开启后按字段归属合并，其他控制器或 GitOps 工具设置的字段不会被覆盖
+type ServerSideApplyConfig struct {
+	Enabled bool `json:"enabled"`
+	// FieldManager 为空时使用 route-service
+	FieldManager string `json:"field_manager"`
+	// Force 与其他 field manager 冲突时强制接管字段，默认返回冲突错误
+	Force bool `json:"force"`
+}
+
+// 校验请求中的服务端应用选项，未开启服务端应用时不允许传入
+func (u *RouteDataService) checkApplyOptions(info *route.RouteInfo) error {
+	if info.RouteFieldManager == "" && info.RouteApplyConflicts == "" {
+		return nil
+	}
+	if !u.Config.ServerSideApply.Enabled || !isIngressAdapter(info.RouteAdapter) {
+		return errors.New("未开启服务端应用，不能设置 field manager 和冲突处理")
+	}
+	if len(info.RouteFieldManager) > 128 {
+		return errors.New("field manager 不能超过 128 个字符")
+	}
+	switch info.RouteApplyConflicts {
+	case "", ApplyConflictsForce, ApplyConflictsFail:
+		return nil
+	}
+	return errors.New("不支持的冲突处理：" + info.RouteApplyConflicts)
+}
+
+// 请求中的选项优先，其次使用配置
+func (u *RouteDataService) applyPatchOptions(info *route.RouteInfo) metav1.PatchOptions {
+	config := u.Config.ServerSideApply
+	fieldManager := info.RouteFieldManager
+	if fieldManager == "" {
+		fieldManager = config.FieldManager
+	}
+	if fieldManager == "" {
+		fieldManager = DefaultFieldManager
+	}
+	force := config.Force
+	switch info.RouteApplyConflicts {
+	case ApplyConflictsForce:
+		force = true
+	case ApplyConflictsFail:
+		force = false
+	}
+	return metav1.PatchOptions{FieldManager: fieldManager, Force: &force}
+}
+
+// 以服务端应用写入 Ingress，不存在时创建
+func (u *RouteDataService) applyIngress(info *route.RouteInfo) error {
+	body, err := u.ingressApplyBody(u.setIngress(info))
+	if err != nil {
+		return err
+	}
+	_, err = u.ingresses(info.RouteNamespace).Patch(context.TODO(), info.RouteName, types.ApplyPatchType, body, u.applyPatchOptions(info))
+	return err
+}
+
+// 服务端应用的请求体需要使用集群实际的 Ingress 版本
+func (u *RouteDataService) ingressApplyBody(ingress *networkingv1.Ingress) ([]byte, error) {
+	ingress.ResourceVersion = ""
+	if u.IngressAPIVersion == IngressAPIV1beta1 {
+		out := toV1beta1Ingress(ingress)
+		out.TypeMeta = metav1.TypeMeta{APIVersion: IngressAPIV1beta1, Kind: "Ingress"}
+		return json.Marshal(out)
+	}
+	ingress.TypeMeta = metav1.TypeMeta{APIVersion: IngressAPIV1, Kind: "Ingress"}
+	return json.Marshal(ingress)
+}
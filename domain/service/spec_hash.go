@@ -0,0 +1,57 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/zxnlx/route/proto/route"
+	"google.golang.org/protobuf/proto"
+)
+
+// 计算路由内容的哈希，map 字段需要确定性序列化
+func specHash(info *route.RouteInfo) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(info)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// 写入k8s的规格哈希，去掉服务端维护的字段、操作人和请求选项，相同规格的更新视为无变化
+func appliedSpecHash(info *route.RouteInfo) (string, error) {
+	spec := proto.Clone(info).(*route.RouteInfo)
+	//服务端填写的字段不参与计算，创建时还没有 ID，和之后的更新保持一致
+	spec.Id = 0
+	spec.RouteLastApplyTime = 0
+	spec.RouteLastApplyDurationMs = 0
+	spec.RouteLastError = ""
+	spec.RouteConsecutiveFailures = 0
+	spec.RouteNextRetryAt = 0
+	spec.RouteDeadLetter = false
+	spec.RouteDisabled = false
+	spec.RouteRevision = 0
+	spec.RouteCreatedBy = ""
+	spec.RouteUpdatedBy = ""
+	spec.RouteConflictPolicy = ""
+	spec.RouteReservedHostOverride = false
+	spec.RouteFieldManager = ""
+	spec.RouteApplyConflicts = ""
+	spec.RouteWaitForReady = false
+	spec.RouteWaitTimeout = 0
+	spec.RouteWaitProbe = false
+	spec.RouteSpecHash = ""
+	for _, v := range spec.RoutePath {
+		v.Id = 0
+		v.RouteId = 0
+	}
+	return specHash(spec)
+}
+
+// 操作 + 路由 + 内容作为合并并发操作的 key
+func routeOperationKey(op string, info *route.RouteInfo) (string, error) {
+	hash, err := specHash(info)
+	if err != nil {
+		return "", err
+	}
+	return op + "/" + info.RouteNamespace + "/" + info.RouteName + "/" + hash, nil
+}
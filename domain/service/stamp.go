@@ -0,0 +1,77 @@
+package service
+
+import (
+	"github.com/zxnlx/route/proto/route"
+	"strconv"
+)
+
+// StampConfig 管理标签、注解配置，下游工具依赖这些标签识别由本服务管理的资源
+type StampConfig struct {
+	// Prefix 标签、注解 key 的前缀
+	Prefix string `json:"prefix"`
+	// ServiceIdentity 写入 managed-by 标签
+	ServiceIdentity string `json:"service_identity"`
+	// Environment 写入 environment 标签，为空时不写
+	Environment string `json:"environment"`
+	// Labels、Annotations 额外的固定标签和注解
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+const (
+	defaultStampPrefix          = "route.zxnlx.io/"
+	defaultStampServiceIdentity = "go.micro.service.route"
+)
+
+func (c StampConfig) prefix() string {
+	if c.Prefix != "" {
+		return c.Prefix
+	}
+	return defaultStampPrefix
+}
+
+// 管理标签
+func (u *RouteDataService) getStampLabels(info *route.RouteInfo) map[string]string {
+	stamp := u.Config.Stamp
+	labels := map[string]string{}
+	for k, v := range stamp.Labels {
+		labels[k] = v
+	}
+	labels["app-name"] = info.RouteName
+	identity := stamp.ServiceIdentity
+	if identity == "" {
+		identity = defaultStampServiceIdentity
+	}
+	labels[stamp.prefix()+"managed-by"] = identity
+	if stamp.Environment != "" {
+		labels[stamp.prefix()+"environment"] = stamp.Environment
+	}
+	if info.Id != 0 {
+		labels[stamp.prefix()+"route-id"] = strconv.FormatInt(info.Id, 10)
+	}
+	if info.RouteOwnerTeam != "" {
+		labels[stamp.prefix()+"owner-team"] = info.RouteOwnerTeam
+	}
+	if info.RouteCostCenter != "" {
+		labels[stamp.prefix()+"cost-center"] = info.RouteCostCenter
+	}
+	return labels
+}
+
+// 管理注解，版本号不是合法的标签选择条件，写在注解里
+func (u *RouteDataService) getStampAnnotations(info *route.RouteInfo) map[string]string {
+	stamp := u.Config.Stamp
+	annotations := map[string]string{}
+	for k, v := range stamp.Annotations {
+		annotations[k] = v
+	}
+	annotations[stamp.prefix()+"revision"] = strconv.FormatInt(info.RouteRevision, 10)
+	if info.RouteSpecHash != "" {
+		annotations[stamp.prefix()+"spec-hash"] = info.RouteSpecHash
+	}
+	//联系方式可能是邮箱，不满足标签值格式
+	if info.RouteContact != "" {
+		annotations[stamp.prefix()+"contact"] = info.RouteContact
+	}
+	return annotations
+}
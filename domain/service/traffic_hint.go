@@ -0,0 +1,126 @@
+package service
+
+import (
+	"errors"
+	"github.com/zxnlx/route/proto/route"
+	"strconv"
+	"strings"
+)
+
+// 未设置超时时 WebSocket 连接的空闲超时秒数
+const defaultWebsocketTimeoutSeconds = 3600
+
+// TrafficHintAnnotations 压缩、WebSocket、HTTP/2 后端开关对应的控制器注解
+// 为 nil 的项表示控制器不支持，为空表示控制器默认支持无需注解；值中的 {timeout} 替换为 WebSocket 超时秒数
+type TrafficHintAnnotations struct {
+	Compression map[string]string `json:"compression"`
+	Websocket   map[string]string `json:"websocket"`
+	HTTP2       map[string]string `json:"http2"`
+}
+
+// 内置的 Ingress class 注解，可以通过配置 traffic_hint_annotations 补充或覆盖
+// ingress-nginx 默认转发 Upgrade 请求头，只需调大代理超时；后端 HTTP/2 只支持 gRPC，不作为通用开关
+var defaultTrafficHintAnnotations = map[string]TrafficHintAnnotations{
+	"nginx": {
+		Compression: map[string]string{
+			"nginx.ingress.kubernetes.io/configuration-snippet": "gzip on;\ngzip_types text/plain text/css text/xml application/json application/javascript application/xml;\n",
+		},
+		Websocket: map[string]string{
+			"nginx.ingress.kubernetes.io/proxy-read-timeout": "{timeout}",
+			"nginx.ingress.kubernetes.io/proxy-send-timeout": "{timeout}",
+		},
+	},
+	"alb": {
+		Websocket: map[string]string{
+			"alb.ingress.kubernetes.io/load-balancer-attributes": "idle_timeout.timeout_seconds={timeout}",
+		},
+		HTTP2: map[string]string{
+			"alb.ingress.kubernetes.io/backend-protocol-version": "HTTP2",
+		},
+	},
+	"traefik": {
+		Websocket: map[string]string{},
+	},
+}
+
+func (u *RouteDataService) trafficHintAnnotations(class string) (TrafficHintAnnotations, bool) {
+	if hints, ok := u.Config.TrafficHintAnnotations[class]; ok {
+		return hints, true
+	}
+	hints, ok := defaultTrafficHintAnnotations[class]
+	return hints, ok
+}
+
+func websocketTimeout(info *route.RouteInfo) int32 {
+	if info.RouteWebsocketTimeoutSeconds > 0 {
+		return info.RouteWebsocketTimeoutSeconds
+	}
+	return defaultWebsocketTimeoutSeconds
+}
+
+// Gateway API 和 Istio 默认支持 WebSocket，压缩和后端协议不在 HTTPRoute、VirtualService 中表达
+func (u *RouteDataService) checkTrafficHints(info *route.RouteInfo) error {
+	if info.RouteWebsocketTimeoutSeconds < 0 {
+		return errors.New("WebSocket 超时不能小于 0")
+	}
+	if info.RouteWebsocketTimeoutSeconds != 0 && !info.RouteWebsocket {
+		return errors.New("设置 WebSocket 超时需要开启 WebSocket")
+	}
+	if !isIngressAdapter(info.RouteAdapter) {
+		switch {
+		case info.RouteCompression:
+			return errors.New("路由 " + info.RouteName + " 开启了压缩，" + info.RouteAdapter + " 不支持")
+		case info.RouteBackendHttp2:
+			return errors.New("路由 " + info.RouteName + " 开启了 HTTP/2 后端，" + info.RouteAdapter + " 请在 Service 的 appProtocol 中设置")
+		case info.RouteWebsocketTimeoutSeconds != 0:
+			return errors.New("路由 " + info.RouteName + " 设置了 WebSocket 超时，" + info.RouteAdapter + " 不支持")
+		}
+		return nil
+	}
+	if !info.RouteCompression && !info.RouteWebsocket && !info.RouteBackendHttp2 {
+		return nil
+	}
+	class := u.getIngressClassName(info)
+	hints, _ := u.trafficHintAnnotations(class)
+	switch {
+	case info.RouteCompression && hints.Compression == nil:
+		return errors.New("路由 " + info.RouteName + " 开启了压缩，Ingress class " + class + " 不支持")
+	case info.RouteWebsocket && hints.Websocket == nil:
+		return errors.New("路由 " + info.RouteName + " 开启了 WebSocket，Ingress class " + class + " 不支持")
+	case info.RouteBackendHttp2 && hints.HTTP2 == nil:
+		return errors.New("路由 " + info.RouteName + " 开启了 HTTP/2 后端，Ingress class " + class + " 不支持")
+	}
+	return nil
+}
+
+// 开关转换为控制器注解，配置片段追加在已有片段之后
+func (u *RouteDataService) applyTrafficHintAnnotations(info *route.RouteInfo, annotations map[string]string) {
+	hints, ok := u.trafficHintAnnotations(u.getIngressClassName(info))
+	if !ok {
+		return
+	}
+	timeout := strconv.FormatInt(int64(websocketTimeout(info)), 10)
+	for _, v := range []struct {
+		enabled bool
+		values  map[string]string
+	}{
+		{info.RouteCompression, hints.Compression},
+		{info.RouteWebsocket, hints.Websocket},
+		{info.RouteBackendHttp2, hints.HTTP2},
+	} {
+		if !v.enabled {
+			continue
+		}
+		for k, value := range v.values {
+			value = strings.ReplaceAll(value, "{timeout}", timeout)
+			if strings.HasSuffix(k, "-snippet") && annotations[k] != "" {
+				snippet := annotations[k]
+				if !strings.HasSuffix(snippet, "\n") {
+					snippet += "\n"
+				}
+				value = snippet + value
+			}
+			annotations[k] = value
+		}
+	}
+}
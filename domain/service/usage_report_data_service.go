@@ -0,0 +1,232 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// 用量报表的统计维度
+const (
+	UsageGroupByNamespace = "namespace"
+	UsageGroupByTeam      = "team"
+)
+
+const (
+	usageSnapshotInterval = time.Hour
+	defaultUsageDays      = 30
+	maxUsageDays          = 366
+)
+
+var usageReportHeader = []string{"day", "namespace", "owner_team", "routes", "hosts", "tls_certificates"}
+
+// IUsageReportDataService 用量报表接口
+type IUsageReportDataService interface {
+	RecordSnapshot(time.Time) error
+	GetUsageReport(*route.UsageReportRequest) (*route.UsageReport, error)
+	Run(context.Context)
+}
+
+// NewUsageReportDataService 创建
+func NewUsageReportDataService(usageSnapshotRepository repository.IUsageSnapshotRepository, routeRepository repository.IRouteRepository) IUsageReportDataService {
+	return &UsageReportDataService{UsageSnapshotRepository: usageSnapshotRepository, RouteRepository: routeRepository}
+}
+
+// UsageReportDataService 按小时记录当天的用量快照，历史用量从快照中读取，当天的用量实时统计
+type UsageReportDataService struct {
+	UsageSnapshotRepository repository.IUsageSnapshotRepository
+	RouteRepository         repository.IRouteRepository
+}
+
+// Run 定时记录快照，ctx 结束时退出
+func (u *UsageReportDataService) Run(ctx context.Context) {
+	ticker := time.NewTicker(usageSnapshotInterval)
+	defer ticker.Stop()
+	for {
+		if err := u.RecordSnapshot(time.Now()); err != nil {
+			common.Error(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RecordSnapshot 记录当前用量作为当天的快照
+func (u *UsageReportDataService) RecordSnapshot(now time.Time) error {
+	snapshots, err := u.currentUsage(now)
+	if err != nil {
+		return err
+	}
+	return u.UsageSnapshotRepository.SaveSnapshots(quotaDay(now), snapshots)
+}
+
+// 按命名空间和负责团队统计，每个开启 TLS 的路由有一个证书
+func (u *UsageReportDataService) currentUsage(now time.Time) ([]model.UsageSnapshot, error) {
+	routes, err := u.RouteRepository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	type usageKey struct{ namespace, team string }
+	usage := map[usageKey]*model.UsageSnapshot{}
+	hosts := map[usageKey]map[string]bool{}
+	keys := []usageKey{}
+	for _, v := range routes {
+		key := usageKey{namespace: v.RouteNamespace, team: v.RouteOwnerTeam}
+		snapshot, ok := usage[key]
+		if !ok {
+			snapshot = &model.UsageSnapshot{SnapshotDay: quotaDay(now), SnapshotNamespace: key.namespace, SnapshotTeam: key.team}
+			usage[key] = snapshot
+			hosts[key] = map[string]bool{}
+			keys = append(keys, key)
+		}
+		snapshot.SnapshotRoutes++
+		if v.RouteHost != "" && !hosts[key][v.RouteHost] {
+			hosts[key][v.RouteHost] = true
+			snapshot.SnapshotHosts++
+		}
+		if v.RouteTlsIssuer != "" {
+			snapshot.SnapshotTlsCertificates++
+		}
+	}
+	snapshots := make([]model.UsageSnapshot, 0, len(keys))
+	for _, key := range keys {
+		snapshots = append(snapshots, *usage[key])
+	}
+	return snapshots, nil
+}
+
+// GetUsageReport 按天汇总，按团队统计时合并团队在各命名空间的用量
+func (u *UsageReportDataService) GetUsageReport(req *route.UsageReportRequest) (*route.UsageReport, error) {
+	now := time.Now()
+	groupBy := req.GroupBy
+	if groupBy == "" {
+		groupBy = UsageGroupByNamespace
+	}
+	if groupBy != UsageGroupByNamespace && groupBy != UsageGroupByTeam {
+		return nil, errors.New("不支持的统计维度：" + groupBy)
+	}
+	from, to, err := usageRange(req.From, req.To, now)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := u.UsageSnapshotRepository.FindSnapshots(from, to)
+	if err != nil {
+		return nil, err
+	}
+	//当天的快照可能已过时，使用实时统计
+	today := quotaDay(now)
+	if from <= today && today <= to {
+		current, err := u.currentUsage(now)
+		if err != nil {
+			return nil, err
+		}
+		kept := snapshots[:0]
+		for _, v := range snapshots {
+			if v.SnapshotDay != today {
+				kept = append(kept, v)
+			}
+		}
+		snapshots = append(kept, current...)
+	}
+	report := &route.UsageReport{From: from, To: to, GroupBy: groupBy, Rows: usageRows(snapshots, groupBy)}
+	switch req.Format {
+	case "", "json":
+	case "csv":
+		content, err := usageCSV(report.Rows)
+		if err != nil {
+			return nil, err
+		}
+		report.File = &route.InventoryFile{FileName: "route-usage-" + from + "-" + to + ".csv", ContentType: "text/csv; charset=utf-8", Content: content}
+	default:
+		return nil, i18n.NewError(i18n.CodeInvalidArgument, "不支持的格式："+req.Format)
+	}
+	return report, nil
+}
+
+func usageRange(from string, to string, now time.Time) (string, string, error) {
+	if to == "" {
+		to = quotaDay(now)
+	}
+	end, err := time.Parse("2006-01-02", to)
+	if err != nil {
+		return "", "", errors.New("结束日期格式应为 2006-01-02")
+	}
+	if from == "" {
+		from = quotaDay(end.AddDate(0, 0, 1-defaultUsageDays))
+	}
+	start, err := time.Parse("2006-01-02", from)
+	if err != nil {
+		return "", "", errors.New("开始日期格式应为 2006-01-02")
+	}
+	if start.After(end) {
+		return "", "", i18n.NewError(i18n.CodeInvalidArgument, "开始日期不能晚于结束日期")
+	}
+	if end.Sub(start) >= maxUsageDays*24*time.Hour {
+		return "", "", errors.New("统计范围不能超过 " + strconv.Itoa(maxUsageDays) + " 天")
+	}
+	return from, to, nil
+}
+
+func usageRows(snapshots []model.UsageSnapshot, groupBy string) []*route.UsageReportRow {
+	rows := map[string]*route.UsageReportRow{}
+	keys := []string{}
+	for _, v := range snapshots {
+		row := &route.UsageReportRow{Day: v.SnapshotDay, RouteNamespace: v.SnapshotNamespace, RouteOwnerTeam: v.SnapshotTeam}
+		if groupBy == UsageGroupByTeam {
+			row.RouteNamespace = ""
+		}
+		key := row.Day + "\x00" + row.RouteNamespace + "\x00" + row.RouteOwnerTeam
+		if existing, ok := rows[key]; ok {
+			row = existing
+		} else {
+			rows[key] = row
+			keys = append(keys, key)
+		}
+		row.Routes += v.SnapshotRoutes
+		row.Hosts += v.SnapshotHosts
+		row.TlsCertificates += v.SnapshotTlsCertificates
+	}
+	sort.Strings(keys)
+	result := make([]*route.UsageReportRow, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, rows[key])
+	}
+	return result
+}
+
+// 带 BOM 方便 Excel 直接打开，与路由清单一致
+func usageCSV(rows []*route.UsageReportRow) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteString("\xef\xbb\xbf")
+	w := csv.NewWriter(buf)
+	if err := w.Write(usageReportHeader); err != nil {
+		return nil, err
+	}
+	for _, v := range rows {
+		record := []string{
+			v.Day,
+			v.RouteNamespace,
+			v.RouteOwnerTeam,
+			strconv.FormatInt(v.Routes, 10),
+			strconv.FormatInt(v.Hosts, 10),
+			strconv.FormatInt(v.TlsCertificates, 10),
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
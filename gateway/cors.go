@@ -0,0 +1,64 @@
+package gateway
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// CORSConfig 跨域配置，单页应用和网关不同域时使用
+type CORSConfig struct {
+	// AllowedOrigins 允许的来源，如 https://console.example.com，* 表示任意来源，不能和 AllowCredentials 同时使用
+	AllowedOrigins []string `json:"allowed_origins"`
+	// AllowCredentials 允许携带 Cookie，使用 OIDC 登录时需要开启
+	AllowCredentials bool `json:"allow_credentials"`
+	// MaxAgeSeconds 预检结果的缓存时间，默认 600 秒
+	MaxAgeSeconds int `json:"max_age_seconds"`
+}
+
+var (
+	corsAllowHeaders  = "Content-Type, X-Grpc-Web, X-User-Agent, Grpc-Timeout, Authorization, X-Api-Key, X-Emergency-Override, Last-Event-ID, Accept-Language"
+	corsExposeHeaders = "Grpc-Status, Grpc-Message, Retry-After, X-Error-Code"
+)
+
+func (c CORSConfig) allowed(origin string) bool {
+	for _, v := range c.AllowedOrigins {
+		if v == "*" && !c.AllowCredentials || strings.EqualFold(v, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// 来源在允许列表中时返回跨域响应头，预检请求直接返回
+func (g *Gateway) cors(next http.Handler) http.Handler {
+	config := g.Config.CORS
+	if len(config.AllowedOrigins) == 0 {
+		return next
+	}
+	maxAge := config.MaxAgeSeconds
+	if maxAge <= 0 {
+		maxAge = 600
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		w.Header().Add("Vary", "Origin")
+		if origin == "" || !config.allowed(origin) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		if config.AllowCredentials {
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+		w.Header().Set("Access-Control-Expose-Headers", corsExposeHeaders)
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", corsAllowHeaders)
+			w.Header().Set("Access-Control-Max-Age", strconv.Itoa(maxAge))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,55 @@
+package gateway
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/proto/route"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// 列表的 ETag 由路由数、最后修改时间、查询参数和调用方的权限范围生成，删除或修改任意路由都会改变
+// 调用方的身份、角色或命名空间变化后也会改变，不会对已经不可见或不完整的列表返回 304
+// 先于查询列表计算，期间有修改时下次轮询仍会返回新的内容
+// 通过 Route 服务获取，和查询列表经过相同的鉴权，没有权限时不返回 304
+func (g *Gateway) collectionETag(r *http.Request) (string, error) {
+	version := &route.RouteCollectionVersion{}
+	if err := g.call(r, "GetRouteCollectionVersion", &route.FindAll{}, version); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(strconv.FormatInt(version.Count, 10) + "-" + strconv.FormatInt(version.UpdatedAt, 10) + "-" + r.URL.RawQuery + "-" + version.Scope))
+	return `W/"` + hex.EncodeToString(sum[:8]) + `"`, nil
+}
+
+// 按弱比较判断 If-None-Match 是否包含 etag
+func etagMatch(ifNoneMatch string, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, v := range strings.Split(ifNoneMatch, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" || strings.TrimPrefix(v, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// 设置 ETag，和请求中的一致时返回 304，调用方不再查询列表
+func (g *Gateway) checkNotModified(w http.ResponseWriter, r *http.Request) bool {
+	etag, err := g.collectionETag(r)
+	if err != nil {
+		//计算失败或没有权限时照常查询列表，由查询返回错误
+		common.Error(err)
+		return false
+	}
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	if etagMatch(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return true
+	}
+	return false
+}
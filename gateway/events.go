@@ -0,0 +1,204 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/service"
+	"github.com/zxnlx/route/proto/route"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	eventPollingInterval = time.Second
+	eventBatchSize       = 500
+	eventHeartbeat       = 15 * time.Second
+	// 订阅者的缓冲，跟不上时断开连接，浏览器重连后按 Last-Event-ID 补发
+	eventSubscriberBuffer = 256
+)
+
+// eventHub 所有连接共用一个轮询，按事件 ID 推送新事件
+// 事件表在多个实例之间共享，连接到任意实例都能收到全部事件
+type eventHub struct {
+	service     service.IEventDataService
+	once        sync.Once
+	mu          sync.Mutex
+	subscribers map[chan model.Event]struct{}
+}
+
+func newEventHub(eventDataService service.IEventDataService) *eventHub {
+	return &eventHub{service: eventDataService, subscribers: map[chan model.Event]struct{}{}}
+}
+
+// 第一个订阅者到来时开始轮询
+func (h *eventHub) subscribe() chan model.Event {
+	h.once.Do(func() {
+		go h.run(context.Background())
+	})
+	ch := make(chan model.Event, eventSubscriberBuffer)
+	h.mu.Lock()
+	h.subscribers[ch] = struct{}{}
+	h.mu.Unlock()
+	return ch
+}
+
+func (h *eventHub) unsubscribe(ch chan model.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subscribers[ch]; ok {
+		delete(h.subscribers, ch)
+		close(ch)
+	}
+}
+
+// 从最新的事件开始轮询，之前的事件由连接按 Last-Event-ID 自行补发
+func (h *eventHub) run(ctx context.Context) {
+	lastID, err := h.service.LatestEventID()
+	if err != nil {
+		common.Error(err)
+	}
+	ticker := time.NewTicker(eventPollingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		events, err := h.service.ListEventsAfter(lastID, eventBatchSize)
+		if err != nil {
+			common.Error(err)
+			continue
+		}
+		for _, v := range events {
+			h.broadcast(v)
+			lastID = v.ID
+		}
+	}
+}
+
+// 不阻塞轮询，缓冲已满的订阅者直接断开
+func (h *eventHub) broadcast(event model.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subscribers {
+		select {
+		case ch <- event:
+		default:
+			delete(h.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// GET /v1/events/stream?route_id=1&type=apply_failed
+// 以 Server-Sent Events 推送路由事件，断线重连时浏览器带上 Last-Event-ID，补发之后的事件
+func (g *Gateway) streamEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "不支持流式响应", http.StatusInternalServerError)
+		return
+	}
+	query := r.URL.Query()
+	routeID, _ := strconv.ParseInt(query.Get("route_id"), 10, 64)
+	eventType := query.Get("type")
+	lastID, _ := strconv.ParseInt(r.Header.Get("Last-Event-ID"), 10, 64)
+	if lastID == 0 {
+		lastID, _ = strconv.ParseInt(query.Get("last_event_id"), 10, 64)
+	}
+	//事件由网关直接推送，连接时和心跳时通过 ListEvents 鉴权，指定路由时按路由所在的命名空间检查，否则需要不限命名空间的角色
+	authorize := func() error {
+		return g.call(r, "ListEvents", &route.ListEventsRequest{RouteId: routeID, Type: eventType, Since: time.Now().Unix()}, &route.AllEvent{})
+	}
+	if err := authorize(); err != nil {
+		writeCallError(w, err)
+		return
+	}
+	//先订阅再补发，补发过的事件不再重复推送
+	ch := g.events.subscribe()
+	defer g.events.unsubscribe(ch)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	send := func(v model.Event) bool {
+		if v.ID <= lastID || routeID != 0 && v.RouteID != routeID || eventType != "" && v.EventType != eventType {
+			return true
+		}
+		lastID = v.ID
+		if err := writeEvent(w, v); err != nil {
+			return false
+		}
+		flusher.Flush()
+		return true
+	}
+	if lastID > 0 {
+		for {
+			events, err := g.EventDataService.ListEventsAfter(lastID, eventBatchSize)
+			if err != nil {
+				common.Error(err)
+				return
+			}
+			for _, v := range events {
+				if !send(v) {
+					return
+				}
+				lastID = v.ID
+			}
+			if len(events) < eventBatchSize {
+				break
+			}
+		}
+	}
+	flusher.Flush()
+	heartbeat := time.NewTicker(eventHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			//权限被收回或会话过期后断开
+			if err := authorize(); err != nil {
+				common.Error(err)
+				return
+			}
+			if _, err := w.Write([]byte(": ping\n\n")); err != nil {
+				return
+			}
+			flusher.Flush()
+		case v, ok := <-ch:
+			if !ok {
+				return
+			}
+			if !send(v) {
+				return
+			}
+		}
+	}
+}
+
+// 事件内容和 ListEvents 返回的相同，使用默认的 message 类型，浏览器通过 onmessage 接收
+func writeEvent(w http.ResponseWriter, v model.Event) error {
+	data, err := json.Marshal(&route.EventInfo{
+		Id:           v.ID,
+		RouteId:      v.RouteID,
+		EventType:    v.EventType,
+		EventMessage: v.EventMessage,
+		CreatedAt:    v.CreatedAt.Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write([]byte("id: " + strconv.FormatInt(v.ID, 10) + "\ndata: " + string(data) + "\n\n"))
+	return err
+}
@@ -0,0 +1,163 @@
+package gateway
+
+import (
+	"errors"
+	"github.com/asim/go-micro/v3/client"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/service"
+	"github.com/zxnlx/route/metrics"
+	"github.com/zxnlx/route/proto/route"
+	"github.com/zxnlx/route/secrets"
+	"github.com/zxnlx/route/wrapper"
+	"net/http"
+)
+
+// Config 网关配置，从配置中心的 route.gateway 节点读取
+type Config struct {
+	// OIDC 登录，未配置时网关不做认证
+	OIDC OIDCConfig `json:"oidc"`
+	// GRPCWeb 开启后浏览器可以通过 grpc-web 直接调用 Route 服务
+	GRPCWeb bool `json:"grpc_web"`
+	// CORS 允许跨域访问网关的来源，未配置时不返回跨域响应头
+	CORS CORSConfig `json:"cors"`
+	// Assertion 登录用户身份的签名密钥，Route 服务校验签名后才使用网关传入的身份
+	Assertion wrapper.AssertionConfig `json:"assertion"`
+}
+
+// Gateway 对外提供的 HTTP 接口
+type Gateway struct {
+	RouteDataService service.IRouteDataService
+	EventDataService service.IEventDataService
+	// Client 转发 grpc-web 请求
+	Client      client.Client
+	ServiceName string
+	Config      Config
+	assertion   *wrapper.Assertion
+	mux         *http.ServeMux
+	oidc        *oidcProvider
+	events      *eventHub
+}
+
+// NewGateway 创建 HTTP 网关，provider 用于读取签名密钥的引用，未配置时为空
+func NewGateway(routeDataService service.IRouteDataService, eventDataService service.IEventDataService, client client.Client, serviceName string, config Config, provider secrets.Provider) *Gateway {
+	g := &Gateway{
+		RouteDataService: routeDataService,
+		EventDataService: eventDataService,
+		Client:           client,
+		ServiceName:      serviceName,
+		Config:           config,
+		assertion:        wrapper.NewAssertion(config.Assertion, provider),
+		mux:              http.NewServeMux(),
+		events:           newEventHub(eventDataService),
+	}
+	if config.OIDC.enabled() {
+		g.oidc = newOIDCProvider(config.OIDC)
+		g.mux.HandleFunc("/auth/login", g.login)
+		g.mux.HandleFunc("/auth/callback", g.callback)
+		g.mux.HandleFunc("/auth/logout", g.logout)
+		g.mux.HandleFunc("/auth/me", g.me)
+	}
+	g.mux.HandleFunc("/v1/routes", g.routes)
+	g.mux.HandleFunc("/v1/routes/", g.routeByID)
+	g.mux.HandleFunc("/v1/routes/inventory.csv", g.exportInventory)
+	g.mux.HandleFunc("/v1/routes/manifests.tar.gz", g.exportManifests)
+	g.mux.HandleFunc("/v1/events/stream", g.streamEvents)
+	if config.GRPCWeb {
+		g.mux.HandleFunc(grpcWebPrefix, g.grpcWeb)
+	}
+	g.mux.Handle("/metrics", promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{}))
+	g.mux.HandleFunc("/v1/observability/grafana-dashboard.json", g.grafanaDashboard)
+	g.mux.HandleFunc("/v1/observability/prometheus-rules.yaml", g.prometheusRules)
+	g.mux.Handle("/ui/", uiHandler())
+	g.mux.HandleFunc("/", g.index)
+	return g
+}
+
+// Run 启动 HTTP 服务，阻塞直到出错
+func (g *Gateway) Run(addr string) error {
+	if err := g.Config.OIDC.check(); err != nil {
+		return err
+	}
+	//登录用户的身份需要签名后传给 Route 服务
+	if g.Config.OIDC.enabled() && g.Config.Assertion.Key == "" && g.Config.Assertion.KeyRef == "" {
+		return errors.New("开启 OIDC 登录需要配置身份声明的签名密钥 assertion.key 或 assertion.key_ref")
+	}
+	common.Info("HTTP 网关监听 " + addr)
+	//预检请求不带凭证，在认证之前处理
+	return http.ListenAndServe(addr, g.cors(g.authenticate(g.mux)))
+}
+
+// GET /v1/routes/inventory.csv 导出路由清单
+func (g *Gateway) exportInventory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	file := &route.InventoryFile{}
+	if err := g.call(r, "ExportInventory", &route.FindAll{}, file); err != nil {
+		writeCallError(w, err)
+		return
+	}
+	writeFile(w, file)
+}
+
+// GET /v1/routes/manifests.tar.gz?namespace=shop&format=kustomize&overlay=dev&overlay=prod
+func (g *Gateway) exportManifests(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	query := r.URL.Query()
+	req := &route.ExportManifestsRequest{
+		Namespace:  query.Get("namespace"),
+		Format:     query.Get("format"),
+		Overlays:   query["overlay"],
+		RouteNames: query["route"],
+	}
+	file := &route.InventoryFile{}
+	if err := g.call(r, "ExportManifests", req, file); err != nil {
+		writeCallError(w, err)
+		return
+	}
+	writeFile(w, file)
+}
+
+// 导出的文件以附件下载
+func writeFile(w http.ResponseWriter, file *route.InventoryFile) {
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("Content-Disposition", "attachment; filename="+file.FileName)
+	_, _ = w.Write(file.Content)
+}
+
+// GET /v1/observability/grafana-dashboard.json?namespace=route&datasource=Prometheus
+func (g *Gateway) grafanaDashboard(w http.ResponseWriter, r *http.Request) {
+	namespace := queryDefault(r, "namespace", metrics.Namespace)
+	content, err := metrics.GrafanaDashboard(namespace, queryDefault(r, "datasource", "Prometheus"))
+	if err != nil {
+		common.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(content)
+}
+
+// GET /v1/observability/prometheus-rules.yaml?namespace=route
+func (g *Gateway) prometheusRules(w http.ResponseWriter, r *http.Request) {
+	content, err := metrics.PrometheusRules(queryDefault(r, "namespace", metrics.Namespace))
+	if err != nil {
+		common.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	_, _ = w.Write(content)
+}
+
+func queryDefault(r *http.Request, key, def string) string {
+	if v := r.URL.Query().Get(key); v != "" {
+		return v
+	}
+	return def
+}
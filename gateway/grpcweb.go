@@ -0,0 +1,257 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	microerrors "github.com/asim/go-micro/v3/errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/proto/route"
+	"github.com/zxnlx/route/wrapper"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// grpc-web 请求的路径前缀，和 gRPC 相同为 /<包名>.<服务名>/
+const grpcWebPrefix = "/route.Route/"
+
+// 请求体的最大长度
+const maxGRPCWebBody = 4 << 20
+
+// gRPC 状态码
+const (
+	grpcUnknown            = 2
+	grpcInvalidArgument    = 3
+	grpcDeadlineExceeded   = 4
+	grpcNotFound           = 5
+	grpcPermissionDenied   = 7
+	grpcResourceExhausted  = 8
+	grpcFailedPrecondition = 9
+	grpcAborted            = 10
+	grpcUnimplemented      = 12
+	grpcInternal           = 13
+	grpcUnavailable        = 14
+	grpcUnauthenticated    = 16
+)
+
+// grpcWeb 把 grpc-web 请求转换为对 Route 服务的调用，经过和 gRPC 调用相同的鉴权、限流和日志
+// 只支持一元调用，消息不能压缩；application/grpc-web-text 按 base64 编解码
+func (g *Gateway) grpcWeb(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	contentType := r.Header.Get("Content-Type")
+	text := strings.HasPrefix(contentType, "application/grpc-web-text")
+	if !text && !strings.HasPrefix(contentType, "application/grpc-web") {
+		http.Error(w, "Content-Type 必须是 application/grpc-web 或 application/grpc-web-text", http.StatusUnsupportedMediaType)
+		return
+	}
+	if text {
+		w.Header().Set("Content-Type", "application/grpc-web-text+proto")
+	} else {
+		w.Header().Set("Content-Type", "application/grpc-web+proto")
+	}
+	method := grpcWebMethod(strings.TrimPrefix(r.URL.Path, grpcWebPrefix))
+	if method == nil {
+		writeGRPCStatus(w, grpcUnimplemented, "方法 "+r.URL.Path+" 不存在")
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxGRPCWebBody+1))
+	if err != nil {
+		writeGRPCStatus(w, grpcInvalidArgument, err.Error())
+		return
+	}
+	if len(body) > maxGRPCWebBody {
+		writeGRPCStatus(w, grpcResourceExhausted, "请求超过 4MB")
+		return
+	}
+	if text {
+		if body, err = decodeGRPCWebText(body); err != nil {
+			writeGRPCStatus(w, grpcInvalidArgument, err.Error())
+			return
+		}
+	}
+	data, err := readGRPCWebFrame(body)
+	if err != nil {
+		writeGRPCStatus(w, grpcInvalidArgument, err.Error())
+		return
+	}
+	req, rsp, err := newGRPCWebMessages(method)
+	if err != nil {
+		writeGRPCStatus(w, grpcInternal, err.Error())
+		return
+	}
+	if err := proto.Unmarshal(data, req); err != nil {
+		writeGRPCStatus(w, grpcInvalidArgument, err.Error())
+		return
+	}
+	ctx, cancel := g.callContext(r)
+	defer cancel()
+	endpoint := string(method.Parent().Name()) + "." + string(method.Name())
+	if err := g.Client.Call(ctx, g.Client.NewRequest(g.ServiceName, endpoint, req), rsp); err != nil {
+		code, message := grpcWebError(err)
+		if seconds, ok := retryAfter(message); ok {
+			w.Header().Set("Retry-After", seconds)
+		}
+		if errorCode := wrapper.ErrorCode(err); errorCode != "" {
+			w.Header().Set(wrapper.ErrorCodeHeader, errorCode)
+		}
+		writeGRPCStatus(w, code, message)
+		return
+	}
+	out, err := proto.Marshal(rsp)
+	if err != nil {
+		writeGRPCStatus(w, grpcInternal, err.Error())
+		return
+	}
+	frames := appendGRPCWebFrame(nil, 0, out)
+	frames = appendGRPCWebFrame(frames, 0x80, []byte("grpc-status:0\r\ngrpc-message:\r\n"))
+	if text {
+		frames = []byte(base64.StdEncoding.EncodeToString(frames))
+	}
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(frames); err != nil {
+		common.Error(err)
+	}
+}
+
+// 只开放 Route 服务中的一元方法
+func grpcWebMethod(name string) protoreflect.MethodDescriptor {
+	service := route.File_proto_route_route_proto.Services().ByName("Route")
+	if service == nil {
+		return nil
+	}
+	method := service.Methods().ByName(protoreflect.Name(name))
+	if method == nil || method.IsStreamingClient() || method.IsStreamingServer() {
+		return nil
+	}
+	return method
+}
+
+func newGRPCWebMessages(method protoreflect.MethodDescriptor) (proto.Message, proto.Message, error) {
+	in, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	out, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	return in.New().Interface(), out.New().Interface(), nil
+}
+
+// grpc-timeout 的格式为数字加单位，如 10S、500m
+func parseGRPCTimeout(v string) (time.Duration, bool) {
+	if len(v) < 2 {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(v[:len(v)-1], 10, 64)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	units := map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second, 'm': time.Millisecond, 'u': time.Microsecond, 'n': time.Nanosecond}
+	unit, ok := units[v[len(v)-1]]
+	if !ok {
+		return 0, false
+	}
+	return time.Duration(n) * unit, true
+}
+
+// 客户端可能分多段发送，每段单独补齐
+func decodeGRPCWebText(body []byte) ([]byte, error) {
+	body = bytes.Join(bytes.Fields(body), nil)
+	out := []byte{}
+	for len(body) > 0 {
+		end := bytes.IndexByte(body, '=')
+		if end < 0 {
+			end = len(body)
+		} else {
+			for end < len(body) && body[end] == '=' {
+				end++
+			}
+		}
+		chunk, err := base64.StdEncoding.DecodeString(string(body[:end]))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, chunk...)
+		body = body[end:]
+	}
+	return out, nil
+}
+
+// 一元调用只有一个数据帧
+func readGRPCWebFrame(body []byte) ([]byte, error) {
+	if len(body) < 5 {
+		return nil, errors.New("grpc-web 消息帧不完整")
+	}
+	if body[0]&1 != 0 {
+		return nil, errors.New("不支持压缩的 grpc-web 消息")
+	}
+	size := binary.BigEndian.Uint32(body[1:5])
+	if uint64(len(body)-5) < uint64(size) {
+		return nil, errors.New("grpc-web 消息帧不完整")
+	}
+	return body[5 : 5+size], nil
+}
+
+func appendGRPCWebFrame(dst []byte, flag byte, data []byte) []byte {
+	header := make([]byte, 5)
+	header[0] = flag
+	binary.BigEndian.PutUint32(header[1:], uint32(len(data)))
+	return append(append(dst, header...), data...)
+}
+
+// 失败时只返回状态头，不返回消息体
+func writeGRPCStatus(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Grpc-Status", strconv.Itoa(code))
+	w.Header().Set("Grpc-Message", url.PathEscape(message))
+	w.WriteHeader(http.StatusOK)
+}
+
+// go-micro 的错误码沿用 HTTP 状态码，转换为对应的 gRPC 状态码
+func grpcWebError(err error) (int, string) {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return grpcDeadlineExceeded, err.Error()
+	}
+	e := microerrors.Parse(err.Error())
+	message := e.Detail
+	if message == "" {
+		message = err.Error()
+	}
+	switch e.Code {
+	case http.StatusBadRequest:
+		return grpcInvalidArgument, message
+	case http.StatusUnauthorized:
+		return grpcUnauthenticated, message
+	case http.StatusForbidden:
+		return grpcPermissionDenied, message
+	case http.StatusNotFound:
+		return grpcNotFound, message
+	case http.StatusRequestTimeout:
+		return grpcDeadlineExceeded, message
+	case http.StatusConflict:
+		return grpcAborted, message
+	case http.StatusPreconditionFailed:
+		return grpcFailedPrecondition, message
+	case http.StatusTooManyRequests:
+		return grpcResourceExhausted, message
+	case http.StatusNotImplemented:
+		return grpcUnimplemented, message
+	case http.StatusServiceUnavailable:
+		return grpcUnavailable, message
+	case http.StatusInternalServerError:
+		return grpcInternal, message
+	}
+	return grpcUnknown, message
+}
@@ -0,0 +1,354 @@
+package gateway
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OIDCConfig 网关的 OIDC 登录配置，Issuer 为空时不开启登录
+type OIDCConfig struct {
+	Issuer       string   `json:"issuer"`
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret"`
+	RedirectURL  string   `json:"redirect_url"`
+	Scopes       []string `json:"scopes"`
+	// RoleClaim 用于映射角色的 claim，默认 groups，值可以是字符串或字符串列表
+	RoleClaim string `json:"role_claim"`
+	// RoleMapping claim 的值到角色（viewer、editor、admin）的映射，匹配多个时取最高的角色
+	RoleMapping map[string]string `json:"role_mapping"`
+	// DefaultRole 没有匹配到角色时使用，为空时拒绝登录
+	DefaultRole string `json:"default_role"`
+	// SessionSecret 会话 cookie 的签名密钥，多副本部署时必须一致
+	SessionSecret     string `json:"session_secret"`
+	SessionTTLSeconds int64  `json:"session_ttl_seconds"`
+	// PublicPaths 不需要登录的路径前缀，默认只有 /metrics
+	PublicPaths []string `json:"public_paths"`
+	// AdminPaths 需要 admin 角色的路径前缀
+	AdminPaths []string `json:"admin_paths"`
+}
+
+func (c OIDCConfig) enabled() bool {
+	return c.Issuer != ""
+}
+
+func (c OIDCConfig) scopes() []string {
+	if len(c.Scopes) > 0 {
+		return c.Scopes
+	}
+	return []string{"openid", "profile", "email", "groups"}
+}
+
+func (c OIDCConfig) roleClaim() string {
+	if c.RoleClaim != "" {
+		return c.RoleClaim
+	}
+	return "groups"
+}
+
+func (c OIDCConfig) publicPaths() []string {
+	if c.PublicPaths != nil {
+		return c.PublicPaths
+	}
+	return []string{"/metrics"}
+}
+
+func (c OIDCConfig) sessionTTL() time.Duration {
+	if c.SessionTTLSeconds > 0 {
+		return time.Duration(c.SessionTTLSeconds) * time.Second
+	}
+	return 8 * time.Hour
+}
+
+func (c OIDCConfig) check() error {
+	if !c.enabled() {
+		return nil
+	}
+	if c.ClientID == "" || c.RedirectURL == "" {
+		return errors.New("OIDC 登录需要配置 client_id 和 redirect_url")
+	}
+	if len(c.SessionSecret) < 32 {
+		return errors.New("OIDC 会话密钥 session_secret 至少 32 个字符")
+	}
+	for k, v := range c.RoleMapping {
+		if roleLevel(v) == 0 {
+			return errors.New("角色映射 " + k + " 的角色 " + v + " 不存在")
+		}
+	}
+	if c.DefaultRole != "" && roleLevel(c.DefaultRole) == 0 {
+		return errors.New("默认角色 " + c.DefaultRole + " 不存在")
+	}
+	return nil
+}
+
+// 网关角色，权限依次递增
+const (
+	RoleViewer = "viewer"
+	RoleEditor = "editor"
+	RoleAdmin  = "admin"
+)
+
+func roleLevel(role string) int {
+	switch role {
+	case RoleViewer:
+		return 1
+	case RoleEditor:
+		return 2
+	case RoleAdmin:
+		return 3
+	}
+	return 0
+}
+
+// 按 claim 映射角色，匹配多个时取最高的
+func (c OIDCConfig) mapRole(claims map[string]interface{}) string {
+	values := []string{}
+	switch v := claims[c.roleClaim()].(type) {
+	case string:
+		values = append(values, v)
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	role := ""
+	for _, v := range values {
+		if mapped := c.RoleMapping[v]; roleLevel(mapped) > roleLevel(role) {
+			role = mapped
+		}
+	}
+	if role == "" {
+		role = c.DefaultRole
+	}
+	return role
+}
+
+// oidcProvider 通过 discovery 获取端点，签名公钥按 kid 缓存，遇到未知 kid 时刷新
+type oidcProvider struct {
+	config OIDCConfig
+	client *http.Client
+
+	mu                    sync.Mutex
+	authorizationEndpoint string
+	tokenEndpoint         string
+	jwksURI               string
+	keys                  map[string]*rsa.PublicKey
+}
+
+func newOIDCProvider(config OIDCConfig) *oidcProvider {
+	return &oidcProvider{config: config, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (p *oidcProvider) getJSON(ctx context.Context, rawURL string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	rsp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return errors.New("请求 " + rawURL + " 失败：" + rsp.Status)
+	}
+	return json.NewDecoder(rsp.Body).Decode(out)
+}
+
+func (p *oidcProvider) discover(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.tokenEndpoint != "" {
+		return nil
+	}
+	var doc struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		JwksURI               string `json:"jwks_uri"`
+	}
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.config.Issuer, "/")+"/.well-known/openid-configuration", &doc); err != nil {
+		return err
+	}
+	if doc.Issuer != p.config.Issuer {
+		return errors.New("OIDC issuer 不一致：" + doc.Issuer)
+	}
+	p.authorizationEndpoint, p.tokenEndpoint, p.jwksURI = doc.AuthorizationEndpoint, doc.TokenEndpoint, doc.JwksURI
+	return nil
+}
+
+// 登录跳转地址
+func (p *oidcProvider) authCodeURL(ctx context.Context, state string, nonce string) (string, error) {
+	if err := p.discover(ctx); err != nil {
+		return "", err
+	}
+	values := url.Values{
+		"response_type": {"code"},
+		"client_id":     {p.config.ClientID},
+		"redirect_uri":  {p.config.RedirectURL},
+		"scope":         {strings.Join(p.config.scopes(), " ")},
+		"state":         {state},
+		"nonce":         {nonce},
+	}
+	return p.authorizationEndpoint + "?" + values.Encode(), nil
+}
+
+// 用授权码换取 id_token 并校验，返回其中的 claims
+func (p *oidcProvider) exchange(ctx context.Context, code string, nonce string) (map[string]interface{}, error) {
+	if err := p.discover(ctx); err != nil {
+		return nil, err
+	}
+	form := url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {p.config.RedirectURL},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.tokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	rsp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return nil, errors.New("换取令牌失败：" + rsp.Status)
+	}
+	var token struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.NewDecoder(rsp.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("令牌响应中没有 id_token")
+	}
+	return p.verify(ctx, token.IDToken, nonce)
+}
+
+// 校验 id_token 的签名（RS256）、issuer、audience、过期时间和 nonce
+func (p *oidcProvider) verify(ctx context.Context, idToken string, nonce string) (map[string]interface{}, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("id_token 格式错误")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+	if header.Alg != "RS256" {
+		return nil, errors.New("不支持的 id_token 签名算法：" + header.Alg)
+	}
+	key, err := p.publicKey(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, errors.New("id_token 签名校验失败")
+	}
+	claims := map[string]interface{}{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+	if claims["iss"] != p.config.Issuer {
+		return nil, errors.New("id_token 的 issuer 不一致")
+	}
+	if !audienceContains(claims["aud"], p.config.ClientID) {
+		return nil, errors.New("id_token 的 audience 不包含 " + p.config.ClientID)
+	}
+	if exp, ok := claims["exp"].(float64); !ok || time.Now().Unix() >= int64(exp) {
+		return nil, errors.New("id_token 已过期")
+	}
+	if claims["nonce"] != nonce {
+		return nil, errors.New("id_token 的 nonce 不一致")
+	}
+	return claims, nil
+}
+
+func (p *oidcProvider) publicKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	key, ok := p.keys[kid]
+	jwksURI := p.jwksURI
+	p.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+	var jwks struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := p.getJSON(ctx, jwksURI, &jwks); err != nil {
+		return nil, err
+	}
+	keys := map[string]*rsa.PublicKey{}
+	for _, v := range jwks.Keys {
+		if v.Kty != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(v.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(v.E)
+		if err != nil {
+			continue
+		}
+		keys[v.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	return nil, errors.New("找不到 id_token 的签名公钥 " + kid)
+}
+
+func decodeSegment(segment string, out interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+func audienceContains(aud interface{}, clientID string) bool {
+	switch v := aud.(type) {
+	case string:
+		return v == clientID
+	case []interface{}:
+		for _, item := range v {
+			if item == clientID {
+				return true
+			}
+		}
+	}
+	return false
+}
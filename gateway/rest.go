@@ -0,0 +1,177 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	microerrors "github.com/asim/go-micro/v3/errors"
+	"github.com/asim/go-micro/v3/metadata"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/proto/route"
+	"github.com/zxnlx/route/wrapper"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// 转发给 Route 服务的请求头，X-Actor 由网关根据登录用户签名后填写，不接受浏览器传入
+var forwardHeaders = []string{"Authorization", "X-Api-Key", "X-Emergency-Override", "Accept-Language"}
+
+// 转发认证相关的请求头，登录网关的用户作为调用方身份，按 grpc-timeout 设置超时
+func (g *Gateway) callContext(r *http.Request) (context.Context, context.CancelFunc) {
+	md := metadata.Metadata{}
+	for _, k := range forwardHeaders {
+		if v := r.Header.Get(k); v != "" {
+			md[k] = v
+		}
+	}
+	if session, ok := SessionFromContext(r.Context()); ok {
+		actor := session.Email
+		if actor == "" {
+			actor = session.Subject
+		}
+		//签名失败时不传入身份，按未认证的调用方鉴权
+		if err := g.assertion.Sign(r.Context(), md, actor); err != nil {
+			common.Error(err)
+		}
+	}
+	ctx := metadata.NewContext(r.Context(), md)
+	if timeout, ok := parseGRPCTimeout(r.Header.Get("Grpc-Timeout")); ok {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// 调用 Route 服务，和 gRPC 调用经过相同的鉴权、校验和事件记录
+func (g *Gateway) call(r *http.Request, method string, req interface{}, rsp interface{}) error {
+	ctx, cancel := g.callContext(r)
+	defer cancel()
+	return g.Client.Call(ctx, g.Client.NewRequest(g.ServiceName, "Route."+method, req), rsp)
+}
+
+// GET /v1/routes?page_size=100&page_token=&fields=id,route_name,status 路由列表，不带分页参数时返回全部，支持 If-None-Match
+// POST /v1/routes 创建路由
+func (g *Gateway) routes(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		if g.checkNotModified(w, r) {
+			return
+		}
+		query := r.URL.Query()
+		req := &route.FindAll{PageToken: query.Get("page_token")}
+		if v := query.Get("fields"); v != "" {
+			req.Fields = strings.Split(v, ",")
+		}
+		if v := query.Get("page_size"); v != "" {
+			size, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				http.Error(w, "page_size 必须是数字", http.StatusBadRequest)
+				return
+			}
+			req.PageSize = int32(size)
+		}
+		rsp := &route.AllRoute{}
+		if err := g.call(r, "FindAllRoute", req, rsp); err != nil {
+			w.Header().Del("ETag")
+			writeCallError(w, err)
+			return
+		}
+		writeJSON(w, rsp)
+	case http.MethodPost:
+		info := &route.RouteInfo{}
+		if err := json.NewDecoder(r.Body).Decode(info); err != nil {
+			http.Error(w, "请求格式不正确："+err.Error(), http.StatusBadRequest)
+			return
+		}
+		rsp := &route.Response{}
+		if err := g.call(r, "AddRoute", info, rsp); err != nil {
+			writeCallError(w, err)
+			return
+		}
+		writeJSON(w, rsp)
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// GET /v1/routes/{id} 路由详情
+// PUT /v1/routes/{id} 更新路由
+// GET /v1/routes/{id}/diff 和线上资源的差异
+func (g *Gateway) routeByID(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/routes/"), "/"), "/")
+	id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || id <= 0 || len(parts) > 2 || len(parts) == 2 && parts[1] != "diff" {
+		http.NotFound(w, r)
+		return
+	}
+	switch {
+	case len(parts) == 2 && r.Method == http.MethodGet:
+		rsp := &route.RouteDiff{}
+		if err := g.call(r, "DiffRoute", &route.RouteId{Id: id}, rsp); err != nil {
+			writeCallError(w, err)
+			return
+		}
+		writeJSON(w, rsp)
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		rsp := &route.RouteInfo{}
+		if err := g.call(r, "FindRouteByID", &route.RouteId{Id: id}, rsp); err != nil {
+			writeCallError(w, err)
+			return
+		}
+		writeJSON(w, rsp)
+	case len(parts) == 1 && r.Method == http.MethodPut:
+		info := &route.RouteInfo{}
+		if err := json.NewDecoder(r.Body).Decode(info); err != nil {
+			http.Error(w, "请求格式不正确："+err.Error(), http.StatusBadRequest)
+			return
+		}
+		info.Id = id
+		rsp := &route.Response{}
+		if err := g.call(r, "UpdateRoute", info, rsp); err != nil {
+			writeCallError(w, err)
+			return
+		}
+		writeJSON(w, rsp)
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		common.Error(err)
+	}
+}
+
+// Route 服务返回的错误码沿用 HTTP 状态码，无法解析时按 500 返回
+func writeCallError(w http.ResponseWriter, err error) {
+	e := microerrors.Parse(err.Error())
+	code := int(e.Code)
+	if code < 400 || code > 599 {
+		code = http.StatusInternalServerError
+	}
+	message := e.Detail
+	if message == "" {
+		message = err.Error()
+	}
+	if seconds, ok := retryAfter(message); ok {
+		w.Header().Set("Retry-After", seconds)
+	}
+	if errorCode := wrapper.ErrorCode(err); errorCode != "" {
+		w.Header().Set(wrapper.ErrorCodeHeader, errorCode)
+	}
+	http.Error(w, message, code)
+}
+
+// 写入队列已满等错误的详情末尾带有重试间隔
+func retryAfter(message string) (string, bool) {
+	i := strings.LastIndex(message, wrapper.RetryAfterPrefix)
+	if i < 0 {
+		return "", false
+	}
+	seconds := message[i+len(wrapper.RetryAfterPrefix):]
+	if _, err := strconv.Atoi(seconds); err != nil {
+		return "", false
+	}
+	return seconds, true
+}
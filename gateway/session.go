@@ -0,0 +1,260 @@
+package gateway
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"github.com/zxnlx/common"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	sessionCookie = "route_session"
+	// 登录过程中保存 state、nonce 和登录后跳转的地址
+	loginCookie = "route_oidc_login"
+	loginTTL    = 10 * time.Minute
+)
+
+// Session 登录用户，签名后保存在 cookie 中
+type Session struct {
+	Subject   string `json:"sub"`
+	Email     string `json:"email,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Role      string `json:"role"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+type loginState struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Redirect string `json:"redirect"`
+	Expires  int64  `json:"exp"`
+}
+
+type sessionKey struct{}
+
+// SessionFromContext 网关处理函数中获取登录用户，未开启登录时返回 false
+func SessionFromContext(ctx context.Context) (*Session, bool) {
+	session, ok := ctx.Value(sessionKey{}).(*Session)
+	return session, ok
+}
+
+// 内容为 base64(json).hex(hmac-sha256)
+func (g *Gateway) sign(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	mac := hmac.New(sha256.New, []byte(g.Config.OIDC.SessionSecret))
+	mac.Write([]byte(payload))
+	return payload + "." + hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+func (g *Gateway) unsign(value string, v interface{}) error {
+	i := strings.LastIndex(value, ".")
+	if i < 0 {
+		return errors.New("cookie 格式错误")
+	}
+	mac := hmac.New(sha256.New, []byte(g.Config.OIDC.SessionSecret))
+	mac.Write([]byte(value[:i]))
+	expected := hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(value[i+1:])) {
+		return errors.New("cookie 签名错误")
+	}
+	return decodeSegment(value[:i], v)
+}
+
+func (g *Gateway) setCookie(w http.ResponseWriter, name string, v interface{}, ttl time.Duration) error {
+	value, err := g.sign(v)
+	if err != nil {
+		return err
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   int(ttl.Seconds()),
+		HttpOnly: true,
+		Secure:   strings.HasPrefix(g.Config.OIDC.RedirectURL, "https://"),
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+func clearCookie(w http.ResponseWriter, name string) {
+	http.SetCookie(w, &http.Cookie{Name: name, Value: "", Path: "/", MaxAge: -1, HttpOnly: true})
+}
+
+func (g *Gateway) session(r *http.Request) (*Session, bool) {
+	cookie, err := r.Cookie(sessionCookie)
+	if err != nil {
+		return nil, false
+	}
+	session := &Session{}
+	if err := g.unsign(cookie.Value, session); err != nil {
+		return nil, false
+	}
+	if time.Now().Unix() >= session.ExpiresAt {
+		return nil, false
+	}
+	return session, true
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// 只允许跳转到本站的相对路径
+func safeRedirect(target string) string {
+	if !strings.HasPrefix(target, "/") || strings.HasPrefix(target, "//") || strings.HasPrefix(target, "/\\") {
+		return "/"
+	}
+	return target
+}
+
+// GET /auth/login?redirect=/v1/... 跳转到 OIDC 登录页
+func (g *Gateway) login(w http.ResponseWriter, r *http.Request) {
+	state, err := randomToken()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	target, err := g.oidc.authCodeURL(r.Context(), state, nonce)
+	if err != nil {
+		common.Error(err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	login := loginState{State: state, Nonce: nonce, Redirect: safeRedirect(r.URL.Query().Get("redirect")), Expires: time.Now().Add(loginTTL).Unix()}
+	if err := g.setCookie(w, loginCookie, login, loginTTL); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, target, http.StatusFound)
+}
+
+// GET /auth/callback OIDC 登录回调，校验 state 后换取 id_token 并建立会话
+func (g *Gateway) callback(w http.ResponseWriter, r *http.Request) {
+	cookie, err := r.Cookie(loginCookie)
+	if err != nil {
+		http.Error(w, "登录已过期，请重新登录", http.StatusBadRequest)
+		return
+	}
+	login := loginState{}
+	if err := g.unsign(cookie.Value, &login); err != nil || time.Now().Unix() >= login.Expires {
+		http.Error(w, "登录已过期，请重新登录", http.StatusBadRequest)
+		return
+	}
+	clearCookie(w, loginCookie)
+	query := r.URL.Query()
+	if e := query.Get("error"); e != "" {
+		http.Error(w, "登录失败："+e+" "+query.Get("error_description"), http.StatusUnauthorized)
+		return
+	}
+	if !hmac.Equal([]byte(query.Get("state")), []byte(login.State)) {
+		http.Error(w, "登录 state 不一致", http.StatusBadRequest)
+		return
+	}
+	claims, err := g.oidc.exchange(r.Context(), query.Get("code"), login.Nonce)
+	if err != nil {
+		common.Error(err)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	session := &Session{Role: g.Config.OIDC.mapRole(claims), ExpiresAt: time.Now().Add(g.Config.OIDC.sessionTTL()).Unix()}
+	session.Subject, _ = claims["sub"].(string)
+	session.Email, _ = claims["email"].(string)
+	session.Name, _ = claims["name"].(string)
+	if session.Role == "" {
+		common.Info("用户 " + session.Subject + " 没有匹配的角色，拒绝登录")
+		http.Error(w, "没有访问权限", http.StatusForbidden)
+		return
+	}
+	if err := g.setCookie(w, sessionCookie, session, g.Config.OIDC.sessionTTL()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	common.Info("用户 " + session.Subject + " 登录网关，角色 " + session.Role)
+	http.Redirect(w, r, login.Redirect, http.StatusFound)
+}
+
+// POST /auth/logout 退出登录
+func (g *Gateway) logout(w http.ResponseWriter, r *http.Request) {
+	clearCookie(w, sessionCookie)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GET /auth/me 当前登录用户
+func (g *Gateway) me(w http.ResponseWriter, r *http.Request) {
+	session, _ := SessionFromContext(r.Context())
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(session)
+}
+
+// 读取请求和登录相关接口需要 viewer，其余需要 editor，AdminPaths 下需要 admin
+func (g *Gateway) requiredRole(r *http.Request) string {
+	//grpc-web 请求由 Route 服务按调用方鉴权
+	if strings.HasPrefix(r.URL.Path, "/auth/") || strings.HasPrefix(r.URL.Path, grpcWebPrefix) {
+		return RoleViewer
+	}
+	for _, v := range g.Config.OIDC.AdminPaths {
+		if strings.HasPrefix(r.URL.Path, v) {
+			return RoleAdmin
+		}
+	}
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return RoleViewer
+	}
+	return RoleEditor
+}
+
+// 未开启 OIDC 时直接放行；页面请求未登录时跳转登录，接口请求返回 401
+func (g *Gateway) authenticate(next http.Handler) http.Handler {
+	if !g.Config.OIDC.enabled() {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/login" || r.URL.Path == "/auth/callback" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		for _, v := range g.Config.OIDC.publicPaths() {
+			if strings.HasPrefix(r.URL.Path, v) {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+		session, ok := g.session(r)
+		if !ok {
+			if r.Method == http.MethodGet && strings.Contains(r.Header.Get("Accept"), "text/html") {
+				http.Redirect(w, r, "/auth/login?redirect="+url.QueryEscape(r.URL.RequestURI()), http.StatusFound)
+				return
+			}
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		if roleLevel(session.Role) < roleLevel(g.requiredRole(r)) {
+			http.Error(w, "角色 "+session.Role+" 没有权限", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), sessionKey{}, session)))
+	})
+}
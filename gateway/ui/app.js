@@ -0,0 +1,265 @@
+// 简易路由管理页面，通过网关的 /v1/routes 接口读写，权限和 gRPC 调用相同
+(function () {
+  "use strict";
+
+  var $ = function (id) { return document.getElementById(id); };
+  var routes = [];
+  var current = null;
+  // 保存后跳转页面再显示的提示
+  var flash = "";
+
+  var apiKey = $("api-key");
+  apiKey.value = localStorage.getItem("route-api-key") || "";
+  apiKey.addEventListener("change", function () {
+    localStorage.setItem("route-api-key", apiKey.value);
+  });
+
+  function request(method, path, body) {
+    var headers = {};
+    if (apiKey.value) {
+      headers["X-Api-Key"] = apiKey.value;
+    }
+    if (body !== undefined) {
+      headers["Content-Type"] = "application/json";
+    }
+    return fetch(path, {
+      method: method,
+      headers: headers,
+      credentials: "same-origin",
+      body: body === undefined ? undefined : JSON.stringify(body)
+    }).then(function (rsp) {
+      if (rsp.status === 401) {
+        location.href = "/auth/login?redirect=" + encodeURIComponent(location.pathname + location.hash);
+      }
+      if (!rsp.ok) {
+        return rsp.text().then(function (text) { throw new Error(text || rsp.statusText); });
+      }
+      return rsp.json();
+    });
+  }
+
+  function showMessage(text, error) {
+    var el = $("message");
+    el.textContent = text;
+    el.className = error ? "message error" : "message";
+    el.hidden = !text;
+  }
+
+  function show(view) {
+    ["list-view", "detail-view", "form-view"].forEach(function (id) {
+      $(id).hidden = id !== view;
+    });
+  }
+
+  function cell(tr, text, className) {
+    var td = document.createElement("td");
+    td.textContent = text === undefined || text === null ? "" : String(text);
+    if (className) {
+      td.className = className;
+    }
+    tr.appendChild(td);
+    return td;
+  }
+
+  // 停用、最近一次写入失败、正常
+  function status(r) {
+    if (r.route_disabled) {
+      return { text: "已停用", className: "status-disabled" };
+    }
+    if (r.route_last_error) {
+      return { text: "失败 " + (r.route_consecutive_failures || 1) + " 次：" + r.route_last_error, className: "status-error" };
+    }
+    return { text: "正常", className: "status-ok" };
+  }
+
+  function renderList() {
+    var keyword = $("filter").value.trim().toLowerCase();
+    var tbody = $("routes");
+    tbody.textContent = "";
+    routes.filter(function (r) {
+      return !keyword || [r.route_name, r.route_namespace, r.route_host].some(function (v) {
+        return (v || "").toLowerCase().indexOf(keyword) >= 0;
+      });
+    }).forEach(function (r) {
+      var tr = document.createElement("tr");
+      tr.className = "clickable";
+      tr.addEventListener("click", function () { location.hash = "#/routes/" + r.id; });
+      cell(tr, r.route_name);
+      cell(tr, r.route_namespace);
+      cell(tr, r.route_host);
+      cell(tr, r.route_class);
+      cell(tr, r.route_revision);
+      var s = status(r);
+      cell(tr, s.text, s.className);
+      cell(tr, r.route_updated_by || r.route_created_by);
+      tbody.appendChild(tr);
+    });
+  }
+
+  // 列表只需要的字段，不返回路径和注解
+  var listFields = ["route_name", "route_namespace", "route_host", "route_class", "route_revision", "route_created_by", "route_updated_by", "status"];
+
+  function loadList() {
+    show("list-view");
+    return request("GET", "/v1/routes?fields=" + listFields.join(",")).then(function (rsp) {
+      routes = (rsp.route_info || []).sort(function (a, b) {
+        return (a.route_namespace + "/" + a.route_name).localeCompare(b.route_namespace + "/" + b.route_name);
+      });
+      renderList();
+    });
+  }
+
+  function loadDetail(id) {
+    show("detail-view");
+    $("diff").hidden = true;
+    return request("GET", "/v1/routes/" + id).then(function (r) {
+      current = r;
+      $("detail-title").textContent = r.route_namespace + "/" + r.route_name;
+      $("edit-link").href = "#/routes/" + r.id + "/edit";
+      var dl = $("detail");
+      dl.textContent = "";
+      var s = status(r);
+      [
+        ["域名", r.route_host],
+        ["Ingress class", r.route_class],
+        ["证书签发", r.route_tls_issuer],
+        ["版本", r.route_revision],
+        ["状态", s.text],
+        ["最近写入", r.route_last_apply_time ? new Date(r.route_last_apply_time * 1000).toLocaleString() : ""],
+        ["负责团队", r.route_owner_team],
+        ["创建人", r.route_created_by],
+        ["最后修改", r.route_updated_by]
+      ].forEach(function (v) {
+        var dt = document.createElement("dt");
+        dt.textContent = v[0];
+        var dd = document.createElement("dd");
+        dd.textContent = v[1] === undefined ? "" : String(v[1]);
+        dl.appendChild(dt);
+        dl.appendChild(dd);
+      });
+      var tbody = $("detail-paths");
+      tbody.textContent = "";
+      (r.route_path || []).forEach(function (p) {
+        var tr = document.createElement("tr");
+        cell(tr, p.route_path_name);
+        cell(tr, p.route_backend_service);
+        cell(tr, p.route_backend_service_port);
+        tbody.appendChild(tr);
+      });
+    });
+  }
+
+  function loadDiff() {
+    return request("GET", "/v1/routes/" + current.id + "/diff").then(function (d) {
+      $("diff").hidden = false;
+      $("diff-summary").textContent = d.live_missing ? "线上 " + d.kind + " 不存在" : d.in_sync ? "和线上一致" : "有 " + (d.changes || []).length + " 处差异";
+      var tbody = $("diff-changes");
+      tbody.textContent = "";
+      (d.changes || []).forEach(function (c) {
+        var tr = document.createElement("tr");
+        cell(tr, c.path);
+        cell(tr, c.op);
+        cell(tr, c.desired, "value");
+        cell(tr, c.live, "value");
+        cell(tr, c.manager);
+        tbody.appendChild(tr);
+      });
+    });
+  }
+
+  function addPathRow(p) {
+    var row = $("path-row").content.firstElementChild.cloneNode(true);
+    if (p) {
+      row.querySelector("[name=route_path_name]").value = p.route_path_name || "/";
+      row.querySelector("[name=route_backend_service]").value = p.route_backend_service || "";
+      row.querySelector("[name=route_backend_service_port]").value = p.route_backend_service_port || "";
+    }
+    row.querySelector(".remove-path").addEventListener("click", function () { row.remove(); });
+    $("form-paths").appendChild(row);
+  }
+
+  var textFields = ["route_name", "route_namespace", "route_host", "route_class", "route_tls_issuer", "route_owner_team"];
+
+  // 编辑时在原有规格上修改，表单中没有的字段保持不变
+  function showForm(r) {
+    show("form-view");
+    current = r;
+    var form = $("route-form");
+    $("form-title").textContent = r.id ? "编辑 " + r.route_namespace + "/" + r.route_name : "新建路由";
+    $("cancel-link").href = r.id ? "#/routes/" + r.id : "#/";
+    textFields.forEach(function (k) { form.elements[k].value = r[k] || ""; });
+    form.elements.route_name.readOnly = !!r.id;
+    form.elements.route_namespace.readOnly = !!r.id;
+    form.elements.route_disabled.checked = !!r.route_disabled;
+    $("form-paths").textContent = "";
+    (r.route_path && r.route_path.length ? r.route_path : [null]).forEach(addPathRow);
+  }
+
+  function submitForm(e) {
+    e.preventDefault();
+    var form = $("route-form");
+    var r = Object.assign({}, current);
+    textFields.forEach(function (k) { r[k] = form.elements[k].value.trim(); });
+    r.route_disabled = form.elements.route_disabled.checked;
+    var old = current.route_path || [];
+    r.route_path = Array.prototype.map.call($("form-paths").rows, function (row) {
+      var name = row.querySelector("[name=route_path_name]").value.trim();
+      var p = Object.assign({}, old.find(function (v) { return v.route_path_name === name; }) || {});
+      p.route_path_name = name;
+      p.route_backend_service = row.querySelector("[name=route_backend_service]").value.trim();
+      p.route_backend_service_port = parseInt(row.querySelector("[name=route_backend_service_port]").value, 10);
+      return p;
+    });
+    var call = r.id ? request("PUT", "/v1/routes/" + r.id, r) : request("POST", "/v1/routes", r);
+    call.then(function (rsp) {
+      flash = rsp.msg || "已保存";
+      location.hash = r.id ? "#/routes/" + r.id : "#/";
+    }).catch(function (err) { showMessage(err.message, true); });
+  }
+
+  function route() {
+    var hash = location.hash.replace(/^#/, "") || "/";
+    var m;
+    var done;
+    if (hash === "/new") {
+      showForm({});
+      return;
+    } else if ((m = hash.match(/^\/routes\/(\d+)\/edit$/))) {
+      done = request("GET", "/v1/routes/" + m[1]).then(showForm);
+    } else if ((m = hash.match(/^\/routes\/(\d+)$/))) {
+      done = loadDetail(m[1]);
+    } else {
+      done = loadList();
+    }
+    done.catch(function (err) { showMessage(err.message, true); });
+  }
+
+  // 有路由事件时刷新列表
+  function watchEvents() {
+    if (!window.EventSource) {
+      return;
+    }
+    var source = new EventSource("/v1/events/stream", { withCredentials: true });
+    source.onopen = function () { $("live").textContent = "实时更新"; };
+    source.onerror = function () { $("live").textContent = "实时更新已断开，正在重连"; };
+    source.onmessage = function () {
+      if (!$("list-view").hidden) {
+        loadList().catch(function (err) { showMessage(err.message, true); });
+      }
+    };
+  }
+
+  $("filter").addEventListener("input", renderList);
+  $("diff-button").addEventListener("click", function () {
+    loadDiff().catch(function (err) { showMessage(err.message, true); });
+  });
+  $("add-path").addEventListener("click", function () { addPathRow(null); });
+  $("route-form").addEventListener("submit", submitForm);
+  window.addEventListener("hashchange", function () {
+    showMessage(flash);
+    flash = "";
+    route();
+  });
+  route();
+  watchEvents();
+})();
@@ -0,0 +1,14 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/metadata"
+)
+
+// 调用方身份，X-Actor 由鉴权 wrapper 校验 API 密钥或网关签名后填写，没有校验过的身份时为 unknown
+func actorFromContext(ctx context.Context) string {
+	if actor, ok := metadata.Get(ctx, "X-Actor"); ok && actor != "" {
+		return actor
+	}
+	return "unknown"
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/proto/route"
+)
+
+// AdoptIngresses 批量接管已存在的 Ingress
+func (e *RouteHandler) AdoptIngresses(ctx context.Context, req *route.AdoptIngressesRequest, rsp *route.AdoptIngressesResponse) error {
+	log.Info("Received *route.AdoptIngresses request")
+	if req.RouteNamespace == "" {
+		err := i18n.NewError(i18n.CodeNamespaceRequired, "命名空间不能为空")
+		common.Error(err)
+		return err
+	}
+	if err := e.checkFreeze(ctx, req.RouteNamespace); err != nil {
+		common.Error(err)
+		return err
+	}
+	result, err := e.RouteDataService.AdoptIngresses(req.RouteNamespace, req.LabelSelector, actorFromContext(ctx))
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.Adopted = result.Adopted
+	rsp.Skipped = result.Skipped
+	return nil
+}
+
+// ReleaseRoute 交还手动管理
+func (e *RouteHandler) ReleaseRoute(ctx context.Context, req *route.RouteId, rsp *route.Response) error {
+	log.Info("Received *route.ReleaseRoute request")
+	routeModel, err := e.RouteDataService.FindRouteByID(req.Id)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	if err := e.checkFreeze(ctx, routeModel.RouteNamespace); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	if err := e.RouteDataService.ReleaseRoute(req.Id, actorFromContext(ctx)); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "Route " + routeModel.RouteNamespace + "/" + routeModel.RouteName + " 已交还手动管理"
+	return nil
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/proto/route"
+	"strconv"
+)
+
+// AddAnnotationTemplate 添加注解模板
+func (e *RouteHandler) AddAnnotationTemplate(ctx context.Context, info *route.AnnotationTemplateInfo, rsp *route.Response) error {
+	log.Info("Received *route.AddAnnotationTemplate request")
+	annotationTemplate := &model.AnnotationTemplate{}
+	if err := common.SwapTo(info, annotationTemplate); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	id, err := e.AnnotationTemplateDataService.AddAnnotationTemplate(annotationTemplate)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "注解模板添加成功 ID 号为：" + strconv.FormatInt(id, 10)
+	rsp.Id = id
+	return nil
+}
+
+// DeleteAnnotationTemplate 删除注解模板
+func (e *RouteHandler) DeleteAnnotationTemplate(ctx context.Context, req *route.AnnotationTemplateId, rsp *route.Response) error {
+	log.Info("Received *route.DeleteAnnotationTemplate request")
+	if err := e.AnnotationTemplateDataService.DeleteAnnotationTemplate(req.Id); err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// UpdateAnnotationTemplate 更新注解模板
+func (e *RouteHandler) UpdateAnnotationTemplate(ctx context.Context, req *route.AnnotationTemplateInfo, rsp *route.Response) error {
+	log.Info("Received *route.UpdateAnnotationTemplate request")
+	annotationTemplate, err := e.AnnotationTemplateDataService.FindAnnotationTemplateByID(req.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	if err := common.SwapTo(req, annotationTemplate); err != nil {
+		common.Error(err)
+		return err
+	}
+	return e.AnnotationTemplateDataService.UpdateAnnotationTemplate(annotationTemplate)
+}
+
+// FindAllAnnotationTemplate 查询所有注解模板
+func (e *RouteHandler) FindAllAnnotationTemplate(ctx context.Context, req *route.FindAll, rsp *route.AllAnnotationTemplate) error {
+	log.Info("Received *route.FindAllAnnotationTemplate request")
+	all, err := e.AnnotationTemplateDataService.FindAllAnnotationTemplate()
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, v := range all {
+		info := &route.AnnotationTemplateInfo{}
+		if err := common.SwapTo(v, info); err != nil {
+			common.Error(err)
+			return err
+		}
+		rsp.AnnotationTemplateInfo = append(rsp.AnnotationTemplateInfo, info)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/proto/route"
+)
+
+// CreateAPIKey 创建 API 密钥
+func (e *RouteHandler) CreateAPIKey(ctx context.Context, info *route.APIKeyInfo, rsp *route.CreateAPIKeyResponse) error {
+	log.Info("Received *route.CreateAPIKey request")
+	apiKey := &model.APIKey{}
+	if err := common.SwapTo(info, apiKey); err != nil {
+		common.Error(err)
+		return err
+	}
+	key, err := e.APIKeyDataService.CreateAPIKey(apiKey, actorFromContext(ctx))
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.Id = apiKey.ID
+	rsp.Key = key
+	rsp.KeyPrefix = apiKey.KeyPrefix
+	return nil
+}
+
+// RevokeAPIKey 吊销 API 密钥
+func (e *RouteHandler) RevokeAPIKey(ctx context.Context, req *route.APIKeyId, rsp *route.Response) error {
+	log.Info("Received *route.RevokeAPIKey request")
+	if err := e.APIKeyDataService.RevokeAPIKey(req.Id, actorFromContext(ctx)); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "API 密钥已吊销"
+	return nil
+}
+
+// ListAPIKeys 查询 API 密钥，不返回密钥本身
+func (e *RouteHandler) ListAPIKeys(ctx context.Context, req *route.FindAll, rsp *route.AllAPIKey) error {
+	log.Info("Received *route.ListAPIKeys request")
+	all, err := e.APIKeyDataService.ListAPIKeys()
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, v := range all {
+		info := &route.APIKeyInfo{}
+		if err := common.SwapTo(v, info); err != nil {
+			common.Error(err)
+			return err
+		}
+		rsp.ApiKeyInfo = append(rsp.ApiKeyInfo, info)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/proto/route"
+	"strconv"
+)
+
+// AddApplication 添加应用
+func (e *RouteHandler) AddApplication(ctx context.Context, info *route.ApplicationInfo, rsp *route.Response) error {
+	log.Info("Received *route.AddApplication request")
+	application := &model.Application{}
+	if err := common.SwapTo(info, application); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	id, err := e.ApplicationDataService.AddApplication(application)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "应用添加成功 ID 号为：" + strconv.FormatInt(id, 10)
+	rsp.Id = id
+	return nil
+}
+
+// DeleteApplication 删除应用以及应用下所有路由
+func (e *RouteHandler) DeleteApplication(ctx context.Context, req *route.ApplicationId, rsp *route.Response) error {
+	log.Info("Received *route.DeleteApplication request")
+	if err := e.checkApplicationFreeze(ctx, req.Id); err != nil {
+		common.Error(err)
+		return err
+	}
+	if err := e.ApplicationDataService.DeleteApplication(req.Id); err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// UpdateApplication 更新应用
+func (e *RouteHandler) UpdateApplication(ctx context.Context, req *route.ApplicationInfo, rsp *route.Response) error {
+	log.Info("Received *route.UpdateApplication request")
+	application, err := e.ApplicationDataService.FindApplicationByID(req.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	if err := common.SwapTo(req, application); err != nil {
+		common.Error(err)
+		return err
+	}
+	return e.ApplicationDataService.UpdateApplication(application)
+}
+
+// FindApplicationByID 根据ID查询应用
+func (e *RouteHandler) FindApplicationByID(ctx context.Context, req *route.ApplicationId, rsp *route.ApplicationInfo) error {
+	log.Info("Received *route.FindApplicationByID request")
+	application, err := e.ApplicationDataService.FindApplicationByID(req.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	if err := common.SwapTo(application, rsp); err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// FindAllApplication 查询所有应用
+func (e *RouteHandler) FindAllApplication(ctx context.Context, req *route.FindAll, rsp *route.AllApplication) error {
+	log.Info("Received *route.FindAllApplication request")
+	all, err := e.ApplicationDataService.FindAllApplication()
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, v := range all {
+		info := &route.ApplicationInfo{}
+		if err := common.SwapTo(v, info); err != nil {
+			common.Error(err)
+			return err
+		}
+		rsp.ApplicationInfo = append(rsp.ApplicationInfo, info)
+	}
+	return nil
+}
+
+// DisableApplication 禁用应用下所有路由
+func (e *RouteHandler) DisableApplication(ctx context.Context, req *route.ApplicationId, rsp *route.Response) error {
+	log.Info("Received *route.DisableApplication request")
+	if err := e.checkApplicationFreeze(ctx, req.Id); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	if err := e.ApplicationDataService.DisableApplication(req.Id); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "应用 ID：" + strconv.FormatInt(req.Id, 10) + " 已禁用"
+	return nil
+}
+
+// EnableApplication 启用应用下所有路由
+func (e *RouteHandler) EnableApplication(ctx context.Context, req *route.ApplicationId, rsp *route.Response) error {
+	log.Info("Received *route.EnableApplication request")
+	if err := e.checkApplicationFreeze(ctx, req.Id); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	if err := e.ApplicationDataService.EnableApplication(req.Id); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "应用 ID：" + strconv.FormatInt(req.Id, 10) + " 已启用"
+	return nil
+}
+
+// ExportApplication 导出应用下所有路由
+func (e *RouteHandler) ExportApplication(ctx context.Context, req *route.ApplicationId, rsp *route.AllRoute) error {
+	log.Info("Received *route.ExportApplication request")
+	routes, err := e.ApplicationDataService.ExportApplication(req.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, v := range routes {
+		routeInfo := &route.RouteInfo{}
+		if err := common.SwapTo(v, routeInfo); err != nil {
+			common.Error(err)
+			return err
+		}
+		rsp.RouteInfo = append(rsp.RouteInfo, routeInfo)
+	}
+	return nil
+}
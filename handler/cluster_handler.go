@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/proto/route"
+)
+
+// GetClusterCapabilities 查询集群支持的功能
+func (e *RouteHandler) GetClusterCapabilities(ctx context.Context, req *route.ClusterRequest, rsp *route.ClusterCapabilities) error {
+	log.Info("Received *route.GetClusterCapabilities request")
+	capabilities, err := e.RouteDataService.GetClusterCapabilities(req.Cluster)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	//数据转化
+	if err := common.SwapTo(capabilities, rsp); err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// GetControllerConfig 读取 Ingress 控制器的全局配置
+func (e *RouteHandler) GetControllerConfig(ctx context.Context, req *route.ControllerConfigRequest, rsp *route.ControllerConfig) error {
+	log.Info("Received *route.GetControllerConfig request")
+	config, err := e.RouteDataService.GetControllerConfig(req.Controller)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	//数据转化
+	if err := common.SwapTo(config, rsp); err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// PatchControllerConfig 修改 Ingress 控制器的全局配置
+func (e *RouteHandler) PatchControllerConfig(ctx context.Context, req *route.ControllerConfigPatch, rsp *route.ControllerConfig) error {
+	log.Info("Received *route.PatchControllerConfig request")
+	config, err := e.RouteDataService.PatchControllerConfig(req, actorFromContext(ctx))
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	//数据转化
+	if err := common.SwapTo(config, rsp); err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// GetControllerInfo 查询 Ingress 控制器的副本状态和版本
+func (e *RouteHandler) GetControllerInfo(ctx context.Context, req *route.ControllerInfoRequest, rsp *route.ControllerInfo) error {
+	log.Info("Received *route.GetControllerInfo request")
+	info, err := e.RouteDataService.GetControllerInfo(req)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	//数据转化
+	if err := common.SwapTo(info, rsp); err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
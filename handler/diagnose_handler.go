@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/proto/route"
+)
+
+// Diagnose 自检报告
+func (e *RouteHandler) Diagnose(ctx context.Context, req *route.DiagnoseRequest, rsp *route.DiagnoseReport) error {
+	log.Info("Received *route.Diagnose request")
+	if e.DiagnosticsDataService == nil {
+		err := errors.New("未开启诊断")
+		common.Error(err)
+		return err
+	}
+	report := e.DiagnosticsDataService.Diagnose(ctx)
+	rsp.Ok = report.Ok
+	rsp.Database = report.Database
+	rsp.Registry = report.Registry
+	rsp.Clusters = report.Clusters
+	rsp.Config = report.Config
+	rsp.GeneratedAt = report.GeneratedAt
+	return nil
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/proto/route"
+	"time"
+)
+
+// ListEvents 查询路由事件，按时间倒序
+func (e *RouteHandler) ListEvents(ctx context.Context, req *route.ListEventsRequest, rsp *route.AllEvent) error {
+	log.Info("Received *route.ListEvents request")
+	var since time.Time
+	if req.Since > 0 {
+		since = time.Unix(req.Since, 0)
+	}
+	events, err := e.EventDataService.ListEvents(req.RouteId, req.Type, since)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, v := range events {
+		rsp.EventInfo = append(rsp.EventInfo, &route.EventInfo{
+			Id:           v.ID,
+			RouteId:      v.RouteID,
+			EventType:    v.EventType,
+			EventMessage: v.EventMessage,
+			CreatedAt:    v.CreatedAt.Unix(),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/metadata"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/domain/service"
+	"github.com/zxnlx/route/notify"
+	"github.com/zxnlx/route/proto/route"
+	"strconv"
+	"time"
+)
+
+// 变更前检查冻结窗口，紧急变更携带 X-Emergency-Override: true，且操作人有 EmergencyOverride 的角色时可以跳过
+// 未开启 RBAC 时 Authorize 总是通过，不能跳过；跳过时记录事件
+func (e *RouteHandler) checkFreeze(ctx context.Context, namespace string) error {
+	frozen := e.FreezeWindowDataService.CheckFrozen(namespace, time.Now())
+	if frozen == nil {
+		return nil
+	}
+	if override, ok := metadata.Get(ctx, "X-Emergency-Override"); !ok || override != "true" {
+		return frozen
+	}
+	if e.RoleBindingDataService == nil || !e.RoleBindingDataService.Enabled() {
+		return frozen
+	}
+	actor, _ := metadata.Get(ctx, "X-Actor")
+	if err := e.RoleBindingDataService.Authorize(actor, service.MethodEmergencyOverride, &route.RouteName{RouteNamespace: namespace}); err != nil {
+		return err
+	}
+	message := actor + " 紧急变更跳过冻结窗口，命名空间：" + namespace + "，" + frozen.Error()
+	common.Info(message)
+	if _, err := e.EventDataService.AddEvent(&model.Event{EventType: notify.EventFreezeOverridden, EventMessage: message}); err != nil {
+		common.Error(err)
+	}
+	return nil
+}
+
+// 应用下的路由可能分布在多个命名空间，逐个检查
+func (e *RouteHandler) checkApplicationFreeze(ctx context.Context, applicationID int64) error {
+	routes, err := e.RouteDataService.FindRouteByApplicationID(applicationID)
+	if err != nil {
+		return err
+	}
+	checked := map[string]bool{}
+	for _, v := range routes {
+		if checked[v.RouteNamespace] {
+			continue
+		}
+		checked[v.RouteNamespace] = true
+		if err := e.checkFreeze(ctx, v.RouteNamespace); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddFreezeWindow 添加冻结窗口
+func (e *RouteHandler) AddFreezeWindow(ctx context.Context, info *route.FreezeWindowInfo, rsp *route.Response) error {
+	log.Info("Received *route.AddFreezeWindow request")
+	freezeWindow := &model.FreezeWindow{}
+	if err := common.SwapTo(info, freezeWindow); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	id, err := e.FreezeWindowDataService.AddFreezeWindow(freezeWindow)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "冻结窗口添加成功 ID 号为：" + strconv.FormatInt(id, 10)
+	rsp.Id = id
+	return nil
+}
+
+// DeleteFreezeWindow 删除冻结窗口
+func (e *RouteHandler) DeleteFreezeWindow(ctx context.Context, req *route.FreezeWindowId, rsp *route.Response) error {
+	log.Info("Received *route.DeleteFreezeWindow request")
+	if err := e.FreezeWindowDataService.DeleteFreezeWindow(req.Id); err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// UpdateFreezeWindow 更新冻结窗口
+func (e *RouteHandler) UpdateFreezeWindow(ctx context.Context, req *route.FreezeWindowInfo, rsp *route.Response) error {
+	log.Info("Received *route.UpdateFreezeWindow request")
+	freezeWindow, err := e.FreezeWindowDataService.FindFreezeWindowByID(req.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	if err := common.SwapTo(req, freezeWindow); err != nil {
+		common.Error(err)
+		return err
+	}
+	return e.FreezeWindowDataService.UpdateFreezeWindow(freezeWindow)
+}
+
+// FindAllFreezeWindow 查询所有冻结窗口
+func (e *RouteHandler) FindAllFreezeWindow(ctx context.Context, req *route.FindAll, rsp *route.AllFreezeWindow) error {
+	log.Info("Received *route.FindAllFreezeWindow request")
+	all, err := e.FreezeWindowDataService.FindAllFreezeWindow()
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, v := range all {
+		info := &route.FreezeWindowInfo{}
+		if err := common.SwapTo(v, info); err != nil {
+			common.Error(err)
+			return err
+		}
+		rsp.FreezeWindowInfo = append(rsp.FreezeWindowInfo, info)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	microerrors "github.com/asim/go-micro/v3/errors"
+	"github.com/zxnlx/route/domain/service"
+	"github.com/zxnlx/route/proto/health"
+)
+
+// HealthHandler 实现 grpc.health.v1.Health
+type HealthHandler struct {
+	HealthDataService service.IHealthDataService
+}
+
+// Check 检查服务或依赖状态，未知依赖按协议返回 NOT_FOUND
+func (e *HealthHandler) Check(ctx context.Context, req *health.HealthCheckRequest, rsp *health.HealthCheckResponse) error {
+	if err := e.HealthDataService.Check(ctx, req.Service); err != nil {
+		if errors.Is(err, service.ErrUnknownDependency) {
+			return microerrors.NotFound("grpc.health.v1.Health", "unknown service %s", req.Service)
+		}
+		rsp.Status = health.HealthCheckResponse_NOT_SERVING
+		return nil
+	}
+	rsp.Status = health.HealthCheckResponse_SERVING
+	return nil
+}
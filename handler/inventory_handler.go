@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/proto/route"
+	"time"
+)
+
+// ExportInventory 导出路由清单 CSV
+func (e *RouteHandler) ExportInventory(ctx context.Context, req *route.FindAll, rsp *route.InventoryFile) error {
+	log.Info("Received *route.ExportInventory request")
+	content, err := e.RouteDataService.ExportInventory()
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.FileName = "route-inventory-" + time.Now().Format("20060102") + ".csv"
+	rsp.ContentType = "text/csv; charset=utf-8"
+	rsp.Content = content
+	return nil
+}
+
+// ExportManifests 导出命名空间中的路由为 Kustomize 或 Helm chart
+func (e *RouteHandler) ExportManifests(ctx context.Context, req *route.ExportManifestsRequest, rsp *route.InventoryFile) error {
+	log.Info("Received *route.ExportManifests request")
+	file, err := e.RouteDataService.ExportManifests(req)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.FileName = file.FileName
+	rsp.ContentType = file.ContentType
+	rsp.Content = file.Content
+	return nil
+}
+
+// GetUsageReport 按命名空间或团队导出用量报表
+func (e *RouteHandler) GetUsageReport(ctx context.Context, req *route.UsageReportRequest, rsp *route.UsageReport) error {
+	log.Info("Received *route.GetUsageReport request")
+	report, err := e.UsageReportDataService.GetUsageReport(req)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.From = report.From
+	rsp.To = report.To
+	rsp.GroupBy = report.GroupBy
+	rsp.Rows = report.Rows
+	rsp.File = report.File
+	return nil
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/service"
+	"github.com/zxnlx/route/proto/route"
+)
+
+// LintRoute 检查路由规格，先补全命名空间默认配置，和实际创建时保持一致
+func (e *RouteHandler) LintRoute(ctx context.Context, info *route.RouteInfo, rsp *route.LintResult) error {
+	log.Info("Received *route.LintRoute request")
+	if err := e.NamespaceDefaultDataService.ApplyDefaults(info); err != nil {
+		common.Error(err)
+		return err
+	}
+	if err := e.NamespaceDefaultDataService.CheckHost(info); err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.Warnings = e.RouteDataService.LintRoute(info)
+	return nil
+}
+
+// ListNamingViolations 按当前的命名规则检查已有的路由
+func (e *RouteHandler) ListNamingViolations(ctx context.Context, req *route.NamingViolationsRequest, rsp *route.NamingViolationReport) error {
+	log.Info("Received *route.ListNamingViolations request")
+	report, err := e.RouteDataService.ListNamingViolations(req)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.Checked = report.Checked
+	rsp.Violations = report.Violations
+	return nil
+}
+
+// ImportLegacyConfig 转换旧的 nginx、HAProxy 配置为路由草稿，草稿补全命名空间默认配置后再检查规格
+func (e *RouteHandler) ImportLegacyConfig(ctx context.Context, req *route.LegacyConfigRequest, rsp *route.LegacyImportResult) error {
+	log.Info("Received *route.ImportLegacyConfig request")
+	result, err := e.RouteDataService.ImportLegacyConfig(req)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return e.reviewDrafts(result, rsp)
+}
+
+// ConvertManifest 转换 Ingress YAML 为路由规格，和导入旧配置一样补全默认配置后检查
+func (e *RouteHandler) ConvertManifest(ctx context.Context, req *route.ConvertManifestRequest, rsp *route.LegacyImportResult) error {
+	log.Info("Received *route.ConvertManifest request")
+	result, err := e.RouteDataService.ConvertManifest(req)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	return e.reviewDrafts(result, rsp)
+}
+
+// 草稿补全命名空间默认配置，附上规格检查的警告
+func (e *RouteHandler) reviewDrafts(result *route.LegacyImportResult, rsp *route.LegacyImportResult) error {
+	for _, v := range result.Drafts {
+		if err := e.NamespaceDefaultDataService.ApplyDefaults(v.Route); err != nil {
+			common.Error(err)
+			return err
+		}
+		//草稿不写入，域名不允许时作为警告提示
+		if err := e.NamespaceDefaultDataService.CheckHost(v.Route); err != nil {
+			v.Warnings = append(v.Warnings, &route.LintWarning{
+				Rule:     service.LintHostNotAllowed,
+				Severity: service.LintSeverityWarning,
+				Field:    "route_host",
+				Message:  err.Error(),
+			})
+		}
+		v.Warnings = append(v.Warnings, e.RouteDataService.LintRoute(v.Route)...)
+	}
+	rsp.Drafts = result.Drafts
+	rsp.Warnings = result.Warnings
+	return nil
+}
+
+// DiffRoute 对比期望规格和线上资源
+func (e *RouteHandler) DiffRoute(ctx context.Context, req *route.RouteId, rsp *route.RouteDiff) error {
+	log.Info("Received *route.DiffRoute request")
+	diff, err := e.RouteDataService.DiffRoute(req.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.Id = diff.Id
+	rsp.Kind = diff.Kind
+	rsp.LiveMissing = diff.LiveMissing
+	rsp.InSync = diff.InSync
+	rsp.Changes = diff.Changes
+	return nil
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/proto/route"
+	"strconv"
+)
+
+// AddNamespaceDefault 添加命名空间默认配置
+func (e *RouteHandler) AddNamespaceDefault(ctx context.Context, info *route.NamespaceDefaultInfo, rsp *route.Response) error {
+	log.Info("Received *route.AddNamespaceDefault request")
+	namespaceDefault := &model.NamespaceDefault{}
+	if err := common.SwapTo(info, namespaceDefault); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	id, err := e.NamespaceDefaultDataService.AddNamespaceDefault(namespaceDefault)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "命名空间默认配置添加成功 ID 号为：" + strconv.FormatInt(id, 10)
+	rsp.Id = id
+	return nil
+}
+
+// DeleteNamespaceDefault 删除命名空间默认配置
+func (e *RouteHandler) DeleteNamespaceDefault(ctx context.Context, req *route.NamespaceDefaultId, rsp *route.Response) error {
+	log.Info("Received *route.DeleteNamespaceDefault request")
+	if err := e.NamespaceDefaultDataService.DeleteNamespaceDefault(req.Id); err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// UpdateNamespaceDefault 更新命名空间默认配置
+func (e *RouteHandler) UpdateNamespaceDefault(ctx context.Context, req *route.NamespaceDefaultInfo, rsp *route.Response) error {
+	log.Info("Received *route.UpdateNamespaceDefault request")
+	namespaceDefault, err := e.NamespaceDefaultDataService.FindNamespaceDefaultByID(req.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	if err := common.SwapTo(req, namespaceDefault); err != nil {
+		common.Error(err)
+		return err
+	}
+	return e.NamespaceDefaultDataService.UpdateNamespaceDefault(namespaceDefault)
+}
+
+// FindNamespaceDefaultByID 根据ID查询命名空间默认配置
+func (e *RouteHandler) FindNamespaceDefaultByID(ctx context.Context, req *route.NamespaceDefaultId, rsp *route.NamespaceDefaultInfo) error {
+	log.Info("Received *route.FindNamespaceDefaultByID request")
+	namespaceDefault, err := e.NamespaceDefaultDataService.FindNamespaceDefaultByID(req.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	if err := common.SwapTo(namespaceDefault, rsp); err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// FindAllNamespaceDefault 查询所有命名空间默认配置
+func (e *RouteHandler) FindAllNamespaceDefault(ctx context.Context, req *route.FindAll, rsp *route.AllNamespaceDefault) error {
+	log.Info("Received *route.FindAllNamespaceDefault request")
+	all, err := e.NamespaceDefaultDataService.FindAllNamespaceDefault()
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, v := range all {
+		info := &route.NamespaceDefaultInfo{}
+		if err := common.SwapTo(v, info); err != nil {
+			common.Error(err)
+			return err
+		}
+		rsp.NamespaceDefaultInfo = append(rsp.NamespaceDefaultInfo, info)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/proto/route"
+)
+
+// PromoteRoute 晋级路由到目标环境，检查目标命名空间的冻结窗口
+func (e *RouteHandler) PromoteRoute(ctx context.Context, req *route.PromoteRouteRequest, rsp *route.PromoteRouteResponse) error {
+	log.Info("Received *route.PromoteRoute request")
+	namespace, err := e.RouteDataService.PromotionNamespace(req.Id, req.TargetEnv)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	if err := e.checkFreeze(ctx, namespace); err != nil {
+		common.Error(err)
+		return err
+	}
+	result, err := e.RouteDataService.PromoteRoute(req.Id, req.TargetEnv, actorFromContext(ctx))
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.Id = result.Id
+	rsp.SourceEnv = result.SourceEnv
+	rsp.TargetEnv = result.TargetEnv
+	rsp.RouteNamespace = result.RouteNamespace
+	rsp.RouteName = result.RouteName
+	rsp.RouteHost = result.RouteHost
+	rsp.Created = result.Created
+	return nil
+}
+
+// CompareEnvironments 对比两个环境的路由
+func (e *RouteHandler) CompareEnvironments(ctx context.Context, req *route.CompareEnvironmentsRequest, rsp *route.EnvironmentComparison) error {
+	log.Info("Received *route.CompareEnvironments request")
+	comparison, err := e.RouteDataService.CompareEnvironments(req)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.EnvA = comparison.EnvA
+	rsp.EnvB = comparison.EnvB
+	rsp.InSync = comparison.InSync
+	rsp.Differences = comparison.Differences
+	return nil
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/proto/route"
+)
+
+// SetQuota 设置命名空间配额
+func (e *RouteHandler) SetQuota(ctx context.Context, info *route.QuotaInfo, rsp *route.Response) error {
+	log.Info("Received *route.SetQuota request")
+	quota := &model.Quota{}
+	if err := common.SwapTo(info, quota); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	quota.QuotaUpdatedBy = actorFromContext(ctx)
+	if err := e.QuotaDataService.SetQuota(quota); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "配额设置成功"
+	return nil
+}
+
+// DeleteQuota 删除命名空间配额，之后使用默认配额
+func (e *RouteHandler) DeleteQuota(ctx context.Context, info *route.QuotaInfo, rsp *route.Response) error {
+	log.Info("Received *route.DeleteQuota request")
+	if err := e.QuotaDataService.DeleteQuota(info.QuotaNamespace); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "配额删除成功"
+	return nil
+}
+
+// ListQuotas 查询所有配额
+func (e *RouteHandler) ListQuotas(ctx context.Context, req *route.FindAll, rsp *route.AllQuota) error {
+	log.Info("Received *route.ListQuotas request")
+	all, err := e.QuotaDataService.FindAllQuota()
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, v := range all {
+		info := &route.QuotaInfo{}
+		if err := common.SwapTo(v, info); err != nil {
+			common.Error(err)
+			return err
+		}
+		rsp.QuotaInfo = append(rsp.QuotaInfo, info)
+	}
+	return nil
+}
+
+// GetQuotaUsage 查询命名空间的用量和配额
+func (e *RouteHandler) GetQuotaUsage(ctx context.Context, req *route.QuotaUsageRequest, rsp *route.QuotaUsage) error {
+	log.Info("Received *route.GetQuotaUsage request")
+	usage, err := e.QuotaDataService.GetQuotaUsage(req.RouteNamespace)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.RouteNamespace = usage.RouteNamespace
+	rsp.Routes = usage.Routes
+	rsp.HostCount = usage.HostCount
+	rsp.Hosts = usage.Hosts
+	rsp.RoutesCreatedToday = usage.RoutesCreatedToday
+	rsp.Quota = usage.Quota
+	return nil
+}
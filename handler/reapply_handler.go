@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/proto/route"
+	"strings"
+)
+
+// ReapplyAll 批量重新写入路由，指定了具体命名空间时先检查冻结窗口，写入每个路由前再按所在命名空间检查
+func (e *RouteHandler) ReapplyAll(ctx context.Context, req *route.ReapplyFilter, rsp *route.ReapplyJob) error {
+	log.Info("Received *route.ReapplyAll request")
+	if req.RouteNamespace != "" && !strings.ContainsAny(req.RouteNamespace, "*?[") {
+		if err := e.checkFreeze(ctx, req.RouteNamespace); err != nil {
+			common.Error(err)
+			return err
+		}
+	}
+	job, err := e.RouteDataService.ReapplyAll(req, actorFromContext(ctx), func(namespace string) error {
+		return e.checkFreeze(ctx, namespace)
+	})
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	copyReapplyJob(job, rsp)
+	return nil
+}
+
+// GetReapplyJob 查询批量重新写入的进度
+func (e *RouteHandler) GetReapplyJob(ctx context.Context, req *route.ReapplyJobId, rsp *route.ReapplyJob) error {
+	log.Info("Received *route.GetReapplyJob request")
+	job, err := e.RouteDataService.GetReapplyJob(req.JobId)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	copyReapplyJob(job, rsp)
+	return nil
+}
+
+// ReencryptRoutes 密钥轮换后重新加密敏感字段
+func (e *RouteHandler) ReencryptRoutes(ctx context.Context, req *route.FindAll, rsp *route.ReencryptResult) error {
+	log.Info("Received *route.ReencryptRoutes request")
+	result, err := e.RouteDataService.ReencryptRoutes(actorFromContext(ctx))
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.Routes = result.Routes
+	rsp.Archives = result.Archives
+	return nil
+}
+
+func copyReapplyJob(job *route.ReapplyJob, rsp *route.ReapplyJob) {
+	rsp.JobId = job.JobId
+	rsp.State = job.State
+	rsp.Total = job.Total
+	rsp.Processed = job.Processed
+	rsp.Succeeded = job.Succeeded
+	rsp.Failed = job.Failed
+	rsp.Skipped = job.Skipped
+	rsp.Failures = job.Failures
+	rsp.StartedAt = job.StartedAt
+	rsp.FinishedAt = job.FinishedAt
+	rsp.CreatedBy = job.CreatedBy
+}
+
+// ListFailedRoutes 查询死信列表中的路由
+func (e *RouteHandler) ListFailedRoutes(ctx context.Context, req *route.FailedRoutesRequest, rsp *route.AllRoute) error {
+	log.Info("Received *route.ListFailedRoutes request")
+	routes, err := e.RouteDataService.ListFailedRoutes(req)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, v := range routes {
+		routeInfo := &route.RouteInfo{}
+		if err := common.SwapTo(v, routeInfo); err != nil {
+			common.Error(err)
+			return err
+		}
+		rsp.RouteInfo = append(rsp.RouteInfo, routeInfo)
+	}
+	return nil
+}
+
+// RetryRoute 手动重试写入失败的路由
+func (e *RouteHandler) RetryRoute(ctx context.Context, req *route.RouteId, rsp *route.Response) error {
+	log.Info("Received *route.RetryRoute request")
+	routeModel, err := e.RouteDataService.FindRouteByID(req.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	if err := e.checkFreeze(ctx, routeModel.RouteNamespace); err != nil {
+		common.Error(err)
+		return err
+	}
+	if err := e.RouteDataService.RetryRoute(req.Id, actorFromContext(ctx)); err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.Msg = "重试成功"
+	return nil
+}
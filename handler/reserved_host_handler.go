@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/proto/route"
+	"strconv"
+)
+
+// AddReservedHost 添加保留域名
+func (e *RouteHandler) AddReservedHost(ctx context.Context, info *route.ReservedHostInfo, rsp *route.Response) error {
+	log.Info("Received *route.AddReservedHost request")
+	reservedHost := &model.ReservedHost{}
+	if err := common.SwapTo(info, reservedHost); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	reservedHost.ReservedCreatedBy = actorFromContext(ctx)
+	id, err := e.ReservedHostDataService.AddReservedHost(reservedHost)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "保留域名添加成功 ID 号为：" + strconv.FormatInt(id, 10)
+	rsp.Id = id
+	return nil
+}
+
+// DeleteReservedHost 删除保留域名
+func (e *RouteHandler) DeleteReservedHost(ctx context.Context, req *route.ReservedHostId, rsp *route.Response) error {
+	log.Info("Received *route.DeleteReservedHost request")
+	if err := e.ReservedHostDataService.DeleteReservedHost(req.Id); err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// UpdateReservedHost 更新保留域名
+func (e *RouteHandler) UpdateReservedHost(ctx context.Context, req *route.ReservedHostInfo, rsp *route.Response) error {
+	log.Info("Received *route.UpdateReservedHost request")
+	//创建人置空后数据转化时不会覆盖数据库中的值
+	req.ReservedCreatedBy = ""
+	reservedHost, err := e.ReservedHostDataService.FindReservedHostByID(req.Id)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	if err := common.SwapTo(req, reservedHost); err != nil {
+		common.Error(err)
+		return err
+	}
+	return e.ReservedHostDataService.UpdateReservedHost(reservedHost)
+}
+
+// FindAllReservedHost 查询所有保留域名
+func (e *RouteHandler) FindAllReservedHost(ctx context.Context, req *route.FindAll, rsp *route.AllReservedHost) error {
+	log.Info("Received *route.FindAllReservedHost request")
+	all, err := e.ReservedHostDataService.FindAllReservedHost()
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, v := range all {
+		info := &route.ReservedHostInfo{}
+		if err := common.SwapTo(v, info); err != nil {
+			common.Error(err)
+			return err
+		}
+		rsp.ReservedHostInfo = append(rsp.ReservedHostInfo, info)
+	}
+	return nil
+}
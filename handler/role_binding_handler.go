@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/util/log"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/model"
+	"github.com/zxnlx/route/proto/route"
+	"strconv"
+)
+
+// GrantRole 授予角色
+func (e *RouteHandler) GrantRole(ctx context.Context, info *route.RoleBindingInfo, rsp *route.Response) error {
+	log.Info("Received *route.GrantRole request")
+	roleBinding := &model.RoleBinding{}
+	if err := common.SwapTo(info, roleBinding); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	id, err := e.RoleBindingDataService.GrantRole(roleBinding, actorFromContext(ctx))
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "角色授予成功 ID 号为：" + strconv.FormatInt(id, 10)
+	rsp.Id = id
+	return nil
+}
+
+// RevokeRole 收回角色
+func (e *RouteHandler) RevokeRole(ctx context.Context, info *route.RoleBindingInfo, rsp *route.Response) error {
+	log.Info("Received *route.RevokeRole request")
+	roleBinding := &model.RoleBinding{}
+	if err := common.SwapTo(info, roleBinding); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	if err := e.RoleBindingDataService.RevokeRole(roleBinding, actorFromContext(ctx)); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	rsp.Msg = "角色收回成功"
+	return nil
+}
+
+// ListBindings 查询角色绑定
+func (e *RouteHandler) ListBindings(ctx context.Context, req *route.ListBindingsRequest, rsp *route.AllRoleBinding) error {
+	log.Info("Received *route.ListBindings request")
+	all, err := e.RoleBindingDataService.ListBindings(req.BindingSubject, req.BindingNamespace)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, v := range all {
+		info := &route.RoleBindingInfo{}
+		if err := common.SwapTo(v, info); err != nil {
+			common.Error(err)
+			return err
+		}
+		rsp.RoleBindingInfo = append(rsp.RoleBindingInfo, info)
+	}
+	return nil
+}
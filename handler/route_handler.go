@@ -4,7 +4,6 @@ import (
 	"context"
 	"github.com/asim/go-micro/v3/util/log"
 	"github.com/zxnlx/common"
-	"github.com/zxnlx/route/domain/model"
 	"github.com/zxnlx/route/domain/service"
 	"github.com/zxnlx/route/proto/route"
 	"strconv"
@@ -12,33 +11,70 @@ import (
 
 type RouteHandler struct {
 	//注意这里的类型是 IRouteDataService 接口类型
-	RouteDataService service.IRouteDataService
+	RouteDataService              service.IRouteDataService
+	NamespaceDefaultDataService   service.INamespaceDefaultDataService
+	ApplicationDataService        service.IApplicationDataService
+	EventDataService              service.IEventDataService
+	FreezeWindowDataService       service.IFreezeWindowDataService
+	ReservedHostDataService       service.IReservedHostDataService
+	AnnotationTemplateDataService service.IAnnotationTemplateDataService
+	RoleBindingDataService        service.IRoleBindingDataService
+	APIKeyDataService             service.IAPIKeyDataService
+	QuotaDataService              service.IQuotaDataService
+	UsageReportDataService        service.IUsageReportDataService
+	//为空时 Diagnose 返回错误
+	DiagnosticsDataService service.IDiagnosticsDataService
 }
 
 // AddRoute 添加路由
 func (e *RouteHandler) AddRoute(ctx context.Context, info *route.RouteInfo, rsp *route.Response) error {
 	log.Info("Received *route.AddRoute request")
-	route := &model.Route{}
-	if err := common.SwapTo(info, route); err != nil {
+	if err := e.checkFreeze(ctx, info.RouteNamespace); err != nil {
 		common.Error(err)
 		rsp.Msg = err.Error()
 		return err
 	}
-	//创建route到k8s
-	if err := e.RouteDataService.CreateRouteToK8s(info); err != nil {
+	//记录创建人，不信任调用方传入的值
+	info.RouteCreatedBy = actorFromContext(ctx)
+	info.RouteUpdatedBy = info.RouteCreatedBy
+	//补全命名空间默认配置
+	if err := e.NamespaceDefaultDataService.ApplyDefaults(info); err != nil {
 		common.Error(err)
 		rsp.Msg = err.Error()
 		return err
-	} else {
-		//写入数据库
-		routeID, err := e.RouteDataService.AddRoute(route)
-		if err != nil {
+	}
+	//校验所属应用是否存在
+	if info.RouteApplicationId != 0 {
+		if _, err := e.ApplicationDataService.FindApplicationByID(info.RouteApplicationId); err != nil {
 			common.Error(err)
 			rsp.Msg = err.Error()
 			return err
 		}
-		common.Info("Route 添加成功 ID 号为：" + strconv.FormatInt(routeID, 10))
-		rsp.Msg = "Route 添加成功 ID 号为：" + strconv.FormatInt(routeID, 10)
+	}
+	//创建route到k8s并写入数据库
+	routeID, err := e.RouteDataService.CreateRouteContext(ctx, info)
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	common.Info("Route 添加成功 ID 号为：" + strconv.FormatInt(routeID, 10))
+	rsp.Msg = "Route 添加成功 ID 号为：" + strconv.FormatInt(routeID, 10)
+	rsp.Id = routeID
+	return e.waitForReady(info, rsp)
+}
+
+// 设置了 route_wait_for_ready 时等待路由就绪，返回最终状态
+func (e *RouteHandler) waitForReady(info *route.RouteInfo, rsp *route.Response) error {
+	if !info.RouteWaitForReady {
+		return nil
+	}
+	status, err := e.RouteDataService.WaitForReady(info)
+	rsp.Status = status
+	if err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
 	}
 	return nil
 }
@@ -51,33 +87,71 @@ func (e *RouteHandler) DeleteRoute(ctx context.Context, req *route.RouteId, rsp
 		common.Error(err)
 		return err
 	}
-	//从k8s中删除，并且删除数据库中数据
-	if err := e.RouteDataService.DeleteRouteFromK8s(routeModel); err != nil {
+	if err := e.checkFreeze(ctx, routeModel.RouteNamespace); err != nil {
+		common.Error(err)
+		return err
+	}
+	//按删除策略从k8s中删除，并且删除数据库中数据
+	if err := e.RouteDataService.DeleteRouteFromK8s(routeModel, req.DeletePolicy); err != nil {
+		common.Error(err)
+		return err
+	}
+	return nil
+}
+
+// DeleteRouteByName 根据命名空间和名称删除route
+func (e *RouteHandler) DeleteRouteByName(ctx context.Context, req *route.RouteName, rsp *route.Response) error {
+	log.Info("Received *route.DeleteRouteByName request")
+	if err := e.checkFreeze(ctx, req.RouteNamespace); err != nil {
+		common.Error(err)
+		rsp.Msg = err.Error()
+		return err
+	}
+	if err := e.RouteDataService.DeleteRouteByName(req.RouteNamespace, req.RouteName, req.DeletePolicy); err != nil {
 		common.Error(err)
+		rsp.Msg = err.Error()
 		return err
 	}
+	rsp.Msg = "Route " + req.RouteNamespace + "/" + req.RouteName + " 删除成功"
 	return nil
 }
 
 // UpdateRoute 更新route
 func (e *RouteHandler) UpdateRoute(ctx context.Context, req *route.RouteInfo, rsp *route.Response) error {
 	log.Info("Received *route.UpdateRoute request")
-	if err := e.RouteDataService.UpdateRouteToK8s(req); err != nil {
+	//移出冻结的命名空间同样是变更，原命名空间也需要检查
+	stored, err := e.RouteDataService.FindRouteByID(req.Id)
+	if err != nil {
 		common.Error(err)
 		return err
 	}
-	//查询数据库的信息
-	routeModel, err := e.RouteDataService.FindRouteByID(req.Id)
+	for _, namespace := range []string{stored.RouteNamespace, req.RouteNamespace} {
+		if err := e.checkFreeze(ctx, namespace); err != nil {
+			common.Error(err)
+			return err
+		}
+	}
+	//创建人置空后数据转化时不会覆盖数据库中的值
+	req.RouteCreatedBy = ""
+	req.RouteUpdatedBy = actorFromContext(ctx)
+	//写入状态由服务端维护，不接受调用方传入
+	req.RouteLastApplyTime = 0
+	req.RouteLastApplyDurationMs = 0
+	req.RouteLastError = ""
+	req.RouteConsecutiveFailures = 0
+	req.RouteNextRetryAt = 0
+	req.RouteDeadLetter = false
+	changed, err := e.RouteDataService.UpdateRouteToK8sContext(ctx, req)
 	if err != nil {
 		common.Error(err)
 		return err
 	}
-	//数据更新
-	if err := common.SwapTo(req, routeModel); err != nil {
-		common.Error(err)
-		return err
+	//规格没有变化时不写k8s和数据库
+	if !changed {
+		rsp.Msg = "unchanged"
+		rsp.Unchanged = true
 	}
-	return e.RouteDataService.UpdateRoute(routeModel)
+	return e.waitForReady(req, rsp)
 }
 
 // FindRouteByID 根据ID查询route信息
@@ -98,7 +172,11 @@ func (e *RouteHandler) FindRouteByID(ctx context.Context, req *route.RouteId, rs
 
 func (e *RouteHandler) FindAllRoute(ctx context.Context, req *route.FindAll, rsp *route.AllRoute) error {
 	log.Info("Received *route.FindAllRoute request")
-	allRoute, err := e.RouteDataService.FindAllRoute()
+	//分页时和 SearchRoutes 相同，只是没有条件
+	if req.PageSize != 0 || req.PageToken != "" {
+		return e.SearchRoutes(ctx, &route.SearchRoutesRequest{PageSize: req.PageSize, PageToken: req.PageToken, Fields: req.Fields}, rsp)
+	}
+	allRoute, err := e.RouteDataService.FindAllRoute(req.Fields)
 	if err != nil {
 		common.Error(err)
 		return err
@@ -112,8 +190,92 @@ func (e *RouteHandler) FindAllRoute(ctx context.Context, req *route.FindAll, rsp
 			common.Error(err)
 			return err
 		}
+		if err := service.ProjectRouteInfo(routeInfo, req.Fields); err != nil {
+			common.Error(err)
+			return err
+		}
 		//数据合并
 		rsp.RouteInfo = append(rsp.RouteInfo, routeInfo)
 	}
 	return nil
 }
+
+// GetRouteCollectionVersion 路由数、最后修改时间和调用方的权限范围，网关据此生成列表的 ETag
+func (e *RouteHandler) GetRouteCollectionVersion(ctx context.Context, req *route.FindAll, rsp *route.RouteCollectionVersion) error {
+	log.Info("Received *route.GetRouteCollectionVersion request")
+	count, updatedAt, err := e.RouteDataService.RouteCollectionVersion()
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	//同一列表对不同调用方可见的内容不同，ETag 按调用方区分
+	actor := actorFromContext(ctx)
+	scope, err := e.RoleBindingDataService.Scope(actor)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	rsp.Count = count
+	rsp.UpdatedAt = updatedAt.UnixNano()
+	rsp.Scope = actor + "|" + scope
+	return nil
+}
+
+// 流式返回时每批查询的路由数
+const streamBatchSize = 500
+
+// StreamAllRoutes 按游标分批查询，逐条发送，不受单条消息大小的限制
+func (e *RouteHandler) StreamAllRoutes(ctx context.Context, req *route.FindAll, stream route.Route_StreamAllRoutesStream) error {
+	log.Info("Received *route.StreamAllRoutes request")
+	token := ""
+	for {
+		routes, next, err := e.RouteDataService.SearchRoutes(&route.SearchRoutesRequest{PageSize: streamBatchSize, PageToken: token, Fields: req.Fields})
+		if err != nil {
+			common.Error(err)
+			return err
+		}
+		for _, v := range routes {
+			routeInfo := &route.RouteInfo{}
+			if err := common.SwapTo(v, routeInfo); err != nil {
+				common.Error(err)
+				return err
+			}
+			if err := service.ProjectRouteInfo(routeInfo, req.Fields); err != nil {
+				common.Error(err)
+				return err
+			}
+			if err := stream.Send(routeInfo); err != nil {
+				common.Error(err)
+				return err
+			}
+		}
+		if next == "" {
+			return nil
+		}
+		token = next
+	}
+}
+
+// SearchRoutes 按条件分页查询路由
+func (e *RouteHandler) SearchRoutes(ctx context.Context, req *route.SearchRoutesRequest, rsp *route.AllRoute) error {
+	log.Info("Received *route.SearchRoutes request")
+	routes, next, err := e.RouteDataService.SearchRoutes(req)
+	if err != nil {
+		common.Error(err)
+		return err
+	}
+	for _, v := range routes {
+		routeInfo := &route.RouteInfo{}
+		if err := common.SwapTo(v, routeInfo); err != nil {
+			common.Error(err)
+			return err
+		}
+		if err := service.ProjectRouteInfo(routeInfo, req.Fields); err != nil {
+			common.Error(err)
+			return err
+		}
+		rsp.RouteInfo = append(rsp.RouteInfo, routeInfo)
+	}
+	rsp.NextPageToken = next
+	return nil
+}
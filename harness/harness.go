@@ -0,0 +1,242 @@
+// Package harness 在进程内启动完整的路由服务用于端到端测试：默认使用 kvstore 内存仓库，指定 DB（如 SQLite）时使用和 MySQL 相同的 gorm 仓库，
+// 默认使用 fake clientset，通过 -e2e.kubeconfig 指定 kind 或 envtest 集群时操作真实集群
+package harness
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"github.com/asim/go-micro/v3"
+	microclient "github.com/asim/go-micro/v3/client"
+	"github.com/asim/go-micro/v3/registry"
+	"github.com/asim/go-micro/v3/server"
+	"github.com/zxnlx/route/app"
+	"github.com/zxnlx/route/client"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/domain/repository/kvstore"
+	"github.com/zxnlx/route/domain/service"
+	"github.com/zxnlx/route/handler"
+	"github.com/zxnlx/route/proto/route"
+	"github.com/zxnlx/route/storage"
+	"gorm.io/gorm"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+	"time"
+)
+
+var kubeconfig = flag.String("e2e.kubeconfig", "", "kind 或 envtest 集群的 kubeconfig，为空时使用 fake clientset")
+
+// Options 启动参数
+type Options struct {
+	// Kubeconfig 为空时使用 fake clientset
+	Kubeconfig string
+	// Config 路由服务配置，为空时使用默认值
+	Config *service.RouteConfig
+	// DB 不为空时使用 gorm 仓库，启动时迁移表结构，测试中一般为 SQLite；为空时使用 kvstore 内存仓库
+	DB *gorm.DB
+	// App 路由仓库和服务的构造函数，和 app.Run 使用同一个类型，为空时使用默认实现
+	// NewRouteRepository 只在 DB 不为空时使用
+	App app.Options
+	// StartTimeout 等待 RPC 服务启动的时间，默认 10s
+	StartTimeout time.Duration
+}
+
+// DefaultOptions 读取命令行参数，需要在 flag.Parse 之后调用
+func DefaultOptions() Options {
+	return Options{Kubeconfig: *kubeconfig}
+}
+
+// Harness 进程内运行的路由服务
+type Harness struct {
+	// Client 调用进程内 RPC 服务的客户端
+	Client *client.Client
+	// K8s、Dynamic 测试中用于检查或模拟控制器行为
+	K8s     kubernetes.Interface
+	Dynamic dynamic.Interface
+	// Store 使用内存仓库时不为空，DB 使用 gorm 仓库时不为空
+	Store *kvstore.Store
+	DB    *gorm.DB
+
+	cancel context.CancelFunc
+	done   chan error
+}
+
+var (
+	httpRouteResource   = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "httproutes"}
+	certificateResource = schema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}
+	middlewareResource  = schema.GroupVersionResource{Group: "traefik.io", Version: "v1alpha1", Resource: "middlewares"}
+	istioResource       = schema.GroupVersionResource{Group: "networking.istio.io", Version: "v1beta1", Resource: "virtualservices"}
+)
+
+// Start 启动服务，使用完后调用 Stop
+func Start(opts Options) (*Harness, error) {
+	clientSet, dynamicClient, err := newK8sClients(opts.Kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	config := opts.Config
+	if config == nil {
+		config = &service.RouteConfig{}
+	}
+	startTimeout := opts.StartTimeout
+	if startTimeout <= 0 {
+		startTimeout = 10 * time.Second
+	}
+	newRouteDataService := service.NewRouteDataService
+	if opts.App.NewRouteDataService != nil {
+		newRouteDataService = opts.App.NewRouteDataService
+	}
+	repos := &storage.Storage{DB: opts.DB, NewRouteRepository: opts.App.NewRouteRepository}
+	if opts.DB != nil {
+		if err := repository.Migrate(opts.DB); err != nil {
+			return nil, err
+		}
+	} else {
+		repos.Store = kvstore.NewMemoryStore()
+	}
+	annotationTemplateRepository := repos.AnnotationTemplateRepository()
+	apiKeyRepository := repos.APIKeyRepository()
+	quotaRepository := repos.QuotaRepository()
+	routeDataService := newRouteDataService(repos.RouteRepository(), annotationTemplateRepository, quotaRepository, clientSet, dynamicClient, config, nil)
+	roleBindingDataService := service.NewRoleBindingDataService(repos.RoleBindingRepository(), repos.RouteRepository(), apiKeyRepository, repos.NamespaceDefaultRepository(), config.RBAC, config.Environments)
+	namespaceDefaultDataService := service.NewNamespaceDefaultDataService(repos.NamespaceDefaultRepository())
+	reservedHostDataService := service.NewReservedHostDataService(repos.ReservedHostRepository(), repos.RouteRepository(), roleBindingDataService)
+	if err := routeDataService.AddRouteHook(service.StageValidate, func(op *service.RouteOperation) error {
+		return namespaceDefaultDataService.CheckHost(op.Info)
+	}); err != nil {
+		return nil, err
+	}
+	if err := routeDataService.AddRouteHook(service.StageValidate, reservedHostDataService.CheckRoute); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	//默认的 server 和 client 是全局的，同一进程中启动多个服务时需要各自创建
+	srv := micro.NewService(
+		micro.Server(server.NewServer()),
+		micro.Client(microclient.NewClient()),
+		micro.Name(client.ServiceName),
+		micro.Address("127.0.0.1:0"),
+		micro.Registry(registry.NewMemoryRegistry()),
+		micro.Context(ctx),
+		micro.HandleSignal(false),
+		micro.AfterStart(func() error {
+			close(started)
+			return nil
+		}),
+	)
+	err = route.RegisterRouteHandler(srv.Server(), &handler.RouteHandler{
+		RouteDataService:              routeDataService,
+		NamespaceDefaultDataService:   namespaceDefaultDataService,
+		ApplicationDataService:        service.NewApplicationDataService(repos.ApplicationRepository(), routeDataService),
+		EventDataService:              service.NewEventDataService(repos.EventRepository()),
+		FreezeWindowDataService:       service.NewFreezeWindowDataService(repos.FreezeWindowRepository()),
+		ReservedHostDataService:       reservedHostDataService,
+		AnnotationTemplateDataService: service.NewAnnotationTemplateDataService(annotationTemplateRepository),
+		RoleBindingDataService:        roleBindingDataService,
+		APIKeyDataService:             service.NewAPIKeyDataService(apiKeyRepository, repos.RouteRepository(), config.Environments),
+		QuotaDataService:              service.NewQuotaDataService(quotaRepository, repos.RouteRepository()),
+		UsageReportDataService:        service.NewUsageReportDataService(repos.UsageSnapshotRepository(), repos.RouteRepository()),
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	h := &Harness{
+		Client:  client.New(srv.Client()),
+		K8s:     clientSet,
+		Dynamic: dynamicClient,
+		Store:   repos.Store,
+		DB:      opts.DB,
+		cancel:  cancel,
+		done:    make(chan error, 1),
+	}
+	go func() {
+		h.done <- srv.Run()
+	}()
+	select {
+	case <-started:
+		return h, nil
+	case err := <-h.done:
+		cancel()
+		return nil, err
+	case <-time.After(startTimeout):
+		cancel()
+		return nil, errors.New("等待路由服务启动超时")
+	}
+}
+
+// Stop 停止服务
+func (h *Harness) Stop() error {
+	h.cancel()
+	return <-h.done
+}
+
+// MarkIngressReady 模拟 Ingress 控制器分配负载均衡地址，fake clientset 下 WaitForReady 依赖它
+func (h *Harness) MarkIngressReady(ctx context.Context, namespace string, name string, ip string) error {
+	ingress, err := h.K8s.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	ingress.Status.LoadBalancer.Ingress = []networkingv1.IngressLoadBalancerIngress{{IP: ip}}
+	_, err = h.K8s.NetworkingV1().Ingresses(namespace).UpdateStatus(ctx, ingress, metav1.UpdateOptions{})
+	return err
+}
+
+func newK8sClients(path string) (kubernetes.Interface, dynamic.Interface, error) {
+	if path != "" {
+		config, err := clientcmd.BuildConfigFromFlags("", path)
+		if err != nil {
+			return nil, nil, err
+		}
+		clientSet, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			return nil, nil, err
+		}
+		dynamicClient, err := dynamic.NewForConfig(config)
+		if err != nil {
+			return nil, nil, err
+		}
+		return clientSet, dynamicClient, nil
+	}
+	clientSet, dynamicClient := FakeK8sClients()
+	return clientSet, dynamicClient, nil
+}
+
+// FakeK8sClients 带有 Ingress discovery 信息的 fake clientset，权限预检全部放行，压测也使用它
+func FakeK8sClients() (kubernetes.Interface, dynamic.Interface) {
+	clientSet := fake.NewSimpleClientset()
+	//启动时根据 discovery 选择 Ingress 版本
+	clientSet.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: networkingv1.SchemeGroupVersion.String(),
+			APIResources: []metav1.APIResource{
+				{Name: "ingresses", Namespaced: true, Kind: "Ingress"},
+				{Name: "ingressclasses", Kind: "IngressClass"},
+			},
+		},
+	}
+	//fake clientset 不做鉴权，权限预检全部放行
+	clientSet.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = true
+		return true, review, nil
+	})
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		httpRouteResource:   "HTTPRouteList",
+		certificateResource: "CertificateList",
+		middlewareResource:  "MiddlewareList",
+		istioResource:       "VirtualServiceList",
+	})
+	return clientSet, dynamicClient
+}
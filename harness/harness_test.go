@@ -0,0 +1,127 @@
+package harness_test
+
+import (
+	"context"
+	"github.com/zxnlx/route/harness"
+	"github.com/zxnlx/route/proto/route"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 运行方式：go test ./harness，指定 -e2e.kubeconfig 时在 kind 或 envtest 集群中执行
+// SQLite 依赖 cgo，CGO_ENABLED=0 时跳过 SQLite 的用例
+
+// 每个用例使用单独的数据库文件
+func openSQLite(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "route.db")), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		if strings.Contains(err.Error(), "CGO_ENABLED=0") {
+			t.Skip("SQLite 需要 cgo：" + err.Error())
+		}
+		t.Fatal(err)
+	}
+	return db
+}
+
+func startHarness(t *testing.T, backend string) *harness.Harness {
+	t.Helper()
+	opts := harness.DefaultOptions()
+	if backend == "sqlite" {
+		opts.DB = openSQLite(t)
+	}
+	h, err := harness.Start(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := h.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	return h
+}
+
+// 创建、查询、修改、无变化的修改、删除，检查数据库记录和集群中的 Ingress
+func TestRouteLifecycle(t *testing.T) {
+	for _, backend := range []string{"memory", "sqlite"} {
+		t.Run(backend, func(t *testing.T) {
+			h := startHarness(t, backend)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			info := &route.RouteInfo{
+				RouteName:      "e2e-" + backend,
+				RouteNamespace: "default",
+				RouteHost:      "e2e-" + backend + ".example.com",
+				RouteClass:     "nginx",
+				RouteOwnerTeam: "e2e",
+				RoutePath: []*route.RoutePath{
+					{RoutePathName: "/", RouteBackendService: "e2e-svc", RouteBackendServicePort: 8080},
+				},
+			}
+			id, err := h.Client.AddRoute(ctx, info)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id == 0 {
+				t.Fatal("创建后没有返回路由ID")
+			}
+			ingress, err := h.K8s.NetworkingV1().Ingresses(info.RouteNamespace).Get(ctx, info.RouteName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ingress.Spec.Rules[0].Host; got != info.RouteHost {
+				t.Fatalf("Ingress 的域名为 %s，应为 %s", got, info.RouteHost)
+			}
+
+			stored, err := h.Client.FindRoute(ctx, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stored.RouteHost != info.RouteHost || len(stored.RoutePath) != 1 {
+				t.Fatalf("查询到的路由和创建的不一致：%+v", stored)
+			}
+
+			stored.RoutePath[0].RouteBackendServicePort = 9090
+			changed, err := h.Client.UpdateRoute(ctx, stored)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !changed {
+				t.Fatal("修改了后端端口但没有写入")
+			}
+			ingress, err = h.K8s.NetworkingV1().Ingresses(info.RouteNamespace).Get(ctx, info.RouteName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Port.Number; got != 9090 {
+				t.Fatalf("Ingress 的后端端口为 %d，应为 9090", got)
+			}
+
+			changed, err = h.Client.UpdateRoute(ctx, stored)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if changed {
+				t.Fatal("规格未变化但重新写入了")
+			}
+
+			if err := h.Client.DeleteRoute(ctx, id, ""); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := h.K8s.NetworkingV1().Ingresses(info.RouteNamespace).Get(ctx, info.RouteName, metav1.GetOptions{}); !k8serrors.IsNotFound(err) {
+				t.Fatalf("删除后 Ingress 仍然存在：%v", err)
+			}
+			if _, err := h.Client.FindRoute(ctx, id); err == nil {
+				t.Fatal("删除后仍然可以查询到路由")
+			}
+		})
+	}
+}
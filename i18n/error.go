@@ -0,0 +1,89 @@
+package i18n
+
+import "errors"
+
+// 错误码，和 messages.yaml 中的 code 一致
+const (
+	CodeRateLimited          = "RATE_LIMITED"
+	CodeApplyQueueFull       = "APPLY_QUEUE_FULL"
+	CodeMessageTooLarge      = "MESSAGE_TOO_LARGE"
+	CodeAccessDenied         = "ACCESS_DENIED"
+	CodePermissionDenied     = "PERMISSION_DENIED"
+	CodeInvalidAssertion     = "INVALID_ASSERTION"
+	CodeInvalidAPIKey        = "INVALID_API_KEY"
+	CodeInvalidPageToken     = "INVALID_PAGE_TOKEN"
+	CodePageTokenMismatch    = "PAGE_TOKEN_MISMATCH"
+	CodePageSizeTooLarge     = "PAGE_SIZE_TOO_LARGE"
+	CodeNotFound             = "NOT_FOUND"
+	CodeReapplyJobNotFound   = "REAPPLY_JOB_NOT_FOUND"
+	CodeRouteNotFailed       = "ROUTE_NOT_FAILED"
+	CodeUnknownDependency    = "UNKNOWN_DEPENDENCY"
+	CodeRouteAlreadyExists   = "ROUTE_ALREADY_EXISTS"
+	CodeRouteDeleting        = "ROUTE_DELETING"
+	CodeDuplicatePath        = "DUPLICATE_PATH"
+	CodeRouteOwnerRequired   = "ROUTE_OWNER_REQUIRED"
+	CodeRouteNotReady        = "ROUTE_NOT_READY"
+	CodeNamespaceRequired    = "NAMESPACE_REQUIRED"
+	CodeNamespaceNotAllowed  = "NAMESPACE_NOT_ALLOWED"
+	CodeNamespaceForbidden   = "NAMESPACE_FORBIDDEN"
+	CodeClusterNotAllowed    = "CLUSTER_NOT_ALLOWED"
+	CodeClusterForbidden     = "CLUSTER_FORBIDDEN"
+	CodeUnknownCluster       = "UNKNOWN_CLUSTER"
+	CodeChangeFreeze         = "CHANGE_FREEZE"
+	CodeQuotaExceeded        = "QUOTA_EXCEEDED"
+	CodeHostNotAllowed       = "HOST_NOT_ALLOWED"
+	CodeHostReserved         = "HOST_RESERVED"
+	CodeNamingRuleViolated   = "NAMING_RULE_VIOLATED"
+	CodeAnnotationNotAllowed = "ANNOTATION_NOT_ALLOWED"
+	CodeUnsupportedAdapter   = "UNSUPPORTED_ADAPTER"
+	CodeIngressClassNotFound = "INGRESS_CLASS_NOT_FOUND"
+	CodeIngressClassRequired = "INGRESS_CLASS_REQUIRED"
+	CodeNotManaged           = "NOT_MANAGED"
+	CodeConfigMapConflict    = "CONFIGMAP_CONFLICT"
+	CodeAdmissionFailed      = "ADMISSION_FAILED"
+	CodeInvalidArgument      = "INVALID_ARGUMENT"
+)
+
+// Error 创建时带上错误码的错误，错误码不依赖消息内容，修改消息不影响错误码
+type Error struct {
+	Code string
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap 支持 errors.Is 判断原来的错误
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ErrorCode 错误码
+func (e *Error) ErrorCode() string {
+	return e.Code
+}
+
+// NewError 创建带错误码的错误，代替 errors.New
+func NewError(code string, message string) error {
+	return &Error{Code: code, Err: errors.New(message)}
+}
+
+// WithCode 给已有的错误带上错误码
+func WithCode(code string, err error) error {
+	return &Error{Code: code, Err: err}
+}
+
+// coded 带错误码的错误，其他包的错误类型实现 ErrorCode 方法即可
+type coded interface {
+	ErrorCode() string
+}
+
+// Code 错误链中第一个错误码，没有时为空
+func Code(err error) string {
+	var e coded
+	if errors.As(err, &e) {
+		return e.ErrorCode()
+	}
+	return ""
+}
@@ -0,0 +1,222 @@
+// Package i18n 错误消息的多语言目录，服务内部的错误使用中文，返回给调用方前按请求的语言翻译
+// 错误码不随语言变化，调用方按错误码判断错误类型，不要按消息内容判断
+package i18n
+
+import (
+	_ "embed"
+	"errors"
+	"github.com/asim/go-micro/v3/config"
+	"github.com/zxnlx/common"
+	"regexp"
+	"sigs.k8s.io/yaml"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// 支持的语言，服务内部的错误消息为中文
+const (
+	LangZh = "zh"
+	LangEn = "en"
+)
+
+// Config 多语言配置，从配置中心的 route.i18n 节点读取，修改后无需重启
+type Config struct {
+	// DefaultLanguage 请求没有指定语言或指定的语言不支持时使用，默认 zh
+	DefaultLanguage string `json:"default_language"`
+}
+
+func (c Config) defaultLanguage() string {
+	if supported(c.DefaultLanguage) {
+		return strings.ToLower(c.DefaultLanguage)
+	}
+	return LangZh
+}
+
+//go:embed messages.yaml
+var messagesYAML []byte
+
+// Message 目录中的一条消息
+type Message struct {
+	Code string `json:"code"`
+	Zh   string `json:"zh"`
+	En   string `json:"en"`
+}
+
+func (m Message) template(lang string) string {
+	if lang == LangEn {
+		return m.En
+	}
+	return m.Zh
+}
+
+type entry struct {
+	Message
+	patterns []pattern
+}
+
+// 一种语言的模板，names 为各个分组对应的占位名
+type pattern struct {
+	re    *regexp.Regexp
+	names []string
+}
+
+var placeholder = regexp.MustCompile(`\{(\w+)\}`)
+
+// Catalog 按顺序匹配的消息目录
+type Catalog struct {
+	entries []entry
+	config  atomic.Value
+}
+
+// Default 服务使用的目录，启动时按配置中心设置默认语言
+var Default = MustLoad(messagesYAML)
+
+// Load 解析 YAML 格式的目录
+func Load(data []byte) (*Catalog, error) {
+	var messages []Message
+	if err := yaml.Unmarshal(data, &messages); err != nil {
+		return nil, err
+	}
+	c := &Catalog{}
+	c.SetConfig(Config{})
+	for _, v := range messages {
+		if v.Code == "" || v.Zh == "" || v.En == "" {
+			return nil, errors.New("消息目录中的 code、zh、en 不能为空：" + v.Zh + v.En)
+		}
+		e := entry{Message: v, patterns: []pattern{compile(v.Zh), compile(v.En)}}
+		if sortedNames(e.patterns[0]) != sortedNames(e.patterns[1]) {
+			return nil, errors.New("消息 " + v.Code + " 各语言的占位不一致")
+		}
+		c.entries = append(c.entries, e)
+	}
+	return c, nil
+}
+
+// MustLoad 解析失败时 panic，用于内置的目录
+func MustLoad(data []byte) *Catalog {
+	c, err := Load(data)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// 模板中的文字原样匹配，占位匹配任意内容
+func compile(template string) pattern {
+	var b strings.Builder
+	var names []string
+	b.WriteString("^")
+	last := 0
+	for _, loc := range placeholder.FindAllStringSubmatchIndex(template, -1) {
+		b.WriteString(regexp.QuoteMeta(template[last:loc[0]]))
+		b.WriteString("(.+?)")
+		names = append(names, template[loc[2]:loc[3]])
+		last = loc[1]
+	}
+	b.WriteString(regexp.QuoteMeta(template[last:]))
+	b.WriteString("$")
+	return pattern{re: regexp.MustCompile(b.String()), names: names}
+}
+
+func sortedNames(p pattern) string {
+	names := append([]string(nil), p.names...)
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+// SetConfig 运行时替换配置
+func (c *Catalog) SetConfig(config Config) {
+	c.config.Store(config)
+}
+
+// Config 当前生效的配置
+func (c *Catalog) Config() Config {
+	return c.config.Load().(Config)
+}
+
+// Watch 监听配置中心的变更，path 一般为 route, i18n
+func (c *Catalog) Watch(conf config.Config, path ...string) {
+	watcher, err := conf.Watch(path...)
+	if err != nil {
+		common.Error(err)
+		return
+	}
+	for {
+		value, err := watcher.Next()
+		if err != nil {
+			common.Error(err)
+			return
+		}
+		i18nConfig := Config{}
+		if err := value.Scan(&i18nConfig); err != nil {
+			common.Error(err)
+			continue
+		}
+		c.SetConfig(i18nConfig)
+		common.Infof("多语言配置已更新 default_language=%s", i18nConfig.defaultLanguage())
+	}
+}
+
+// Negotiate 按 Accept-Language 选出支持的语言，如 en-US,en;q=0.9,zh;q=0.8，都不支持时使用默认语言
+func (c *Catalog) Negotiate(acceptLanguage string) string {
+	best, bestQ := "", 0.0
+	for _, v := range strings.Split(acceptLanguage, ",") {
+		parts := strings.Split(strings.TrimSpace(v), ";")
+		tag := strings.ToLower(strings.TrimSpace(parts[0]))
+		if i := strings.IndexAny(tag, "-_"); i > 0 {
+			tag = tag[:i]
+		}
+		q := 1.0
+		for _, p := range parts[1:] {
+			p = strings.TrimSpace(p)
+			if strings.HasPrefix(p, "q=") {
+				if f, err := strconv.ParseFloat(p[2:], 64); err == nil {
+					q = f
+				}
+			}
+		}
+		if supported(tag) && q > bestQ {
+			best, bestQ = tag, q
+		}
+	}
+	if best == "" {
+		return c.Config().defaultLanguage()
+	}
+	return best
+}
+
+func supported(lang string) bool {
+	lang = strings.ToLower(lang)
+	return lang == LangZh || lang == LangEn
+}
+
+// Translate 把消息翻译为 lang，返回错误码和翻译后的消息，目录中没有时错误码为空，消息原样返回
+func (c *Catalog) Translate(message string, lang string) (string, string) {
+	if !supported(lang) {
+		lang = c.Config().defaultLanguage()
+	}
+	lang = strings.ToLower(lang)
+	for _, e := range c.entries {
+		for _, p := range e.patterns {
+			match := p.re.FindStringSubmatch(message)
+			if match == nil {
+				continue
+			}
+			values := map[string]string{}
+			for i, name := range p.names {
+				values[name] = match[i+1]
+			}
+			//嵌套的错误按同一语言翻译
+			if inner, ok := values["error"]; ok {
+				_, values["error"] = c.Translate(inner, lang)
+			}
+			translated := placeholder.ReplaceAllStringFunc(e.template(lang), func(s string) string {
+				return values[s[1:len(s)-1]]
+			})
+			return e.Code, translated
+		}
+	}
+	return "", message
+}
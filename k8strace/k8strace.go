@@ -0,0 +1,249 @@
+// Package k8strace 记录每个 Kubernetes API 请求的动词、路径、状态码、耗时和重试次数，
+// 运行时通过配置中心开关，用于排查 API server 限流（429、APF 排队）等问题
+package k8strace
+
+import (
+	"encoding/json"
+	"github.com/asim/go-micro/v3/config"
+	"github.com/zxnlx/common"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// 限流或服务端错误后，在 Retry-After 加上这段时间内的相同请求视为重试
+	retryGrace = 5 * time.Second
+	// 跟踪的待重试请求个数上限
+	maxPendingRetries = 1000
+)
+
+// Config 请求追踪配置，从配置中心的 route.k8s_trace 节点读取，修改后无需重启
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// SlowMilliseconds 只记录耗时超过该值的请求，失败和被限流的请求始终记录，为 0 时全部记录
+	SlowMilliseconds int `json:"slow_ms"`
+	// Verbs 只记录这些动词，如 get、list、watch、create、update、patch、delete，为空时全部记录
+	Verbs []string `json:"verbs"`
+}
+
+func (c Config) match(verb string, status int, duration time.Duration) bool {
+	if len(c.Verbs) > 0 && !contains(c.Verbs, verb) {
+		return false
+	}
+	if status == 0 || status >= 400 {
+		return true
+	}
+	return duration >= time.Duration(c.SlowMilliseconds)*time.Millisecond
+}
+
+// Tracer 包装 client-go 的 Transport，未开启时直接转发
+type Tracer struct {
+	config  atomic.Value
+	mu      sync.Mutex
+	pending map[string]pendingRetry
+}
+
+type pendingRetry struct {
+	attempts int
+	until    time.Time
+}
+
+// Default 服务使用的实例，创建 k8s client 时安装，读取配置中心后再设置
+var Default = New(Config{})
+
+// New 创建
+func New(config Config) *Tracer {
+	t := &Tracer{pending: map[string]pendingRetry{}}
+	t.SetConfig(config)
+	return t
+}
+
+// SetConfig 运行时替换配置
+func (t *Tracer) SetConfig(config Config) {
+	t.config.Store(config)
+}
+
+// Config 当前生效的配置
+func (t *Tracer) Config() Config {
+	return t.config.Load().(Config)
+}
+
+// Watch 监听配置中心的变更，path 一般为 route, k8s_trace
+func (t *Tracer) Watch(conf config.Config, path ...string) {
+	watcher, err := conf.Watch(path...)
+	if err != nil {
+		common.Error(err)
+		return
+	}
+	for {
+		value, err := watcher.Next()
+		if err != nil {
+			common.Error(err)
+			return
+		}
+		traceConfig := Config{}
+		if err := value.Scan(&traceConfig); err != nil {
+			common.Error(err)
+			continue
+		}
+		t.SetConfig(traceConfig)
+		common.Infof("k8s 请求追踪配置已更新 enabled=%v slow_ms=%d", traceConfig.Enabled, traceConfig.SlowMilliseconds)
+	}
+}
+
+// WrapTransport 用于 rest.Config.Wrap
+func (t *Tracer) WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return &transport{tracer: t, next: rt}
+}
+
+type transport struct {
+	tracer *Tracer
+	next   http.RoundTripper
+}
+
+func (rt *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	conf := rt.tracer.Config()
+	if !conf.Enabled {
+		return rt.next.RoundTrip(req)
+	}
+	key := req.Method + " " + req.URL.String()
+	attempt := rt.tracer.attempt(key)
+	start := time.Now()
+	rsp, err := rt.next.RoundTrip(req)
+	duration := time.Since(start)
+	status := 0
+	if rsp != nil {
+		status = rsp.StatusCode
+	}
+	rt.tracer.record(key, attempt, rsp)
+	verb := requestVerb(req)
+	if !conf.match(verb, status, duration) {
+		return rsp, err
+	}
+	entry := map[string]interface{}{
+		"k8s_verb":    verb,
+		"method":      req.Method,
+		"path":        req.URL.Path,
+		"status":      status,
+		"duration_ms": duration.Milliseconds(),
+		"retry":       attempt,
+	}
+	if req.URL.RawQuery != "" {
+		entry["query"] = req.URL.RawQuery
+	}
+	if rsp != nil {
+		//API Priority and Fairness 的分组，排队或被拒绝时用于定位限流规则
+		for name, header := range map[string]string{
+			"retry_after":    "Retry-After",
+			"priority_level": "X-Kubernetes-Pf-Prioritylevel-Uid",
+			"flow_schema":    "X-Kubernetes-Pf-Flowschema-Uid",
+		} {
+			if v := rsp.Header.Get(header); v != "" {
+				entry[name] = v
+			}
+		}
+	}
+	if err != nil {
+		entry["error"] = err.Error()
+	}
+	b, _ := json.Marshal(entry)
+	common.Debug(string(b))
+	return rsp, err
+}
+
+// client-go 遇到 429 和带 Retry-After 的 5xx 会按原样重发，相同的请求视为同一次操作的重试
+func (t *Tracer) attempt(key string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p, ok := t.pending[key]
+	if !ok || time.Now().After(p.until) {
+		return 0
+	}
+	return p.attempts
+}
+
+func (t *Tracer) record(key string, attempt int, rsp *http.Response) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if rsp == nil || (rsp.StatusCode != http.StatusTooManyRequests && rsp.StatusCode < 500) {
+		delete(t.pending, key)
+		return
+	}
+	wait, _ := strconv.Atoi(rsp.Header.Get("Retry-After"))
+	now := time.Now()
+	if len(t.pending) >= maxPendingRetries {
+		for k, v := range t.pending {
+			if now.After(v.until) {
+				delete(t.pending, k)
+			}
+		}
+		if len(t.pending) >= maxPendingRetries {
+			return
+		}
+	}
+	t.pending[key] = pendingRetry{attempts: attempt + 1, until: now.Add(time.Duration(wait)*time.Second + retryGrace)}
+}
+
+// 按方法和路径推断动词，规则和 API server 的 RequestInfo 相同
+func requestVerb(req *http.Request) string {
+	name := resourceName(req.URL.Path)
+	switch req.Method {
+	case http.MethodGet:
+		if req.URL.Query().Get("watch") == "true" || req.URL.Query().Get("watch") == "1" {
+			return "watch"
+		}
+		if name == "" {
+			return "list"
+		}
+		return "get"
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		if name == "" {
+			return "deletecollection"
+		}
+		return "delete"
+	}
+	return strings.ToLower(req.Method)
+}
+
+// /api/v1/namespaces/ns/ingresses/name、/apis/group/version/... 中的资源名称，集合请求返回空
+func resourceName(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case len(parts) >= 2 && parts[0] == "api":
+		parts = parts[2:]
+	case len(parts) >= 3 && parts[0] == "apis":
+		parts = parts[3:]
+	default:
+		return ""
+	}
+	//命名空间对象本身
+	if len(parts) == 2 && parts[0] == "namespaces" {
+		return parts[1]
+	}
+	if len(parts) >= 3 && parts[0] == "namespaces" {
+		parts = parts[2:]
+	}
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,494 @@
+package legacyconf
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// HAProxy 配置中的一行，已去掉注释
+type haproxyLine struct {
+	fields []string
+	line   int
+}
+
+func (l haproxyLine) where() string {
+	return "第 " + strconv.Itoa(l.line) + " 行"
+}
+
+// HAProxy 配置中的一个段，如 frontend、backend、listen
+type haproxySection struct {
+	kind  string
+	name  string
+	line  int
+	lines []haproxyLine
+}
+
+func (s *haproxySection) where() string {
+	return s.kind + " " + s.name + "（第 " + strconv.Itoa(s.line) + " 行）"
+}
+
+// 段内第一个指定关键字的参数
+func (s *haproxySection) value(keyword string) string {
+	for _, l := range s.lines {
+		if l.fields[0] == keyword && len(l.fields) > 1 {
+			return l.fields[1]
+		}
+	}
+	return ""
+}
+
+var haproxySectionKinds = map[string]bool{
+	"global": true, "defaults": true, "frontend": true, "backend": true, "listen": true,
+	"resolvers": true, "peers": true, "userlist": true, "mailers": true, "program": true,
+	"cache": true, "http-errors": true, "ring": true,
+}
+
+// acl 的条件，kind 为 host、path 或 other
+type haproxyACL struct {
+	kind        string
+	values      []string
+	unsupported string
+	//路径精确匹配，按前缀转换
+	exact bool
+}
+
+// 按 HAProxy 的语法拆分一行：空白分隔，引号内的内容为一个词，# 开始的词到行尾为注释
+func splitHAProxyLine(s string) []string {
+	fields := []string{}
+	word := &strings.Builder{}
+	inWord := false
+	var quote rune
+	for _, c := range s {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				word.WriteRune(c)
+			}
+		case c == '"' || c == '\'':
+			quote, inWord = c, true
+		case c == '#' && !inWord:
+			return fields
+		case c == ' ' || c == '\t' || c == '\r':
+			if inWord {
+				fields = append(fields, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(c)
+			inWord = true
+		}
+	}
+	if inWord {
+		fields = append(fields, word.String())
+	}
+	return fields
+}
+
+func parseHAProxy(content string) ([]*haproxySection, error) {
+	sections := []*haproxySection{}
+	var current *haproxySection
+	for i, text := range strings.Split(content, "\n") {
+		fields := splitHAProxyLine(text)
+		if len(fields) == 0 {
+			continue
+		}
+		if haproxySectionKinds[fields[0]] {
+			current = &haproxySection{kind: fields[0], line: i + 1}
+			if len(fields) > 1 {
+				current.name = fields[1]
+			}
+			if (current.kind == "frontend" || current.kind == "backend" || current.kind == "listen") && current.name == "" {
+				return nil, errors.New("第 " + strconv.Itoa(i+1) + " 行的 " + current.kind + " 缺少名称")
+			}
+			sections = append(sections, current)
+			continue
+		}
+		if current == nil {
+			return nil, errors.New("第 " + strconv.Itoa(i+1) + " 行的配置不在任何段中")
+		}
+		current.lines = append(current.lines, haproxyLine{fields: fields, line: i + 1})
+	}
+	return sections, nil
+}
+
+// 解析 acl 的匹配方式和值，只转换按域名和路径前缀的匹配
+func parseHAProxyACL(fields []string) (string, *haproxyACL) {
+	acl := &haproxyACL{kind: "other"}
+	if len(fields) < 4 {
+		acl.unsupported = "acl 格式不正确"
+		return "", acl
+	}
+	name, criterion := fields[1], strings.ToLower(fields[2])
+	method := ""
+	values := []string{}
+	for i := 3; i < len(fields); i++ {
+		v := fields[i]
+		switch {
+		case v == "-m" && i+1 < len(fields):
+			method = fields[i+1]
+			i++
+		case v == "-f":
+			acl.unsupported = "从文件读取的 acl 无法转换"
+			return name, acl
+		case v == "--":
+			values = append(values, fields[i+1:]...)
+			i = len(fields)
+		case strings.HasPrefix(v, "-") && len(values) == 0:
+		default:
+			values = append(values, v)
+		}
+	}
+	switch criterion {
+	case "hdr(host)", "req.hdr(host)", "hdr_str(host)", "hdr_dom(host)", "req.hdr_dom(host)", "hdr_end(host)":
+		acl.kind = "host"
+		if strings.HasPrefix(criterion, "hdr_dom") {
+			method = "dom"
+		}
+		if strings.HasPrefix(criterion, "hdr_end") {
+			method = "end"
+		}
+		for _, v := range values {
+			v = strings.ToLower(v)
+			if i := strings.LastIndex(v, ":"); i > 0 {
+				v = v[:i]
+			}
+			switch method {
+			case "", "str", "dom":
+				acl.values = append(acl.values, v)
+			case "end":
+				if !strings.HasPrefix(v, ".") {
+					acl.unsupported = "域名后缀 " + v + " 不是以 . 开头，无法转换为通配域名"
+					return name, acl
+				}
+				acl.values = append(acl.values, "*"+v)
+			default:
+				acl.unsupported = "域名匹配方式 -m " + method + " 无法转换"
+				return name, acl
+			}
+		}
+	case "path", "path_beg", "path_dir", "path_str":
+		acl.kind = "path"
+		if criterion == "path_beg" || criterion == "path_dir" {
+			method = "beg"
+		}
+		if method != "" && method != "beg" && method != "str" && method != "dir" {
+			acl.unsupported = "路径匹配方式 -m " + method + " 无法转换"
+			return name, acl
+		}
+		acl.exact = method == "" || method == "str"
+		acl.values = values
+	default:
+		acl.unsupported = "条件 " + criterion + " 无法转换"
+	}
+	return name, acl
+}
+
+// ParseHAProxy 解析 HAProxy 配置中的 frontend、backend 和 listen 段
+// use_backend 中按域名和路径前缀的条件转换为路由，backend 按名称转换为 Service
+func ParseHAProxy(content string) (*Result, error) {
+	sections, err := parseHAProxy(content)
+	if err != nil {
+		return nil, err
+	}
+	b := newBuilder()
+	backends := map[string]*haproxySection{}
+	frontends := []*haproxySection{}
+	defaultMode := ""
+	for _, s := range sections {
+		switch s.kind {
+		case "defaults":
+			if mode := s.value("mode"); mode != "" {
+				defaultMode = mode
+			}
+		case "backend":
+			backends[s.name] = s
+		case "frontend":
+			frontends = append(frontends, s)
+		case "listen":
+			backends[s.name] = s
+			frontends = append(frontends, s)
+		}
+		if s.kind == "backend" || s.kind == "frontend" || s.kind == "listen" {
+			if mode := s.value("mode"); mode == "tcp" || mode == "" && defaultMode == "tcp" {
+				b.warn(RuleUnsupported, severityWarning, "", s.where()+" 是 tcp 模式，无法转换为路由")
+				s.kind = "tcp"
+			}
+		}
+	}
+	if len(frontends) == 0 {
+		return nil, errors.New("配置中没有 frontend 或 listen 段")
+	}
+	if defaultMode == "" {
+		b.warn(RuleApproximate, severityInfo, "", "defaults 中没有指定 mode，按 http 转换")
+	}
+	used := map[string]bool{}
+	for _, f := range frontends {
+		if f.kind == "tcp" {
+			continue
+		}
+		convertHAProxyFrontend(b, f, backends, used)
+	}
+	for name, s := range backends {
+		if s.kind == "backend" && !used[name] {
+			b.warn(RuleUnsupported, severityInfo, "", s.where()+" 没有被任何 frontend 使用")
+		}
+	}
+	return b.finish(), nil
+}
+
+func convertHAProxyFrontend(b *builder, f *haproxySection, backends map[string]*haproxySection, used map[string]bool) {
+	acls := map[string]*haproxyACL{}
+	tls := false
+	drafts := []*Draft{}
+	addDraft := func(host string) *Draft {
+		d := b.draft(host, f.name, f.where())
+		for _, v := range drafts {
+			if v == d {
+				return d
+			}
+		}
+		drafts = append(drafts, d)
+		if tls {
+			d.warn(RuleTLS, severityInfo, "route_tls_issuer", f.where()+" 启用了 TLS，请设置 route_tls_issuer 或在 k8s 中导入原证书")
+		}
+		return d
+	}
+	// 路径指向 backend，backend 中的请求头设置对整条路由生效
+	addPath := func(d *Draft, path string, name string, l haproxyLine) {
+		s, ok := backends[name]
+		if !ok || s.kind == "tcp" {
+			d.warn(RuleUnsupported, severityWarning, "route_path", l.where()+"的 backend "+name+" 不存在或不是 http 模式，已跳过")
+			return
+		}
+		used[name] = true
+		servers := []string{}
+		port := int32(0)
+		for _, v := range s.lines {
+			if v.fields[0] == "server-template" {
+				d.warn(RuleApproximate, severityInfo, "route_path", v.where()+"的 server-template 按 backend 名称转换为 Service")
+			}
+			if v.fields[0] != "server" || len(v.fields) < 3 {
+				continue
+			}
+			addr := v.fields[2]
+			if i := strings.Index(addr, "@"); i >= 0 {
+				addr = addr[i+1:]
+			}
+			server, err := parseBackend(addr, 80)
+			if err != nil {
+				d.warn(RuleUnsupported, severityWarning, "route_path", v.where()+"："+err.Error())
+				continue
+			}
+			if port == 0 {
+				port = server.port
+			}
+			servers = append(servers, server.String())
+			for _, option := range v.fields[3:] {
+				if option == "ssl" {
+					d.warn(RuleApproximate, severityInfo, "route_annotations", v.where()+"的后端使用 TLS，请按 Ingress class 配置后端协议注解")
+					break
+				}
+			}
+		}
+		if port == 0 {
+			port = 80
+		}
+		service := dns1035(name)
+		if d.addPath(path, service, port, l.where()+"的 "+name) == nil {
+			return
+		}
+		d.warnBackend(len(d.Route.RoutePath)-1, "backend "+name+"（"+strings.Join(servers, ", ")+"）", service)
+		for _, v := range s.lines {
+			convertHAProxyHeader(d, v)
+		}
+	}
+	defaultBackend := ""
+	if f.kind == "listen" {
+		defaultBackend = f.name
+	}
+	for _, l := range f.lines {
+		switch l.fields[0] {
+		case "bind":
+			for _, v := range l.fields[1:] {
+				if v == "ssl" || strings.HasSuffix(v, ":443") {
+					tls = true
+				}
+			}
+		case "acl":
+			name, acl := parseHAProxyACL(l.fields)
+			if current, ok := acls[name]; ok {
+				//同名的 acl 之间是或的关系
+				if current.kind != acl.kind {
+					current.unsupported = "同名 acl 的匹配方式不同"
+				}
+				current.values = append(current.values, acl.values...)
+				if acl.unsupported != "" {
+					current.unsupported = acl.unsupported
+				}
+				continue
+			}
+			acls[name] = acl
+		case "default_backend":
+			if len(l.fields) > 1 {
+				defaultBackend = l.fields[1]
+			}
+		}
+	}
+	for _, l := range f.lines {
+		if l.fields[0] != "use_backend" || len(l.fields) < 2 {
+			continue
+		}
+		name := l.fields[1]
+		if strings.Contains(name, "%[") {
+			b.warn(RuleUnsupported, severityWarning, "", l.where()+"的 use_backend "+name+" 按表达式选择 backend，无法转换")
+			continue
+		}
+		hosts, paths, ok := haproxyCondition(b, l, acls)
+		if !ok {
+			continue
+		}
+		for _, host := range hosts {
+			d := addDraft(host)
+			for _, path := range paths {
+				addPath(d, path, name, l)
+			}
+		}
+	}
+	//默认 backend 作为各个域名的兜底路径
+	if defaultBackend != "" {
+		if len(drafts) == 0 {
+			addDraft("")
+		}
+		for _, d := range drafts {
+			if !hasPath(d, "/") {
+				addPath(d, "/", defaultBackend, haproxyLine{line: f.line})
+			}
+		}
+	}
+	if len(drafts) == 0 {
+		b.warn(RuleUnsupported, severityWarning, "", f.where()+" 没有可以转换的 use_backend 或 default_backend")
+	}
+	for _, d := range drafts {
+		for _, l := range f.lines {
+			convertHAProxyHeader(d, l)
+		}
+	}
+}
+
+// 条件中的 acl 之间是与的关系，只转换一个域名条件加一个路径条件，其他条件忽略
+func haproxyCondition(b *builder, l haproxyLine, acls map[string]*haproxyACL) ([]string, []string, bool) {
+	hosts, paths := []string{""}, []string{"/"}
+	if len(l.fields) == 2 {
+		return hosts, paths, true
+	}
+	if l.fields[2] != "if" {
+		b.warn(RuleUnsupported, severityWarning, "", l.where()+"的 use_backend 使用了 "+l.fields[2]+"，无法转换")
+		return nil, nil, false
+	}
+	hostSet, pathSet := false, false
+	for _, cond := range l.fields[3:] {
+		if cond == "||" || strings.EqualFold(cond, "or") || strings.HasPrefix(cond, "!") || strings.HasPrefix(cond, "{") {
+			b.warn(RuleUnsupported, severityWarning, "", l.where()+"的 use_backend 条件包含 "+cond+"，无法转换")
+			return nil, nil, false
+		}
+		acl, ok := acls[cond]
+		if !ok {
+			b.warn(RuleUnsupported, severityWarning, "", l.where()+"的 use_backend 使用了未定义的 acl "+cond)
+			return nil, nil, false
+		}
+		if acl.unsupported != "" {
+			b.warn(RuleUnsupported, severityWarning, "", l.where()+"的 acl "+cond+" "+acl.unsupported)
+			return nil, nil, false
+		}
+		switch acl.kind {
+		case "host":
+			if hostSet {
+				b.warn(RuleUnsupported, severityWarning, "", l.where()+"的 use_backend 同时要求多个域名条件，无法转换")
+				return nil, nil, false
+			}
+			hosts, hostSet = acl.values, true
+		case "path":
+			if pathSet {
+				b.warn(RuleUnsupported, severityWarning, "", l.where()+"的 use_backend 同时要求多个路径条件，无法转换")
+				return nil, nil, false
+			}
+			paths, pathSet = acl.values, true
+			if acl.exact {
+				b.warn(RuleApproximate, severityWarning, "route_path", l.where()+"的 acl "+cond+" 是路径精确匹配，按前缀转换")
+			}
+		}
+	}
+	return hosts, paths, true
+}
+
+// 转换 http-request、http-response 的请求头、响应头设置和压缩配置
+func convertHAProxyHeader(d *Draft, l haproxyLine) {
+	fields := l.fields
+	switch fields[0] {
+	case "http-request", "http-response":
+	case "compression":
+		if len(fields) > 1 && fields[1] == "algo" {
+			d.Route.RouteCompression = true
+		}
+		return
+	case "redirect":
+		d.warn(RuleUnsupported, severityInfo, "", l.where()+"的 redirect 未转换，跳转 HTTPS 由 Ingress 控制器处理")
+		return
+	default:
+		return
+	}
+	if len(fields) < 2 {
+		return
+	}
+	action := fields[1]
+	for _, v := range fields[2:] {
+		if v == "if" || v == "unless" {
+			d.warn(RuleUnsupported, severityWarning, "", l.where()+"的 "+fields[0]+" "+action+" 带有条件，无法转换")
+			return
+		}
+	}
+	request := fields[0] == "http-request"
+	switch action {
+	case "set-header", "add-header":
+		if len(fields) < 4 {
+			return
+		}
+		name, value := fields[2], strings.Join(fields[3:], " ")
+		if strings.Contains(value, "%[") || strings.Contains(value, "%") {
+			d.warn(RuleUnsupported, severityInfo, "", l.where()+"的请求头/响应头 "+name+" 使用了变量，未转换")
+			return
+		}
+		switch {
+		case request && action == "add-header":
+			d.setHeader(&d.Route.RouteRequestHeaderAdd, "route_request_header_add", name, value)
+		case request:
+			d.setHeader(&d.Route.RouteRequestHeaderSet, "route_request_header_set", name, value)
+		default:
+			d.setHeader(&d.Route.RouteResponseHeaderSet, "route_response_header_set", name, value)
+		}
+	case "del-header":
+		if len(fields) < 3 {
+			return
+		}
+		if request {
+			d.Route.RouteRequestHeaderRemove = appendOnce(d.Route.RouteRequestHeaderRemove, fields[2])
+		} else {
+			d.Route.RouteResponseHeaderRemove = appendOnce(d.Route.RouteResponseHeaderRemove, fields[2])
+		}
+	default:
+		d.warn(RuleUnsupported, severityWarning, "", l.where()+"的 "+fields[0]+" "+action+" 未转换")
+	}
+}
+
+func hasPath(d *Draft, path string) bool {
+	for _, v := range d.Route.RoutePath {
+		if v.RoutePathName == path {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,349 @@
+package legacyconf
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitHAProxyLine(t *testing.T) {
+	cases := []struct {
+		line string
+		want []string
+	}{
+		{line: "  bind *:80  ", want: []string{"bind", "*:80"}},
+		{line: "\tserver s1 10.0.0.1:8080 check\r", want: []string{"server", "s1", "10.0.0.1:8080", "check"}},
+		{line: "# 整行注释", want: []string{}},
+		{line: "mode http # 行尾注释", want: []string{"mode", "http"}},
+		{line: "acl a path_beg /a#b", want: []string{"acl", "a", "path_beg", "/a#b"}},
+		{line: `http-response set-header X-Msg "hello world # not comment"`, want: []string{"http-response", "set-header", "X-Msg", "hello world # not comment"}},
+		{line: `acl q hdr(host) -i 'quoted.example.com'`, want: []string{"acl", "q", "hdr(host)", "-i", "quoted.example.com"}},
+		{line: `http-request set-header X-Empty ""`, want: []string{"http-request", "set-header", "X-Empty", ""}},
+		{line: `x-"a b"c`, want: []string{"x-a bc"}},
+	}
+	for _, c := range cases {
+		if got := splitHAProxyLine(c.line); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("splitHAProxyLine(%q) = %q，应该是 %q", c.line, got, c.want)
+		}
+	}
+}
+
+func TestParseHAProxyACL(t *testing.T) {
+	cases := []struct {
+		name        string
+		line        string
+		kind        string
+		values      []string
+		exact       bool
+		unsupported string
+	}{
+		{name: "域名", line: "acl a hdr(host) -i API.example.com:8080", kind: "host", values: []string{"api.example.com"}},
+		{name: "多个域名", line: "acl a req.hdr(host) -i a.example.com b.example.com", kind: "host", values: []string{"a.example.com", "b.example.com"}},
+		{name: "域名后缀", line: "acl a hdr_end(host) -i .example.com", kind: "host", values: []string{"*.example.com"}},
+		{name: "域名后缀不以点开头", line: "acl a hdr_end(host) -i example.com", kind: "host", unsupported: "不是以 . 开头"},
+		{name: "域名正则", line: "acl a hdr(host) -m reg ^api", kind: "host", unsupported: "-m reg"},
+		{name: "路径前缀", line: "acl a path_beg /api /v1", kind: "path", values: []string{"/api", "/v1"}},
+		{name: "路径精确匹配", line: "acl a path /exact", kind: "path", values: []string{"/exact"}, exact: true},
+		{name: "路径按 -m beg", line: "acl a path -m beg /api", kind: "path", values: []string{"/api"}},
+		{name: "路径正则", line: "acl a path -m reg ^/api", kind: "path", unsupported: "-m reg"},
+		{name: "-- 之后的值", line: "acl a path_beg -- -dash", kind: "path", values: []string{"-dash"}},
+		{name: "从文件读取", line: "acl a hdr(host) -f /etc/haproxy/hosts.lst", kind: "other", unsupported: "从文件读取"},
+		{name: "其他条件", line: "acl a src 10.0.0.0/8", kind: "other", unsupported: "条件 src"},
+		{name: "格式不正确", line: "acl a path_beg", kind: "other", unsupported: "格式不正确"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, acl := parseHAProxyACL(splitHAProxyLine(c.line))
+			if acl.kind != c.kind {
+				t.Fatalf("kind = %s，应该是 %s", acl.kind, c.kind)
+			}
+			if c.unsupported != "" {
+				if !strings.Contains(acl.unsupported, c.unsupported) {
+					t.Fatalf("unsupported = %q，应该包含 %q", acl.unsupported, c.unsupported)
+				}
+				return
+			}
+			if acl.unsupported != "" {
+				t.Fatalf("unsupported = %q", acl.unsupported)
+			}
+			if !reflect.DeepEqual(acl.values, c.values) || acl.exact != c.exact {
+				t.Fatalf("values = %q，exact = %v，应该是 %q，%v", acl.values, acl.exact, c.values, c.exact)
+			}
+		})
+	}
+}
+
+func TestParseHAProxyErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "不在段中的配置", content: "# 注释\nmode http\nfrontend web", want: "第 2 行的配置不在任何段中"},
+		{name: "frontend 缺少名称", content: "defaults\n  mode http\nfrontend\n  bind *:80", want: "第 3 行的 frontend 缺少名称"},
+		{name: "backend 缺少名称", content: "backend # 注释", want: "第 1 行的 backend 缺少名称"},
+		{name: "没有 frontend", content: "global\n  daemon\nbackend web\n  server w1 10.0.0.1", want: "配置中没有 frontend 或 listen 段"},
+		{name: "空配置", content: "", want: "配置中没有 frontend 或 listen 段"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := ParseHAProxy(c.content)
+			if err == nil || !strings.Contains(err.Error(), c.want) {
+				t.Fatalf("err = %v，应该包含 %q", err, c.want)
+			}
+		})
+	}
+}
+
+func TestParseHAProxy(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		check   func(t *testing.T, result *Result)
+	}{
+		{
+			name: "frontend、acl 和 backend",
+			content: `
+global
+    daemon
+defaults
+    mode http
+frontend web
+    bind *:80
+    acl host_api hdr(host) -i api.example.com
+    acl path_v1 path_beg /v1
+    use_backend api_v1 if host_api path_v1
+    default_backend web_default
+backend api_v1
+    server a1 10.0.0.1:8080 check
+    server a2 10.0.0.2:8080 check
+    http-request set-header X-Env prod
+backend web_default
+    server w1 10.0.0.3
+backend unused
+    server u1 10.0.0.4:8080`,
+			check: func(t *testing.T, result *Result) {
+				if want := []string{"api-example-com"}; !reflect.DeepEqual(draftNames(result), want) {
+					t.Fatalf("drafts = %v，应该是 %v", draftNames(result), want)
+				}
+				d := result.Drafts[0]
+				if want := []string{"/v1 api-v1:8080", "/ web-default:80"}; !reflect.DeepEqual(draftPaths(d), want) {
+					t.Fatalf("paths = %v，应该是 %v", draftPaths(d), want)
+				}
+				if want := map[string]string{"X-Env": "prod"}; !reflect.DeepEqual(d.Route.RouteRequestHeaderSet, want) {
+					t.Fatalf("RouteRequestHeaderSet = %v，应该是 %v", d.Route.RouteRequestHeaderSet, want)
+				}
+				if !hasWarning(d.Warnings, RuleBackend, "backend api_v1（10.0.0.1:8080, 10.0.0.2:8080）") {
+					t.Fatalf("缺少后端的警告：%v", d.Warnings)
+				}
+				if !hasWarning(result.Warnings, RuleUnsupported, "backend unused（第 18 行） 没有被任何 frontend 使用") {
+					t.Fatalf("缺少 backend 没有使用的警告：%v", result.Warnings)
+				}
+				if hasWarning(result.Warnings, RuleApproximate, "没有指定 mode") {
+					t.Fatal("defaults 中已经指定了 mode")
+				}
+			},
+		},
+		{
+			name: "listen 和 TLS",
+			content: `
+listen app
+    bind :443 ssl crt /etc/haproxy/app.pem
+    mode http
+    compression algo gzip
+    server s1 app.internal:9000 ssl verify none`,
+			check: func(t *testing.T, result *Result) {
+				d := findDraft(t, result, "app")
+				if want := []string{"/ app:9000"}; !reflect.DeepEqual(draftPaths(d), want) {
+					t.Fatalf("paths = %v，应该是 %v", draftPaths(d), want)
+				}
+				if !d.Route.RouteCompression {
+					t.Fatal("RouteCompression 应该为 true")
+				}
+				for _, w := range []struct{ rule, contains string }{
+					{RuleTLS, "启用了 TLS"},
+					{RuleApproximate, "后端使用 TLS"},
+					{RuleApproximate, "没有指定域名"},
+				} {
+					if !hasWarning(d.Warnings, w.rule, w.contains) {
+						t.Errorf("缺少警告 %s：%s", w.rule, w.contains)
+					}
+				}
+				if !hasWarning(result.Warnings, RuleApproximate, "没有指定 mode") {
+					t.Fatalf("缺少 defaults 没有 mode 的警告：%v", result.Warnings)
+				}
+			},
+		},
+		{
+			name: "注释、引号和请求头",
+			content: `
+# 旧机房的配置
+defaults
+    mode http   # 所有段使用 http
+frontend web
+    bind *:80
+    acl host_q hdr(host) -i "quoted.example.com" # 行尾注释
+    acl host_q hdr(host) -i other.example.com
+    use_backend web if host_q
+    http-response set-header X-Msg "hello world"
+    http-response del-header Server
+    http-request add-header X-Trace on
+    http-request del-header X-Internal
+    http-request set-header X-Client %[src]
+    http-request deny if { path_beg /admin }
+    http-request redirect scheme https
+    redirect scheme https if !{ ssl_fc }
+backend web
+    server w1 10.0.0.1:8080`,
+			check: func(t *testing.T, result *Result) {
+				if want := []string{"other-example-com", "quoted-example-com"}; !reflect.DeepEqual(draftNames(result), want) {
+					t.Fatalf("drafts = %v，应该是 %v", draftNames(result), want)
+				}
+				d := findDraft(t, result, "quoted-example-com")
+				if want := map[string]string{"X-Msg": "hello world"}; !reflect.DeepEqual(d.Route.RouteResponseHeaderSet, want) {
+					t.Fatalf("RouteResponseHeaderSet = %v，应该是 %v", d.Route.RouteResponseHeaderSet, want)
+				}
+				if want := map[string]string{"X-Trace": "on"}; !reflect.DeepEqual(d.Route.RouteRequestHeaderAdd, want) {
+					t.Fatalf("RouteRequestHeaderAdd = %v，应该是 %v", d.Route.RouteRequestHeaderAdd, want)
+				}
+				if !reflect.DeepEqual(d.Route.RouteResponseHeaderRemove, []string{"Server"}) || !reflect.DeepEqual(d.Route.RouteRequestHeaderRemove, []string{"X-Internal"}) {
+					t.Fatalf("RouteResponseHeaderRemove = %v，RouteRequestHeaderRemove = %v", d.Route.RouteResponseHeaderRemove, d.Route.RouteRequestHeaderRemove)
+				}
+				for _, w := range []struct{ rule, contains string }{
+					{RuleUnsupported, "X-Client 使用了变量"},
+					{RuleUnsupported, "http-request deny 带有条件"},
+					{RuleUnsupported, "http-request redirect 未转换"},
+					{RuleUnsupported, "redirect 未转换"},
+				} {
+					if !hasWarning(d.Warnings, w.rule, w.contains) {
+						t.Errorf("缺少警告 %s：%s", w.rule, w.contains)
+					}
+				}
+			},
+		},
+		{
+			name: "通配域名和路径精确匹配",
+			content: `
+defaults
+    mode http
+frontend web
+    bind *:80
+    acl wildcard hdr_end(host) -i .example.com
+    acl exact path /health
+    use_backend web if wildcard exact
+backend web
+    server w1 web.internal:8080`,
+			check: func(t *testing.T, result *Result) {
+				d := findDraft(t, result, "wildcard-example-com")
+				if d.Route.RouteHost != "*.example.com" {
+					t.Fatalf("RouteHost = %s", d.Route.RouteHost)
+				}
+				if want := []string{"/health web:8080"}; !reflect.DeepEqual(draftPaths(d), want) {
+					t.Fatalf("paths = %v，应该是 %v", draftPaths(d), want)
+				}
+				if !hasWarning(result.Warnings, RuleApproximate, "路径精确匹配") {
+					t.Fatalf("缺少精确匹配的警告：%v", result.Warnings)
+				}
+			},
+		},
+		{
+			name: "无法转换的 use_backend",
+			content: `
+defaults
+    mode http
+frontend web
+    bind *:80
+    acl host_api hdr(host) -i api.example.com
+    acl host_www hdr(host) -i www.example.com
+    acl from_office src 10.0.0.0/8
+    acl hosts_file hdr(host) -f /etc/haproxy/hosts.lst
+    use_backend api if !host_api
+    use_backend api if host_api || host_www
+    use_backend api if undefined_acl
+    use_backend api if from_office
+    use_backend api if hosts_file
+    use_backend api if host_api host_www
+    use_backend api unless host_api
+    use_backend %[req.hdr(host),lower]
+    use_backend missing if host_api
+backend api
+    server a1 10.0.0.1:8080`,
+			check: func(t *testing.T, result *Result) {
+				for _, contains := range []string{
+					"条件包含 !host_api",
+					"条件包含 ||",
+					"未定义的 acl undefined_acl",
+					"acl from_office 条件 src 无法转换",
+					"acl hosts_file 从文件读取",
+					"同时要求多个域名条件",
+					"使用了 unless",
+					"按表达式选择 backend",
+				} {
+					if !hasWarning(result.Warnings, RuleUnsupported, contains) {
+						t.Errorf("缺少警告：%s", contains)
+					}
+				}
+				if len(result.Drafts) != 0 {
+					t.Fatalf("drafts = %v", draftNames(result))
+				}
+				if !hasWarning(result.Warnings, RuleUnsupported, "backend missing 不存在") {
+					t.Fatalf("缺少 backend 不存在的警告：%v", result.Warnings)
+				}
+			},
+		},
+		{
+			name: "tcp 模式",
+			content: `
+defaults
+    mode tcp
+frontend db
+    bind *:3306
+    default_backend mysql
+backend mysql
+    server m1 10.0.0.1:3306
+frontend web
+    mode http
+    bind *:80
+    default_backend web
+backend web
+    mode http
+    server w1 10.0.0.2:8080`,
+			check: func(t *testing.T, result *Result) {
+				for _, contains := range []string{"frontend db（第 4 行） 是 tcp 模式", "backend mysql（第 7 行） 是 tcp 模式"} {
+					if !hasWarning(result.Warnings, RuleUnsupported, contains) {
+						t.Errorf("缺少警告：%s", contains)
+					}
+				}
+				d := findDraft(t, result, "web")
+				if want := []string{"/ web:8080"}; !reflect.DeepEqual(draftPaths(d), want) {
+					t.Fatalf("paths = %v，应该是 %v", draftPaths(d), want)
+				}
+			},
+		},
+		{
+			name: "没有 use_backend 和 default_backend",
+			content: `
+defaults
+    mode http
+frontend web
+    bind *:80`,
+			check: func(t *testing.T, result *Result) {
+				if len(result.Drafts) != 0 {
+					t.Fatalf("drafts = %v", draftNames(result))
+				}
+				if !hasWarning(result.Warnings, RuleUnsupported, "没有可以转换的 use_backend 或 default_backend") {
+					t.Fatalf("缺少警告：%v", result.Warnings)
+				}
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result, err := ParseHAProxy(c.content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.check(t, result)
+		})
+	}
+}
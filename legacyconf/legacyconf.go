@@ -0,0 +1,241 @@
+// Package legacyconf 解析旧的 nginx、HAProxy 反向代理配置，转换为路由草稿，用于从机房迁移到 k8s
+// 草稿只包含能直接对应的部分，无法转换的配置以警告返回，需要人工审核后再创建
+package legacyconf
+
+import (
+	"errors"
+	"github.com/zxnlx/route/proto/route"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// 支持的配置格式
+const (
+	FormatNginx   = "nginx"
+	FormatHAProxy = "haproxy"
+)
+
+// 警告规则
+const (
+	// RuleUnsupported 无法转换的配置，已跳过
+	RuleUnsupported = "legacy-unsupported"
+	// RuleBackend 后端是机房地址，需要替换为 k8s Service
+	RuleBackend = "legacy-backend"
+	// RuleTLS 原配置启用了 TLS，需要配置证书签发
+	RuleTLS = "legacy-tls"
+	// RuleApproximate 按近似的方式转换，行为可能和原配置不同
+	RuleApproximate = "legacy-approximate"
+)
+
+// 警告级别，和路由规格检查相同
+const (
+	severityWarning = "warning"
+	severityInfo    = "info"
+)
+
+// Draft 一条路由草稿，每个域名一条
+type Draft struct {
+	Route *route.RouteInfo
+	// Source 来源位置
+	Source   string
+	Warnings []*route.LintWarning
+}
+
+// Result 解析结果
+type Result struct {
+	Drafts []*Draft
+	// Warnings 没有对应到任何草稿的警告
+	Warnings []*route.LintWarning
+}
+
+// Parse 按格式解析配置
+func Parse(format string, content string) (*Result, error) {
+	switch format {
+	case FormatNginx:
+		return ParseNginx(content)
+	case FormatHAProxy:
+		return ParseHAProxy(content)
+	}
+	return nil, errors.New("不支持的配置格式：" + format)
+}
+
+// 旧配置中的后端地址
+type backend struct {
+	host string
+	port int32
+}
+
+func (b backend) String() string {
+	return net.JoinHostPort(b.host, strconv.FormatInt(int64(b.port), 10))
+}
+
+// 解析 host:port，没有端口时使用默认端口
+func parseBackend(addr string, defaultPort int32) (backend, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		//没有端口
+		return backend{host: strings.Trim(addr, "[]"), port: defaultPort}, nil
+	}
+	if port == "" {
+		return backend{host: host, port: defaultPort}, nil
+	}
+	n, err := strconv.ParseInt(port, 10, 32)
+	if err != nil || n <= 0 || n > 65535 {
+		return backend{}, errors.New("端口不正确：" + addr)
+	}
+	return backend{host: host, port: int32(n)}, nil
+}
+
+func newWarning(rule, severity, field, message string) *route.LintWarning {
+	return &route.LintWarning{Rule: rule, Severity: severity, Field: field, Message: message}
+}
+
+func (d *Draft) warn(rule, severity, field, message string) {
+	d.Warnings = append(d.Warnings, newWarning(rule, severity, field, message))
+}
+
+// 添加路径，后端按名称生成 Service 名，同一路径只保留第一个
+func (d *Draft) addPath(path string, service string, port int32, origin string) *route.RoutePath {
+	for _, v := range d.Route.RoutePath {
+		if v.RoutePathName == path {
+			d.warn(RuleUnsupported, severityWarning, "route_path", "路径 "+path+" 重复，只保留第一个，"+origin+" 已跳过")
+			return nil
+		}
+	}
+	p := &route.RoutePath{RoutePathName: path, RouteBackendService: service, RouteBackendServicePort: port}
+	d.Route.RoutePath = append(d.Route.RoutePath, p)
+	return p
+}
+
+// 后端不是 k8s Service，提示需要替换或创建对应的 Service
+func (d *Draft) warnBackend(index int, addr string, service string) {
+	d.warn(RuleBackend, severityInfo, "route_path["+strconv.Itoa(index)+"].route_backend_service",
+		"后端 "+addr+" 不是 k8s Service，草稿使用 "+service+"，请替换为实际的 Service 或创建指向该地址的 ExternalName Service")
+}
+
+// 路由上的请求头、响应头对所有路径生效，不同位置的值不同时保留第一个
+func (d *Draft) setHeader(headers *map[string]string, field string, name string, value string) {
+	if *headers == nil {
+		*headers = map[string]string{}
+	}
+	if current, ok := (*headers)[name]; ok {
+		if current != value {
+			d.warn(RuleApproximate, severityWarning, field, "请求头/响应头 "+name+" 在不同位置的值不同，保留 "+current)
+		}
+		return
+	}
+	(*headers)[name] = value
+}
+
+func appendOnce(list []string, v string) []string {
+	for _, item := range list {
+		if strings.EqualFold(item, v) {
+			return list
+		}
+	}
+	return append(list, v)
+}
+
+// 按域名合并草稿，保持出现的顺序
+type builder struct {
+	drafts []*Draft
+	byHost map[string]*Draft
+	names  map[string]bool
+	result *Result
+}
+
+func newBuilder() *builder {
+	return &builder{byHost: map[string]*Draft{}, names: map[string]bool{}, result: &Result{}}
+}
+
+// 同一域名的多个配置块合并为一条草稿，没有域名时按 fallback 生成名称
+func (b *builder) draft(host string, fallback string, source string) *Draft {
+	if d, ok := b.byHost[host]; ok {
+		if !strings.Contains(d.Source, source) {
+			d.Source += "；" + source
+		}
+		return d
+	}
+	name := routeName(host)
+	if host == "" {
+		name = dns1035(fallback)
+	}
+	for i := 2; b.names[name]; i++ {
+		name = routeName(host) + "-" + strconv.Itoa(i)
+		if host == "" {
+			name = dns1035(fallback) + "-" + strconv.Itoa(i)
+		}
+	}
+	b.names[name] = true
+	d := &Draft{Route: &route.RouteInfo{RouteName: name, RouteHost: host}, Source: source}
+	if host == "" {
+		d.warn(RuleApproximate, severityWarning, "route_host", source+" 没有指定域名，请补充 route_host")
+	}
+	b.byHost[host] = d
+	b.drafts = append(b.drafts, d)
+	return d
+}
+
+func (b *builder) warn(rule, severity, field, message string) {
+	b.result.Warnings = append(b.result.Warnings, newWarning(rule, severity, field, message))
+}
+
+// 没有路径的草稿不能创建，警告移到结果中
+func (b *builder) finish() *Result {
+	for _, d := range b.drafts {
+		//超时只在 WebSocket 路由上使用
+		if !d.Route.RouteWebsocket {
+			d.Route.RouteWebsocketTimeoutSeconds = 0
+		}
+		if len(d.Route.RoutePath) == 0 {
+			b.warn(RuleUnsupported, severityWarning, "", d.Source+" 没有可以转换的转发规则，未生成草稿")
+			b.result.Warnings = append(b.result.Warnings, d.Warnings...)
+			continue
+		}
+		b.result.Drafts = append(b.result.Drafts, d)
+	}
+	return b.result
+}
+
+// 域名转换为路由名称，如 api.example.com 转换为 api-example-com，*.example.com 转换为 wildcard-example-com
+func routeName(host string) string {
+	if strings.HasPrefix(host, "*.") {
+		host = "wildcard." + strings.TrimPrefix(host, "*.")
+	}
+	return dns1035(host)
+}
+
+// 后端地址转换为 Service 名称，域名取第一段，IP 加上 legacy- 前缀
+func serviceName(host string) string {
+	if net.ParseIP(host) != nil {
+		return dns1035("legacy-" + host)
+	}
+	if i := strings.Index(host, "."); i > 0 {
+		host = host[:i]
+	}
+	return dns1035(host)
+}
+
+// 转换为 DNS-1035 标签：小写字母开头，只包含小写字母、数字和 -，最长 63
+func dns1035(s string) string {
+	name := strings.Trim(strings.Map(func(r rune) rune {
+		if r >= 'A' && r <= 'Z' {
+			return r + 'a' - 'A'
+		}
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '-'
+	}, s), "-")
+	for strings.Contains(name, "--") {
+		name = strings.ReplaceAll(name, "--", "-")
+	}
+	if name == "" || name[0] < 'a' || name[0] > 'z' {
+		name = "legacy-" + name
+	}
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return strings.TrimRight(name, "-")
+}
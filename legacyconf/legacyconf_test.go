@@ -0,0 +1,139 @@
+package legacyconf
+
+import (
+	"github.com/zxnlx/route/proto/route"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// 草稿的路径，格式为 路径 Service:端口
+func draftPaths(d *Draft) []string {
+	paths := []string{}
+	for _, v := range d.Route.RoutePath {
+		paths = append(paths, v.RoutePathName+" "+v.RouteBackendService+":"+strconv.Itoa(int(v.RouteBackendServicePort)))
+	}
+	return paths
+}
+
+// 按名称取草稿，没有时用例失败
+func findDraft(t *testing.T, result *Result, name string) *Draft {
+	t.Helper()
+	names := []string{}
+	for _, d := range result.Drafts {
+		if d.Route.RouteName == name {
+			return d
+		}
+		names = append(names, d.Route.RouteName)
+	}
+	t.Fatalf("没有草稿 %s，已有 %v", name, names)
+	return nil
+}
+
+func draftNames(result *Result) []string {
+	names := []string{}
+	for _, d := range result.Drafts {
+		names = append(names, d.Route.RouteName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// 警告中有指定规则且消息包含 contains 的
+func hasWarning(warnings []*route.LintWarning, rule string, contains string) bool {
+	for _, w := range warnings {
+		if w.Rule == rule && strings.Contains(w.Message, contains) {
+			return true
+		}
+	}
+	return false
+}
+
+// 结果和各草稿中的全部警告
+func allWarnings(result *Result) []*route.LintWarning {
+	warnings := append([]*route.LintWarning(nil), result.Warnings...)
+	for _, d := range result.Drafts {
+		warnings = append(warnings, d.Warnings...)
+	}
+	return warnings
+}
+
+func TestParseUnknownFormat(t *testing.T) {
+	if _, err := Parse("apache", "<VirtualHost *:80></VirtualHost>"); err == nil || !strings.Contains(err.Error(), "不支持的配置格式") {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+func TestParseBackend(t *testing.T) {
+	cases := []struct {
+		addr    string
+		want    string
+		wantErr bool
+	}{
+		{addr: "10.0.0.1:8080", want: "10.0.0.1:8080"},
+		{addr: "10.0.0.1", want: "10.0.0.1:80"},
+		{addr: "api.internal:", want: "api.internal:80"},
+		{addr: "[::1]:9000", want: "[::1]:9000"},
+		{addr: "[::1]", want: "[::1]:80"},
+		{addr: "api.internal:http", wantErr: true},
+		{addr: "api.internal:0", wantErr: true},
+		{addr: "api.internal:65536", wantErr: true},
+	}
+	for _, c := range cases {
+		t.Run(c.addr, func(t *testing.T) {
+			got, err := parseBackend(c.addr, 80)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("parseBackend(%q) = %v，应该返回错误", c.addr, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != c.want {
+				t.Fatalf("parseBackend(%q) = %s，应该是 %s", c.addr, got, c.want)
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	cases := []struct {
+		fn   func(string) string
+		in   string
+		want string
+	}{
+		{fn: routeName, in: "api.example.com", want: "api-example-com"},
+		{fn: routeName, in: "*.example.com", want: "wildcard-example-com"},
+		{fn: serviceName, in: "static.internal", want: "static"},
+		{fn: serviceName, in: "10.0.0.1", want: "legacy-10-0-0-1"},
+		{fn: dns1035, in: "API_Backend", want: "api-backend"},
+		{fn: dns1035, in: "--a__b--", want: "a-b"},
+		{fn: dns1035, in: "8080", want: "legacy-8080"},
+		{fn: dns1035, in: "", want: "legacy"},
+		{fn: dns1035, in: strings.Repeat("a", 70), want: strings.Repeat("a", 63)},
+	}
+	for _, c := range cases {
+		if got := c.fn(c.in); got != c.want {
+			t.Errorf("%q 转换为 %q，应该是 %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestBuilderUniqueNames(t *testing.T) {
+	b := newBuilder()
+	first := b.draft("", "server 1", "a")
+	second := b.draft("", "server 1", "b")
+	if first != second {
+		t.Fatal("同一域名应该合并为一条草稿")
+	}
+	if first.Source != "a；b" {
+		t.Fatalf("Source = %q", first.Source)
+	}
+	b.draft("api.example.com", "", "c")
+	if d := b.draft("api-example.com", "", "d"); d.Route.RouteName != "api-example-com-2" {
+		t.Fatalf("重名时应该加上序号，实际为 %s", d.Route.RouteName)
+	}
+}
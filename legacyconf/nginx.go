@@ -0,0 +1,445 @@
+package legacyconf
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// nginx 配置中的一条指令，带块的指令 block 不为 nil
+type directive struct {
+	name  string
+	args  []string
+	line  int
+	block []*directive
+}
+
+type nginxToken struct {
+	text   string
+	line   int
+	quoted bool
+}
+
+// 按 nginx 的语法拆分：空白分隔，# 到行尾为注释，引号内的内容为一个词，{ } ; 单独成词
+func tokenizeNginx(content string) ([]nginxToken, error) {
+	tokens := []nginxToken{}
+	line := 1
+	word := &strings.Builder{}
+	wordLine := 0
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, nginxToken{text: word.String(), line: wordLine})
+			word.Reset()
+		}
+	}
+	runes := []rune(content)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case c == '\n':
+			flush()
+			line++
+		case c == ' ' || c == '\t' || c == '\r':
+			flush()
+		case c == '#' && word.Len() == 0:
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			i--
+		case c == '{' || c == '}' || c == ';':
+			flush()
+			tokens = append(tokens, nginxToken{text: string(c), line: line})
+		case (c == '"' || c == '\'') && word.Len() == 0:
+			start := line
+			quoted := &strings.Builder{}
+			i++
+			for ; i < len(runes) && runes[i] != c; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				if runes[i] == '\n' {
+					line++
+				}
+				quoted.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, errors.New("第 " + strconv.Itoa(start) + " 行的引号没有结束")
+			}
+			tokens = append(tokens, nginxToken{text: quoted.String(), line: start, quoted: true})
+		default:
+			if word.Len() == 0 {
+				wordLine = line
+			}
+			word.WriteRune(c)
+		}
+	}
+	flush()
+	return tokens, nil
+}
+
+// 解析为指令树
+func parseNginx(content string) ([]*directive, error) {
+	tokens, err := tokenizeNginx(content)
+	if err != nil {
+		return nil, err
+	}
+	i := 0
+	directives, err := parseNginxBlock(tokens, &i, false)
+	if err != nil {
+		return nil, err
+	}
+	return directives, nil
+}
+
+func parseNginxBlock(tokens []nginxToken, i *int, nested bool) ([]*directive, error) {
+	directives := []*directive{}
+	var current *directive
+	for ; *i < len(tokens); *i++ {
+		t := tokens[*i]
+		if t.quoted {
+			if current == nil {
+				current = &directive{name: t.text, line: t.line}
+			} else {
+				current.args = append(current.args, t.text)
+			}
+			continue
+		}
+		switch t.text {
+		case ";":
+			if current == nil {
+				return nil, errors.New("第 " + strconv.Itoa(t.line) + " 行多余的 ;")
+			}
+			directives = append(directives, current)
+			current = nil
+		case "{":
+			if current == nil {
+				return nil, errors.New("第 " + strconv.Itoa(t.line) + " 行的 { 前没有指令")
+			}
+			*i++
+			block, err := parseNginxBlock(tokens, i, true)
+			if err != nil {
+				return nil, err
+			}
+			current.block = block
+			directives = append(directives, current)
+			current = nil
+		case "}":
+			if !nested {
+				return nil, errors.New("第 " + strconv.Itoa(t.line) + " 行多余的 }")
+			}
+			if current != nil {
+				return nil, errors.New("第 " + strconv.Itoa(current.line) + " 行的指令 " + current.name + " 缺少 ;")
+			}
+			return directives, nil
+		default:
+			if current == nil {
+				current = &directive{name: t.text, line: t.line}
+			} else {
+				current.args = append(current.args, t.text)
+			}
+		}
+	}
+	if nested {
+		return nil, errors.New("配置结束时还有没有闭合的 {")
+	}
+	if current != nil {
+		return nil, errors.New("第 " + strconv.Itoa(current.line) + " 行的指令 " + current.name + " 缺少 ;")
+	}
+	return directives, nil
+}
+
+// 递归找出所有指定名称的块，不进入找到的块内部
+func findBlocks(directives []*directive, name string) []*directive {
+	found := []*directive{}
+	for _, d := range directives {
+		if d.block == nil {
+			continue
+		}
+		if d.name == name {
+			found = append(found, d)
+			continue
+		}
+		found = append(found, findBlocks(d.block, name)...)
+	}
+	return found
+}
+
+func (d *directive) where() string {
+	return "第 " + strconv.Itoa(d.line) + " 行"
+}
+
+// 不影响路由规格的指令，不提示
+var nginxIgnored = map[string]bool{
+	"proxy_http_version": true, "proxy_redirect": true, "proxy_buffering": true, "proxy_buffers": true,
+	"proxy_buffer_size": true, "proxy_busy_buffers_size": true, "proxy_connect_timeout": true,
+	"proxy_send_timeout": true, "proxy_next_upstream": true, "access_log": true, "error_log": true,
+	"keepalive_timeout": true, "charset": true, "index": true, "ssl_certificate": true,
+	"ssl_certificate_key": true, "ssl_protocols": true, "ssl_ciphers": true, "ssl_prefer_server_ciphers": true,
+	"ssl_session_cache": true, "ssl_session_timeout": true, "gzip_types": true, "gzip_min_length": true,
+	"gzip_comp_level": true, "gzip_vary": true, "gzip_proxied": true, "listen": true, "server_name": true,
+	"location": true, "proxy_pass": true, "grpc_pass": true, "client_body_buffer_size": true, "ssl": true,
+	"http2": true,
+}
+
+// ParseNginx 解析 nginx 配置中的 server 和 location 块
+// 每个 server_name 一条草稿，proxy_pass 的 location 转换为前缀路径，upstream 按名称转换为 Service
+func ParseNginx(content string) (*Result, error) {
+	directives, err := parseNginx(content)
+	if err != nil {
+		return nil, err
+	}
+	upstreams := map[string][]backend{}
+	used := map[string]bool{}
+	b := newBuilder()
+	//stream 中是四层代理，不是路由
+	http := make([]*directive, 0, len(directives))
+	for _, d := range directives {
+		if d.name == "stream" && d.block != nil {
+			b.warn(RuleUnsupported, severityWarning, "", d.where()+"的 stream 块是四层代理，无法转换为路由")
+			continue
+		}
+		http = append(http, d)
+	}
+	directives = http
+	for _, u := range findBlocks(directives, "upstream") {
+		if len(u.args) != 1 {
+			b.warn(RuleUnsupported, severityWarning, "", u.where()+"的 upstream 缺少名称")
+			continue
+		}
+		servers := []backend{}
+		for _, s := range u.block {
+			if s.name != "server" || len(s.args) == 0 {
+				continue
+			}
+			if strings.HasPrefix(s.args[0], "unix:") {
+				b.warn(RuleUnsupported, severityWarning, "", "upstream "+u.args[0]+" 的 "+s.args[0]+" 是 unix socket，无法转换")
+				continue
+			}
+			addr, err := parseBackend(s.args[0], 80)
+			if err != nil {
+				return nil, errors.New(s.where() + "：" + err.Error())
+			}
+			servers = append(servers, addr)
+		}
+		upstreams[u.args[0]] = servers
+	}
+	servers := findBlocks(directives, "server")
+	if len(servers) == 0 {
+		return nil, errors.New("配置中没有 server 块")
+	}
+	for _, server := range servers {
+		hosts := []string{}
+		tls := false
+		for _, d := range server.block {
+			switch d.name {
+			case "server_name":
+				for _, v := range d.args {
+					switch {
+					case v == "_" || v == "" || v == "localhost":
+					case strings.HasPrefix(v, "~"):
+						b.warn(RuleUnsupported, severityWarning, "route_host", d.where()+"的正则域名 "+v+" 无法转换")
+					case strings.HasPrefix(v, ".") || strings.HasSuffix(v, ".*"):
+						b.warn(RuleUnsupported, severityWarning, "route_host", d.where()+"的域名 "+v+" 无法转换，只支持 *.example.com 形式的通配")
+					default:
+						hosts = appendOnce(hosts, strings.ToLower(v))
+					}
+				}
+			case "listen":
+				for _, v := range d.args {
+					if v == "ssl" || v == "443" || strings.HasSuffix(v, ":443") {
+						tls = true
+					}
+				}
+			case "ssl":
+				tls = tls || len(d.args) > 0 && d.args[0] == "on"
+			}
+		}
+		if len(hosts) == 0 {
+			hosts = []string{""}
+		}
+		for _, host := range hosts {
+			source := "server " + host + "（" + server.where() + "）"
+			if host == "" {
+				source = "server（" + server.where() + "）"
+			}
+			d := b.draft(host, "server-"+strconv.Itoa(server.line), source)
+			if tls {
+				d.warn(RuleTLS, severityInfo, "route_tls_issuer", server.where()+"的 server 启用了 TLS，请设置 route_tls_issuer 或在 k8s 中导入原证书")
+			}
+			convertNginxServer(d, server, upstreams, used)
+		}
+	}
+	for name := range upstreams {
+		if !used[name] {
+			b.warn(RuleUnsupported, severityInfo, "", "upstream "+name+" 没有被任何 proxy_pass 使用")
+		}
+	}
+	return b.finish(), nil
+}
+
+// server 中的请求头、响应头等设置作用于所有 location
+func convertNginxServer(d *Draft, server *directive, upstreams map[string][]backend, used map[string]bool) {
+	for _, v := range server.block {
+		if v.name == "location" {
+			continue
+		}
+		convertNginxDirective(d, v)
+	}
+	for _, location := range server.block {
+		if location.name == "location" {
+			convertNginxLocation(d, location, upstreams, used)
+		}
+	}
+}
+
+func convertNginxLocation(d *Draft, location *directive, upstreams map[string][]backend, used map[string]bool) {
+	args := location.args
+	if len(args) == 0 {
+		return
+	}
+	path := args[len(args)-1]
+	if len(args) == 2 {
+		switch args[0] {
+		case "=":
+			d.warn(RuleApproximate, severityWarning, "route_path", location.where()+"的精确匹配 = "+path+" 按前缀转换")
+		case "^~":
+		default:
+			d.warn(RuleUnsupported, severityWarning, "route_path", location.where()+"的正则 location "+args[0]+" "+path+" 无法转换，已跳过")
+			return
+		}
+	}
+	if strings.HasPrefix(path, "@") {
+		d.warn(RuleUnsupported, severityInfo, "route_path", location.where()+"的命名 location "+path+" 只在 nginx 内部跳转使用，已跳过")
+		return
+	}
+	var pass *directive
+	for _, v := range location.block {
+		if v.name == "proxy_pass" || v.name == "grpc_pass" {
+			pass = v
+		}
+		if v.name == "location" {
+			d.warn(RuleUnsupported, severityWarning, "route_path", v.where()+"的嵌套 location 无法转换，已跳过")
+		}
+	}
+	if pass == nil || len(pass.args) == 0 {
+		d.warn(RuleUnsupported, severityWarning, "route_path", location.where()+"的 location "+path+" 没有 proxy_pass，未转换（return、rewrite、root 等需要手动处理）")
+		return
+	}
+	target := pass.args[0]
+	if strings.Contains(target, "$") {
+		d.warn(RuleUnsupported, severityWarning, "route_path", pass.where()+"的 "+pass.name+" "+target+" 使用了变量，无法转换")
+		return
+	}
+	defaultPort := int32(80)
+	for _, scheme := range []string{"http://", "https://", "grpc://", "grpcs://"} {
+		if strings.HasPrefix(target, scheme) {
+			target = strings.TrimPrefix(target, scheme)
+			if scheme == "https://" || scheme == "grpcs://" {
+				defaultPort = 443
+				d.warn(RuleApproximate, severityInfo, "route_annotations", pass.where()+"的后端使用 TLS，请按 Ingress class 配置后端协议注解")
+			}
+		}
+	}
+	if pass.name == "grpc_pass" {
+		d.Route.RouteBackendHttp2 = true
+	}
+	if i := strings.Index(target, "/"); i >= 0 {
+		if uri := target[i:]; uri != path {
+			d.warn(RuleApproximate, severityWarning, "route_path", pass.where()+"的 proxy_pass 带有 URI "+uri+"，会改写路径，请按 Ingress class 配置 rewrite 注解")
+		}
+		target = target[:i]
+	}
+	addr, err := parseBackend(target, defaultPort)
+	if err != nil {
+		d.warn(RuleUnsupported, severityWarning, "route_path", pass.where()+"："+err.Error())
+		return
+	}
+	service, port, origin := serviceName(addr.host), addr.port, addr.String()
+	if servers, ok := upstreams[addr.host]; ok {
+		used[addr.host] = true
+		service = dns1035(addr.host)
+		if len(servers) == 0 {
+			d.warn(RuleUnsupported, severityWarning, "route_path", "upstream "+addr.host+" 没有可用的 server")
+		} else {
+			port = servers[0].port
+			addrs := make([]string, 0, len(servers))
+			for _, s := range servers {
+				addrs = append(addrs, s.String())
+			}
+			origin = "upstream " + addr.host + "（" + strings.Join(addrs, ", ") + "）"
+		}
+	}
+	p := d.addPath(path, service, port, location.where()+"的 location "+path)
+	if p == nil {
+		return
+	}
+	d.warnBackend(len(d.Route.RoutePath)-1, origin, service)
+	for _, v := range location.block {
+		convertNginxDirective(d, v)
+	}
+}
+
+// 转换请求头、响应头、压缩、WebSocket 等对路由整体生效的指令
+func convertNginxDirective(d *Draft, v *directive) {
+	switch v.name {
+	case "proxy_set_header", "grpc_set_header":
+		if len(v.args) < 2 {
+			return
+		}
+		name, value := v.args[0], v.args[1]
+		switch {
+		case strings.EqualFold(name, "Upgrade") && value == "$http_upgrade":
+			d.Route.RouteWebsocket = true
+		case strings.EqualFold(name, "Connection") && strings.Contains(value, "upgrade"):
+			d.Route.RouteWebsocket = true
+		case strings.EqualFold(name, "Host"):
+			d.warn(RuleApproximate, severityInfo, "route_request_header_set", v.where()+"的 Host 请求头由 Ingress 控制器设置，未转换")
+		case strings.Contains(value, "$"):
+			d.warn(RuleUnsupported, severityInfo, "route_request_header_set", v.where()+"的请求头 "+name+" 使用了变量 "+value+"，未转换，常见的 X-Forwarded-* 由 Ingress 控制器设置")
+		default:
+			d.setHeader(&d.Route.RouteRequestHeaderSet, "route_request_header_set", name, value)
+		}
+	case "add_header":
+		if len(v.args) < 2 {
+			return
+		}
+		if strings.Contains(v.args[1], "$") {
+			d.warn(RuleUnsupported, severityWarning, "route_response_header_set", v.where()+"的响应头 "+v.args[0]+" 使用了变量，未转换")
+			return
+		}
+		d.setHeader(&d.Route.RouteResponseHeaderSet, "route_response_header_set", v.args[0], v.args[1])
+	case "proxy_hide_header":
+		if len(v.args) > 0 {
+			d.Route.RouteResponseHeaderRemove = appendOnce(d.Route.RouteResponseHeaderRemove, v.args[0])
+		}
+	case "gzip":
+		d.Route.RouteCompression = d.Route.RouteCompression || len(v.args) > 0 && v.args[0] == "on"
+	case "proxy_read_timeout":
+		if seconds, ok := nginxSeconds(v.args); ok && seconds > d.Route.RouteWebsocketTimeoutSeconds {
+			d.Route.RouteWebsocketTimeoutSeconds = seconds
+		}
+	case "auth_request":
+		d.warn(RuleUnsupported, severityWarning, "route_auth_url", v.where()+"的 auth_request 需要手动配置为 route_auth_url")
+	default:
+		if !nginxIgnored[v.name] {
+			d.warn(RuleUnsupported, severityInfo, "", v.where()+"的指令 "+v.name+" 未转换")
+		}
+	}
+}
+
+// nginx 的时间，如 60、60s、5m、1h
+func nginxSeconds(args []string) (int32, bool) {
+	if len(args) == 0 {
+		return 0, false
+	}
+	v := args[0]
+	if n, err := strconv.ParseInt(v, 10, 32); err == nil {
+		return int32(n), true
+	}
+	duration, err := time.ParseDuration(v)
+	if err != nil || duration < time.Second {
+		return 0, false
+	}
+	return int32(duration / time.Second), true
+}
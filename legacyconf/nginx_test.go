@@ -0,0 +1,351 @@
+package legacyconf
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTokenizeNginx(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		want    []string
+		lines   []int
+	}{
+		{
+			name:    "指令和块",
+			content: "server {\n  listen 80;\n}",
+			want:    []string{"server", "{", "listen", "80", ";", "}"},
+			lines:   []int{1, 1, 2, 2, 2, 3},
+		},
+		{
+			name:    "注释到行尾",
+			content: "# 注释 { ;\nlisten 80; # 行尾注释\n",
+			want:    []string{"listen", "80", ";"},
+			lines:   []int{2, 2, 2},
+		},
+		{
+			name:    "词中的 # 不是注释",
+			content: "add_header X-Tag a#b;",
+			want:    []string{"add_header", "X-Tag", "a#b", ";"},
+		},
+		{
+			name:    "引号内的空白、分号和注释",
+			content: `add_header X-Note "a; b # c" 'single { }';`,
+			want:    []string{"add_header", "X-Note", "a; b # c", "single { }", ";"},
+		},
+		{
+			name:    "引号内的转义",
+			content: `proxy_set_header X-Say "say \"hi\"";`,
+			want:    []string{"proxy_set_header", "X-Say", `say "hi"`, ";"},
+		},
+		{
+			name:    "跨行的引号按开始的行",
+			content: "add_header X-Multi \"a\nb\";\nlisten 80;",
+			want:    []string{"add_header", "X-Multi", "a\nb", ";", "listen", "80", ";"},
+			lines:   []int{1, 1, 1, 2, 3, 3, 3},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tokens, err := tokenizeNginx(c.content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := []string{}
+			lines := []int{}
+			for _, v := range tokens {
+				got = append(got, v.text)
+				lines = append(lines, v.line)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("tokens = %q，应该是 %q", got, c.want)
+			}
+			if c.lines != nil && !reflect.DeepEqual(lines, c.lines) {
+				t.Fatalf("lines = %v，应该是 %v", lines, c.lines)
+			}
+		})
+	}
+}
+
+func TestParseNginxErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "引号没有结束", content: "server {\n  server_name \"a.example.com;\n}", want: "第 2 行的引号没有结束"},
+		{name: "多余的分号", content: "server { listen 80; }\n;", want: "第 2 行多余的 ;"},
+		{name: "块前没有指令", content: "{ listen 80; }", want: "第 1 行的 { 前没有指令"},
+		{name: "多余的右括号", content: "server { listen 80; }\n}", want: "第 2 行多余的 }"},
+		{name: "块内缺少分号", content: "server {\n  listen 80\n}", want: "第 2 行的指令 listen 缺少 ;"},
+		{name: "末尾缺少分号", content: "server { listen 80; }\nworker_processes 4", want: "第 2 行的指令 worker_processes 缺少 ;"},
+		{name: "块没有闭合", content: "http {\n  server { listen 80; }", want: "没有闭合的 {"},
+		{name: "没有 server 块", content: "events { worker_connections 1024; }", want: "配置中没有 server 块"},
+		{name: "空配置", content: "# 只有注释\n", want: "配置中没有 server 块"},
+		{name: "upstream 端口不正确", content: "upstream api { server 10.0.0.1:99999; }\nserver { location / { proxy_pass http://api; } }", want: "第 1 行：端口不正确"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := ParseNginx(c.content)
+			if err == nil || !strings.Contains(err.Error(), c.want) {
+				t.Fatalf("err = %v，应该包含 %q", err, c.want)
+			}
+		})
+	}
+}
+
+func TestParseNginx(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		check   func(t *testing.T, result *Result)
+	}{
+		{
+			name: "server 和 upstream",
+			content: `
+http {
+    upstream api_backend {
+        server 10.0.0.1:8080 weight=3;
+        server 10.0.0.2:8080;
+    }
+    server {
+        listen 80;
+        server_name api.example.com;
+        location / {
+            proxy_pass http://api_backend;
+        }
+        location /static/ {
+            proxy_pass http://static.internal:9000;
+        }
+    }
+}`,
+			check: func(t *testing.T, result *Result) {
+				d := findDraft(t, result, "api-example-com")
+				if d.Route.RouteHost != "api.example.com" {
+					t.Fatalf("RouteHost = %s", d.Route.RouteHost)
+				}
+				if want := []string{"/ api-backend:8080", "/static/ static:9000"}; !reflect.DeepEqual(draftPaths(d), want) {
+					t.Fatalf("paths = %v，应该是 %v", draftPaths(d), want)
+				}
+				if !hasWarning(d.Warnings, RuleBackend, "upstream api_backend（10.0.0.1:8080, 10.0.0.2:8080）") {
+					t.Fatalf("缺少后端的警告：%v", d.Warnings)
+				}
+				if hasWarning(result.Warnings, RuleUnsupported, "没有被任何 proxy_pass 使用") {
+					t.Fatal("upstream 已被使用，不应该提示")
+				}
+			},
+		},
+		{
+			name: "多个域名和 TLS",
+			content: `
+server {
+    listen 443 ssl;
+    server_name a.example.com *.example.com _ ~^www\d+\.example\.com$ .example.org;
+    ssl_certificate /etc/nginx/cert.pem;
+    location / { proxy_pass http://web:8080; }
+}`,
+			check: func(t *testing.T, result *Result) {
+				if want := []string{"a-example-com", "wildcard-example-com"}; !reflect.DeepEqual(draftNames(result), want) {
+					t.Fatalf("drafts = %v，应该是 %v", draftNames(result), want)
+				}
+				for _, d := range result.Drafts {
+					if !hasWarning(d.Warnings, RuleTLS, "启用了 TLS") {
+						t.Fatalf("%s 缺少 TLS 的警告", d.Route.RouteName)
+					}
+				}
+				if !hasWarning(result.Warnings, RuleUnsupported, "正则域名") || !hasWarning(result.Warnings, RuleUnsupported, ".example.org") {
+					t.Fatalf("缺少域名无法转换的警告：%v", result.Warnings)
+				}
+			},
+		},
+		{
+			name: "注释和引号",
+			content: `
+# 旧机房的配置
+server {
+    server_name "quoted.example.com"; # 行尾注释
+    add_header X-Frame-Options "SAMEORIGIN";
+    add_header 'X-Note' "a # b";
+    proxy_set_header X-Say "say \"hi\"";
+    location "/a b" { proxy_pass http://svc:8080; }
+}`,
+			check: func(t *testing.T, result *Result) {
+				d := findDraft(t, result, "quoted-example-com")
+				if want := []string{"/a b svc:8080"}; !reflect.DeepEqual(draftPaths(d), want) {
+					t.Fatalf("paths = %v，应该是 %v", draftPaths(d), want)
+				}
+				if want := map[string]string{"X-Frame-Options": "SAMEORIGIN", "X-Note": "a # b"}; !reflect.DeepEqual(d.Route.RouteResponseHeaderSet, want) {
+					t.Fatalf("RouteResponseHeaderSet = %v，应该是 %v", d.Route.RouteResponseHeaderSet, want)
+				}
+				if want := map[string]string{"X-Say": `say "hi"`}; !reflect.DeepEqual(d.Route.RouteRequestHeaderSet, want) {
+					t.Fatalf("RouteRequestHeaderSet = %v，应该是 %v", d.Route.RouteRequestHeaderSet, want)
+				}
+			},
+		},
+		{
+			name: "location 的匹配方式",
+			content: `
+server {
+    server_name web.example.com;
+    location = /exact { proxy_pass http://web:8080; }
+    location ^~ /prefix { proxy_pass http://web:8080; }
+    location ~ \.php$ { proxy_pass http://php:9000; }
+    location @fallback { proxy_pass http://web:8080; }
+    location /static { root /var/www; }
+    location /var { proxy_pass http://$backend; }
+    location /rewrite { proxy_pass http://web:8080/v2/; }
+    location /nested {
+        proxy_pass http://web:8080;
+        location /nested/inner { proxy_pass http://web:8081; }
+    }
+    location /exact { proxy_pass http://other:8080; }
+}`,
+			check: func(t *testing.T, result *Result) {
+				d := findDraft(t, result, "web-example-com")
+				want := []string{"/exact web:8080", "/prefix web:8080", "/rewrite web:8080", "/nested web:8080"}
+				if !reflect.DeepEqual(draftPaths(d), want) {
+					t.Fatalf("paths = %v，应该是 %v", draftPaths(d), want)
+				}
+				for _, w := range []struct{ rule, contains string }{
+					{RuleApproximate, "精确匹配 = /exact"},
+					{RuleUnsupported, "正则 location ~ \\.php$"},
+					{RuleUnsupported, "命名 location @fallback"},
+					{RuleUnsupported, "location /static 没有 proxy_pass"},
+					{RuleUnsupported, "使用了变量"},
+					{RuleApproximate, "带有 URI /v2/"},
+					{RuleUnsupported, "嵌套 location"},
+					{RuleUnsupported, "路径 /exact 重复"},
+				} {
+					if !hasWarning(d.Warnings, w.rule, w.contains) {
+						t.Errorf("缺少警告 %s：%s", w.rule, w.contains)
+					}
+				}
+			},
+		},
+		{
+			name: "没有域名的 server",
+			content: `server {
+    listen 8080;
+    location / { proxy_pass http://10.0.0.5:8080; }
+}`,
+			check: func(t *testing.T, result *Result) {
+				d := findDraft(t, result, "server-1")
+				if d.Route.RouteHost != "" {
+					t.Fatalf("RouteHost = %s", d.Route.RouteHost)
+				}
+				if want := []string{"/ legacy-10-0-0-5:8080"}; !reflect.DeepEqual(draftPaths(d), want) {
+					t.Fatalf("paths = %v，应该是 %v", draftPaths(d), want)
+				}
+				if !hasWarning(d.Warnings, RuleApproximate, "没有指定域名") {
+					t.Fatalf("缺少补充域名的警告：%v", d.Warnings)
+				}
+			},
+		},
+		{
+			name: "WebSocket、压缩和 gRPC",
+			content: `
+server {
+    server_name ws.example.com;
+    gzip on;
+    location /ws {
+        proxy_pass http://ws:8080;
+        proxy_set_header Upgrade $http_upgrade;
+        proxy_set_header Connection "upgrade";
+        proxy_set_header Host $host;
+        proxy_read_timeout 1h;
+    }
+    location /grpc { grpc_pass grpcs://grpc-svc; }
+}`,
+			check: func(t *testing.T, result *Result) {
+				d := findDraft(t, result, "ws-example-com")
+				if !d.Route.RouteWebsocket || d.Route.RouteWebsocketTimeoutSeconds != 3600 {
+					t.Fatalf("RouteWebsocket = %v，RouteWebsocketTimeoutSeconds = %d", d.Route.RouteWebsocket, d.Route.RouteWebsocketTimeoutSeconds)
+				}
+				if !d.Route.RouteCompression || !d.Route.RouteBackendHttp2 {
+					t.Fatalf("RouteCompression = %v，RouteBackendHttp2 = %v", d.Route.RouteCompression, d.Route.RouteBackendHttp2)
+				}
+				if want := []string{"/ws ws:8080", "/grpc grpc-svc:443"}; !reflect.DeepEqual(draftPaths(d), want) {
+					t.Fatalf("paths = %v，应该是 %v", draftPaths(d), want)
+				}
+				if len(d.Route.RouteRequestHeaderSet) != 0 {
+					t.Fatalf("Host 和 WebSocket 的请求头不应该转换：%v", d.Route.RouteRequestHeaderSet)
+				}
+			},
+		},
+		{
+			name: "include、stream 和没有使用的 upstream",
+			content: `
+include /etc/nginx/modules/*.conf;
+stream {
+    server { listen 3306; proxy_pass db:3306; }
+}
+upstream unused { server 10.0.0.9; }
+upstream sock { server unix:/run/app.sock; }
+server {
+    server_name inc.example.com;
+    include /etc/nginx/snippets/headers.conf;
+    location / { proxy_pass http://sock; }
+}`,
+			check: func(t *testing.T, result *Result) {
+				warnings := allWarnings(result)
+				for _, contains := range []string{"指令 include 未转换", "stream 块是四层代理", "upstream unused 没有被任何 proxy_pass 使用", "unix socket", "upstream sock 没有可用的 server"} {
+					if !hasWarning(warnings, RuleUnsupported, contains) {
+						t.Errorf("缺少警告：%s", contains)
+					}
+				}
+				d := findDraft(t, result, "inc-example-com")
+				if want := []string{"/ sock:80"}; !reflect.DeepEqual(draftPaths(d), want) {
+					t.Fatalf("paths = %v，应该是 %v", draftPaths(d), want)
+				}
+			},
+		},
+		{
+			name: "没有可以转换的 location",
+			content: `
+server {
+    server_name static.example.com;
+    location / { root /var/www; }
+}`,
+			check: func(t *testing.T, result *Result) {
+				if len(result.Drafts) != 0 {
+					t.Fatalf("drafts = %v", draftNames(result))
+				}
+				if !hasWarning(result.Warnings, RuleUnsupported, "没有可以转换的转发规则") || !hasWarning(result.Warnings, RuleUnsupported, "没有 proxy_pass") {
+					t.Fatalf("草稿的警告应该移到结果中：%v", result.Warnings)
+				}
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result, err := ParseNginx(c.content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.check(t, result)
+		})
+	}
+}
+
+func TestNginxSeconds(t *testing.T) {
+	cases := []struct {
+		args []string
+		want int32
+		ok   bool
+	}{
+		{args: []string{"60"}, want: 60, ok: true},
+		{args: []string{"90s"}, want: 90, ok: true},
+		{args: []string{"5m"}, want: 300, ok: true},
+		{args: []string{"500ms"}},
+		{args: []string{"forever"}},
+		{},
+	}
+	for _, c := range cases {
+		got, ok := nginxSeconds(c.args)
+		if got != c.want || ok != c.ok {
+			t.Errorf("nginxSeconds(%v) = %d, %v，应该是 %d, %v", c.args, got, ok, c.want, c.ok)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+// Package loadtest 在进程内压测路由的渲染和写入：kvstore 内存仓库代替 mysql，fake clientset 代替集群，
+// 依次执行创建、更新、无变化更新、删除，报告每个阶段的吞吐、延迟和内存分配，用于发布前发现性能退化
+package loadtest
+
+import (
+	"errors"
+	"fmt"
+	"github.com/zxnlx/route/domain/repository/kvstore"
+	"github.com/zxnlx/route/domain/service"
+	"github.com/zxnlx/route/harness"
+	"github.com/zxnlx/route/proto/route"
+	"io"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"text/tabwriter"
+	"time"
+)
+
+// Options 压测参数
+type Options struct {
+	// Routes 生成的路由个数，默认 1000
+	Routes int
+	// Concurrency 并发数，默认 8
+	Concurrency int
+	// Namespaces 路由分布的命名空间个数，默认 10
+	Namespaces int
+	// Paths 每个路由的路径个数，默认 3
+	Paths int
+	// CPUProfile、MemProfile 不为空时写入 pprof 文件，可用 go tool pprof 查看
+	CPUProfile string
+	MemProfile string
+	// Config 路由服务配置，为空时使用默认值
+	Config *service.RouteConfig
+}
+
+// Phase 一个阶段的结果，分配次数和字节数为进程在该阶段内的总量除以操作数
+type Phase struct {
+	Name        string        `json:"name"`
+	Operations  int           `json:"operations"`
+	Errors      int64         `json:"errors"`
+	Duration    time.Duration `json:"duration"`
+	PerSecond   float64       `json:"per_second"`
+	P50         time.Duration `json:"p50"`
+	P95         time.Duration `json:"p95"`
+	P99         time.Duration `json:"p99"`
+	AllocsPerOp uint64        `json:"allocs_per_op"`
+	BytesPerOp  uint64        `json:"bytes_per_op"`
+	// FirstError 第一个失败的原因，便于排查
+	FirstError string `json:"first_error,omitempty"`
+}
+
+// Report 压测报告
+type Report struct {
+	Routes      int     `json:"routes"`
+	Concurrency int     `json:"concurrency"`
+	Phases      []Phase `json:"phases"`
+}
+
+// Failed 是否有操作失败
+func (r *Report) Failed() bool {
+	for _, v := range r.Phases {
+		if v.Errors > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Write 按表格输出
+func (r *Report) Write(w io.Writer) error {
+	fmt.Fprintf(w, "路由 %d 个，并发 %d\n", r.Routes, r.Concurrency)
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "阶段\t操作数\t失败\t耗时\t每秒\tP50\tP95\tP99\tallocs/op\tB/op")
+	for _, v := range r.Phases {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%.1f\t%s\t%s\t%s\t%d\t%d\n", v.Name, v.Operations, v.Errors, v.Duration.Round(time.Millisecond), v.PerSecond, v.P50, v.P95, v.P99, v.AllocsPerOp, v.BytesPerOp)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	for _, v := range r.Phases {
+		if v.FirstError != "" {
+			fmt.Fprintf(w, "%s 失败：%s\n", v.Name, v.FirstError)
+		}
+	}
+	return nil
+}
+
+// Run 执行压测
+func Run(opts Options) (*Report, error) {
+	if opts.Routes <= 0 {
+		opts.Routes = 1000
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 8
+	}
+	if opts.Namespaces <= 0 {
+		opts.Namespaces = 10
+	}
+	if opts.Paths <= 0 {
+		opts.Paths = 3
+	}
+	routeDataService := newRouteDataService(opts.Config)
+
+	if opts.CPUProfile != "" {
+		f, err := os.Create(opts.CPUProfile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		if err := pprof.StartCPUProfile(f); err != nil {
+			return nil, err
+		}
+		defer pprof.StopCPUProfile()
+	}
+
+	routes := syntheticRoutes(opts)
+	ids := make([]int64, len(routes))
+	report := &Report{Routes: opts.Routes, Concurrency: opts.Concurrency}
+	report.Phases = append(report.Phases, runPhase("create", len(routes), opts.Concurrency, func(i int) error {
+		id, err := routeDataService.CreateRoute(routes[i])
+		ids[i] = id
+		return err
+	}))
+	//修改后端端口，每次都会写入k8s和数据库
+	report.Phases = append(report.Phases, runPhase("update", len(routes), opts.Concurrency, func(i int) error {
+		if ids[i] == 0 {
+			return errors.New("路由未创建")
+		}
+		info := routes[i]
+		info.Id = ids[i]
+		for _, p := range info.RoutePath {
+			p.RouteBackendServicePort++
+		}
+		_, err := routeDataService.UpdateRouteToK8s(info)
+		return err
+	}))
+	//规格不变，只经过校验和渲染，按规格哈希跳过写入
+	report.Phases = append(report.Phases, runPhase("update-unchanged", len(routes), opts.Concurrency, func(i int) error {
+		if ids[i] == 0 {
+			return errors.New("路由未创建")
+		}
+		changed, err := routeDataService.UpdateRouteToK8s(routes[i])
+		if err == nil && changed {
+			return errors.New("规格未变化但重新写入了")
+		}
+		return err
+	}))
+	report.Phases = append(report.Phases, runPhase("delete", len(routes), opts.Concurrency, func(i int) error {
+		if ids[i] == 0 {
+			return errors.New("路由未创建")
+		}
+		route2, err := routeDataService.FindRouteByID(ids[i])
+		if err != nil {
+			return err
+		}
+		return routeDataService.DeleteRouteFromK8s(route2, service.DeletePolicyDefault)
+	}))
+
+	if opts.MemProfile != "" {
+		f, err := os.Create(opts.MemProfile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		runtime.GC()
+		if err := pprof.WriteHeapProfile(f); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// 每次压测使用新的内存仓库和 fake clientset，config 为空时使用默认值
+func newRouteDataService(config *service.RouteConfig) service.IRouteDataService {
+	if config == nil {
+		config = &service.RouteConfig{}
+	}
+	clientSet, dynamicClient := harness.FakeK8sClients()
+	store := kvstore.NewMemoryStore()
+	return service.NewRouteDataService(kvstore.NewRouteRepository(store), kvstore.NewAnnotationTemplateRepository(store), kvstore.NewQuotaRepository(store), clientSet, dynamicClient, config, nil)
+}
+
+// 路由按命名空间均匀分布，域名和后端各不相同，避免冲突检查把它们当作同一路由
+func syntheticRoutes(opts Options) []*route.RouteInfo {
+	routes := make([]*route.RouteInfo, opts.Routes)
+	for i := range routes {
+		name := "load-" + strconv.Itoa(i)
+		namespace := "loadtest-" + strconv.Itoa(i%opts.Namespaces)
+		info := &route.RouteInfo{
+			RouteName:      name,
+			RouteNamespace: namespace,
+			RouteHost:      name + "." + namespace + ".loadtest.local",
+			RouteClass:     "nginx",
+			RouteOwnerTeam: "loadtest",
+		}
+		for j := 0; j < opts.Paths; j++ {
+			info.RoutePath = append(info.RoutePath, &route.RoutePath{
+				RoutePathName:           "/p" + strconv.Itoa(j),
+				RouteBackendService:     name + "-svc",
+				RouteBackendServicePort: int32(8000 + j),
+			})
+		}
+		routes[i] = info
+	}
+	return routes
+}
+
+// 按并发数执行 n 次操作，统计延迟分位数和阶段内的内存分配
+func runPhase(name string, n int, concurrency int, fn func(int) error) Phase {
+	runtime.GC()
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	latencies := make([]time.Duration, n)
+	var next, failed int64
+	var firstError string
+	var once sync.Once
+	var wg sync.WaitGroup
+	start := time.Now()
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1) - 1)
+				if i >= n {
+					return
+				}
+				opStart := time.Now()
+				if err := fn(i); err != nil {
+					atomic.AddInt64(&failed, 1)
+					once.Do(func() { firstError = err.Error() })
+				}
+				latencies[i] = time.Since(opStart)
+			}
+		}()
+	}
+	wg.Wait()
+	duration := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	phase := Phase{Name: name, Operations: n, Errors: failed, Duration: duration, FirstError: firstError}
+	if n == 0 {
+		return phase
+	}
+	phase.PerSecond = float64(n) / duration.Seconds()
+	phase.AllocsPerOp = (after.Mallocs - before.Mallocs) / uint64(n)
+	phase.BytesPerOp = (after.TotalAlloc - before.TotalAlloc) / uint64(n)
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	phase.P50 = percentile(latencies, 50)
+	phase.P95 = percentile(latencies, 95)
+	phase.P99 = percentile(latencies, 99)
+	return phase
+}
+
+// latencies 已排序
+func percentile(latencies []time.Duration, p int) time.Duration {
+	i := len(latencies) * p / 100
+	if i >= len(latencies) {
+		i = len(latencies) - 1
+	}
+	return latencies[i].Round(time.Microsecond)
+}
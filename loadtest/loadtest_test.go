@@ -0,0 +1,116 @@
+package loadtest
+
+import (
+	"github.com/zxnlx/route/domain/service"
+	"github.com/zxnlx/route/proto/route"
+	"sync/atomic"
+	"testing"
+)
+
+// 运行方式：go test ./loadtest -run '^$' -bench . -benchmem
+// 和 --loadtest 使用相同的内存仓库、fake clientset 和合成路由，可以用 benchstat 对比发布前后的结果
+
+func benchmarkRoutes(n int) []*route.RouteInfo {
+	return syntheticRoutes(Options{Routes: n, Namespaces: 10, Paths: 3})
+}
+
+// 计时之前创建好路由，返回路由ID
+func createRoutes(b *testing.B, routeDataService service.IRouteDataService, routes []*route.RouteInfo) []int64 {
+	b.Helper()
+	ids := make([]int64, len(routes))
+	for i, info := range routes {
+		id, err := routeDataService.CreateRoute(info)
+		if err != nil {
+			b.Fatal(err)
+		}
+		ids[i] = id
+		info.Id = id
+	}
+	return ids
+}
+
+func BenchmarkCreateRoute(b *testing.B) {
+	routeDataService := newRouteDataService(nil)
+	routes := benchmarkRoutes(b.N)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := routeDataService.CreateRoute(routes[i]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCreateRouteParallel(b *testing.B) {
+	routeDataService := newRouteDataService(nil)
+	routes := benchmarkRoutes(b.N)
+	var next int64
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			i := atomic.AddInt64(&next, 1) - 1
+			if _, err := routeDataService.CreateRoute(routes[i]); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
+
+// 修改后端端口，每次都会写入k8s和数据库
+func BenchmarkUpdateRoute(b *testing.B) {
+	routeDataService := newRouteDataService(nil)
+	routes := benchmarkRoutes(b.N)
+	createRoutes(b, routeDataService, routes)
+	for _, info := range routes {
+		for _, p := range info.RoutePath {
+			p.RouteBackendServicePort++
+		}
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		changed, err := routeDataService.UpdateRouteToK8s(routes[i])
+		if err != nil {
+			b.Fatal(err)
+		}
+		if !changed {
+			b.Fatal("修改了后端端口但没有写入")
+		}
+	}
+}
+
+// 规格不变，只经过校验和渲染，按规格哈希跳过写入
+func BenchmarkUpdateRouteUnchanged(b *testing.B) {
+	routeDataService := newRouteDataService(nil)
+	routes := benchmarkRoutes(b.N)
+	createRoutes(b, routeDataService, routes)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		changed, err := routeDataService.UpdateRouteToK8s(routes[i])
+		if err != nil {
+			b.Fatal(err)
+		}
+		if changed {
+			b.Fatal("规格未变化但重新写入了")
+		}
+	}
+}
+
+func BenchmarkDeleteRoute(b *testing.B) {
+	routeDataService := newRouteDataService(nil)
+	ids := createRoutes(b, routeDataService, benchmarkRoutes(b.N))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		route2, err := routeDataService.FindRouteByID(ids[i])
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := routeDataService.DeleteRouteFromK8s(route2, service.DeletePolicyDefault); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
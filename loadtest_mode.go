@@ -0,0 +1,58 @@
+package main
+
+import (
+	"errors"
+	"github.com/urfave/cli/v2"
+	"github.com/zxnlx/route/loadtest"
+	"os"
+)
+
+// 压测模式的参数，--loadtest 时在进程内执行，不连接注册中心、配置中心和集群
+var loadTestFlags = []cli.Flag{
+	&cli.BoolFlag{Name: "loadtest", Usage: "在进程内压测路由的渲染和写入，使用内存仓库和 fake clientset，完成后退出"},
+	&cli.IntFlag{Name: "loadtest-routes", Usage: "生成的路由个数", Value: 1000},
+	&cli.IntFlag{Name: "loadtest-concurrency", Usage: "并发数", Value: 8},
+	&cli.IntFlag{Name: "loadtest-namespaces", Usage: "路由分布的命名空间个数", Value: 10},
+	&cli.IntFlag{Name: "loadtest-paths", Usage: "每个路由的路径个数", Value: 3},
+	&cli.StringFlag{Name: "loadtest-cpuprofile", Usage: "写入 CPU profile 的文件"},
+	&cli.StringFlag{Name: "loadtest-memprofile", Usage: "写入内存 profile 的文件"},
+}
+
+// 参数中有 --loadtest 时执行压测并返回 true，有操作失败时返回错误
+func runLoadTest(args []string) (bool, error) {
+	requested := false
+	for _, v := range args[1:] {
+		if v == "--loadtest" || v == "-loadtest" || v == "--loadtest=true" {
+			requested = true
+		}
+	}
+	if !requested {
+		return false, nil
+	}
+	app := &cli.App{
+		Name:  "route --loadtest",
+		Usage: "压测路由的渲染和写入",
+		Flags: loadTestFlags,
+		Action: func(c *cli.Context) error {
+			report, err := loadtest.Run(loadtest.Options{
+				Routes:      c.Int("loadtest-routes"),
+				Concurrency: c.Int("loadtest-concurrency"),
+				Namespaces:  c.Int("loadtest-namespaces"),
+				Paths:       c.Int("loadtest-paths"),
+				CPUProfile:  c.String("loadtest-cpuprofile"),
+				MemProfile:  c.String("loadtest-memprofile"),
+			})
+			if err != nil {
+				return err
+			}
+			if err := report.Write(os.Stdout); err != nil {
+				return err
+			}
+			if report.Failed() {
+				return errors.New("压测中有操作失败")
+			}
+			return nil
+		},
+	}
+	return true, app.Run(args)
+}
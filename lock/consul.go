@@ -0,0 +1,76 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/consul/api"
+	"github.com/zxnlx/common"
+	"time"
+)
+
+// ConsulLocker 基于 Consul session 的分布式锁，多副本部署时串行化同一路由的变更
+type ConsulLocker struct {
+	client   *api.Client
+	prefix   string
+	waitTime time.Duration
+}
+
+// NewConsulLocker 创建，prefix 为锁在 KV 中的前缀
+func NewConsulLocker(address string, prefix string, waitTime time.Duration) (*ConsulLocker, error) {
+	config := api.DefaultConfig()
+	config.Address = address
+	client, err := api.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &ConsulLocker{client: client, prefix: prefix, waitTime: waitTime}, nil
+}
+
+// Lock 在等待时间内拿不到锁时返回错误，返回解锁函数
+func (l *ConsulLocker) Lock(key string) (func(), error) {
+	consulLock, err := l.client.LockOpts(&api.LockOptions{
+		Key:          l.prefix + key,
+		SessionName:  "route-lock",
+		SessionTTL:   "15s",
+		LockWaitTime: l.waitTime,
+		LockTryOnce:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	lost, err := consulLock.Lock(nil)
+	if err != nil {
+		return nil, err
+	}
+	if lost == nil {
+		return nil, errors.New("路由 " + key + " 正在被其他副本操作，请稍后重试")
+	}
+	return func() {
+		if err := consulLock.Unlock(); err != nil {
+			common.Error(err)
+		}
+		//其他副本在等待时删除会失败，忽略
+		_ = consulLock.Destroy()
+	}, nil
+}
+
+// Lead 阻塞直到拿到领导权，进程存活期间一直持有，session 由 Consul 客户端续期
+// 返回的通道关闭表示领导权丢失，ctx 取消时放弃等待
+func (l *ConsulLocker) Lead(ctx context.Context, key string) (<-chan struct{}, error) {
+	consulLock, err := l.client.LockOpts(&api.LockOptions{
+		Key:         l.prefix + key,
+		SessionName: "route-leader",
+		SessionTTL:  "15s",
+	})
+	if err != nil {
+		return nil, err
+	}
+	lost, err := consulLock.Lock(ctx.Done())
+	if err != nil {
+		return nil, err
+	}
+	if lost == nil {
+		return nil, ctx.Err()
+	}
+	return lost, nil
+}
@@ -0,0 +1,199 @@
+// Package logsample 高频日志采样和重复错误聚合，故障期间控制日志量
+// 采样：同一类日志每 N 条只记录 1 条；聚合：窗口内相同的错误只记录第一条，窗口结束时输出重复次数
+package logsample
+
+import (
+	"context"
+	"fmt"
+	"github.com/asim/go-micro/v3/config"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/metrics"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultSampleEvery     = 100
+	defaultAggregateWindow = time.Minute
+	defaultMaxErrors       = 1000
+	flushInterval          = time.Second
+)
+
+// Config 日志采样配置，从配置中心的 route.log_sampling 节点读取，修改后无需重启
+type Config struct {
+	// SampleEvery 高频日志每多少条记录 1 条，默认 100，为 1 时全部记录
+	SampleEvery int `json:"sample_every"`
+	// AggregateWindowSeconds 相同错误的聚合窗口，默认 60 秒，为负数时不聚合
+	AggregateWindowSeconds int `json:"aggregate_window_seconds"`
+	// MaxErrors 窗口内跟踪的不同错误个数，超出后直接输出，默认 1000
+	MaxErrors int `json:"max_errors"`
+}
+
+func (c Config) sampleEvery() uint64 {
+	if c.SampleEvery <= 0 {
+		return defaultSampleEvery
+	}
+	return uint64(c.SampleEvery)
+}
+
+func (c Config) window() time.Duration {
+	if c.AggregateWindowSeconds == 0 {
+		return defaultAggregateWindow
+	}
+	return time.Duration(c.AggregateWindowSeconds) * time.Second
+}
+
+func (c Config) maxErrors() int {
+	if c.MaxErrors <= 0 {
+		return defaultMaxErrors
+	}
+	return c.MaxErrors
+}
+
+// Logger 采样计数和聚合中的错误
+type Logger struct {
+	config   atomic.Value
+	mu       sync.Mutex
+	counters map[string]uint64
+	errors   map[string]*aggregate
+}
+
+type aggregate struct {
+	first    time.Time
+	repeated int
+}
+
+// Default 服务使用的实例，启动时按配置中心设置
+var Default = New(Config{})
+
+// New 创建
+func New(config Config) *Logger {
+	l := &Logger{counters: map[string]uint64{}, errors: map[string]*aggregate{}}
+	l.SetConfig(config)
+	return l
+}
+
+// SetConfig 运行时替换配置
+func (l *Logger) SetConfig(config Config) {
+	l.config.Store(config)
+}
+
+// Config 当前生效的配置
+func (l *Logger) Config() Config {
+	return l.config.Load().(Config)
+}
+
+// Watch 监听配置中心的变更，path 一般为 route, log_sampling
+func (l *Logger) Watch(conf config.Config, path ...string) {
+	watcher, err := conf.Watch(path...)
+	if err != nil {
+		common.Error(err)
+		return
+	}
+	for {
+		value, err := watcher.Next()
+		if err != nil {
+			common.Error(err)
+			return
+		}
+		samplingConfig := Config{}
+		if err := value.Scan(&samplingConfig); err != nil {
+			common.Error(err)
+			continue
+		}
+		l.SetConfig(samplingConfig)
+		common.Infof("日志采样配置已更新 sample_every=%d aggregate_window_seconds=%d", samplingConfig.SampleEvery, samplingConfig.AggregateWindowSeconds)
+	}
+}
+
+// Info 按 key 采样记录，key 为日志的类别，如 route_unchanged，不要包含路由名称等取值很多的内容
+func (l *Logger) Info(key string, message string) {
+	every := l.Config().sampleEvery()
+	l.mu.Lock()
+	n := l.counters[key]
+	l.counters[key] = n + 1
+	l.mu.Unlock()
+	if n%every != 0 {
+		metrics.ObserveLogSuppressed("sampled")
+		return
+	}
+	if n > 0 {
+		message += "（同类日志每 " + strconv.FormatUint(every, 10) + " 条记录 1 条）"
+	}
+	common.Info(message)
+}
+
+// Error 窗口内相同的错误只记录第一条，其余计数，由 Run 在窗口结束时输出次数
+func (l *Logger) Error(v interface{}) {
+	conf := l.Config()
+	window := conf.window()
+	if window < 0 {
+		common.Error(v)
+		return
+	}
+	message := fmt.Sprint(v)
+	l.mu.Lock()
+	if a, ok := l.errors[message]; ok {
+		a.repeated++
+		l.mu.Unlock()
+		metrics.ObserveLogSuppressed("aggregated")
+		return
+	}
+	if len(l.errors) < conf.maxErrors() {
+		l.errors[message] = &aggregate{first: time.Now()}
+	}
+	l.mu.Unlock()
+	common.Error(v)
+}
+
+// Flush 输出已到窗口结束时间的重复次数，force 为 true 时输出全部
+func (l *Logger) Flush(force bool) {
+	window := l.Config().window()
+	now := time.Now()
+	type summary struct {
+		message  string
+		repeated int
+	}
+	var summaries []summary
+	l.mu.Lock()
+	for k, v := range l.errors {
+		if !force && now.Sub(v.first) < window {
+			continue
+		}
+		if v.repeated > 0 {
+			summaries = append(summaries, summary{message: k, repeated: v.repeated})
+		}
+		delete(l.errors, k)
+	}
+	l.mu.Unlock()
+	for _, v := range summaries {
+		common.Errorf("以下错误在 %s 内又出现 %d 次：%s", window, v.repeated, v.message)
+	}
+}
+
+// Run 定时输出聚合的错误，ctx 结束时输出剩余的并退出
+func (l *Logger) Run(ctx context.Context) {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			l.Flush(true)
+			return
+		case <-ticker.C:
+			l.Flush(false)
+		}
+	}
+}
+
+// Info 使用 Default 采样记录
+func Info(key string, message string) {
+	Default.Info(key, message)
+}
+
+// Error 使用 Default 聚合记录
+func Error(v interface{}) {
+	Default.Error(v)
+}
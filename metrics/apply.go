@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"time"
+)
+
+var (
+	applyDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "apply",
+		Name:      "duration_seconds",
+		Help:      "写入k8s耗时，result 为 success 或 failure",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"result"})
+	applyFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "apply",
+		Name:      "failures_total",
+		Help:      "每个路由写入k8s失败次数",
+	}, []string{"route_namespace", "route_name"})
+	applyConsecutiveFailures = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "apply",
+		Name:      "consecutive_failures",
+		Help:      "每个路由写入k8s连续失败次数，成功后归零",
+	}, []string{"route_namespace", "route_name"})
+	applyWorkers = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "apply",
+		Name:      "workers",
+		Help:      "同时写入k8s的最大个数",
+	})
+	applyQueueCapacity = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "apply",
+		Name:      "queue_capacity",
+		Help:      "等待写入k8s的最大个数，超出时拒绝请求",
+	})
+	applyQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "apply",
+		Name:      "queue_depth",
+		Help:      "等待写入k8s的个数",
+	}, []string{"cluster"})
+	applyWorkersBusy = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "apply",
+		Name:      "workers_busy",
+		Help:      "正在写入k8s的个数",
+	}, []string{"cluster"})
+	applyClusterInflight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "apply",
+		Name:      "cluster_inflight",
+		Help:      "每个集群正在写入的个数，未配置集群并发上限时和 workers_busy 相同",
+	}, []string{"cluster"})
+	applyQueueRejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "apply",
+		Name:      "queue_rejected_total",
+		Help:      "写入队列已满被拒绝的请求数",
+	}, []string{"cluster"})
+)
+
+func init() {
+	Registry.MustRegister(applyDuration, applyFailures, applyConsecutiveFailures, applyWorkers, applyQueueCapacity, applyQueueDepth, applyWorkersBusy, applyClusterInflight, applyQueueRejected)
+}
+
+// SetApplyWorkers 记录写入队列的配置
+func SetApplyWorkers(workers int, capacity int) {
+	applyWorkers.Set(float64(workers))
+	applyQueueCapacity.Set(float64(capacity))
+}
+
+// ObserveApplyQueue 记录写入队列当前的排队和并发个数
+func ObserveApplyQueue(cluster string, depth int, busy int, inflight int) {
+	applyQueueDepth.WithLabelValues(cluster).Set(float64(depth))
+	applyWorkersBusy.WithLabelValues(cluster).Set(float64(busy))
+	applyClusterInflight.WithLabelValues(cluster).Set(float64(inflight))
+}
+
+// ObserveApplyQueueRejected 记录一次队列已满的拒绝
+func ObserveApplyQueueRejected(cluster string) {
+	applyQueueRejected.WithLabelValues(cluster).Inc()
+}
+
+// ObserveApply 记录一次写入k8s的结果
+func ObserveApply(namespace string, name string, duration time.Duration, consecutiveFailures int64, err error) {
+	ObserveApplyDuration(duration, err)
+	if err != nil {
+		applyFailures.WithLabelValues(namespace, name).Inc()
+	}
+	applyConsecutiveFailures.WithLabelValues(namespace, name).Set(float64(consecutiveFailures))
+}
+
+// ObserveApplyDuration 只记录耗时，不记录按路由的指标，用于数据库中没有记录的路由，例如创建失败后回滚
+func ObserveApplyDuration(duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	applyDuration.WithLabelValues(result).Observe(duration.Seconds())
+}
+
+// ForgetRoute 删除按路由的指标，路由删除、交还手动管理或创建回滚后调用
+func ForgetRoute(namespace string, name string) {
+	applyFailures.DeleteLabelValues(namespace, name)
+	applyConsecutiveFailures.DeleteLabelValues(namespace, name)
+}
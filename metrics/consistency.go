@@ -0,0 +1,30 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	consistencyDrift = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "consistency",
+		Name:      "drift",
+		Help:      "启动时一致性检查发现的不一致个数，type 为 missing（集群中缺失）或 orphaned（数据库中没有）",
+	}, []string{"type"})
+	consistencyRepaired = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "consistency",
+		Name:      "repaired_total",
+		Help:      "一致性检查自动修复的个数",
+	}, []string{"type"})
+)
+
+func init() {
+	Registry.MustRegister(consistencyDrift, consistencyRepaired)
+}
+
+// ObserveConsistency 记录一次一致性检查的结果
+func ObserveConsistency(missing int, orphaned int, repairedMissing int, repairedOrphaned int) {
+	consistencyDrift.WithLabelValues("missing").Set(float64(missing))
+	consistencyDrift.WithLabelValues("orphaned").Set(float64(orphaned))
+	consistencyRepaired.WithLabelValues("missing").Add(float64(repairedMissing))
+	consistencyRepaired.WithLabelValues("orphaned").Add(float64(repairedOrphaned))
+}
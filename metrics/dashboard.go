@@ -0,0 +1,154 @@
+package metrics
+
+import (
+	"encoding/json"
+	dto "github.com/prometheus/client_model/go"
+	"sigs.k8s.io/yaml"
+	"sort"
+	"strings"
+)
+
+// 按指标名称前缀从注册中心筛选指标，go_ process_ 等通用指标不生成看板
+func families(namespace string) ([]*dto.MetricFamily, error) {
+	all, err := Registry.Gather()
+	if err != nil {
+		return nil, err
+	}
+	var result []*dto.MetricFamily
+	for _, f := range all {
+		if strings.HasPrefix(f.GetName(), namespace+"_") {
+			result = append(result, f)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].GetName() < result[j].GetName() })
+	return result, nil
+}
+
+// 指标的标签名，用于生成 sum by
+func labelNames(f *dto.MetricFamily) []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, m := range f.GetMetric() {
+		for _, l := range m.GetLabel() {
+			if !seen[l.GetName()] {
+				seen[l.GetName()] = true
+				names = append(names, l.GetName())
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func byClause(f *dto.MetricFamily, extra ...string) string {
+	names := append(labelNames(f), extra...)
+	if len(names) == 0 {
+		return ""
+	}
+	return " by (" + strings.Join(names, ", ") + ")"
+}
+
+// 根据指标类型生成查询语句
+func panelQuery(f *dto.MetricFamily) (string, string) {
+	switch f.GetType() {
+	case dto.MetricType_COUNTER:
+		return "sum(rate(" + f.GetName() + "[5m]))" + byClause(f), "ops"
+	case dto.MetricType_HISTOGRAM:
+		return "histogram_quantile(0.95, sum(rate(" + f.GetName() + "_bucket[5m]))" + byClause(f, "le") + ")", "s"
+	case dto.MetricType_GAUGE:
+		return "sum(" + f.GetName() + ")" + byClause(f), "short"
+	}
+	return "", ""
+}
+
+// GrafanaDashboard 生成可直接导入的 Grafana 看板，每个指标一个面板
+func GrafanaDashboard(namespace, datasource string) ([]byte, error) {
+	fs, err := families(namespace)
+	if err != nil {
+		return nil, err
+	}
+	panels := []interface{}{}
+	for i, f := range fs {
+		query, unit := panelQuery(f)
+		if query == "" {
+			continue
+		}
+		panels = append(panels, map[string]interface{}{
+			"id":          i + 1,
+			"type":        "timeseries",
+			"title":       f.GetName(),
+			"description": f.GetHelp(),
+			"datasource":  datasource,
+			"gridPos":     map[string]interface{}{"h": 8, "w": 12, "x": (i % 2) * 12, "y": (i / 2) * 8},
+			"fieldConfig": map[string]interface{}{"defaults": map[string]interface{}{"unit": unit}},
+			"targets":     []interface{}{map[string]interface{}{"expr": query, "refId": "A"}},
+		})
+	}
+	return json.MarshalIndent(map[string]interface{}{
+		"title":         namespace + " service",
+		"uid":           namespace + "-service",
+		"tags":          []string{namespace},
+		"schemaVersion": 36,
+		"time":          map[string]interface{}{"from": "now-6h", "to": "now"},
+		"panels":        panels,
+	}, "", "  ")
+}
+
+// PrometheusRules 生成告警规则，耗时类指标告警 p95 延迟，带 code 标签的计数指标告警错误率
+func PrometheusRules(namespace string) ([]byte, error) {
+	fs, err := families(namespace)
+	if err != nil {
+		return nil, err
+	}
+	rules := []interface{}{}
+	for _, f := range fs {
+		name := f.GetName()
+		switch f.GetType() {
+		case dto.MetricType_HISTOGRAM:
+			rules = append(rules, map[string]interface{}{
+				"alert":       alertName(name, "HighLatency"),
+				"expr":        "histogram_quantile(0.95, sum(rate(" + name + "_bucket[5m])) by (le)) > 1",
+				"for":         "10m",
+				"labels":      map[string]string{"severity": "warning"},
+				"annotations": map[string]string{"summary": name + " p95 超过 1s"},
+			})
+		case dto.MetricType_COUNTER:
+			if !contains(labelNames(f), "code") {
+				continue
+			}
+			rules = append(rules, map[string]interface{}{
+				"alert":       alertName(name, "HighErrorRate"),
+				"expr":        "sum(rate(" + name + "{code!=\"200\"}[5m])) / sum(rate(" + name + "[5m])) > 0.05",
+				"for":         "10m",
+				"labels":      map[string]string{"severity": "critical"},
+				"annotations": map[string]string{"summary": name + " 错误率超过 5%"},
+			})
+		}
+	}
+	return yaml.Marshal(map[string]interface{}{
+		"groups": []interface{}{
+			map[string]interface{}{"name": namespace + ".rules", "rules": rules},
+		},
+	})
+}
+
+// route_rpc_duration_seconds => RouteRpcDurationSecondsHighLatency
+func alertName(metric, suffix string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(metric, "_") {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String() + suffix
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
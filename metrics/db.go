@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"time"
+)
+
+var (
+	dbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "数据库查询耗时，call 为发起查询的仓库方法，result 为 success 或 failure",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"call", "result"})
+	dbSlowQueries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "db",
+		Name:      "slow_queries_total",
+		Help:      "超过慢查询阈值的查询数",
+	}, []string{"call"})
+)
+
+func init() {
+	Registry.MustRegister(dbQueryDuration, dbSlowQueries)
+}
+
+// ObserveQuery 记录一次数据库查询
+func ObserveQuery(call string, duration time.Duration, slow bool, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	dbQueryDuration.WithLabelValues(call, result).Observe(duration.Seconds())
+	if slow {
+		dbSlowQueries.WithLabelValues(call).Inc()
+	}
+}
@@ -0,0 +1,19 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var logSuppressed = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: Namespace,
+	Subsystem: "log",
+	Name:      "suppressed_total",
+	Help:      "未输出的日志条数，kind 为 sampled（采样省略）或 aggregated（重复错误聚合）",
+}, []string{"kind"})
+
+func init() {
+	Registry.MustRegister(logSuppressed)
+}
+
+// ObserveLogSuppressed 记录一条未输出的日志
+func ObserveLogSuppressed(kind string) {
+	logSuppressed.WithLabelValues(kind).Inc()
+}
@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/errors"
+	"github.com/asim/go-micro/v3/server"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"strconv"
+	"time"
+)
+
+// Namespace 本服务所有指标名称的前缀
+const Namespace = "route"
+
+// Registry 本服务的指标注册中心，/metrics 和看板生成都基于它
+var Registry = prometheus.NewRegistry()
+
+var (
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "rpc",
+		Name:      "requests_total",
+		Help:      "RPC 请求数，code 为 go-micro 错误码，成功为 200",
+	}, []string{"method", "code"})
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "rpc",
+		Name:      "duration_seconds",
+		Help:      "RPC 请求耗时",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpcRequests,
+		rpcDuration,
+	)
+}
+
+// NewHandlerWrapper 记录 RPC 请求数和耗时
+func NewHandlerWrapper() server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			start := time.Now()
+			err := fn(ctx, req, rsp)
+			code := int32(200)
+			if err != nil {
+				code = 500
+				if e := errors.FromError(err); e != nil && e.Code != 0 {
+					code = e.Code
+				}
+			}
+			rpcRequests.WithLabelValues(req.Endpoint(), strconv.FormatInt(int64(code), 10)).Inc()
+			rpcDuration.WithLabelValues(req.Endpoint()).Observe(time.Since(start).Seconds())
+			return err
+		}
+	}
+}
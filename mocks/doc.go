@@ -0,0 +1,7 @@
+// Package mocks 服务和仓库接口的 mock 实现，供本服务和调用方的集成测试使用
+//
+// 接口变更后重新生成：
+//
+//	mockery --dir domain/repository --all --output mocks --outpkg mocks --case underscore
+//	mockery --dir domain/service --name "I.*DataService" --output mocks --outpkg mocks --case underscore
+package mocks
@@ -0,0 +1,34 @@
+package notify
+
+import "github.com/zxnlx/route/secrets"
+
+// Config 通知配置，从配置中心的 route.notify 节点读取
+type Config struct {
+	// Events 订阅的事件类型，为空时只发送失败、漂移、证书过期和审批事件
+	Events   []string         `json:"events"`
+	Webhooks []WebhookConfig  `json:"webhooks"`
+	Slack    []SlackConfig    `json:"slack"`
+	DingTalk []DingTalkConfig `json:"dingtalk"`
+	WeCom    []WeComConfig    `json:"wecom"`
+}
+
+// NewDispatcher 根据配置创建通知分发，secretsProvider 用于读取 webhook 的签名密钥，可以为 nil
+func NewDispatcher(config Config, secretsProvider secrets.Provider) *Dispatcher {
+	d := &Dispatcher{events: map[string]bool{}}
+	for _, e := range config.Events {
+		d.events[e] = true
+	}
+	for _, c := range config.Webhooks {
+		d.channels = append(d.channels, channel{key: channelKey("webhook", c.URL), notifier: &Webhook{config: c, secrets: secretsProvider}})
+	}
+	for _, c := range config.Slack {
+		d.channels = append(d.channels, channel{key: channelKey("slack", c.WebhookURL+"#"+c.Channel), notifier: &Slack{config: c}})
+	}
+	for _, c := range config.DingTalk {
+		d.channels = append(d.channels, channel{key: channelKey("dingtalk", c.WebhookURL), notifier: &DingTalk{config: c}})
+	}
+	for _, c := range config.WeCom {
+		d.channels = append(d.channels, channel{key: channelKey("wecom", c.WebhookURL), notifier: &WeCom{config: c}})
+	}
+	return d
+}
@@ -0,0 +1,44 @@
+package notify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DingTalkConfig 钉钉群机器人，配置了 secret 时使用加签
+type DingTalkConfig struct {
+	WebhookURL string `json:"webhook_url"`
+	Secret     string `json:"secret"`
+}
+
+type DingTalk struct {
+	config DingTalkConfig
+}
+
+func (n *DingTalk) Notify(e Event) error {
+	return postJSON(n.signedURL(), map[string]interface{}{
+		"msgtype": "text",
+		"text":    map[string]string{"content": e.Text()},
+	})
+}
+
+// 加签：timestamp + "\n" + secret 做 HmacSHA256 后 base64
+func (n *DingTalk) signedURL() string {
+	if n.config.Secret == "" {
+		return n.config.WebhookURL
+	}
+	timestamp := strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10)
+	mac := hmac.New(sha256.New, []byte(n.config.Secret))
+	mac.Write([]byte(timestamp + "\n" + n.config.Secret))
+	sign := url.QueryEscape(base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	sep := "?"
+	if strings.Contains(n.config.WebhookURL, "?") {
+		sep = "&"
+	}
+	return n.config.WebhookURL + sep + "timestamp=" + timestamp + "&sign=" + sign
+}
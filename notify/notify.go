@@ -0,0 +1,162 @@
+package notify
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"github.com/zxnlx/common"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// 事件类型
+const (
+	EventApplySucceeded      = "apply_succeeded"
+	EventOrphanDeleted       = "orphan_deleted"
+	EventApplyFailed         = "apply_failed"
+	EventDriftDetected       = "drift_detected"
+	EventCertificateExpiring = "certificate_expiring"
+	EventApprovalRequested   = "approval_requested"
+	EventRouteReleased       = "route_released"
+	EventRoutePromoted       = "route_promoted"
+	EventRouteDeleting       = "route_deleting"
+	EventRouteDeleted        = "route_deleted"
+	EventRouteDeadLettered   = "route_dead_lettered"
+	// EventControllerConfigChanged 控制器的全局配置被修改，不属于某个路由
+	EventControllerConfigChanged = "controller_config_changed"
+	// EventFreezeOverridden 紧急变更跳过了冻结窗口，记录操作人
+	EventFreezeOverridden = "freeze_overridden"
+)
+
+// Event 需要通知的事件
+type Event struct {
+	Type           string            `json:"type"`
+	RouteID        int64             `json:"route_id"`
+	RouteName      string            `json:"route_name"`
+	RouteNamespace string            `json:"route_namespace"`
+	Message        string            `json:"message"`
+	Time           time.Time         `json:"time"`
+	Extra          map[string]string `json:"extra,omitempty"`
+}
+
+// Text 人类可读的消息内容，聊天工具使用
+func (e Event) Text() string {
+	text := "[" + e.Type + "] 路由 " + e.RouteNamespace + "/" + e.RouteName
+	if e.RouteID != 0 {
+		text += "（ID：" + strconv.FormatInt(e.RouteID, 10) + "）"
+	}
+	return text + "：" + e.Message
+}
+
+// Notifier 通知渠道
+type Notifier interface {
+	Notify(Event) error
+}
+
+var httpClient = &http.Client{Timeout: 5 * time.Second}
+
+func postJSON(url string, body interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return post(url, data, nil)
+}
+
+func post(url string, data []byte, header http.Header) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+	rsp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode/100 != 2 {
+		return errors.New("通知发送失败，状态码：" + strconv.Itoa(rsp.StatusCode))
+	}
+	return nil
+}
+
+// 未配置订阅时默认发送的事件，成功类事件只记录不通知
+var defaultEvents = map[string]bool{
+	EventApplyFailed:         true,
+	EventDriftDetected:       true,
+	EventCertificateExpiring: true,
+	EventApprovalRequested:   true,
+	EventRouteDeadLettered:   true,
+}
+
+// ChannelNotifier 按渠道发送并返回发送成功的渠道，重试时跳过已经成功的渠道，避免重复通知
+type ChannelNotifier interface {
+	NotifyChannels(e Event, delivered []string) ([]string, error)
+}
+
+// 通知渠道，key 由类型和地址的哈希组成，地址可能包含密钥，不直接保存
+type channel struct {
+	key      string
+	notifier Notifier
+}
+
+func channelKey(kind string, url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return kind + ":" + hex.EncodeToString(sum[:8])
+}
+
+// Dispatcher 把事件分发到所有通知渠道，只发送订阅的事件类型
+type Dispatcher struct {
+	channels []channel
+	events   map[string]bool
+}
+
+var _ ChannelNotifier = (*Dispatcher)(nil)
+
+// Notify 依次发送到所有通知渠道，返回第一个错误
+func (d *Dispatcher) Notify(e Event) error {
+	_, err := d.NotifyChannels(e, nil)
+	return err
+}
+
+// NotifyChannels 发送到 delivered 以外的渠道，返回包括 delivered 在内所有发送成功的渠道和第一个错误，失败由 outbox 重试
+func (d *Dispatcher) NotifyChannels(e Event, delivered []string) ([]string, error) {
+	result := append([]string(nil), delivered...)
+	events := d.events
+	if len(events) == 0 {
+		events = defaultEvents
+	}
+	if !events[e.Type] {
+		return result, nil
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	skip := map[string]bool{}
+	for _, v := range delivered {
+		skip[v] = true
+	}
+	var first error
+	for _, c := range d.channels {
+		if skip[c.key] {
+			continue
+		}
+		if err := c.notifier.Notify(e); err != nil {
+			common.Error(err)
+			if first == nil {
+				first = err
+			}
+			continue
+		}
+		//同一地址配置了多次时只发送一次
+		skip[c.key] = true
+		result = append(result, c.key)
+	}
+	return result, first
+}
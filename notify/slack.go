@@ -0,0 +1,19 @@
+package notify
+
+// SlackConfig Slack incoming webhook
+type SlackConfig struct {
+	WebhookURL string `json:"webhook_url"`
+	Channel    string `json:"channel"`
+}
+
+type Slack struct {
+	config SlackConfig
+}
+
+func (n *Slack) Notify(e Event) error {
+	body := map[string]string{"text": e.Text()}
+	if n.config.Channel != "" {
+		body["channel"] = n.config.Channel
+	}
+	return postJSON(n.config.WebhookURL, body)
+}
@@ -0,0 +1,51 @@
+package notify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/zxnlx/route/secrets"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// WebhookConfig 通用 webhook，直接发送事件 JSON
+// 配置了签名密钥时在 X-Route-Signature 中携带 sha256=hex(HmacSHA256(timestamp + "." + body))
+type WebhookConfig struct {
+	URL        string `json:"url"`
+	SigningKey string `json:"signing_key"`
+	// SigningKeyRef 签名密钥在密钥后端中的引用，格式 path#field，优先于 signing_key，轮换后自动使用新密钥
+	SigningKeyRef string `json:"signing_key_ref"`
+}
+
+type Webhook struct {
+	config  WebhookConfig
+	secrets secrets.Provider
+}
+
+func (n *Webhook) Notify(e Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	key := n.config.SigningKey
+	if n.config.SigningKeyRef != "" {
+		if key, err = secrets.Resolve(context.TODO(), n.secrets, n.config.SigningKeyRef); err != nil {
+			return err
+		}
+	}
+	if key == "" {
+		return post(n.config.URL, data, nil)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(data)
+	header := http.Header{}
+	header.Set("X-Route-Timestamp", timestamp)
+	header.Set("X-Route-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	return post(n.config.URL, data, header)
+}
@@ -0,0 +1,17 @@
+package notify
+
+// WeComConfig 企业微信群机器人
+type WeComConfig struct {
+	WebhookURL string `json:"webhook_url"`
+}
+
+type WeCom struct {
+	config WeComConfig
+}
+
+func (n *WeCom) Notify(e Event) error {
+	return postJSON(n.config.WebhookURL, map[string]interface{}{
+		"msgtype": "text",
+		"text":    map[string]string{"content": e.Text()},
+	})
+}
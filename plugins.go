@@ -0,0 +1,6 @@
+package main
+
+// 路由修改插件在这里导入，插件包在 init 中调用 service.RegisterRouteMutator 注册，再通过 route.mutators 启用
+// 例如：
+//
+//	import _ "example.com/platform/routeplugins"
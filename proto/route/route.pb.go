@@ -25,11 +25,70 @@ type RouteInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             int64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	RouteName      string       `protobuf:"bytes,2,opt,name=route_name,json=routeName,proto3" json:"route_name,omitempty"`
-	RouteNamespace string       `protobuf:"bytes,3,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
-	RouteHost      string       `protobuf:"bytes,4,opt,name=route_host,json=routeHost,proto3" json:"route_host,omitempty"`
-	RoutePath      []*RoutePath `protobuf:"bytes,5,rep,name=route_path,json=routePath,proto3" json:"route_path,omitempty"`
+	Id                 int64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RouteName          string            `protobuf:"bytes,2,opt,name=route_name,json=routeName,proto3" json:"route_name,omitempty"`
+	RouteNamespace     string            `protobuf:"bytes,3,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+	RouteHost          string            `protobuf:"bytes,4,opt,name=route_host,json=routeHost,proto3" json:"route_host,omitempty"`
+	RoutePath          []*RoutePath      `protobuf:"bytes,5,rep,name=route_path,json=routePath,proto3" json:"route_path,omitempty"`
+	RouteClass         string            `protobuf:"bytes,6,opt,name=route_class,json=routeClass,proto3" json:"route_class,omitempty"`
+	RouteAnnotations   map[string]string `protobuf:"bytes,7,rep,name=route_annotations,json=routeAnnotations,proto3" json:"route_annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RouteTlsIssuer     string            `protobuf:"bytes,8,opt,name=route_tls_issuer,json=routeTlsIssuer,proto3" json:"route_tls_issuer,omitempty"`
+	RouteApplicationId int64             `protobuf:"varint,9,opt,name=route_application_id,json=routeApplicationId,proto3" json:"route_application_id,omitempty"`
+	RouteDisabled      bool              `protobuf:"varint,10,opt,name=route_disabled,json=routeDisabled,proto3" json:"route_disabled,omitempty"`
+	//为空或 ingress 时使用 Ingress，gateway-api 时生成 HTTPRoute，istio 时生成 VirtualService
+	RouteAdapter string `protobuf:"bytes,11,opt,name=route_adapter,json=routeAdapter,proto3" json:"route_adapter,omitempty"`
+	//gateway-api、istio 下挂载的 Gateway，格式 namespace/name，省略 namespace 时同路由命名空间
+	RouteGateway string `protobuf:"bytes,12,opt,name=route_gateway,json=routeGateway,proto3" json:"route_gateway,omitempty"`
+	//响应头，添加或覆盖 / 删除
+	RouteResponseHeaderSet    map[string]string `protobuf:"bytes,13,rep,name=route_response_header_set,json=routeResponseHeaderSet,proto3" json:"route_response_header_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RouteResponseHeaderRemove []string          `protobuf:"bytes,14,rep,name=route_response_header_remove,json=routeResponseHeaderRemove,proto3" json:"route_response_header_remove,omitempty"`
+	//请求头，追加 / 添加或覆盖 / 删除
+	RouteRequestHeaderAdd    map[string]string `protobuf:"bytes,15,rep,name=route_request_header_add,json=routeRequestHeaderAdd,proto3" json:"route_request_header_add,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RouteRequestHeaderSet    map[string]string `protobuf:"bytes,16,rep,name=route_request_header_set,json=routeRequestHeaderSet,proto3" json:"route_request_header_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RouteRequestHeaderRemove []string          `protobuf:"bytes,17,rep,name=route_request_header_remove,json=routeRequestHeaderRemove,proto3" json:"route_request_header_remove,omitempty"`
+	//每次更新到k8s递增，由服务端维护
+	RouteRevision int64 `protobuf:"varint,18,opt,name=route_revision,json=routeRevision,proto3" json:"route_revision,omitempty"`
+	//创建人、最后修改人，由服务端根据调用方身份填写
+	RouteCreatedBy string `protobuf:"bytes,19,opt,name=route_created_by,json=routeCreatedBy,proto3" json:"route_created_by,omitempty"`
+	RouteUpdatedBy string `protobuf:"bytes,20,opt,name=route_updated_by,json=routeUpdatedBy,proto3" json:"route_updated_by,omitempty"`
+	//负责团队、联系方式、成本中心
+	RouteOwnerTeam  string `protobuf:"bytes,21,opt,name=route_owner_team,json=routeOwnerTeam,proto3" json:"route_owner_team,omitempty"`
+	RouteContact    string `protobuf:"bytes,22,opt,name=route_contact,json=routeContact,proto3" json:"route_contact,omitempty"`
+	RouteCostCenter string `protobuf:"bytes,23,opt,name=route_cost_center,json=routeCostCenter,proto3" json:"route_cost_center,omitempty"`
+	//创建时k8s中已存在同名资源的处理策略：fail（默认）、adopt、replace，不保存
+	RouteConflictPolicy string `protobuf:"bytes,24,opt,name=route_conflict_policy,json=routeConflictPolicy,proto3" json:"route_conflict_policy,omitempty"`
+	//创建、更新后等待路由就绪再返回，不保存
+	RouteWaitForReady bool `protobuf:"varint,25,opt,name=route_wait_for_ready,json=routeWaitForReady,proto3" json:"route_wait_for_ready,omitempty"`
+	//等待超时秒数，默认 60，最大 600
+	RouteWaitTimeout int64 `protobuf:"varint,26,opt,name=route_wait_timeout,json=routeWaitTimeout,proto3" json:"route_wait_timeout,omitempty"`
+	//就绪后再通过负载均衡地址探测一次
+	RouteWaitProbe bool `protobuf:"varint,27,opt,name=route_wait_probe,json=routeWaitProbe,proto3" json:"route_wait_probe,omitempty"`
+	//最后一次写入k8s的规格哈希，由服务端维护
+	RouteSpecHash string `protobuf:"bytes,28,opt,name=route_spec_hash,json=routeSpecHash,proto3" json:"route_spec_hash,omitempty"`
+	//最近一次写入k8s的时间（unix 秒）、耗时、错误和连续失败次数，由服务端维护
+	RouteLastApplyTime       int64  `protobuf:"varint,29,opt,name=route_last_apply_time,json=routeLastApplyTime,proto3" json:"route_last_apply_time,omitempty"`
+	RouteLastApplyDurationMs int64  `protobuf:"varint,30,opt,name=route_last_apply_duration_ms,json=routeLastApplyDurationMs,proto3" json:"route_last_apply_duration_ms,omitempty"`
+	RouteLastError           string `protobuf:"bytes,31,opt,name=route_last_error,json=routeLastError,proto3" json:"route_last_error,omitempty"`
+	RouteConsecutiveFailures int64  `protobuf:"varint,32,opt,name=route_consecutive_failures,json=routeConsecutiveFailures,proto3" json:"route_consecutive_failures,omitempty"`
+	//服务端应用使用的 field manager，为空时使用配置，不保存
+	RouteFieldManager string `protobuf:"bytes,33,opt,name=route_field_manager,json=routeFieldManager,proto3" json:"route_field_manager,omitempty"`
+	//服务端应用字段冲突时的处理：force 强制接管、fail 返回冲突，为空时使用配置，不保存
+	RouteApplyConflicts string `protobuf:"bytes,34,opt,name=route_apply_conflicts,json=routeApplyConflicts,proto3" json:"route_apply_conflicts,omitempty"`
+	//响应压缩、WebSocket、HTTP/2 后端，按 Ingress class 转换为控制器注解
+	RouteCompression bool `protobuf:"varint,35,opt,name=route_compression,json=routeCompression,proto3" json:"route_compression,omitempty"`
+	RouteWebsocket   bool `protobuf:"varint,36,opt,name=route_websocket,json=routeWebsocket,proto3" json:"route_websocket,omitempty"`
+	//WebSocket 连接空闲超时秒数，默认 3600
+	RouteWebsocketTimeoutSeconds int32 `protobuf:"varint,37,opt,name=route_websocket_timeout_seconds,json=routeWebsocketTimeoutSeconds,proto3" json:"route_websocket_timeout_seconds,omitempty"`
+	RouteBackendHttp2            bool  `protobuf:"varint,38,opt,name=route_backend_http2,json=routeBackendHttp2,proto3" json:"route_backend_http2,omitempty"`
+	//外部认证地址、未登录时的跳转地址、认证成功后传给后端的响应头，nginx 使用 auth-url 注解，Traefik 使用 ForwardAuth 中间件
+	RouteAuthUrl             string   `protobuf:"bytes,39,opt,name=route_auth_url,json=routeAuthUrl,proto3" json:"route_auth_url,omitempty"`
+	RouteAuthSignin          string   `protobuf:"bytes,40,opt,name=route_auth_signin,json=routeAuthSignin,proto3" json:"route_auth_signin,omitempty"`
+	RouteAuthResponseHeaders []string `protobuf:"bytes,41,rep,name=route_auth_response_headers,json=routeAuthResponseHeaders,proto3" json:"route_auth_response_headers,omitempty"`
+	//写入失败后下次自动重试的时间（unix 秒），超过重试次数后进入死信列表，由服务端维护
+	RouteNextRetryAt int64 `protobuf:"varint,42,opt,name=route_next_retry_at,json=routeNextRetryAt,proto3" json:"route_next_retry_at,omitempty"`
+	RouteDeadLetter  bool  `protobuf:"varint,43,opt,name=route_dead_letter,json=routeDeadLetter,proto3" json:"route_dead_letter,omitempty"`
+	//使用其他命名空间的保留域名，需要开启 RBAC 且有 global-admin 角色，不保存
+	RouteReservedHostOverride bool `protobuf:"varint,44,opt,name=route_reserved_host_override,json=routeReservedHostOverride,proto3" json:"route_reserved_host_override,omitempty"`
 }
 
 func (x *RouteInfo) Reset() {
@@ -99,6 +158,279 @@ func (x *RouteInfo) GetRoutePath() []*RoutePath {
 	return nil
 }
 
+func (x *RouteInfo) GetRouteClass() string {
+	if x != nil {
+		return x.RouteClass
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteAnnotations() map[string]string {
+	if x != nil {
+		return x.RouteAnnotations
+	}
+	return nil
+}
+
+func (x *RouteInfo) GetRouteTlsIssuer() string {
+	if x != nil {
+		return x.RouteTlsIssuer
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteApplicationId() int64 {
+	if x != nil {
+		return x.RouteApplicationId
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteDisabled() bool {
+	if x != nil {
+		return x.RouteDisabled
+	}
+	return false
+}
+
+func (x *RouteInfo) GetRouteAdapter() string {
+	if x != nil {
+		return x.RouteAdapter
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteGateway() string {
+	if x != nil {
+		return x.RouteGateway
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteResponseHeaderSet() map[string]string {
+	if x != nil {
+		return x.RouteResponseHeaderSet
+	}
+	return nil
+}
+
+func (x *RouteInfo) GetRouteResponseHeaderRemove() []string {
+	if x != nil {
+		return x.RouteResponseHeaderRemove
+	}
+	return nil
+}
+
+func (x *RouteInfo) GetRouteRequestHeaderAdd() map[string]string {
+	if x != nil {
+		return x.RouteRequestHeaderAdd
+	}
+	return nil
+}
+
+func (x *RouteInfo) GetRouteRequestHeaderSet() map[string]string {
+	if x != nil {
+		return x.RouteRequestHeaderSet
+	}
+	return nil
+}
+
+func (x *RouteInfo) GetRouteRequestHeaderRemove() []string {
+	if x != nil {
+		return x.RouteRequestHeaderRemove
+	}
+	return nil
+}
+
+func (x *RouteInfo) GetRouteRevision() int64 {
+	if x != nil {
+		return x.RouteRevision
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteCreatedBy() string {
+	if x != nil {
+		return x.RouteCreatedBy
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteUpdatedBy() string {
+	if x != nil {
+		return x.RouteUpdatedBy
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteOwnerTeam() string {
+	if x != nil {
+		return x.RouteOwnerTeam
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteContact() string {
+	if x != nil {
+		return x.RouteContact
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteCostCenter() string {
+	if x != nil {
+		return x.RouteCostCenter
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteConflictPolicy() string {
+	if x != nil {
+		return x.RouteConflictPolicy
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteWaitForReady() bool {
+	if x != nil {
+		return x.RouteWaitForReady
+	}
+	return false
+}
+
+func (x *RouteInfo) GetRouteWaitTimeout() int64 {
+	if x != nil {
+		return x.RouteWaitTimeout
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteWaitProbe() bool {
+	if x != nil {
+		return x.RouteWaitProbe
+	}
+	return false
+}
+
+func (x *RouteInfo) GetRouteSpecHash() string {
+	if x != nil {
+		return x.RouteSpecHash
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteLastApplyTime() int64 {
+	if x != nil {
+		return x.RouteLastApplyTime
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteLastApplyDurationMs() int64 {
+	if x != nil {
+		return x.RouteLastApplyDurationMs
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteLastError() string {
+	if x != nil {
+		return x.RouteLastError
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteConsecutiveFailures() int64 {
+	if x != nil {
+		return x.RouteConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteFieldManager() string {
+	if x != nil {
+		return x.RouteFieldManager
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteApplyConflicts() string {
+	if x != nil {
+		return x.RouteApplyConflicts
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteCompression() bool {
+	if x != nil {
+		return x.RouteCompression
+	}
+	return false
+}
+
+func (x *RouteInfo) GetRouteWebsocket() bool {
+	if x != nil {
+		return x.RouteWebsocket
+	}
+	return false
+}
+
+func (x *RouteInfo) GetRouteWebsocketTimeoutSeconds() int32 {
+	if x != nil {
+		return x.RouteWebsocketTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteBackendHttp2() bool {
+	if x != nil {
+		return x.RouteBackendHttp2
+	}
+	return false
+}
+
+func (x *RouteInfo) GetRouteAuthUrl() string {
+	if x != nil {
+		return x.RouteAuthUrl
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteAuthSignin() string {
+	if x != nil {
+		return x.RouteAuthSignin
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRouteAuthResponseHeaders() []string {
+	if x != nil {
+		return x.RouteAuthResponseHeaders
+	}
+	return nil
+}
+
+func (x *RouteInfo) GetRouteNextRetryAt() int64 {
+	if x != nil {
+		return x.RouteNextRetryAt
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetRouteDeadLetter() bool {
+	if x != nil {
+		return x.RouteDeadLetter
+	}
+	return false
+}
+
+func (x *RouteInfo) GetRouteReservedHostOverride() bool {
+	if x != nil {
+		return x.RouteReservedHostOverride
+	}
+	return false
+}
+
 type RoutePath struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -109,6 +441,15 @@ type RoutePath struct {
 	RoutePathName           string `protobuf:"bytes,3,opt,name=route_path_name,json=routePathName,proto3" json:"route_path_name,omitempty"`
 	RouteBackendService     string `protobuf:"bytes,4,opt,name=route_backend_service,json=routeBackendService,proto3" json:"route_backend_service,omitempty"`
 	RouteBackendServicePort int32  `protobuf:"varint,5,opt,name=route_backend_service_port,json=routeBackendServicePort,proto3" json:"route_backend_service_port,omitempty"`
+	//以下匹配条件仅 gateway-api 支持
+	RouteHeaderMatch []*RouteHeaderMatch `protobuf:"bytes,6,rep,name=route_header_match,json=routeHeaderMatch,proto3" json:"route_header_match,omitempty"`
+	RouteMethod      []string            `protobuf:"bytes,7,rep,name=route_method,json=routeMethod,proto3" json:"route_method,omitempty"`
+	//影子流量，复制一份请求到镜像服务，响应会被丢弃
+	RouteMirrorService     string `protobuf:"bytes,8,opt,name=route_mirror_service,json=routeMirrorService,proto3" json:"route_mirror_service,omitempty"`
+	RouteMirrorServicePort int32  `protobuf:"varint,9,opt,name=route_mirror_service_port,json=routeMirrorServicePort,proto3" json:"route_mirror_service_port,omitempty"`
+	//后端健康检查路径和间隔秒数，按 Ingress class 转换为控制器注解，如 alb
+	RouteHealthcheckPath            string `protobuf:"bytes,10,opt,name=route_healthcheck_path,json=routeHealthcheckPath,proto3" json:"route_healthcheck_path,omitempty"`
+	RouteHealthcheckIntervalSeconds int32  `protobuf:"varint,11,opt,name=route_healthcheck_interval_seconds,json=routeHealthcheckIntervalSeconds,proto3" json:"route_healthcheck_interval_seconds,omitempty"`
 }
 
 func (x *RoutePath) Reset() {
@@ -178,16 +519,61 @@ func (x *RoutePath) GetRouteBackendServicePort() int32 {
 	return 0
 }
 
-type RouteId struct {
+func (x *RoutePath) GetRouteHeaderMatch() []*RouteHeaderMatch {
+	if x != nil {
+		return x.RouteHeaderMatch
+	}
+	return nil
+}
+
+func (x *RoutePath) GetRouteMethod() []string {
+	if x != nil {
+		return x.RouteMethod
+	}
+	return nil
+}
+
+func (x *RoutePath) GetRouteMirrorService() string {
+	if x != nil {
+		return x.RouteMirrorService
+	}
+	return ""
+}
+
+func (x *RoutePath) GetRouteMirrorServicePort() int32 {
+	if x != nil {
+		return x.RouteMirrorServicePort
+	}
+	return 0
+}
+
+func (x *RoutePath) GetRouteHealthcheckPath() string {
+	if x != nil {
+		return x.RouteHealthcheckPath
+	}
+	return ""
+}
+
+func (x *RoutePath) GetRouteHealthcheckIntervalSeconds() int32 {
+	if x != nil {
+		return x.RouteHealthcheckIntervalSeconds
+	}
+	return 0
+}
+
+type RouteHeaderMatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	//Exact 或 RegularExpression，默认 Exact
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *RouteId) Reset() {
-	*x = RouteId{}
+func (x *RouteHeaderMatch) Reset() {
+	*x = RouteHeaderMatch{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_route_route_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -195,13 +581,13 @@ func (x *RouteId) Reset() {
 	}
 }
 
-func (x *RouteId) String() string {
+func (x *RouteHeaderMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteId) ProtoMessage() {}
+func (*RouteHeaderMatch) ProtoMessage() {}
 
-func (x *RouteId) ProtoReflect() protoreflect.Message {
+func (x *RouteHeaderMatch) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_route_route_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -213,26 +599,44 @@ func (x *RouteId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteId.ProtoReflect.Descriptor instead.
-func (*RouteId) Descriptor() ([]byte, []int) {
+// Deprecated: Use RouteHeaderMatch.ProtoReflect.Descriptor instead.
+func (*RouteHeaderMatch) Descriptor() ([]byte, []int) {
 	return file_proto_route_route_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *RouteId) GetId() int64 {
+func (x *RouteHeaderMatch) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type FindAll struct {
+func (x *RouteHeaderMatch) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *RouteHeaderMatch) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type RouteId struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//删除策略，仅删除时使用：空为默认，cascade 同时删除关联资源，orphan 保留k8s资源只删除数据库记录
+	DeletePolicy string `protobuf:"bytes,2,opt,name=delete_policy,json=deletePolicy,proto3" json:"delete_policy,omitempty"`
 }
 
-func (x *FindAll) Reset() {
-	*x = FindAll{}
+func (x *RouteId) Reset() {
+	*x = RouteId{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_route_route_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -240,13 +644,13 @@ func (x *FindAll) Reset() {
 	}
 }
 
-func (x *FindAll) String() string {
+func (x *RouteId) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindAll) ProtoMessage() {}
+func (*RouteId) ProtoMessage() {}
 
-func (x *FindAll) ProtoReflect() protoreflect.Message {
+func (x *RouteId) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_route_route_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -258,23 +662,238 @@ func (x *FindAll) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAll.ProtoReflect.Descriptor instead.
-func (*FindAll) Descriptor() ([]byte, []int) {
+// Deprecated: Use RouteId.ProtoReflect.Descriptor instead.
+func (*RouteId) Descriptor() ([]byte, []int) {
 	return file_proto_route_route_proto_rawDescGZIP(), []int{3}
 }
 
+func (x *RouteId) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RouteId) GetDeletePolicy() string {
+	if x != nil {
+		return x.DeletePolicy
+	}
+	return ""
+}
+
+type RouteName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteNamespace string `protobuf:"bytes,1,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+	RouteName      string `protobuf:"bytes,2,opt,name=route_name,json=routeName,proto3" json:"route_name,omitempty"`
+	//同 RouteId.delete_policy
+	DeletePolicy string `protobuf:"bytes,3,opt,name=delete_policy,json=deletePolicy,proto3" json:"delete_policy,omitempty"`
+}
+
+func (x *RouteName) Reset() {
+	*x = RouteName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteName) ProtoMessage() {}
+
+func (x *RouteName) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteName.ProtoReflect.Descriptor instead.
+func (*RouteName) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RouteName) GetRouteNamespace() string {
+	if x != nil {
+		return x.RouteNamespace
+	}
+	return ""
+}
+
+func (x *RouteName) GetRouteName() string {
+	if x != nil {
+		return x.RouteName
+	}
+	return ""
+}
+
+func (x *RouteName) GetDeletePolicy() string {
+	if x != nil {
+		return x.DeletePolicy
+	}
+	return ""
+}
+
+type FindAll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//分页参数，仅 FindAllRoute 支持，page_size 为 0 且没有 page_token 时返回全部
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	//上一页返回的 next_page_token
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	//只返回这些字段，FindAllRoute 和 StreamAllRoutes 支持，为空时返回全部，见 SearchRoutesRequest.fields
+	Fields []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *FindAll) Reset() {
+	*x = FindAll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAll) ProtoMessage() {}
+
+func (x *FindAll) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAll.ProtoReflect.Descriptor instead.
+func (*FindAll) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FindAll) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FindAll) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *FindAll) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type RouteCollectionVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	//最后修改时间，unix 纳秒
+	UpdatedAt int64 `protobuf:"varint,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	//调用方身份及其角色和命名空间，权限变化后 ETag 随之变化
+	Scope string `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+}
+
+func (x *RouteCollectionVersion) Reset() {
+	*x = RouteCollectionVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteCollectionVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteCollectionVersion) ProtoMessage() {}
+
+func (x *RouteCollectionVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteCollectionVersion.ProtoReflect.Descriptor instead.
+func (*RouteCollectionVersion) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RouteCollectionVersion) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RouteCollectionVersion) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *RouteCollectionVersion) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
 type Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	//设置 route_wait_for_ready 时返回最终状态
+	Status *RouteStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	//更新路由时规格没有变化，没有写入k8s和数据库
+	Unchanged bool `protobuf:"varint,3,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	//添加成功时为新记录的 ID
+	Id int64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (x *Response) Reset() {
 	*x = Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_route_proto_msgTypes[4]
+		mi := &file_proto_route_route_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -287,7 +906,7 @@ func (x *Response) String() string {
 func (*Response) ProtoMessage() {}
 
 func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_route_proto_msgTypes[4]
+	mi := &file_proto_route_route_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +919,7 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Response.ProtoReflect.Descriptor instead.
 func (*Response) Descriptor() ([]byte, []int) {
-	return file_proto_route_route_proto_rawDescGZIP(), []int{4}
+	return file_proto_route_route_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Response) GetMsg() string {
@@ -310,31 +929,59 @@ func (x *Response) GetMsg() string {
 	return ""
 }
 
-type AllRoute struct {
+func (x *Response) GetStatus() *RouteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *Response) GetUnchanged() bool {
+	if x != nil {
+		return x.Unchanged
+	}
+	return false
+}
+
+func (x *Response) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RouteStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RouteInfo []*RouteInfo `protobuf:"bytes,1,rep,name=route_info,json=routeInfo,proto3" json:"route_info,omitempty"`
+	Ready   bool     `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	Address []string `protobuf:"bytes,2,rep,name=address,proto3" json:"address,omitempty"`
+	Message string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	//集群使用的 Ingress API 版本
+	IngressApiVersion string `protobuf:"bytes,4,opt,name=ingress_api_version,json=ingressApiVersion,proto3" json:"ingress_api_version,omitempty"`
+	//后端健康汇总：healthy、degraded、unhealthy，无法判断时为 unknown
+	BackendHealth string           `protobuf:"bytes,5,opt,name=backend_health,json=backendHealth,proto3" json:"backend_health,omitempty"`
+	Backends      []*BackendHealth `protobuf:"bytes,6,rep,name=backends,proto3" json:"backends,omitempty"`
 }
 
-func (x *AllRoute) Reset() {
-	*x = AllRoute{}
+func (x *RouteStatus) Reset() {
+	*x = RouteStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_route_route_proto_msgTypes[5]
+		mi := &file_proto_route_route_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AllRoute) String() string {
+func (x *RouteStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllRoute) ProtoMessage() {}
+func (*RouteStatus) ProtoMessage() {}
 
-func (x *AllRoute) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_route_route_proto_msgTypes[5]
+func (x *RouteStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -345,126 +992,7725 @@ func (x *AllRoute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllRoute.ProtoReflect.Descriptor instead.
-func (*AllRoute) Descriptor() ([]byte, []int) {
-	return file_proto_route_route_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use RouteStatus.ProtoReflect.Descriptor instead.
+func (*RouteStatus) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AllRoute) GetRouteInfo() []*RouteInfo {
+func (x *RouteStatus) GetReady() bool {
 	if x != nil {
-		return x.RouteInfo
+		return x.Ready
+	}
+	return false
+}
+
+func (x *RouteStatus) GetAddress() []string {
+	if x != nil {
+		return x.Address
 	}
 	return nil
 }
 
-var File_proto_route_route_proto protoreflect.FileDescriptor
+func (x *RouteStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
 
-var file_proto_route_route_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2f, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x22, 0xb3, 0x01, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
-	0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x09, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0xcf, 0x01, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12,
-	0x26, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x50,
-	0x61, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x17, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x19, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x09, 0x0a, 0x07, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x22, 0x1c,
-	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x3b, 0x0a, 0x08,
-	0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x32, 0x86, 0x02, 0x0a, 0x05, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
-	0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0d, 0x46, 0x69,
-	0x6e, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0e, 0x2e, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12,
-	0x31, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
-	0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a,
-	0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x22, 0x00, 0x42, 0x15, 0x5a, 0x13, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x3b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+func (x *RouteStatus) GetIngressApiVersion() string {
+	if x != nil {
+		return x.IngressApiVersion
+	}
+	return ""
 }
 
-var (
-	file_proto_route_route_proto_rawDescOnce sync.Once
-	file_proto_route_route_proto_rawDescData = file_proto_route_route_proto_rawDesc
-)
+func (x *RouteStatus) GetBackendHealth() string {
+	if x != nil {
+		return x.BackendHealth
+	}
+	return ""
+}
 
-func file_proto_route_route_proto_rawDescGZIP() []byte {
-	file_proto_route_route_proto_rawDescOnce.Do(func() {
-		file_proto_route_route_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_route_route_proto_rawDescData)
-	})
-	return file_proto_route_route_proto_rawDescData
+func (x *RouteStatus) GetBackends() []*BackendHealth {
+	if x != nil {
+		return x.Backends
+	}
+	return nil
 }
 
-var file_proto_route_route_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_proto_route_route_proto_goTypes = []interface{}{
-	(*RouteInfo)(nil), // 0: route.RouteInfo
-	(*RoutePath)(nil), // 1: route.RoutePath
-	(*RouteId)(nil),   // 2: route.RouteId
-	(*FindAll)(nil),   // 3: route.FindAll
-	(*Response)(nil),  // 4: route.Response
-	(*AllRoute)(nil),  // 5: route.AllRoute
+// 根据 Service 的 Endpoints 推断的后端健康状况
+type BackendHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service  string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Port     int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Ready    int32  `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	NotReady int32  `protobuf:"varint,4,opt,name=not_ready,json=notReady,proto3" json:"not_ready,omitempty"`
 }
-var file_proto_route_route_proto_depIdxs = []int32{
-	1, // 0: route.RouteInfo.route_path:type_name -> route.RoutePath
-	0, // 1: route.AllRoute.route_info:type_name -> route.RouteInfo
-	0, // 2: route.Route.AddRoute:input_type -> route.RouteInfo
-	2, // 3: route.Route.DeleteRoute:input_type -> route.RouteId
-	0, // 4: route.Route.UpdateRoute:input_type -> route.RouteInfo
-	2, // 5: route.Route.FindRouteByID:input_type -> route.RouteId
-	3, // 6: route.Route.FindAllRoute:input_type -> route.FindAll
-	4, // 7: route.Route.AddRoute:output_type -> route.Response
-	4, // 8: route.Route.DeleteRoute:output_type -> route.Response
-	4, // 9: route.Route.UpdateRoute:output_type -> route.Response
-	0, // 10: route.Route.FindRouteByID:output_type -> route.RouteInfo
-	5, // 11: route.Route.FindAllRoute:output_type -> route.AllRoute
-	7, // [7:12] is the sub-list for method output_type
-	2, // [2:7] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+func (x *BackendHealth) Reset() {
+	*x = BackendHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func init() { file_proto_route_route_proto_init() }
-func file_proto_route_route_proto_init() {
-	if File_proto_route_route_proto != nil {
-		return
+func (x *BackendHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendHealth) ProtoMessage() {}
+
+func (x *BackendHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_route_route_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouteInfo); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendHealth.ProtoReflect.Descriptor instead.
+func (*BackendHealth) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BackendHealth) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *BackendHealth) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *BackendHealth) GetReady() int32 {
+	if x != nil {
+		return x.Ready
+	}
+	return 0
+}
+
+func (x *BackendHealth) GetNotReady() int32 {
+	if x != nil {
+		return x.NotReady
+	}
+	return 0
+}
+
+type AllRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteInfo []*RouteInfo `protobuf:"bytes,1,rep,name=route_info,json=routeInfo,proto3" json:"route_info,omitempty"`
+	//分页查询时下一页的游标，为空表示没有更多
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *AllRoute) Reset() {
+	*x = AllRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllRoute) ProtoMessage() {}
+
+func (x *AllRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllRoute.ProtoReflect.Descriptor instead.
+func (*AllRoute) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AllRoute) GetRouteInfo() []*RouteInfo {
+	if x != nil {
+		return x.RouteInfo
+	}
+	return nil
+}
+
+func (x *AllRoute) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchRoutesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//为空的条件不参与过滤
+	RouteNamespace     string `protobuf:"bytes,1,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+	RouteHost          string `protobuf:"bytes,2,opt,name=route_host,json=routeHost,proto3" json:"route_host,omitempty"`
+	RouteApplicationId int64  `protobuf:"varint,3,opt,name=route_application_id,json=routeApplicationId,proto3" json:"route_application_id,omitempty"`
+	RouteAdapter       string `protobuf:"bytes,4,opt,name=route_adapter,json=routeAdapter,proto3" json:"route_adapter,omitempty"`
+	//排序：id（默认）、name（命名空间和名称）、host，翻页时必须和游标一致
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	//每页条数，默认 100，最大 1000
+	PageSize  int32  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	//只返回这些 RouteInfo 字段，id 总是返回，status 表示禁用、写入状态和重试相关字段，为空时返回全部
+	Fields []string `protobuf:"bytes,8,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *SearchRoutesRequest) Reset() {
+	*x = SearchRoutesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRoutesRequest) ProtoMessage() {}
+
+func (x *SearchRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRoutesRequest.ProtoReflect.Descriptor instead.
+func (*SearchRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchRoutesRequest) GetRouteNamespace() string {
+	if x != nil {
+		return x.RouteNamespace
+	}
+	return ""
+}
+
+func (x *SearchRoutesRequest) GetRouteHost() string {
+	if x != nil {
+		return x.RouteHost
+	}
+	return ""
+}
+
+func (x *SearchRoutesRequest) GetRouteApplicationId() int64 {
+	if x != nil {
+		return x.RouteApplicationId
+	}
+	return 0
+}
+
+func (x *SearchRoutesRequest) GetRouteAdapter() string {
+	if x != nil {
+		return x.RouteAdapter
+	}
+	return ""
+}
+
+func (x *SearchRoutesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *SearchRoutesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchRoutesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchRoutesRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type NamespaceDefaultInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  int64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Namespace           string            `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	DefaultClass        string            `protobuf:"bytes,3,opt,name=default_class,json=defaultClass,proto3" json:"default_class,omitempty"`
+	DefaultAnnotations  map[string]string `protobuf:"bytes,4,rep,name=default_annotations,json=defaultAnnotations,proto3" json:"default_annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DefaultTlsIssuer    string            `protobuf:"bytes,5,opt,name=default_tls_issuer,json=defaultTlsIssuer,proto3" json:"default_tls_issuer,omitempty"`
+	AllowedHostsPattern string            `protobuf:"bytes,6,opt,name=allowed_hosts_pattern,json=allowedHostsPattern,proto3" json:"allowed_hosts_pattern,omitempty"`
+}
+
+func (x *NamespaceDefaultInfo) Reset() {
+	*x = NamespaceDefaultInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceDefaultInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceDefaultInfo) ProtoMessage() {}
+
+func (x *NamespaceDefaultInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceDefaultInfo.ProtoReflect.Descriptor instead.
+func (*NamespaceDefaultInfo) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NamespaceDefaultInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NamespaceDefaultInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *NamespaceDefaultInfo) GetDefaultClass() string {
+	if x != nil {
+		return x.DefaultClass
+	}
+	return ""
+}
+
+func (x *NamespaceDefaultInfo) GetDefaultAnnotations() map[string]string {
+	if x != nil {
+		return x.DefaultAnnotations
+	}
+	return nil
+}
+
+func (x *NamespaceDefaultInfo) GetDefaultTlsIssuer() string {
+	if x != nil {
+		return x.DefaultTlsIssuer
+	}
+	return ""
+}
+
+func (x *NamespaceDefaultInfo) GetAllowedHostsPattern() string {
+	if x != nil {
+		return x.AllowedHostsPattern
+	}
+	return ""
+}
+
+type NamespaceDefaultId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *NamespaceDefaultId) Reset() {
+	*x = NamespaceDefaultId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceDefaultId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceDefaultId) ProtoMessage() {}
+
+func (x *NamespaceDefaultId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceDefaultId.ProtoReflect.Descriptor instead.
+func (*NamespaceDefaultId) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NamespaceDefaultId) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AllNamespaceDefault struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NamespaceDefaultInfo []*NamespaceDefaultInfo `protobuf:"bytes,1,rep,name=namespace_default_info,json=namespaceDefaultInfo,proto3" json:"namespace_default_info,omitempty"`
+}
+
+func (x *AllNamespaceDefault) Reset() {
+	*x = AllNamespaceDefault{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllNamespaceDefault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllNamespaceDefault) ProtoMessage() {}
+
+func (x *AllNamespaceDefault) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllNamespaceDefault.ProtoReflect.Descriptor instead.
+func (*AllNamespaceDefault) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AllNamespaceDefault) GetNamespaceDefaultInfo() []*NamespaceDefaultInfo {
+	if x != nil {
+		return x.NamespaceDefaultInfo
+	}
+	return nil
+}
+
+type ApplicationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationName        string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ApplicationNamespace   string `protobuf:"bytes,3,opt,name=application_namespace,json=applicationNamespace,proto3" json:"application_namespace,omitempty"`
+	ApplicationDescription string `protobuf:"bytes,4,opt,name=application_description,json=applicationDescription,proto3" json:"application_description,omitempty"`
+}
+
+func (x *ApplicationInfo) Reset() {
+	*x = ApplicationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplicationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationInfo) ProtoMessage() {}
+
+func (x *ApplicationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationInfo.ProtoReflect.Descriptor instead.
+func (*ApplicationInfo) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ApplicationInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApplicationInfo) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *ApplicationInfo) GetApplicationNamespace() string {
+	if x != nil {
+		return x.ApplicationNamespace
+	}
+	return ""
+}
+
+func (x *ApplicationInfo) GetApplicationDescription() string {
+	if x != nil {
+		return x.ApplicationDescription
+	}
+	return ""
+}
+
+type ApplicationId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ApplicationId) Reset() {
+	*x = ApplicationId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplicationId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationId) ProtoMessage() {}
+
+func (x *ApplicationId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationId.ProtoReflect.Descriptor instead.
+func (*ApplicationId) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ApplicationId) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AllApplication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationInfo []*ApplicationInfo `protobuf:"bytes,1,rep,name=application_info,json=applicationInfo,proto3" json:"application_info,omitempty"`
+}
+
+func (x *AllApplication) Reset() {
+	*x = AllApplication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllApplication) ProtoMessage() {}
+
+func (x *AllApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllApplication.ProtoReflect.Descriptor instead.
+func (*AllApplication) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AllApplication) GetApplicationInfo() []*ApplicationInfo {
+	if x != nil {
+		return x.ApplicationInfo
+	}
+	return nil
+}
+
+type InventoryFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileName    string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content     []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *InventoryFile) Reset() {
+	*x = InventoryFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InventoryFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryFile) ProtoMessage() {}
+
+func (x *InventoryFile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryFile.ProtoReflect.Descriptor instead.
+func (*InventoryFile) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *InventoryFile) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *InventoryFile) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *InventoryFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ExportManifestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	//kustomize、helm 或 terraform，默认 kustomize；terraform 导出为 kubernetes provider 的 kubernetes_manifest 资源，不打包
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	//kustomize 的 overlays，为空时使用配置的环境
+	Overlays []string `protobuf:"bytes,3,rep,name=overlays,proto3" json:"overlays,omitempty"`
+	//只导出指定名称的路由，为空时导出命名空间中的全部路由
+	RouteNames []string `protobuf:"bytes,4,rep,name=route_names,json=routeNames,proto3" json:"route_names,omitempty"`
+}
+
+func (x *ExportManifestsRequest) Reset() {
+	*x = ExportManifestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportManifestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportManifestsRequest) ProtoMessage() {}
+
+func (x *ExportManifestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportManifestsRequest.ProtoReflect.Descriptor instead.
+func (*ExportManifestsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExportManifestsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ExportManifestsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportManifestsRequest) GetOverlays() []string {
+	if x != nil {
+		return x.Overlays
+	}
+	return nil
+}
+
+func (x *ExportManifestsRequest) GetRouteNames() []string {
+	if x != nil {
+		return x.RouteNames
+	}
+	return nil
+}
+
+type EventInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RouteId      int64  `protobuf:"varint,2,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	EventType    string `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	EventMessage string `protobuf:"bytes,4,opt,name=event_message,json=eventMessage,proto3" json:"event_message,omitempty"`
+	//unix 秒
+	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *EventInfo) Reset() {
+	*x = EventInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventInfo) ProtoMessage() {}
+
+func (x *EventInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventInfo.ProtoReflect.Descriptor instead.
+func (*EventInfo) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EventInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EventInfo) GetRouteId() int64 {
+	if x != nil {
+		return x.RouteId
+	}
+	return 0
+}
+
+func (x *EventInfo) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *EventInfo) GetEventMessage() string {
+	if x != nil {
+		return x.EventMessage
+	}
+	return ""
+}
+
+func (x *EventInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//为 0 时查询全部路由
+	RouteId int64 `protobuf:"varint,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	//为空时查询全部类型
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	//unix 秒，为 0 时不限制
+	Since int64 `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListEventsRequest) GetRouteId() int64 {
+	if x != nil {
+		return x.RouteId
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type AllEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventInfo []*EventInfo `protobuf:"bytes,1,rep,name=event_info,json=eventInfo,proto3" json:"event_info,omitempty"`
+}
+
+func (x *AllEvent) Reset() {
+	*x = AllEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllEvent) ProtoMessage() {}
+
+func (x *AllEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllEvent.ProtoReflect.Descriptor instead.
+func (*AllEvent) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AllEvent) GetEventInfo() []*EventInfo {
+	if x != nil {
+		return x.EventInfo
+	}
+	return nil
+}
+
+type ClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//目前只管理一个集群，为空或 default
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (x *ClusterRequest) Reset() {
+	*x = ClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterRequest) ProtoMessage() {}
+
+func (x *ClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterRequest.ProtoReflect.Descriptor instead.
+func (*ClusterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ClusterRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+type ClusterCapabilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cluster       string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	ServerVersion string `protobuf:"bytes,2,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+	//集群支持的 Ingress API 版本，以及本服务实际使用的版本
+	IngressApiVersions []string `protobuf:"bytes,3,rep,name=ingress_api_versions,json=ingressApiVersions,proto3" json:"ingress_api_versions,omitempty"`
+	IngressApiVersion  string   `protobuf:"bytes,4,opt,name=ingress_api_version,json=ingressApiVersion,proto3" json:"ingress_api_version,omitempty"`
+	IngressClasses     []string `protobuf:"bytes,5,rep,name=ingress_classes,json=ingressClasses,proto3" json:"ingress_classes,omitempty"`
+	GatewayApi         bool     `protobuf:"varint,6,opt,name=gateway_api,json=gatewayApi,proto3" json:"gateway_api,omitempty"`
+	GatewayApiVersions []string `protobuf:"bytes,7,rep,name=gateway_api_versions,json=gatewayApiVersions,proto3" json:"gateway_api_versions,omitempty"`
+	CertManager        bool     `protobuf:"varint,8,opt,name=cert_manager,json=certManager,proto3" json:"cert_manager,omitempty"`
+	Istio              bool     `protobuf:"varint,9,opt,name=istio,proto3" json:"istio,omitempty"`
+}
+
+func (x *ClusterCapabilities) Reset() {
+	*x = ClusterCapabilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterCapabilities) ProtoMessage() {}
+
+func (x *ClusterCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterCapabilities.ProtoReflect.Descriptor instead.
+func (*ClusterCapabilities) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ClusterCapabilities) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ClusterCapabilities) GetServerVersion() string {
+	if x != nil {
+		return x.ServerVersion
+	}
+	return ""
+}
+
+func (x *ClusterCapabilities) GetIngressApiVersions() []string {
+	if x != nil {
+		return x.IngressApiVersions
+	}
+	return nil
+}
+
+func (x *ClusterCapabilities) GetIngressApiVersion() string {
+	if x != nil {
+		return x.IngressApiVersion
+	}
+	return ""
+}
+
+func (x *ClusterCapabilities) GetIngressClasses() []string {
+	if x != nil {
+		return x.IngressClasses
+	}
+	return nil
+}
+
+func (x *ClusterCapabilities) GetGatewayApi() bool {
+	if x != nil {
+		return x.GatewayApi
+	}
+	return false
+}
+
+func (x *ClusterCapabilities) GetGatewayApiVersions() []string {
+	if x != nil {
+		return x.GatewayApiVersions
+	}
+	return nil
+}
+
+func (x *ClusterCapabilities) GetCertManager() bool {
+	if x != nil {
+		return x.CertManager
+	}
+	return false
+}
+
+func (x *ClusterCapabilities) GetIstio() bool {
+	if x != nil {
+		return x.Istio
+	}
+	return false
+}
+
+type ControllerConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//控制器名称，对应配置中 controller_configmaps 的 key，默认支持 nginx
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+}
+
+func (x *ControllerConfigRequest) Reset() {
+	*x = ControllerConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControllerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControllerConfigRequest) ProtoMessage() {}
+
+func (x *ControllerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControllerConfigRequest.ProtoReflect.Descriptor instead.
+func (*ControllerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ControllerConfigRequest) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+type ControllerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	//ConfigMap 所在的命名空间和名称
+	Namespace string            `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Data      map[string]string `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//修改时传回，ConfigMap 已被修改时拒绝
+	ResourceVersion string `protobuf:"bytes,5,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	//最后修改人、修改原因，由服务端维护
+	ChangedBy    string `protobuf:"bytes,6,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangeReason string `protobuf:"bytes,7,opt,name=change_reason,json=changeReason,proto3" json:"change_reason,omitempty"`
+}
+
+func (x *ControllerConfig) Reset() {
+	*x = ControllerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControllerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControllerConfig) ProtoMessage() {}
+
+func (x *ControllerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControllerConfig.ProtoReflect.Descriptor instead.
+func (*ControllerConfig) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ControllerConfig) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *ControllerConfig) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ControllerConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ControllerConfig) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ControllerConfig) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+func (x *ControllerConfig) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *ControllerConfig) GetChangeReason() string {
+	if x != nil {
+		return x.ChangeReason
+	}
+	return ""
+}
+
+type ControllerConfigPatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	//添加或覆盖 / 删除
+	Set    map[string]string `protobuf:"bytes,2,rep,name=set,proto3" json:"set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Remove []string          `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+	//为空时不检查
+	ResourceVersion string `protobuf:"bytes,4,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	//修改原因，必填
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ControllerConfigPatch) Reset() {
+	*x = ControllerConfigPatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControllerConfigPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControllerConfigPatch) ProtoMessage() {}
+
+func (x *ControllerConfigPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControllerConfigPatch.ProtoReflect.Descriptor instead.
+func (*ControllerConfigPatch) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ControllerConfigPatch) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *ControllerConfigPatch) GetSet() map[string]string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *ControllerConfigPatch) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+func (x *ControllerConfigPatch) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+func (x *ControllerConfigPatch) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ControllerInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//Ingress class，为空时使用集群默认的 class
+	RouteClass string `protobuf:"bytes,1,opt,name=route_class,json=routeClass,proto3" json:"route_class,omitempty"`
+	//目前只管理一个集群，为空或 default
+	Cluster string `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (x *ControllerInfoRequest) Reset() {
+	*x = ControllerInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControllerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControllerInfoRequest) ProtoMessage() {}
+
+func (x *ControllerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControllerInfoRequest.ProtoReflect.Descriptor instead.
+func (*ControllerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ControllerInfoRequest) GetRouteClass() string {
+	if x != nil {
+		return x.RouteClass
+	}
+	return ""
+}
+
+func (x *ControllerInfoRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+type ControllerPodInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Node     string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Phase    string `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	Ready    bool   `protobuf:"varint,4,opt,name=ready,proto3" json:"ready,omitempty"`
+	Restarts int32  `protobuf:"varint,5,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	//最近一次重启的原因和时间（unix 秒），没有重启时为空
+	LastRestartReason string `protobuf:"bytes,6,opt,name=last_restart_reason,json=lastRestartReason,proto3" json:"last_restart_reason,omitempty"`
+	LastRestartTime   int64  `protobuf:"varint,7,opt,name=last_restart_time,json=lastRestartTime,proto3" json:"last_restart_time,omitempty"`
+	Image             string `protobuf:"bytes,8,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *ControllerPodInfo) Reset() {
+	*x = ControllerPodInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControllerPodInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControllerPodInfo) ProtoMessage() {}
+
+func (x *ControllerPodInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControllerPodInfo.ProtoReflect.Descriptor instead.
+func (*ControllerPodInfo) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ControllerPodInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ControllerPodInfo) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ControllerPodInfo) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *ControllerPodInfo) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *ControllerPodInfo) GetRestarts() int32 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *ControllerPodInfo) GetLastRestartReason() string {
+	if x != nil {
+		return x.LastRestartReason
+	}
+	return ""
+}
+
+func (x *ControllerPodInfo) GetLastRestartTime() int64 {
+	if x != nil {
+		return x.LastRestartTime
+	}
+	return 0
+}
+
+func (x *ControllerPodInfo) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type ControllerWorkloadInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//Deployment 或 DaemonSet
+	Kind      string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Images    []string `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
+	//镜像标签或 app.kubernetes.io/version 标签
+	Version           string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	DesiredReplicas   int32  `protobuf:"varint,6,opt,name=desired_replicas,json=desiredReplicas,proto3" json:"desired_replicas,omitempty"`
+	ReadyReplicas     int32  `protobuf:"varint,7,opt,name=ready_replicas,json=readyReplicas,proto3" json:"ready_replicas,omitempty"`
+	UpdatedReplicas   int32  `protobuf:"varint,8,opt,name=updated_replicas,json=updatedReplicas,proto3" json:"updated_replicas,omitempty"`
+	AvailableReplicas int32  `protobuf:"varint,9,opt,name=available_replicas,json=availableReplicas,proto3" json:"available_replicas,omitempty"`
+	//所有 Pod 的重启次数之和，以及最近一小时内重启过的 Pod 数
+	Restarts              int32                `protobuf:"varint,10,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	RecentlyRestartedPods int32                `protobuf:"varint,11,opt,name=recently_restarted_pods,json=recentlyRestartedPods,proto3" json:"recently_restarted_pods,omitempty"`
+	Healthy               bool                 `protobuf:"varint,12,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Pods                  []*ControllerPodInfo `protobuf:"bytes,13,rep,name=pods,proto3" json:"pods,omitempty"`
+}
+
+func (x *ControllerWorkloadInfo) Reset() {
+	*x = ControllerWorkloadInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControllerWorkloadInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControllerWorkloadInfo) ProtoMessage() {}
+
+func (x *ControllerWorkloadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControllerWorkloadInfo.ProtoReflect.Descriptor instead.
+func (*ControllerWorkloadInfo) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ControllerWorkloadInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ControllerWorkloadInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ControllerWorkloadInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ControllerWorkloadInfo) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *ControllerWorkloadInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ControllerWorkloadInfo) GetDesiredReplicas() int32 {
+	if x != nil {
+		return x.DesiredReplicas
+	}
+	return 0
+}
+
+func (x *ControllerWorkloadInfo) GetReadyReplicas() int32 {
+	if x != nil {
+		return x.ReadyReplicas
+	}
+	return 0
+}
+
+func (x *ControllerWorkloadInfo) GetUpdatedReplicas() int32 {
+	if x != nil {
+		return x.UpdatedReplicas
+	}
+	return 0
+}
+
+func (x *ControllerWorkloadInfo) GetAvailableReplicas() int32 {
+	if x != nil {
+		return x.AvailableReplicas
+	}
+	return 0
+}
+
+func (x *ControllerWorkloadInfo) GetRestarts() int32 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *ControllerWorkloadInfo) GetRecentlyRestartedPods() int32 {
+	if x != nil {
+		return x.RecentlyRestartedPods
+	}
+	return 0
+}
+
+func (x *ControllerWorkloadInfo) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *ControllerWorkloadInfo) GetPods() []*ControllerPodInfo {
+	if x != nil {
+		return x.Pods
+	}
+	return nil
+}
+
+type ControllerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteClass string `protobuf:"bytes,1,opt,name=route_class,json=routeClass,proto3" json:"route_class,omitempty"`
+	Cluster    string `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	//IngressClass 的 spec.controller，如 k8s.io/ingress-nginx
+	Controller string                    `protobuf:"bytes,3,opt,name=controller,proto3" json:"controller,omitempty"`
+	Workloads  []*ControllerWorkloadInfo `protobuf:"bytes,4,rep,name=workloads,proto3" json:"workloads,omitempty"`
+	//所有工作负载的副本都就绪时为 true
+	Healthy bool `protobuf:"varint,5,opt,name=healthy,proto3" json:"healthy,omitempty"`
+}
+
+func (x *ControllerInfo) Reset() {
+	*x = ControllerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControllerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControllerInfo) ProtoMessage() {}
+
+func (x *ControllerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControllerInfo.ProtoReflect.Descriptor instead.
+func (*ControllerInfo) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ControllerInfo) GetRouteClass() string {
+	if x != nil {
+		return x.RouteClass
+	}
+	return ""
+}
+
+func (x *ControllerInfo) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ControllerInfo) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *ControllerInfo) GetWorkloads() []*ControllerWorkloadInfo {
+	if x != nil {
+		return x.Workloads
+	}
+	return nil
+}
+
+func (x *ControllerInfo) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+type FreezeWindowInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FreezeName string `protobuf:"bytes,2,opt,name=freeze_name,json=freezeName,proto3" json:"freeze_name,omitempty"`
+	//支持通配符，为空时对所有命名空间生效
+	FreezeNamespaces []string `protobuf:"bytes,3,rep,name=freeze_namespaces,json=freezeNamespaces,proto3" json:"freeze_namespaces,omitempty"`
+	//每周重复，格式 Fri 18:00
+	FreezeStart string `protobuf:"bytes,4,opt,name=freeze_start,json=freezeStart,proto3" json:"freeze_start,omitempty"`
+	FreezeEnd   string `protobuf:"bytes,5,opt,name=freeze_end,json=freezeEnd,proto3" json:"freeze_end,omitempty"`
+	//时区，如 Asia/Shanghai，为空时使用服务所在时区
+	FreezeTimezone string `protobuf:"bytes,6,opt,name=freeze_timezone,json=freezeTimezone,proto3" json:"freeze_timezone,omitempty"`
+	FreezeReason   string `protobuf:"bytes,7,opt,name=freeze_reason,json=freezeReason,proto3" json:"freeze_reason,omitempty"`
+}
+
+func (x *FreezeWindowInfo) Reset() {
+	*x = FreezeWindowInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreezeWindowInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeWindowInfo) ProtoMessage() {}
+
+func (x *FreezeWindowInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeWindowInfo.ProtoReflect.Descriptor instead.
+func (*FreezeWindowInfo) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FreezeWindowInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FreezeWindowInfo) GetFreezeName() string {
+	if x != nil {
+		return x.FreezeName
+	}
+	return ""
+}
+
+func (x *FreezeWindowInfo) GetFreezeNamespaces() []string {
+	if x != nil {
+		return x.FreezeNamespaces
+	}
+	return nil
+}
+
+func (x *FreezeWindowInfo) GetFreezeStart() string {
+	if x != nil {
+		return x.FreezeStart
+	}
+	return ""
+}
+
+func (x *FreezeWindowInfo) GetFreezeEnd() string {
+	if x != nil {
+		return x.FreezeEnd
+	}
+	return ""
+}
+
+func (x *FreezeWindowInfo) GetFreezeTimezone() string {
+	if x != nil {
+		return x.FreezeTimezone
+	}
+	return ""
+}
+
+func (x *FreezeWindowInfo) GetFreezeReason() string {
+	if x != nil {
+		return x.FreezeReason
+	}
+	return ""
+}
+
+type FreezeWindowId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FreezeWindowId) Reset() {
+	*x = FreezeWindowId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreezeWindowId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeWindowId) ProtoMessage() {}
+
+func (x *FreezeWindowId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeWindowId.ProtoReflect.Descriptor instead.
+func (*FreezeWindowId) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FreezeWindowId) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AllFreezeWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreezeWindowInfo []*FreezeWindowInfo `protobuf:"bytes,1,rep,name=freeze_window_info,json=freezeWindowInfo,proto3" json:"freeze_window_info,omitempty"`
+}
+
+func (x *AllFreezeWindow) Reset() {
+	*x = AllFreezeWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllFreezeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllFreezeWindow) ProtoMessage() {}
+
+func (x *AllFreezeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllFreezeWindow.ProtoReflect.Descriptor instead.
+func (*AllFreezeWindow) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AllFreezeWindow) GetFreezeWindowInfo() []*FreezeWindowInfo {
+	if x != nil {
+		return x.FreezeWindowInfo
+	}
+	return nil
+}
+
+type ReservedHostInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//域名，如 www.company.com，*.internal 匹配所有以 .internal 结尾的域名
+	ReservedHost string `protobuf:"bytes,2,opt,name=reserved_host,json=reservedHost,proto3" json:"reserved_host,omitempty"`
+	//可以直接使用的命名空间，支持通配符
+	ReservedNamespaces []string `protobuf:"bytes,3,rep,name=reserved_namespaces,json=reservedNamespaces,proto3" json:"reserved_namespaces,omitempty"`
+	ReservedReason     string   `protobuf:"bytes,4,opt,name=reserved_reason,json=reservedReason,proto3" json:"reserved_reason,omitempty"`
+	ReservedCreatedBy  string   `protobuf:"bytes,5,opt,name=reserved_created_by,json=reservedCreatedBy,proto3" json:"reserved_created_by,omitempty"`
+}
+
+func (x *ReservedHostInfo) Reset() {
+	*x = ReservedHostInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservedHostInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservedHostInfo) ProtoMessage() {}
+
+func (x *ReservedHostInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservedHostInfo.ProtoReflect.Descriptor instead.
+func (*ReservedHostInfo) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReservedHostInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReservedHostInfo) GetReservedHost() string {
+	if x != nil {
+		return x.ReservedHost
+	}
+	return ""
+}
+
+func (x *ReservedHostInfo) GetReservedNamespaces() []string {
+	if x != nil {
+		return x.ReservedNamespaces
+	}
+	return nil
+}
+
+func (x *ReservedHostInfo) GetReservedReason() string {
+	if x != nil {
+		return x.ReservedReason
+	}
+	return ""
+}
+
+func (x *ReservedHostInfo) GetReservedCreatedBy() string {
+	if x != nil {
+		return x.ReservedCreatedBy
+	}
+	return ""
+}
+
+type ReservedHostId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReservedHostId) Reset() {
+	*x = ReservedHostId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservedHostId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservedHostId) ProtoMessage() {}
+
+func (x *ReservedHostId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservedHostId.ProtoReflect.Descriptor instead.
+func (*ReservedHostId) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReservedHostId) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AllReservedHost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReservedHostInfo []*ReservedHostInfo `protobuf:"bytes,1,rep,name=reserved_host_info,json=reservedHostInfo,proto3" json:"reserved_host_info,omitempty"`
+}
+
+func (x *AllReservedHost) Reset() {
+	*x = AllReservedHost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllReservedHost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllReservedHost) ProtoMessage() {}
+
+func (x *AllReservedHost) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllReservedHost.ProtoReflect.Descriptor instead.
+func (*AllReservedHost) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AllReservedHost) GetReservedHostInfo() []*ReservedHostInfo {
+	if x != nil {
+		return x.ReservedHostInfo
+	}
+	return nil
+}
+
+type AdoptIngressesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteNamespace string `protobuf:"bytes,1,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+	//标签选择器，为空时接管命名空间下所有未被管理的 Ingress
+	LabelSelector string `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+}
+
+func (x *AdoptIngressesRequest) Reset() {
+	*x = AdoptIngressesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdoptIngressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptIngressesRequest) ProtoMessage() {}
+
+func (x *AdoptIngressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptIngressesRequest.ProtoReflect.Descriptor instead.
+func (*AdoptIngressesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AdoptIngressesRequest) GetRouteNamespace() string {
+	if x != nil {
+		return x.RouteNamespace
+	}
+	return ""
+}
+
+func (x *AdoptIngressesRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+type AdoptedIngress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RouteNamespace string `protobuf:"bytes,2,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+	RouteName      string `protobuf:"bytes,3,opt,name=route_name,json=routeName,proto3" json:"route_name,omitempty"`
+	//跳过的原因，接管成功时为空
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdoptedIngress) Reset() {
+	*x = AdoptedIngress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdoptedIngress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptedIngress) ProtoMessage() {}
+
+func (x *AdoptedIngress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptedIngress.ProtoReflect.Descriptor instead.
+func (*AdoptedIngress) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AdoptedIngress) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdoptedIngress) GetRouteNamespace() string {
+	if x != nil {
+		return x.RouteNamespace
+	}
+	return ""
+}
+
+func (x *AdoptedIngress) GetRouteName() string {
+	if x != nil {
+		return x.RouteName
+	}
+	return ""
+}
+
+func (x *AdoptedIngress) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdoptIngressesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Adopted []*AdoptedIngress `protobuf:"bytes,1,rep,name=adopted,proto3" json:"adopted,omitempty"`
+	Skipped []*AdoptedIngress `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *AdoptIngressesResponse) Reset() {
+	*x = AdoptIngressesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdoptIngressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptIngressesResponse) ProtoMessage() {}
+
+func (x *AdoptIngressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptIngressesResponse.ProtoReflect.Descriptor instead.
+func (*AdoptIngressesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AdoptIngressesResponse) GetAdopted() []*AdoptedIngress {
+	if x != nil {
+		return x.Adopted
+	}
+	return nil
+}
+
+func (x *AdoptIngressesResponse) GetSkipped() []*AdoptedIngress {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type DiagnoseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DiagnoseRequest) Reset() {
+	*x = DiagnoseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnoseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseRequest) ProtoMessage() {}
+
+func (x *DiagnoseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseRequest.ProtoReflect.Descriptor instead.
+func (*DiagnoseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{41}
+}
+
+type DiagnoseReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//所有检查项都通过
+	Ok       bool                `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Database *DatabaseDiagnosis  `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Registry *RegistryDiagnosis  `protobuf:"bytes,3,opt,name=registry,proto3" json:"registry,omitempty"`
+	Clusters []*ClusterDiagnosis `protobuf:"bytes,4,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	//生效的配置，key 为配置节点，value 为 JSON
+	Config map[string]string `protobuf:"bytes,5,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//生成时间（unix 秒）
+	GeneratedAt int64 `protobuf:"varint,6,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+}
+
+func (x *DiagnoseReport) Reset() {
+	*x = DiagnoseReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnoseReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseReport) ProtoMessage() {}
+
+func (x *DiagnoseReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseReport.ProtoReflect.Descriptor instead.
+func (*DiagnoseReport) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DiagnoseReport) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *DiagnoseReport) GetDatabase() *DatabaseDiagnosis {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *DiagnoseReport) GetRegistry() *RegistryDiagnosis {
+	if x != nil {
+		return x.Registry
+	}
+	return nil
+}
+
+func (x *DiagnoseReport) GetClusters() []*ClusterDiagnosis {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *DiagnoseReport) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *DiagnoseReport) GetGeneratedAt() int64 {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return 0
+}
+
+type DatabaseDiagnosis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reachable     bool   `protobuf:"varint,1,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	ServerVersion string `protobuf:"bytes,2,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+	//缺少的表和字段，为空表示已迁移到最新
+	PendingMigrations []string `protobuf:"bytes,3,rep,name=pending_migrations,json=pendingMigrations,proto3" json:"pending_migrations,omitempty"`
+	Error             string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *DatabaseDiagnosis) Reset() {
+	*x = DatabaseDiagnosis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseDiagnosis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseDiagnosis) ProtoMessage() {}
+
+func (x *DatabaseDiagnosis) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseDiagnosis.ProtoReflect.Descriptor instead.
+func (*DatabaseDiagnosis) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DatabaseDiagnosis) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *DatabaseDiagnosis) GetServerVersion() string {
+	if x != nil {
+		return x.ServerVersion
+	}
+	return ""
+}
+
+func (x *DatabaseDiagnosis) GetPendingMigrations() []string {
+	if x != nil {
+		return x.PendingMigrations
+	}
+	return nil
+}
+
+func (x *DatabaseDiagnosis) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RegistryDiagnosis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Registry string `protobuf:"bytes,1,opt,name=registry,proto3" json:"registry,omitempty"`
+	Service  string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	//本实例的节点 ID
+	Node       string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Registered bool   `protobuf:"varint,4,opt,name=registered,proto3" json:"registered,omitempty"`
+	Error      string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RegistryDiagnosis) Reset() {
+	*x = RegistryDiagnosis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistryDiagnosis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistryDiagnosis) ProtoMessage() {}
+
+func (x *RegistryDiagnosis) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistryDiagnosis.ProtoReflect.Descriptor instead.
+func (*RegistryDiagnosis) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RegistryDiagnosis) GetRegistry() string {
+	if x != nil {
+		return x.Registry
+	}
+	return ""
+}
+
+func (x *RegistryDiagnosis) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *RegistryDiagnosis) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *RegistryDiagnosis) GetRegistered() bool {
+	if x != nil {
+		return x.Registered
+	}
+	return false
+}
+
+func (x *RegistryDiagnosis) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ClusterDiagnosis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cluster           string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Reachable         bool   `protobuf:"varint,2,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	ServerVersion     string `protobuf:"bytes,3,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+	IngressApiVersion string `protobuf:"bytes,4,opt,name=ingress_api_version,json=ingressApiVersion,proto3" json:"ingress_api_version,omitempty"`
+	//SelfSubjectAccessReview 检查结果
+	Permissions []*AccessCheck `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Error       string         `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ClusterDiagnosis) Reset() {
+	*x = ClusterDiagnosis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterDiagnosis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterDiagnosis) ProtoMessage() {}
+
+func (x *ClusterDiagnosis) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterDiagnosis.ProtoReflect.Descriptor instead.
+func (*ClusterDiagnosis) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ClusterDiagnosis) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ClusterDiagnosis) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *ClusterDiagnosis) GetServerVersion() string {
+	if x != nil {
+		return x.ServerVersion
+	}
+	return ""
+}
+
+func (x *ClusterDiagnosis) GetIngressApiVersion() string {
+	if x != nil {
+		return x.IngressApiVersion
+	}
+	return ""
+}
+
+func (x *ClusterDiagnosis) GetPermissions() []*AccessCheck {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *ClusterDiagnosis) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AccessCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//为空表示所有命名空间
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Group     string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Resource  string `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Verb      string `protobuf:"bytes,4,opt,name=verb,proto3" json:"verb,omitempty"`
+	Allowed   bool   `protobuf:"varint,5,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AccessCheck) Reset() {
+	*x = AccessCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessCheck) ProtoMessage() {}
+
+func (x *AccessCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessCheck.ProtoReflect.Descriptor instead.
+func (*AccessCheck) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AccessCheck) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AccessCheck) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *AccessCheck) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AccessCheck) GetVerb() string {
+	if x != nil {
+		return x.Verb
+	}
+	return ""
+}
+
+func (x *AccessCheck) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *AccessCheck) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type LintWarning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//规则名，如 missing-tls、broad-path、deprecated-annotation、non-lowercase-name
+	Rule string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	//warning 或 info
+	Severity string `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
+	//相关字段，如 route_host、route_path[0].route_path_name
+	Field   string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *LintWarning) Reset() {
+	*x = LintWarning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintWarning) ProtoMessage() {}
+
+func (x *LintWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintWarning.ProtoReflect.Descriptor instead.
+func (*LintWarning) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *LintWarning) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *LintWarning) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *LintWarning) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *LintWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type LintResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Warnings []*LintWarning `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *LintResult) Reset() {
+	*x = LintResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintResult) ProtoMessage() {}
+
+func (x *LintResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintResult.ProtoReflect.Descriptor instead.
+func (*LintResult) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *LintResult) GetWarnings() []*LintWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type LegacyConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//nginx 或 haproxy
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	//配置文件内容，include 的文件需要拼接后传入
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	//草稿所在的命名空间
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *LegacyConfigRequest) Reset() {
+	*x = LegacyConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegacyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyConfigRequest) ProtoMessage() {}
+
+func (x *LegacyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegacyConfigRequest.ProtoReflect.Descriptor instead.
+func (*LegacyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *LegacyConfigRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *LegacyConfigRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *LegacyConfigRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ConvertManifestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//Ingress YAML，可以包含多个文档
+	Manifest string `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	//YAML 中没有指定命名空间时使用
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *ConvertManifestRequest) Reset() {
+	*x = ConvertManifestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertManifestRequest) ProtoMessage() {}
+
+func (x *ConvertManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertManifestRequest.ProtoReflect.Descriptor instead.
+func (*ConvertManifestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ConvertManifestRequest) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *ConvertManifestRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// 转换得到的路由草稿
+type LegacyRouteDraft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Route *RouteInfo `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	//来源位置，如 server api.example.com（第 12 行）、Ingress shop/web
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	//无法转换的配置和规格检查的警告
+	Warnings []*LintWarning `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *LegacyRouteDraft) Reset() {
+	*x = LegacyRouteDraft{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegacyRouteDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyRouteDraft) ProtoMessage() {}
+
+func (x *LegacyRouteDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegacyRouteDraft.ProtoReflect.Descriptor instead.
+func (*LegacyRouteDraft) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *LegacyRouteDraft) GetRoute() *RouteInfo {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *LegacyRouteDraft) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *LegacyRouteDraft) GetWarnings() []*LintWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type LegacyImportResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Drafts []*LegacyRouteDraft `protobuf:"bytes,1,rep,name=drafts,proto3" json:"drafts,omitempty"`
+	//没有对应到任何草稿的警告，如未使用的 upstream
+	Warnings []*LintWarning `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *LegacyImportResult) Reset() {
+	*x = LegacyImportResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegacyImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyImportResult) ProtoMessage() {}
+
+func (x *LegacyImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegacyImportResult.ProtoReflect.Descriptor instead.
+func (*LegacyImportResult) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *LegacyImportResult) GetDrafts() []*LegacyRouteDraft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+func (x *LegacyImportResult) GetWarnings() []*LintWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type FieldDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//字段路径，如 spec.rules[0].host、metadata.annotations.foo
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	//changed 两边不同、missing 线上缺少、extra 只存在于线上
+	Op string `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	//JSON 格式的值
+	Desired string `protobuf:"bytes,3,opt,name=desired,proto3" json:"desired,omitempty"`
+	Live    string `protobuf:"bytes,4,opt,name=live,proto3" json:"live,omitempty"`
+	//线上字段的 field manager，无法确定时为空
+	Manager string `protobuf:"bytes,5,opt,name=manager,proto3" json:"manager,omitempty"`
+}
+
+func (x *FieldDiff) Reset() {
+	*x = FieldDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldDiff) ProtoMessage() {}
+
+func (x *FieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldDiff.ProtoReflect.Descriptor instead.
+func (*FieldDiff) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *FieldDiff) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetDesired() string {
+	if x != nil {
+		return x.Desired
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetLive() string {
+	if x != nil {
+		return x.Live
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetManager() string {
+	if x != nil {
+		return x.Manager
+	}
+	return ""
+}
+
+type RouteDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//Ingress 或 HTTPRoute
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	//线上资源不存在
+	LiveMissing bool `protobuf:"varint,3,opt,name=live_missing,json=liveMissing,proto3" json:"live_missing,omitempty"`
+	//没有差异
+	InSync  bool         `protobuf:"varint,4,opt,name=in_sync,json=inSync,proto3" json:"in_sync,omitempty"`
+	Changes []*FieldDiff `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *RouteDiff) Reset() {
+	*x = RouteDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteDiff) ProtoMessage() {}
+
+func (x *RouteDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteDiff.ProtoReflect.Descriptor instead.
+func (*RouteDiff) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RouteDiff) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RouteDiff) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RouteDiff) GetLiveMissing() bool {
+	if x != nil {
+		return x.LiveMissing
+	}
+	return false
+}
+
+func (x *RouteDiff) GetInSync() bool {
+	if x != nil {
+		return x.InSync
+	}
+	return false
+}
+
+func (x *RouteDiff) GetChanges() []*FieldDiff {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ReapplyFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//命名空间，支持通配符，为空时不限制
+	RouteNamespace     string `protobuf:"bytes,1,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+	RouteApplicationId int64  `protobuf:"varint,2,opt,name=route_application_id,json=routeApplicationId,proto3" json:"route_application_id,omitempty"`
+	RouteClass         string `protobuf:"bytes,3,opt,name=route_class,json=routeClass,proto3" json:"route_class,omitempty"`
+	RouteAdapter       string `protobuf:"bytes,4,opt,name=route_adapter,json=routeAdapter,proto3" json:"route_adapter,omitempty"`
+	//并发数，默认 4，最大 16
+	Concurrency int32 `protobuf:"varint,5,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+}
+
+func (x *ReapplyFilter) Reset() {
+	*x = ReapplyFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReapplyFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReapplyFilter) ProtoMessage() {}
+
+func (x *ReapplyFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReapplyFilter.ProtoReflect.Descriptor instead.
+func (*ReapplyFilter) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReapplyFilter) GetRouteNamespace() string {
+	if x != nil {
+		return x.RouteNamespace
+	}
+	return ""
+}
+
+func (x *ReapplyFilter) GetRouteApplicationId() int64 {
+	if x != nil {
+		return x.RouteApplicationId
+	}
+	return 0
+}
+
+func (x *ReapplyFilter) GetRouteClass() string {
+	if x != nil {
+		return x.RouteClass
+	}
+	return ""
+}
+
+func (x *ReapplyFilter) GetRouteAdapter() string {
+	if x != nil {
+		return x.RouteAdapter
+	}
+	return ""
+}
+
+func (x *ReapplyFilter) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+type FailedRoutesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//为空时查询有权限的全部命名空间
+	RouteNamespace string `protobuf:"bytes,1,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+	//同时返回等待自动重试的路由，默认只返回死信列表
+	IncludeRetrying bool `protobuf:"varint,2,opt,name=include_retrying,json=includeRetrying,proto3" json:"include_retrying,omitempty"`
+}
+
+func (x *FailedRoutesRequest) Reset() {
+	*x = FailedRoutesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailedRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailedRoutesRequest) ProtoMessage() {}
+
+func (x *FailedRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailedRoutesRequest.ProtoReflect.Descriptor instead.
+func (*FailedRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FailedRoutesRequest) GetRouteNamespace() string {
+	if x != nil {
+		return x.RouteNamespace
+	}
+	return ""
+}
+
+func (x *FailedRoutesRequest) GetIncludeRetrying() bool {
+	if x != nil {
+		return x.IncludeRetrying
+	}
+	return false
+}
+
+type ReapplyFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RouteNamespace string `protobuf:"bytes,2,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+	RouteName      string `protobuf:"bytes,3,opt,name=route_name,json=routeName,proto3" json:"route_name,omitempty"`
+	Error          string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ReapplyFailure) Reset() {
+	*x = ReapplyFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReapplyFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReapplyFailure) ProtoMessage() {}
+
+func (x *ReapplyFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReapplyFailure.ProtoReflect.Descriptor instead.
+func (*ReapplyFailure) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReapplyFailure) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReapplyFailure) GetRouteNamespace() string {
+	if x != nil {
+		return x.RouteNamespace
+	}
+	return ""
+}
+
+func (x *ReapplyFailure) GetRouteName() string {
+	if x != nil {
+		return x.RouteName
+	}
+	return ""
+}
+
+func (x *ReapplyFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReapplyJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	//running、succeeded、failed
+	State     string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Total     int64  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Processed int64  `protobuf:"varint,4,opt,name=processed,proto3" json:"processed,omitempty"`
+	Succeeded int64  `protobuf:"varint,5,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    int64  `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	//已禁用的路由跳过
+	Skipped  int64             `protobuf:"varint,7,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failures []*ReapplyFailure `protobuf:"bytes,8,rep,name=failures,proto3" json:"failures,omitempty"`
+	//unix 秒
+	StartedAt  int64  `protobuf:"varint,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt int64  `protobuf:"varint,10,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	CreatedBy  string `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+}
+
+func (x *ReapplyJob) Reset() {
+	*x = ReapplyJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReapplyJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReapplyJob) ProtoMessage() {}
+
+func (x *ReapplyJob) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReapplyJob.ProtoReflect.Descriptor instead.
+func (*ReapplyJob) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReapplyJob) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ReapplyJob) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ReapplyJob) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReapplyJob) GetProcessed() int64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *ReapplyJob) GetSucceeded() int64 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *ReapplyJob) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ReapplyJob) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ReapplyJob) GetFailures() []*ReapplyFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *ReapplyJob) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *ReapplyJob) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *ReapplyJob) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type ReapplyJobId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *ReapplyJobId) Reset() {
+	*x = ReapplyJobId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReapplyJobId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReapplyJobId) ProtoMessage() {}
+
+func (x *ReapplyJobId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReapplyJobId.ProtoReflect.Descriptor instead.
+func (*ReapplyJobId) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ReapplyJobId) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type ReencryptResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Routes   int64 `protobuf:"varint,1,opt,name=routes,proto3" json:"routes,omitempty"`
+	Archives int64 `protobuf:"varint,2,opt,name=archives,proto3" json:"archives,omitempty"`
+}
+
+func (x *ReencryptResult) Reset() {
+	*x = ReencryptResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReencryptResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReencryptResult) ProtoMessage() {}
+
+func (x *ReencryptResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReencryptResult.ProtoReflect.Descriptor instead.
+func (*ReencryptResult) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReencryptResult) GetRoutes() int64 {
+	if x != nil {
+		return x.Routes
+	}
+	return 0
+}
+
+func (x *ReencryptResult) GetArchives() int64 {
+	if x != nil {
+		return x.Archives
+	}
+	return 0
+}
+
+type AnnotationTemplateInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TemplateName string `protobuf:"bytes,2,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+	//支持通配符，为空时对所有命名空间生效
+	TemplateNamespaces []string `protobuf:"bytes,3,rep,name=template_namespaces,json=templateNamespaces,proto3" json:"template_namespaces,omitempty"`
+	//为空时不限制 Ingress class
+	TemplateClass string `protobuf:"bytes,4,opt,name=template_class,json=templateClass,proto3" json:"template_class,omitempty"`
+	//值支持 {{.RouteName}}、{{.Namespace}}、{{.Env}}、{{.Host}}、{{.Class}}、{{.ApplicationID}}、{{.OwnerTeam}}
+	TemplateAnnotations map[string]string `protobuf:"bytes,5,rep,name=template_annotations,json=templateAnnotations,proto3" json:"template_annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//多个模板设置同一注解时优先级大的生效，路由自身的注解优先于所有模板
+	TemplatePriority int64 `protobuf:"varint,6,opt,name=template_priority,json=templatePriority,proto3" json:"template_priority,omitempty"`
+}
+
+func (x *AnnotationTemplateInfo) Reset() {
+	*x = AnnotationTemplateInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnotationTemplateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotationTemplateInfo) ProtoMessage() {}
+
+func (x *AnnotationTemplateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotationTemplateInfo.ProtoReflect.Descriptor instead.
+func (*AnnotationTemplateInfo) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AnnotationTemplateInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AnnotationTemplateInfo) GetTemplateName() string {
+	if x != nil {
+		return x.TemplateName
+	}
+	return ""
+}
+
+func (x *AnnotationTemplateInfo) GetTemplateNamespaces() []string {
+	if x != nil {
+		return x.TemplateNamespaces
+	}
+	return nil
+}
+
+func (x *AnnotationTemplateInfo) GetTemplateClass() string {
+	if x != nil {
+		return x.TemplateClass
+	}
+	return ""
+}
+
+func (x *AnnotationTemplateInfo) GetTemplateAnnotations() map[string]string {
+	if x != nil {
+		return x.TemplateAnnotations
+	}
+	return nil
+}
+
+func (x *AnnotationTemplateInfo) GetTemplatePriority() int64 {
+	if x != nil {
+		return x.TemplatePriority
+	}
+	return 0
+}
+
+type AnnotationTemplateId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AnnotationTemplateId) Reset() {
+	*x = AnnotationTemplateId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnotationTemplateId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotationTemplateId) ProtoMessage() {}
+
+func (x *AnnotationTemplateId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotationTemplateId.ProtoReflect.Descriptor instead.
+func (*AnnotationTemplateId) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AnnotationTemplateId) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AllAnnotationTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnnotationTemplateInfo []*AnnotationTemplateInfo `protobuf:"bytes,1,rep,name=annotation_template_info,json=annotationTemplateInfo,proto3" json:"annotation_template_info,omitempty"`
+}
+
+func (x *AllAnnotationTemplate) Reset() {
+	*x = AllAnnotationTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllAnnotationTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllAnnotationTemplate) ProtoMessage() {}
+
+func (x *AllAnnotationTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllAnnotationTemplate.ProtoReflect.Descriptor instead.
+func (*AllAnnotationTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AllAnnotationTemplate) GetAnnotationTemplateInfo() []*AnnotationTemplateInfo {
+	if x != nil {
+		return x.AnnotationTemplateInfo
+	}
+	return nil
+}
+
+type PromoteRouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetEnv string `protobuf:"bytes,2,opt,name=target_env,json=targetEnv,proto3" json:"target_env,omitempty"`
+}
+
+func (x *PromoteRouteRequest) Reset() {
+	*x = PromoteRouteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteRouteRequest) ProtoMessage() {}
+
+func (x *PromoteRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteRouteRequest.ProtoReflect.Descriptor instead.
+func (*PromoteRouteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *PromoteRouteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PromoteRouteRequest) GetTargetEnv() string {
+	if x != nil {
+		return x.TargetEnv
+	}
+	return ""
+}
+
+type PromoteRouteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//目标环境中的路由ID
+	Id             int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceEnv      string `protobuf:"bytes,2,opt,name=source_env,json=sourceEnv,proto3" json:"source_env,omitempty"`
+	TargetEnv      string `protobuf:"bytes,3,opt,name=target_env,json=targetEnv,proto3" json:"target_env,omitempty"`
+	RouteNamespace string `protobuf:"bytes,4,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+	RouteName      string `protobuf:"bytes,5,opt,name=route_name,json=routeName,proto3" json:"route_name,omitempty"`
+	RouteHost      string `protobuf:"bytes,6,opt,name=route_host,json=routeHost,proto3" json:"route_host,omitempty"`
+	//目标环境中原来没有该路由
+	Created bool `protobuf:"varint,7,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *PromoteRouteResponse) Reset() {
+	*x = PromoteRouteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteRouteResponse) ProtoMessage() {}
+
+func (x *PromoteRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteRouteResponse.ProtoReflect.Descriptor instead.
+func (*PromoteRouteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PromoteRouteResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PromoteRouteResponse) GetSourceEnv() string {
+	if x != nil {
+		return x.SourceEnv
+	}
+	return ""
+}
+
+func (x *PromoteRouteResponse) GetTargetEnv() string {
+	if x != nil {
+		return x.TargetEnv
+	}
+	return ""
+}
+
+func (x *PromoteRouteResponse) GetRouteNamespace() string {
+	if x != nil {
+		return x.RouteNamespace
+	}
+	return ""
+}
+
+func (x *PromoteRouteResponse) GetRouteName() string {
+	if x != nil {
+		return x.RouteName
+	}
+	return ""
+}
+
+func (x *PromoteRouteResponse) GetRouteHost() string {
+	if x != nil {
+		return x.RouteHost
+	}
+	return ""
+}
+
+func (x *PromoteRouteResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type CompareEnvironmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvA string `protobuf:"bytes,1,opt,name=env_a,json=envA,proto3" json:"env_a,omitempty"`
+	EnvB string `protobuf:"bytes,2,opt,name=env_b,json=envB,proto3" json:"env_b,omitempty"`
+	//按应用或路由名称过滤，都为空时对比全部路由
+	RouteApplicationId int64  `protobuf:"varint,3,opt,name=route_application_id,json=routeApplicationId,proto3" json:"route_application_id,omitempty"`
+	RouteName          string `protobuf:"bytes,4,opt,name=route_name,json=routeName,proto3" json:"route_name,omitempty"`
+}
+
+func (x *CompareEnvironmentsRequest) Reset() {
+	*x = CompareEnvironmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareEnvironmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareEnvironmentsRequest) ProtoMessage() {}
+
+func (x *CompareEnvironmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareEnvironmentsRequest.ProtoReflect.Descriptor instead.
+func (*CompareEnvironmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CompareEnvironmentsRequest) GetEnvA() string {
+	if x != nil {
+		return x.EnvA
+	}
+	return ""
+}
+
+func (x *CompareEnvironmentsRequest) GetEnvB() string {
+	if x != nil {
+		return x.EnvB
+	}
+	return ""
+}
+
+func (x *CompareEnvironmentsRequest) GetRouteApplicationId() int64 {
+	if x != nil {
+		return x.RouteApplicationId
+	}
+	return 0
+}
+
+func (x *CompareEnvironmentsRequest) GetRouteName() string {
+	if x != nil {
+		return x.RouteName
+	}
+	return ""
+}
+
+type EnvironmentDifference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//missing_in_a、missing_in_b、host_mismatch、backend_drift、path_missing_in_a、path_missing_in_b
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	//去掉环境后缀的 命名空间/路由名称
+	Key        string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	IdA        int64  `protobuf:"varint,3,opt,name=id_a,json=idA,proto3" json:"id_a,omitempty"`
+	NamespaceA string `protobuf:"bytes,4,opt,name=namespace_a,json=namespaceA,proto3" json:"namespace_a,omitempty"`
+	IdB        int64  `protobuf:"varint,5,opt,name=id_b,json=idB,proto3" json:"id_b,omitempty"`
+	NamespaceB string `protobuf:"bytes,6,opt,name=namespace_b,json=namespaceB,proto3" json:"namespace_b,omitempty"`
+	RoutePath  string `protobuf:"bytes,7,opt,name=route_path,json=routePath,proto3" json:"route_path,omitempty"`
+	ValueA     string `protobuf:"bytes,8,opt,name=value_a,json=valueA,proto3" json:"value_a,omitempty"`
+	ValueB     string `protobuf:"bytes,9,opt,name=value_b,json=valueB,proto3" json:"value_b,omitempty"`
+}
+
+func (x *EnvironmentDifference) Reset() {
+	*x = EnvironmentDifference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvironmentDifference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvironmentDifference) ProtoMessage() {}
+
+func (x *EnvironmentDifference) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvironmentDifference.ProtoReflect.Descriptor instead.
+func (*EnvironmentDifference) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *EnvironmentDifference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *EnvironmentDifference) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EnvironmentDifference) GetIdA() int64 {
+	if x != nil {
+		return x.IdA
+	}
+	return 0
+}
+
+func (x *EnvironmentDifference) GetNamespaceA() string {
+	if x != nil {
+		return x.NamespaceA
+	}
+	return ""
+}
+
+func (x *EnvironmentDifference) GetIdB() int64 {
+	if x != nil {
+		return x.IdB
+	}
+	return 0
+}
+
+func (x *EnvironmentDifference) GetNamespaceB() string {
+	if x != nil {
+		return x.NamespaceB
+	}
+	return ""
+}
+
+func (x *EnvironmentDifference) GetRoutePath() string {
+	if x != nil {
+		return x.RoutePath
+	}
+	return ""
+}
+
+func (x *EnvironmentDifference) GetValueA() string {
+	if x != nil {
+		return x.ValueA
+	}
+	return ""
+}
+
+func (x *EnvironmentDifference) GetValueB() string {
+	if x != nil {
+		return x.ValueB
+	}
+	return ""
+}
+
+type EnvironmentComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvA        string                   `protobuf:"bytes,1,opt,name=env_a,json=envA,proto3" json:"env_a,omitempty"`
+	EnvB        string                   `protobuf:"bytes,2,opt,name=env_b,json=envB,proto3" json:"env_b,omitempty"`
+	InSync      bool                     `protobuf:"varint,3,opt,name=in_sync,json=inSync,proto3" json:"in_sync,omitempty"`
+	Differences []*EnvironmentDifference `protobuf:"bytes,4,rep,name=differences,proto3" json:"differences,omitempty"`
+}
+
+func (x *EnvironmentComparison) Reset() {
+	*x = EnvironmentComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvironmentComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvironmentComparison) ProtoMessage() {}
+
+func (x *EnvironmentComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvironmentComparison.ProtoReflect.Descriptor instead.
+func (*EnvironmentComparison) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *EnvironmentComparison) GetEnvA() string {
+	if x != nil {
+		return x.EnvA
+	}
+	return ""
+}
+
+func (x *EnvironmentComparison) GetEnvB() string {
+	if x != nil {
+		return x.EnvB
+	}
+	return ""
+}
+
+func (x *EnvironmentComparison) GetInSync() bool {
+	if x != nil {
+		return x.InSync
+	}
+	return false
+}
+
+func (x *EnvironmentComparison) GetDifferences() []*EnvironmentDifference {
+	if x != nil {
+		return x.Differences
+	}
+	return nil
+}
+
+type RoleBindingInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//调用方身份，为 API 密钥对应的身份、网关签名的登录用户或客户端证书对应的 cert:<URI SAN 或 CN>
+	BindingSubject string `protobuf:"bytes,2,opt,name=binding_subject,json=bindingSubject,proto3" json:"binding_subject,omitempty"`
+	BindingRole    string `protobuf:"bytes,3,opt,name=binding_role,json=bindingRole,proto3" json:"binding_role,omitempty"`
+	//支持通配符，为空时对所有命名空间生效，namespace-admin 必须指定
+	BindingNamespace string `protobuf:"bytes,4,opt,name=binding_namespace,json=bindingNamespace,proto3" json:"binding_namespace,omitempty"`
+	//授权人，由服务端填写
+	BindingCreatedBy string `protobuf:"bytes,5,opt,name=binding_created_by,json=bindingCreatedBy,proto3" json:"binding_created_by,omitempty"`
+}
+
+func (x *RoleBindingInfo) Reset() {
+	*x = RoleBindingInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleBindingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBindingInfo) ProtoMessage() {}
+
+func (x *RoleBindingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBindingInfo.ProtoReflect.Descriptor instead.
+func (*RoleBindingInfo) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RoleBindingInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RoleBindingInfo) GetBindingSubject() string {
+	if x != nil {
+		return x.BindingSubject
+	}
+	return ""
+}
+
+func (x *RoleBindingInfo) GetBindingRole() string {
+	if x != nil {
+		return x.BindingRole
+	}
+	return ""
+}
+
+func (x *RoleBindingInfo) GetBindingNamespace() string {
+	if x != nil {
+		return x.BindingNamespace
+	}
+	return ""
+}
+
+func (x *RoleBindingInfo) GetBindingCreatedBy() string {
+	if x != nil {
+		return x.BindingCreatedBy
+	}
+	return ""
+}
+
+type ListBindingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//为空时不过滤
+	BindingSubject   string `protobuf:"bytes,1,opt,name=binding_subject,json=bindingSubject,proto3" json:"binding_subject,omitempty"`
+	BindingNamespace string `protobuf:"bytes,2,opt,name=binding_namespace,json=bindingNamespace,proto3" json:"binding_namespace,omitempty"`
+}
+
+func (x *ListBindingsRequest) Reset() {
+	*x = ListBindingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBindingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBindingsRequest) ProtoMessage() {}
+
+func (x *ListBindingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBindingsRequest.ProtoReflect.Descriptor instead.
+func (*ListBindingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListBindingsRequest) GetBindingSubject() string {
+	if x != nil {
+		return x.BindingSubject
+	}
+	return ""
+}
+
+func (x *ListBindingsRequest) GetBindingNamespace() string {
+	if x != nil {
+		return x.BindingNamespace
+	}
+	return ""
+}
+
+type AllRoleBinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleBindingInfo []*RoleBindingInfo `protobuf:"bytes,1,rep,name=role_binding_info,json=roleBindingInfo,proto3" json:"role_binding_info,omitempty"`
+}
+
+func (x *AllRoleBinding) Reset() {
+	*x = AllRoleBinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllRoleBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllRoleBinding) ProtoMessage() {}
+
+func (x *AllRoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllRoleBinding.ProtoReflect.Descriptor instead.
+func (*AllRoleBinding) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AllRoleBinding) GetRoleBindingInfo() []*RoleBindingInfo {
+	if x != nil {
+		return x.RoleBindingInfo
+	}
+	return nil
+}
+
+type APIKeyInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	KeyName string `protobuf:"bytes,2,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty"`
+	//密钥前缀，由服务端生成
+	KeyPrefix string `protobuf:"bytes,3,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	//支持通配符，为空时对所有命名空间生效
+	KeyNamespaces []string `protobuf:"bytes,4,rep,name=key_namespaces,json=keyNamespaces,proto3" json:"key_namespaces,omitempty"`
+	//read、write、admin，admin 包含 write，write 包含 read
+	KeyVerbs []string `protobuf:"bytes,5,rep,name=key_verbs,json=keyVerbs,proto3" json:"key_verbs,omitempty"`
+	//过期时间（unix 秒），0 表示不过期
+	KeyExpiresAt int64 `protobuf:"varint,6,opt,name=key_expires_at,json=keyExpiresAt,proto3" json:"key_expires_at,omitempty"`
+	//以下由服务端维护
+	KeyRevokedAt  int64  `protobuf:"varint,7,opt,name=key_revoked_at,json=keyRevokedAt,proto3" json:"key_revoked_at,omitempty"`
+	KeyLastUsedAt int64  `protobuf:"varint,8,opt,name=key_last_used_at,json=keyLastUsedAt,proto3" json:"key_last_used_at,omitempty"`
+	KeyCreatedBy  string `protobuf:"bytes,9,opt,name=key_created_by,json=keyCreatedBy,proto3" json:"key_created_by,omitempty"`
+	KeyCreatedAt  int64  `protobuf:"varint,10,opt,name=key_created_at,json=keyCreatedAt,proto3" json:"key_created_at,omitempty"`
+}
+
+func (x *APIKeyInfo) Reset() {
+	*x = APIKeyInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIKeyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeyInfo) ProtoMessage() {}
+
+func (x *APIKeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeyInfo.ProtoReflect.Descriptor instead.
+func (*APIKeyInfo) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *APIKeyInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *APIKeyInfo) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
+	}
+	return ""
+}
+
+func (x *APIKeyInfo) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+func (x *APIKeyInfo) GetKeyNamespaces() []string {
+	if x != nil {
+		return x.KeyNamespaces
+	}
+	return nil
+}
+
+func (x *APIKeyInfo) GetKeyVerbs() []string {
+	if x != nil {
+		return x.KeyVerbs
+	}
+	return nil
+}
+
+func (x *APIKeyInfo) GetKeyExpiresAt() int64 {
+	if x != nil {
+		return x.KeyExpiresAt
+	}
+	return 0
+}
+
+func (x *APIKeyInfo) GetKeyRevokedAt() int64 {
+	if x != nil {
+		return x.KeyRevokedAt
+	}
+	return 0
+}
+
+func (x *APIKeyInfo) GetKeyLastUsedAt() int64 {
+	if x != nil {
+		return x.KeyLastUsedAt
+	}
+	return 0
+}
+
+func (x *APIKeyInfo) GetKeyCreatedBy() string {
+	if x != nil {
+		return x.KeyCreatedBy
+	}
+	return ""
+}
+
+func (x *APIKeyInfo) GetKeyCreatedAt() int64 {
+	if x != nil {
+		return x.KeyCreatedAt
+	}
+	return 0
+}
+
+type APIKeyId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *APIKeyId) Reset() {
+	*x = APIKeyId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIKeyId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeyId) ProtoMessage() {}
+
+func (x *APIKeyId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeyId.ProtoReflect.Descriptor instead.
+func (*APIKeyId) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *APIKeyId) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//明文密钥只返回这一次
+	Key       string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	KeyPrefix string `protobuf:"bytes,3,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateAPIKeyResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateAPIKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyResponse) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+type AllAPIKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKeyInfo []*APIKeyInfo `protobuf:"bytes,1,rep,name=api_key_info,json=apiKeyInfo,proto3" json:"api_key_info,omitempty"`
+}
+
+func (x *AllAPIKey) Reset() {
+	*x = AllAPIKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllAPIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllAPIKey) ProtoMessage() {}
+
+func (x *AllAPIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllAPIKey.ProtoReflect.Descriptor instead.
+func (*AllAPIKey) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AllAPIKey) GetApiKeyInfo() []*APIKeyInfo {
+	if x != nil {
+		return x.ApiKeyInfo
+	}
+	return nil
+}
+
+type QuotaInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//* 为默认配额，对没有单独设置的命名空间生效
+	QuotaNamespace string `protobuf:"bytes,2,opt,name=quota_namespace,json=quotaNamespace,proto3" json:"quota_namespace,omitempty"`
+	//为 0 时不限制
+	QuotaMaxRoutes       int64  `protobuf:"varint,3,opt,name=quota_max_routes,json=quotaMaxRoutes,proto3" json:"quota_max_routes,omitempty"`
+	QuotaMaxHosts        int64  `protobuf:"varint,4,opt,name=quota_max_hosts,json=quotaMaxHosts,proto3" json:"quota_max_hosts,omitempty"`
+	QuotaMaxRoutesPerDay int64  `protobuf:"varint,5,opt,name=quota_max_routes_per_day,json=quotaMaxRoutesPerDay,proto3" json:"quota_max_routes_per_day,omitempty"`
+	QuotaUpdatedBy       string `protobuf:"bytes,6,opt,name=quota_updated_by,json=quotaUpdatedBy,proto3" json:"quota_updated_by,omitempty"`
+}
+
+func (x *QuotaInfo) Reset() {
+	*x = QuotaInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaInfo) ProtoMessage() {}
+
+func (x *QuotaInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaInfo.ProtoReflect.Descriptor instead.
+func (*QuotaInfo) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *QuotaInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QuotaInfo) GetQuotaNamespace() string {
+	if x != nil {
+		return x.QuotaNamespace
+	}
+	return ""
+}
+
+func (x *QuotaInfo) GetQuotaMaxRoutes() int64 {
+	if x != nil {
+		return x.QuotaMaxRoutes
+	}
+	return 0
+}
+
+func (x *QuotaInfo) GetQuotaMaxHosts() int64 {
+	if x != nil {
+		return x.QuotaMaxHosts
+	}
+	return 0
+}
+
+func (x *QuotaInfo) GetQuotaMaxRoutesPerDay() int64 {
+	if x != nil {
+		return x.QuotaMaxRoutesPerDay
+	}
+	return 0
+}
+
+func (x *QuotaInfo) GetQuotaUpdatedBy() string {
+	if x != nil {
+		return x.QuotaUpdatedBy
+	}
+	return ""
+}
+
+type AllQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuotaInfo []*QuotaInfo `protobuf:"bytes,1,rep,name=quota_info,json=quotaInfo,proto3" json:"quota_info,omitempty"`
+}
+
+func (x *AllQuota) Reset() {
+	*x = AllQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllQuota) ProtoMessage() {}
+
+func (x *AllQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllQuota.ProtoReflect.Descriptor instead.
+func (*AllQuota) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AllQuota) GetQuotaInfo() []*QuotaInfo {
+	if x != nil {
+		return x.QuotaInfo
+	}
+	return nil
+}
+
+type QuotaUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteNamespace string `protobuf:"bytes,1,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+}
+
+func (x *QuotaUsageRequest) Reset() {
+	*x = QuotaUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaUsageRequest) ProtoMessage() {}
+
+func (x *QuotaUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaUsageRequest.ProtoReflect.Descriptor instead.
+func (*QuotaUsageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *QuotaUsageRequest) GetRouteNamespace() string {
+	if x != nil {
+		return x.RouteNamespace
+	}
+	return ""
+}
+
+type QuotaUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteNamespace string   `protobuf:"bytes,1,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+	Routes         int64    `protobuf:"varint,2,opt,name=routes,proto3" json:"routes,omitempty"`
+	HostCount      int64    `protobuf:"varint,3,opt,name=host_count,json=hostCount,proto3" json:"host_count,omitempty"`
+	Hosts          []string `protobuf:"bytes,4,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	//删除路由后不减少
+	RoutesCreatedToday int64 `protobuf:"varint,5,opt,name=routes_created_today,json=routesCreatedToday,proto3" json:"routes_created_today,omitempty"`
+	//生效的配额，没有配额时为空
+	Quota *QuotaInfo `protobuf:"bytes,6,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (x *QuotaUsage) Reset() {
+	*x = QuotaUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaUsage) ProtoMessage() {}
+
+func (x *QuotaUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaUsage.ProtoReflect.Descriptor instead.
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *QuotaUsage) GetRouteNamespace() string {
+	if x != nil {
+		return x.RouteNamespace
+	}
+	return ""
+}
+
+func (x *QuotaUsage) GetRoutes() int64 {
+	if x != nil {
+		return x.Routes
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetHostCount() int64 {
+	if x != nil {
+		return x.HostCount
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *QuotaUsage) GetRoutesCreatedToday() int64 {
+	if x != nil {
+		return x.RoutesCreatedToday
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetQuota() *QuotaInfo {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type UsageReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//格式 2006-01-02，为空时为最近 30 天
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	//namespace 或 team，默认 namespace
+	GroupBy string `protobuf:"bytes,3,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	//json 或 csv，csv 时同时返回文件内容
+	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *UsageReportRequest) Reset() {
+	*x = UsageReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageReportRequest) ProtoMessage() {}
+
+func (x *UsageReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageReportRequest.ProtoReflect.Descriptor instead.
+func (*UsageReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UsageReportRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *UsageReportRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *UsageReportRequest) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *UsageReportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type UsageReportRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day string `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	//按 team 统计时为空
+	RouteNamespace  string `protobuf:"bytes,2,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+	RouteOwnerTeam  string `protobuf:"bytes,3,opt,name=route_owner_team,json=routeOwnerTeam,proto3" json:"route_owner_team,omitempty"`
+	Routes          int64  `protobuf:"varint,4,opt,name=routes,proto3" json:"routes,omitempty"`
+	Hosts           int64  `protobuf:"varint,5,opt,name=hosts,proto3" json:"hosts,omitempty"`
+	TlsCertificates int64  `protobuf:"varint,6,opt,name=tls_certificates,json=tlsCertificates,proto3" json:"tls_certificates,omitempty"`
+}
+
+func (x *UsageReportRow) Reset() {
+	*x = UsageReportRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageReportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageReportRow) ProtoMessage() {}
+
+func (x *UsageReportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageReportRow.ProtoReflect.Descriptor instead.
+func (*UsageReportRow) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UsageReportRow) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *UsageReportRow) GetRouteNamespace() string {
+	if x != nil {
+		return x.RouteNamespace
+	}
+	return ""
+}
+
+func (x *UsageReportRow) GetRouteOwnerTeam() string {
+	if x != nil {
+		return x.RouteOwnerTeam
+	}
+	return ""
+}
+
+func (x *UsageReportRow) GetRoutes() int64 {
+	if x != nil {
+		return x.Routes
+	}
+	return 0
+}
+
+func (x *UsageReportRow) GetHosts() int64 {
+	if x != nil {
+		return x.Hosts
+	}
+	return 0
+}
+
+func (x *UsageReportRow) GetTlsCertificates() int64 {
+	if x != nil {
+		return x.TlsCertificates
+	}
+	return 0
+}
+
+type UsageReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From    string            `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To      string            `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	GroupBy string            `protobuf:"bytes,3,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	Rows    []*UsageReportRow `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	File    *InventoryFile    `protobuf:"bytes,5,opt,name=file,proto3" json:"file,omitempty"`
+}
+
+func (x *UsageReport) Reset() {
+	*x = UsageReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageReport) ProtoMessage() {}
+
+func (x *UsageReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageReport.ProtoReflect.Descriptor instead.
+func (*UsageReport) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UsageReport) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *UsageReport) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *UsageReport) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *UsageReport) GetRows() []*UsageReportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *UsageReport) GetFile() *InventoryFile {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+type NamingViolationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//为空时检查全部命名空间，支持通配符
+	RouteNamespace string `protobuf:"bytes,1,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+	//只检查指定的规则，为空时检查全部规则
+	Rule string `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *NamingViolationsRequest) Reset() {
+	*x = NamingViolationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamingViolationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamingViolationsRequest) ProtoMessage() {}
+
+func (x *NamingViolationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamingViolationsRequest.ProtoReflect.Descriptor instead.
+func (*NamingViolationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *NamingViolationsRequest) GetRouteNamespace() string {
+	if x != nil {
+		return x.RouteNamespace
+	}
+	return ""
+}
+
+func (x *NamingViolationsRequest) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+type NamingViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RouteNamespace string `protobuf:"bytes,2,opt,name=route_namespace,json=routeNamespace,proto3" json:"route_namespace,omitempty"`
+	RouteName      string `protobuf:"bytes,3,opt,name=route_name,json=routeName,proto3" json:"route_name,omitempty"`
+	RouteOwnerTeam string `protobuf:"bytes,4,opt,name=route_owner_team,json=routeOwnerTeam,proto3" json:"route_owner_team,omitempty"`
+	//规则名称
+	Rule    string `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *NamingViolation) Reset() {
+	*x = NamingViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamingViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamingViolation) ProtoMessage() {}
+
+func (x *NamingViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamingViolation.ProtoReflect.Descriptor instead.
+func (*NamingViolation) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *NamingViolation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NamingViolation) GetRouteNamespace() string {
+	if x != nil {
+		return x.RouteNamespace
+	}
+	return ""
+}
+
+func (x *NamingViolation) GetRouteName() string {
+	if x != nil {
+		return x.RouteName
+	}
+	return ""
+}
+
+func (x *NamingViolation) GetRouteOwnerTeam() string {
+	if x != nil {
+		return x.RouteOwnerTeam
+	}
+	return ""
+}
+
+func (x *NamingViolation) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *NamingViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type NamingViolationReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//检查的路由数
+	Checked    int64              `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Violations []*NamingViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (x *NamingViolationReport) Reset() {
+	*x = NamingViolationReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_route_route_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamingViolationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamingViolationReport) ProtoMessage() {}
+
+func (x *NamingViolationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_route_route_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamingViolationReport.ProtoReflect.Descriptor instead.
+func (*NamingViolationReport) Descriptor() ([]byte, []int) {
+	return file_proto_route_route_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *NamingViolationReport) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *NamingViolationReport) GetViolations() []*NamingViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+var File_proto_route_route_proto protoreflect.FileDescriptor
+
+var file_proto_route_route_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2f, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x22, 0xe3, 0x13, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x09, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x53, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x6c, 0x73,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x64, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x67, 0x0a, 0x19, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53,
+	0x65, 0x74, 0x12, 0x3f, 0x0a, 0x1c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x19, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x12, 0x64, 0x0a, 0x18, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x18,
+	0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x12, 0x64, 0x0a, 0x18, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12,
+	0x3d, 0x0a, 0x1b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x11,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x18, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x28, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x54,
+	0x65, 0x61, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x0a, 0x14, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x57, 0x61, 0x69,
+	0x74, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x57, 0x61, 0x69, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x57, 0x61, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x48, 0x61, 0x73, 0x68, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4c,
+	0x61, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x1c,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x18, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x61, 0x73,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x21, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x70,
+	0x70, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x22, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x23, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x77,
+	0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x24, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x45,
+	0x0a, 0x1f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x25, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x57, 0x65,
+	0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x32, 0x18, 0x26, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x48, 0x74, 0x74, 0x70, 0x32, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x55, 0x72, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x12, 0x3d, 0x0a, 0x1b, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x29, 0x20, 0x03, 0x28, 0x09, 0x52, 0x18, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x2a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x64,
+	0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x1a, 0x43, 0x0a, 0x15, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x49, 0x0a, 0x1b, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x48, 0x0a, 0x1a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x48, 0x0a, 0x1a,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa9, 0x04, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x26, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x17, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x45, 0x0a, 0x12, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x10, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x69, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x69,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x69,
+	0x72, 0x72, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12,
+	0x34, 0x0a, 0x16, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4b, 0x0a, 0x22, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x1f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x22, 0x50, 0x0a, 0x10, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3e, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x22, 0x78, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x5d,
+	0x0a, 0x07, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x63, 0x0a,
+	0x16, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x22, 0x76, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe0, 0x01, 0x0a, 0x0b, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x08, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x70, 0x0a,
+	0x0d, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x22,
+	0x63, 0x0a, 0x08, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68,
+	0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x48, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xf8, 0x02, 0x0a, 0x14, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x64, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x54, 0x6c, 0x73, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x1a, 0x45,
+	0x0a, 0x17, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x24, 0x0a, 0x12, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x68, 0x0a, 0x13, 0x41,
+	0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x12, 0x51, 0x0a, 0x16, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x14, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xba, 0x01, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x17, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x1f, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x69, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0x99, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x58, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x3b, 0x0a, 0x08, 0x41, 0x6c, 0x6c, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2a, 0x0a, 0x0e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x22, 0xed, 0x02, 0x0a, 0x13, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f,
+	0x61, 0x70, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x41, 0x70, 0x69, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x5f, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x12, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x70, 0x69, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63,
+	0x65, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73,
+	0x74, 0x69, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x74, 0x69, 0x6f,
+	0x22, 0x39, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0xc3, 0x02, 0x0a, 0x10,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x83, 0x02, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x03, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x50, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x03, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x1a,
+	0x36, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x52, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0xf5, 0x01, 0x0a, 0x11,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x50, 0x6f, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x22, 0xd8, 0x03, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x36,
+	0x0a, 0x17, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x15, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x50, 0x6f, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
+	0x12, 0x2c, 0x0a, 0x04, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x50, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x70, 0x6f, 0x64, 0x73, 0x22, 0xc2,
+	0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x09,
+	0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09,
+	0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x79, 0x22, 0x80, 0x02, 0x0a, 0x10, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x20, 0x0a, 0x0e, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x58, 0x0a, 0x0f, 0x41, 0x6c, 0x6c, 0x46,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x45, 0x0a, 0x12, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x10, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0xd1, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x48,
+	0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x13,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x20, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x58, 0x0a, 0x0f, 0x41, 0x6c, 0x6c, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x12, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x10, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x67, 0x0a, 0x15, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x80, 0x01, 0x0a, 0x0e,
+	0x41, 0x64, 0x6f, 0x70, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x7a,
+	0x0a, 0x16, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x61, 0x64, 0x6f, 0x70,
+	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x07, 0x61, 0x64, 0x6f, 0x70, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x6b, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xda, 0x02,
+	0x0a, 0x0e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b,
+	0x12, 0x34, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x69, 0x73, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x69, 0x73, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x08,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x69, 0x73, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a,
+	0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9d, 0x01, 0x0a, 0x11, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x69, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x93, 0x01, 0x0a, 0x11, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x69, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0xed, 0x01, 0x0a, 0x10, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x69, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x0b, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0xa3, 0x01, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x76, 0x65, 0x72, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x76, 0x65, 0x72, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x74, 0x57, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3c, 0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x69,
+	0x6e, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x65, 0x0a, 0x13, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x52, 0x0a, 0x16, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x82,
+	0x01, 0x0a, 0x10, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x69,
+	0x6e, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x75, 0x0a, 0x12, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x64, 0x72, 0x61,
+	0x66, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x52, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x77, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x77, 0x0a, 0x09, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65,
+	0x73, 0x69, 0x72, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x22, 0x97, 0x01, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x69, 0x66,
+	0x66, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x69, 0x76,
+	0x65, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x5f, 0x73,
+	0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x53, 0x79, 0x6e,
+	0x63, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xd2, 0x01,
+	0x0a, 0x0d, 0x52, 0x65, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x22, 0x69, 0x0a, 0x13, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x74, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x22, 0x7e, 0x0a,
+	0x0e, 0x52, 0x65, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xcf, 0x02,
+	0x0a, 0x0a, 0x52, 0x65, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4a, 0x6f, 0x62, 0x12, 0x15, 0x0a, 0x06,
+	0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x31, 0x0a,
+	0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22,
+	0x25, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x12,
+	0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x0f, 0x52, 0x65, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x22, 0x85, 0x03,
+	0x0a, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a,
+	0x13, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x69, 0x0a, 0x14, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x46, 0x0a,
+	0x18, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x26, 0x0a, 0x14, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x70, 0x0a,
+	0x15, 0x41, 0x6c, 0x6c, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x57, 0x0a, 0x18, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x16, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x44, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x65, 0x6e, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x45, 0x6e, 0x76, 0x22, 0xe5, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x76, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x76, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x65, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x6f,
+	0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x48,
+	0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x97, 0x01,
+	0x0a, 0x1a, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05,
+	0x65, 0x6e, 0x76, 0x5f, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x6e, 0x76,
+	0x41, 0x12, 0x13, 0x0a, 0x05, 0x65, 0x6e, 0x76, 0x5f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x65, 0x6e, 0x76, 0x42, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x15, 0x45, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x11, 0x0a, 0x04, 0x69, 0x64, 0x5f, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x41, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x12, 0x11, 0x0a, 0x04, 0x69,
+	0x64, 0x5f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x42, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x62, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x17,
+	0x0a, 0x07, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5f, 0x62, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42,
+	0x22, 0x9a, 0x01, 0x0a, 0x15, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x65, 0x6e,
+	0x76, 0x5f, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x6e, 0x76, 0x41, 0x12,
+	0x13, 0x0a, 0x05, 0x65, 0x6e, 0x76, 0x5f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x65, 0x6e, 0x76, 0x42, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x3e, 0x0a,
+	0x0b, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x0b, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xc8, 0x01,
+	0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x6b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x62, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x54, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x6c, 0x65,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x42, 0x0a, 0x11, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x72, 0x6f, 0x6c, 0x65,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xdb, 0x02, 0x0a, 0x0a,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x6b, 0x65, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x65,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6b,
+	0x65, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x62, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x6b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x62, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6b, 0x65, 0x79, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x6b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x10, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x6b, 0x65, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6b, 0x65, 0x79,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x1a, 0x0a, 0x08, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x57, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x40,
+	0x0a, 0x09, 0x41, 0x6c, 0x6c, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x0c, 0x61,
+	0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0xf8, 0x01, 0x0a, 0x09, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x4d, 0x61, 0x78, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x68,
+	0x6f, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x4d, 0x61, 0x78, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x18, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x4d, 0x61, 0x78, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61,
+	0x79, 0x12, 0x28, 0x0a, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x3b, 0x0a, 0x08, 0x41,
+	0x6c, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x2f, 0x0a, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3c, 0x0a, 0x11, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xdc, 0x01, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f,
+	0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x12, 0x26, 0x0a,
+	0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x6b, 0x0a, 0x12, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x22, 0xce, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f,
+	0x74, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x6c, 0x73, 0x5f,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x79, 0x12, 0x29, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x28, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x56, 0x0a, 0x17, 0x4e, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22,
+	0xc1, 0x01, 0x0a, 0x0f, 0x4e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x69, 0x0a, 0x15, 0x4e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0x8d,
+	0x22, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x33, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44,
+	0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64,
+	0x1a, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x6c, 0x6c,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x4c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x1d, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x38, 0x0a,
+	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1b,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x12, 0x1b, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x54, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x19, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x1a, 0x1b, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x1a, 0x1a, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12,
+	0x3b, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x13, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49,
+	0x44, 0x12, 0x14, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x16, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x15, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x11, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a,
+	0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0f, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x14,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x46, 0x69, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x00,
+	0x12, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x19, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x18, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x4d,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x50, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12,
+	0x50, 0x0a, 0x15, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x50, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x17, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x00, 0x12, 0x4a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3d, 0x0a,
+	0x0f, 0x41, 0x64, 0x64, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x17, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x15, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x17, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x16, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x6c,
+	0x6c, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x48, 0x6f,
+	0x73, 0x74, 0x12, 0x17, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x48, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x48, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3f, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x16, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x22,
+	0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x64, 0x6f, 0x70,
+	0x74, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x49,
+	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x31, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x65, 0x12, 0x16, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x22, 0x00, 0x12, 0x4d, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x00, 0x12, 0x4d, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00,
+	0x12, 0x32, 0x0a, 0x09, 0x4c, 0x69, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x10, 0x2e,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a,
+	0x11, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x09,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x22, 0x00, 0x12, 0x37, 0x0a,
+	0x0a, 0x52, 0x65, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x41, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x1a, 0x11, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x70, 0x70, 0x6c,
+	0x79, 0x4a, 0x6f, 0x62, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61,
+	0x70, 0x70, 0x6c, 0x79, 0x4a, 0x6f, 0x62, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x52, 0x65, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x1a, 0x11, 0x2e, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4a, 0x6f, 0x62, 0x22,
+	0x00, 0x12, 0x41, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0a, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0f, 0x52, 0x65, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x16, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x52, 0x65, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x00, 0x12, 0x49, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a,
+	0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x18, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x41,
+	0x6c, 0x6c, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x1c, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x6c, 0x6c,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x58, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52,
+	0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f,
+	0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x16, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41,
+	0x6c, 0x6c, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12,
+	0x11, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x1a, 0x1b, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x32, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65,
+	0x79, 0x12, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x73, 0x12, 0x0e, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x6c, 0x6c,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x12, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x10, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a,
+	0x0a, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x0e, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x1a, 0x0f, 0x2e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x00, 0x12, 0x3e,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x2e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x42, 0x15,
+	0x5a, 0x13, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x3b,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_route_route_proto_rawDescOnce sync.Once
+	file_proto_route_route_proto_rawDescData = file_proto_route_route_proto_rawDesc
+)
+
+func file_proto_route_route_proto_rawDescGZIP() []byte {
+	file_proto_route_route_proto_rawDescOnce.Do(func() {
+		file_proto_route_route_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_route_route_proto_rawDescData)
+	})
+	return file_proto_route_route_proto_rawDescData
+}
+
+var file_proto_route_route_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
+var file_proto_route_route_proto_goTypes = []interface{}{
+	(*RouteInfo)(nil),                  // 0: route.RouteInfo
+	(*RoutePath)(nil),                  // 1: route.RoutePath
+	(*RouteHeaderMatch)(nil),           // 2: route.RouteHeaderMatch
+	(*RouteId)(nil),                    // 3: route.RouteId
+	(*RouteName)(nil),                  // 4: route.RouteName
+	(*FindAll)(nil),                    // 5: route.FindAll
+	(*RouteCollectionVersion)(nil),     // 6: route.RouteCollectionVersion
+	(*Response)(nil),                   // 7: route.Response
+	(*RouteStatus)(nil),                // 8: route.RouteStatus
+	(*BackendHealth)(nil),              // 9: route.BackendHealth
+	(*AllRoute)(nil),                   // 10: route.AllRoute
+	(*SearchRoutesRequest)(nil),        // 11: route.SearchRoutesRequest
+	(*NamespaceDefaultInfo)(nil),       // 12: route.NamespaceDefaultInfo
+	(*NamespaceDefaultId)(nil),         // 13: route.NamespaceDefaultId
+	(*AllNamespaceDefault)(nil),        // 14: route.AllNamespaceDefault
+	(*ApplicationInfo)(nil),            // 15: route.ApplicationInfo
+	(*ApplicationId)(nil),              // 16: route.ApplicationId
+	(*AllApplication)(nil),             // 17: route.AllApplication
+	(*InventoryFile)(nil),              // 18: route.InventoryFile
+	(*ExportManifestsRequest)(nil),     // 19: route.ExportManifestsRequest
+	(*EventInfo)(nil),                  // 20: route.EventInfo
+	(*ListEventsRequest)(nil),          // 21: route.ListEventsRequest
+	(*AllEvent)(nil),                   // 22: route.AllEvent
+	(*ClusterRequest)(nil),             // 23: route.ClusterRequest
+	(*ClusterCapabilities)(nil),        // 24: route.ClusterCapabilities
+	(*ControllerConfigRequest)(nil),    // 25: route.ControllerConfigRequest
+	(*ControllerConfig)(nil),           // 26: route.ControllerConfig
+	(*ControllerConfigPatch)(nil),      // 27: route.ControllerConfigPatch
+	(*ControllerInfoRequest)(nil),      // 28: route.ControllerInfoRequest
+	(*ControllerPodInfo)(nil),          // 29: route.ControllerPodInfo
+	(*ControllerWorkloadInfo)(nil),     // 30: route.ControllerWorkloadInfo
+	(*ControllerInfo)(nil),             // 31: route.ControllerInfo
+	(*FreezeWindowInfo)(nil),           // 32: route.FreezeWindowInfo
+	(*FreezeWindowId)(nil),             // 33: route.FreezeWindowId
+	(*AllFreezeWindow)(nil),            // 34: route.AllFreezeWindow
+	(*ReservedHostInfo)(nil),           // 35: route.ReservedHostInfo
+	(*ReservedHostId)(nil),             // 36: route.ReservedHostId
+	(*AllReservedHost)(nil),            // 37: route.AllReservedHost
+	(*AdoptIngressesRequest)(nil),      // 38: route.AdoptIngressesRequest
+	(*AdoptedIngress)(nil),             // 39: route.AdoptedIngress
+	(*AdoptIngressesResponse)(nil),     // 40: route.AdoptIngressesResponse
+	(*DiagnoseRequest)(nil),            // 41: route.DiagnoseRequest
+	(*DiagnoseReport)(nil),             // 42: route.DiagnoseReport
+	(*DatabaseDiagnosis)(nil),          // 43: route.DatabaseDiagnosis
+	(*RegistryDiagnosis)(nil),          // 44: route.RegistryDiagnosis
+	(*ClusterDiagnosis)(nil),           // 45: route.ClusterDiagnosis
+	(*AccessCheck)(nil),                // 46: route.AccessCheck
+	(*LintWarning)(nil),                // 47: route.LintWarning
+	(*LintResult)(nil),                 // 48: route.LintResult
+	(*LegacyConfigRequest)(nil),        // 49: route.LegacyConfigRequest
+	(*ConvertManifestRequest)(nil),     // 50: route.ConvertManifestRequest
+	(*LegacyRouteDraft)(nil),           // 51: route.LegacyRouteDraft
+	(*LegacyImportResult)(nil),         // 52: route.LegacyImportResult
+	(*FieldDiff)(nil),                  // 53: route.FieldDiff
+	(*RouteDiff)(nil),                  // 54: route.RouteDiff
+	(*ReapplyFilter)(nil),              // 55: route.ReapplyFilter
+	(*FailedRoutesRequest)(nil),        // 56: route.FailedRoutesRequest
+	(*ReapplyFailure)(nil),             // 57: route.ReapplyFailure
+	(*ReapplyJob)(nil),                 // 58: route.ReapplyJob
+	(*ReapplyJobId)(nil),               // 59: route.ReapplyJobId
+	(*ReencryptResult)(nil),            // 60: route.ReencryptResult
+	(*AnnotationTemplateInfo)(nil),     // 61: route.AnnotationTemplateInfo
+	(*AnnotationTemplateId)(nil),       // 62: route.AnnotationTemplateId
+	(*AllAnnotationTemplate)(nil),      // 63: route.AllAnnotationTemplate
+	(*PromoteRouteRequest)(nil),        // 64: route.PromoteRouteRequest
+	(*PromoteRouteResponse)(nil),       // 65: route.PromoteRouteResponse
+	(*CompareEnvironmentsRequest)(nil), // 66: route.CompareEnvironmentsRequest
+	(*EnvironmentDifference)(nil),      // 67: route.EnvironmentDifference
+	(*EnvironmentComparison)(nil),      // 68: route.EnvironmentComparison
+	(*RoleBindingInfo)(nil),            // 69: route.RoleBindingInfo
+	(*ListBindingsRequest)(nil),        // 70: route.ListBindingsRequest
+	(*AllRoleBinding)(nil),             // 71: route.AllRoleBinding
+	(*APIKeyInfo)(nil),                 // 72: route.APIKeyInfo
+	(*APIKeyId)(nil),                   // 73: route.APIKeyId
+	(*CreateAPIKeyResponse)(nil),       // 74: route.CreateAPIKeyResponse
+	(*AllAPIKey)(nil),                  // 75: route.AllAPIKey
+	(*QuotaInfo)(nil),                  // 76: route.QuotaInfo
+	(*AllQuota)(nil),                   // 77: route.AllQuota
+	(*QuotaUsageRequest)(nil),          // 78: route.QuotaUsageRequest
+	(*QuotaUsage)(nil),                 // 79: route.QuotaUsage
+	(*UsageReportRequest)(nil),         // 80: route.UsageReportRequest
+	(*UsageReportRow)(nil),             // 81: route.UsageReportRow
+	(*UsageReport)(nil),                // 82: route.UsageReport
+	(*NamingViolationsRequest)(nil),    // 83: route.NamingViolationsRequest
+	(*NamingViolation)(nil),            // 84: route.NamingViolation
+	(*NamingViolationReport)(nil),      // 85: route.NamingViolationReport
+	nil,                                // 86: route.RouteInfo.RouteAnnotationsEntry
+	nil,                                // 87: route.RouteInfo.RouteResponseHeaderSetEntry
+	nil,                                // 88: route.RouteInfo.RouteRequestHeaderAddEntry
+	nil,                                // 89: route.RouteInfo.RouteRequestHeaderSetEntry
+	nil,                                // 90: route.NamespaceDefaultInfo.DefaultAnnotationsEntry
+	nil,                                // 91: route.ControllerConfig.DataEntry
+	nil,                                // 92: route.ControllerConfigPatch.SetEntry
+	nil,                                // 93: route.DiagnoseReport.ConfigEntry
+	nil,                                // 94: route.AnnotationTemplateInfo.TemplateAnnotationsEntry
+}
+var file_proto_route_route_proto_depIdxs = []int32{
+	1,   // 0: route.RouteInfo.route_path:type_name -> route.RoutePath
+	86,  // 1: route.RouteInfo.route_annotations:type_name -> route.RouteInfo.RouteAnnotationsEntry
+	87,  // 2: route.RouteInfo.route_response_header_set:type_name -> route.RouteInfo.RouteResponseHeaderSetEntry
+	88,  // 3: route.RouteInfo.route_request_header_add:type_name -> route.RouteInfo.RouteRequestHeaderAddEntry
+	89,  // 4: route.RouteInfo.route_request_header_set:type_name -> route.RouteInfo.RouteRequestHeaderSetEntry
+	2,   // 5: route.RoutePath.route_header_match:type_name -> route.RouteHeaderMatch
+	8,   // 6: route.Response.status:type_name -> route.RouteStatus
+	9,   // 7: route.RouteStatus.backends:type_name -> route.BackendHealth
+	0,   // 8: route.AllRoute.route_info:type_name -> route.RouteInfo
+	90,  // 9: route.NamespaceDefaultInfo.default_annotations:type_name -> route.NamespaceDefaultInfo.DefaultAnnotationsEntry
+	12,  // 10: route.AllNamespaceDefault.namespace_default_info:type_name -> route.NamespaceDefaultInfo
+	15,  // 11: route.AllApplication.application_info:type_name -> route.ApplicationInfo
+	20,  // 12: route.AllEvent.event_info:type_name -> route.EventInfo
+	91,  // 13: route.ControllerConfig.data:type_name -> route.ControllerConfig.DataEntry
+	92,  // 14: route.ControllerConfigPatch.set:type_name -> route.ControllerConfigPatch.SetEntry
+	29,  // 15: route.ControllerWorkloadInfo.pods:type_name -> route.ControllerPodInfo
+	30,  // 16: route.ControllerInfo.workloads:type_name -> route.ControllerWorkloadInfo
+	32,  // 17: route.AllFreezeWindow.freeze_window_info:type_name -> route.FreezeWindowInfo
+	35,  // 18: route.AllReservedHost.reserved_host_info:type_name -> route.ReservedHostInfo
+	39,  // 19: route.AdoptIngressesResponse.adopted:type_name -> route.AdoptedIngress
+	39,  // 20: route.AdoptIngressesResponse.skipped:type_name -> route.AdoptedIngress
+	43,  // 21: route.DiagnoseReport.database:type_name -> route.DatabaseDiagnosis
+	44,  // 22: route.DiagnoseReport.registry:type_name -> route.RegistryDiagnosis
+	45,  // 23: route.DiagnoseReport.clusters:type_name -> route.ClusterDiagnosis
+	93,  // 24: route.DiagnoseReport.config:type_name -> route.DiagnoseReport.ConfigEntry
+	46,  // 25: route.ClusterDiagnosis.permissions:type_name -> route.AccessCheck
+	47,  // 26: route.LintResult.warnings:type_name -> route.LintWarning
+	0,   // 27: route.LegacyRouteDraft.route:type_name -> route.RouteInfo
+	47,  // 28: route.LegacyRouteDraft.warnings:type_name -> route.LintWarning
+	51,  // 29: route.LegacyImportResult.drafts:type_name -> route.LegacyRouteDraft
+	47,  // 30: route.LegacyImportResult.warnings:type_name -> route.LintWarning
+	53,  // 31: route.RouteDiff.changes:type_name -> route.FieldDiff
+	57,  // 32: route.ReapplyJob.failures:type_name -> route.ReapplyFailure
+	94,  // 33: route.AnnotationTemplateInfo.template_annotations:type_name -> route.AnnotationTemplateInfo.TemplateAnnotationsEntry
+	61,  // 34: route.AllAnnotationTemplate.annotation_template_info:type_name -> route.AnnotationTemplateInfo
+	67,  // 35: route.EnvironmentComparison.differences:type_name -> route.EnvironmentDifference
+	69,  // 36: route.AllRoleBinding.role_binding_info:type_name -> route.RoleBindingInfo
+	72,  // 37: route.AllAPIKey.api_key_info:type_name -> route.APIKeyInfo
+	76,  // 38: route.AllQuota.quota_info:type_name -> route.QuotaInfo
+	76,  // 39: route.QuotaUsage.quota:type_name -> route.QuotaInfo
+	81,  // 40: route.UsageReport.rows:type_name -> route.UsageReportRow
+	18,  // 41: route.UsageReport.file:type_name -> route.InventoryFile
+	84,  // 42: route.NamingViolationReport.violations:type_name -> route.NamingViolation
+	0,   // 43: route.Route.AddRoute:input_type -> route.RouteInfo
+	3,   // 44: route.Route.DeleteRoute:input_type -> route.RouteId
+	0,   // 45: route.Route.UpdateRoute:input_type -> route.RouteInfo
+	3,   // 46: route.Route.FindRouteByID:input_type -> route.RouteId
+	5,   // 47: route.Route.FindAllRoute:input_type -> route.FindAll
+	11,  // 48: route.Route.SearchRoutes:input_type -> route.SearchRoutesRequest
+	5,   // 49: route.Route.StreamAllRoutes:input_type -> route.FindAll
+	5,   // 50: route.Route.GetRouteCollectionVersion:input_type -> route.FindAll
+	4,   // 51: route.Route.DeleteRouteByName:input_type -> route.RouteName
+	12,  // 52: route.Route.AddNamespaceDefault:input_type -> route.NamespaceDefaultInfo
+	13,  // 53: route.Route.DeleteNamespaceDefault:input_type -> route.NamespaceDefaultId
+	12,  // 54: route.Route.UpdateNamespaceDefault:input_type -> route.NamespaceDefaultInfo
+	13,  // 55: route.Route.FindNamespaceDefaultByID:input_type -> route.NamespaceDefaultId
+	5,   // 56: route.Route.FindAllNamespaceDefault:input_type -> route.FindAll
+	15,  // 57: route.Route.AddApplication:input_type -> route.ApplicationInfo
+	16,  // 58: route.Route.DeleteApplication:input_type -> route.ApplicationId
+	15,  // 59: route.Route.UpdateApplication:input_type -> route.ApplicationInfo
+	16,  // 60: route.Route.FindApplicationByID:input_type -> route.ApplicationId
+	5,   // 61: route.Route.FindAllApplication:input_type -> route.FindAll
+	16,  // 62: route.Route.DisableApplication:input_type -> route.ApplicationId
+	16,  // 63: route.Route.EnableApplication:input_type -> route.ApplicationId
+	16,  // 64: route.Route.ExportApplication:input_type -> route.ApplicationId
+	5,   // 65: route.Route.ExportInventory:input_type -> route.FindAll
+	19,  // 66: route.Route.ExportManifests:input_type -> route.ExportManifestsRequest
+	80,  // 67: route.Route.GetUsageReport:input_type -> route.UsageReportRequest
+	21,  // 68: route.Route.ListEvents:input_type -> route.ListEventsRequest
+	23,  // 69: route.Route.GetClusterCapabilities:input_type -> route.ClusterRequest
+	25,  // 70: route.Route.GetControllerConfig:input_type -> route.ControllerConfigRequest
+	27,  // 71: route.Route.PatchControllerConfig:input_type -> route.ControllerConfigPatch
+	28,  // 72: route.Route.GetControllerInfo:input_type -> route.ControllerInfoRequest
+	32,  // 73: route.Route.AddFreezeWindow:input_type -> route.FreezeWindowInfo
+	33,  // 74: route.Route.DeleteFreezeWindow:input_type -> route.FreezeWindowId
+	32,  // 75: route.Route.UpdateFreezeWindow:input_type -> route.FreezeWindowInfo
+	5,   // 76: route.Route.FindAllFreezeWindow:input_type -> route.FindAll
+	35,  // 77: route.Route.AddReservedHost:input_type -> route.ReservedHostInfo
+	36,  // 78: route.Route.DeleteReservedHost:input_type -> route.ReservedHostId
+	35,  // 79: route.Route.UpdateReservedHost:input_type -> route.ReservedHostInfo
+	5,   // 80: route.Route.FindAllReservedHost:input_type -> route.FindAll
+	38,  // 81: route.Route.AdoptIngresses:input_type -> route.AdoptIngressesRequest
+	3,   // 82: route.Route.ReleaseRoute:input_type -> route.RouteId
+	41,  // 83: route.Route.Diagnose:input_type -> route.DiagnoseRequest
+	49,  // 84: route.Route.ImportLegacyConfig:input_type -> route.LegacyConfigRequest
+	50,  // 85: route.Route.ConvertManifest:input_type -> route.ConvertManifestRequest
+	0,   // 86: route.Route.LintRoute:input_type -> route.RouteInfo
+	83,  // 87: route.Route.ListNamingViolations:input_type -> route.NamingViolationsRequest
+	3,   // 88: route.Route.DiffRoute:input_type -> route.RouteId
+	55,  // 89: route.Route.ReapplyAll:input_type -> route.ReapplyFilter
+	59,  // 90: route.Route.GetReapplyJob:input_type -> route.ReapplyJobId
+	56,  // 91: route.Route.ListFailedRoutes:input_type -> route.FailedRoutesRequest
+	3,   // 92: route.Route.RetryRoute:input_type -> route.RouteId
+	5,   // 93: route.Route.ReencryptRoutes:input_type -> route.FindAll
+	61,  // 94: route.Route.AddAnnotationTemplate:input_type -> route.AnnotationTemplateInfo
+	62,  // 95: route.Route.DeleteAnnotationTemplate:input_type -> route.AnnotationTemplateId
+	61,  // 96: route.Route.UpdateAnnotationTemplate:input_type -> route.AnnotationTemplateInfo
+	5,   // 97: route.Route.FindAllAnnotationTemplate:input_type -> route.FindAll
+	64,  // 98: route.Route.PromoteRoute:input_type -> route.PromoteRouteRequest
+	66,  // 99: route.Route.CompareEnvironments:input_type -> route.CompareEnvironmentsRequest
+	69,  // 100: route.Route.GrantRole:input_type -> route.RoleBindingInfo
+	69,  // 101: route.Route.RevokeRole:input_type -> route.RoleBindingInfo
+	70,  // 102: route.Route.ListBindings:input_type -> route.ListBindingsRequest
+	72,  // 103: route.Route.CreateAPIKey:input_type -> route.APIKeyInfo
+	73,  // 104: route.Route.RevokeAPIKey:input_type -> route.APIKeyId
+	5,   // 105: route.Route.ListAPIKeys:input_type -> route.FindAll
+	76,  // 106: route.Route.SetQuota:input_type -> route.QuotaInfo
+	76,  // 107: route.Route.DeleteQuota:input_type -> route.QuotaInfo
+	5,   // 108: route.Route.ListQuotas:input_type -> route.FindAll
+	78,  // 109: route.Route.GetQuotaUsage:input_type -> route.QuotaUsageRequest
+	7,   // 110: route.Route.AddRoute:output_type -> route.Response
+	7,   // 111: route.Route.DeleteRoute:output_type -> route.Response
+	7,   // 112: route.Route.UpdateRoute:output_type -> route.Response
+	0,   // 113: route.Route.FindRouteByID:output_type -> route.RouteInfo
+	10,  // 114: route.Route.FindAllRoute:output_type -> route.AllRoute
+	10,  // 115: route.Route.SearchRoutes:output_type -> route.AllRoute
+	0,   // 116: route.Route.StreamAllRoutes:output_type -> route.RouteInfo
+	6,   // 117: route.Route.GetRouteCollectionVersion:output_type -> route.RouteCollectionVersion
+	7,   // 118: route.Route.DeleteRouteByName:output_type -> route.Response
+	7,   // 119: route.Route.AddNamespaceDefault:output_type -> route.Response
+	7,   // 120: route.Route.DeleteNamespaceDefault:output_type -> route.Response
+	7,   // 121: route.Route.UpdateNamespaceDefault:output_type -> route.Response
+	12,  // 122: route.Route.FindNamespaceDefaultByID:output_type -> route.NamespaceDefaultInfo
+	14,  // 123: route.Route.FindAllNamespaceDefault:output_type -> route.AllNamespaceDefault
+	7,   // 124: route.Route.AddApplication:output_type -> route.Response
+	7,   // 125: route.Route.DeleteApplication:output_type -> route.Response
+	7,   // 126: route.Route.UpdateApplication:output_type -> route.Response
+	15,  // 127: route.Route.FindApplicationByID:output_type -> route.ApplicationInfo
+	17,  // 128: route.Route.FindAllApplication:output_type -> route.AllApplication
+	7,   // 129: route.Route.DisableApplication:output_type -> route.Response
+	7,   // 130: route.Route.EnableApplication:output_type -> route.Response
+	10,  // 131: route.Route.ExportApplication:output_type -> route.AllRoute
+	18,  // 132: route.Route.ExportInventory:output_type -> route.InventoryFile
+	18,  // 133: route.Route.ExportManifests:output_type -> route.InventoryFile
+	82,  // 134: route.Route.GetUsageReport:output_type -> route.UsageReport
+	22,  // 135: route.Route.ListEvents:output_type -> route.AllEvent
+	24,  // 136: route.Route.GetClusterCapabilities:output_type -> route.ClusterCapabilities
+	26,  // 137: route.Route.GetControllerConfig:output_type -> route.ControllerConfig
+	26,  // 138: route.Route.PatchControllerConfig:output_type -> route.ControllerConfig
+	31,  // 139: route.Route.GetControllerInfo:output_type -> route.ControllerInfo
+	7,   // 140: route.Route.AddFreezeWindow:output_type -> route.Response
+	7,   // 141: route.Route.DeleteFreezeWindow:output_type -> route.Response
+	7,   // 142: route.Route.UpdateFreezeWindow:output_type -> route.Response
+	34,  // 143: route.Route.FindAllFreezeWindow:output_type -> route.AllFreezeWindow
+	7,   // 144: route.Route.AddReservedHost:output_type -> route.Response
+	7,   // 145: route.Route.DeleteReservedHost:output_type -> route.Response
+	7,   // 146: route.Route.UpdateReservedHost:output_type -> route.Response
+	37,  // 147: route.Route.FindAllReservedHost:output_type -> route.AllReservedHost
+	40,  // 148: route.Route.AdoptIngresses:output_type -> route.AdoptIngressesResponse
+	7,   // 149: route.Route.ReleaseRoute:output_type -> route.Response
+	42,  // 150: route.Route.Diagnose:output_type -> route.DiagnoseReport
+	52,  // 151: route.Route.ImportLegacyConfig:output_type -> route.LegacyImportResult
+	52,  // 152: route.Route.ConvertManifest:output_type -> route.LegacyImportResult
+	48,  // 153: route.Route.LintRoute:output_type -> route.LintResult
+	85,  // 154: route.Route.ListNamingViolations:output_type -> route.NamingViolationReport
+	54,  // 155: route.Route.DiffRoute:output_type -> route.RouteDiff
+	58,  // 156: route.Route.ReapplyAll:output_type -> route.ReapplyJob
+	58,  // 157: route.Route.GetReapplyJob:output_type -> route.ReapplyJob
+	10,  // 158: route.Route.ListFailedRoutes:output_type -> route.AllRoute
+	7,   // 159: route.Route.RetryRoute:output_type -> route.Response
+	60,  // 160: route.Route.ReencryptRoutes:output_type -> route.ReencryptResult
+	7,   // 161: route.Route.AddAnnotationTemplate:output_type -> route.Response
+	7,   // 162: route.Route.DeleteAnnotationTemplate:output_type -> route.Response
+	7,   // 163: route.Route.UpdateAnnotationTemplate:output_type -> route.Response
+	63,  // 164: route.Route.FindAllAnnotationTemplate:output_type -> route.AllAnnotationTemplate
+	65,  // 165: route.Route.PromoteRoute:output_type -> route.PromoteRouteResponse
+	68,  // 166: route.Route.CompareEnvironments:output_type -> route.EnvironmentComparison
+	7,   // 167: route.Route.GrantRole:output_type -> route.Response
+	7,   // 168: route.Route.RevokeRole:output_type -> route.Response
+	71,  // 169: route.Route.ListBindings:output_type -> route.AllRoleBinding
+	74,  // 170: route.Route.CreateAPIKey:output_type -> route.CreateAPIKeyResponse
+	7,   // 171: route.Route.RevokeAPIKey:output_type -> route.Response
+	75,  // 172: route.Route.ListAPIKeys:output_type -> route.AllAPIKey
+	7,   // 173: route.Route.SetQuota:output_type -> route.Response
+	7,   // 174: route.Route.DeleteQuota:output_type -> route.Response
+	77,  // 175: route.Route.ListQuotas:output_type -> route.AllQuota
+	79,  // 176: route.Route.GetQuotaUsage:output_type -> route.QuotaUsage
+	110, // [110:177] is the sub-list for method output_type
+	43,  // [43:110] is the sub-list for method input_type
+	43,  // [43:43] is the sub-list for extension type_name
+	43,  // [43:43] is the sub-list for extension extendee
+	0,   // [0:43] is the sub-list for field type_name
+}
+
+func init() { file_proto_route_route_proto_init() }
+func file_proto_route_route_proto_init() {
+	if File_proto_route_route_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_route_route_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoutePath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteHeaderMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteCollectionVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRoutesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceDefaultInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceDefaultId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllNamespaceDefault); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplicationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplicationId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllApplication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InventoryFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportManifestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterCapabilities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControllerConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControllerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControllerConfigPatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControllerInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControllerPodInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControllerWorkloadInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControllerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreezeWindowInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreezeWindowId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllFreezeWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservedHostInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservedHostId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllReservedHost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdoptIngressesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdoptedIngress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdoptIngressesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnoseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnoseReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseDiagnosis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryDiagnosis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterDiagnosis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LintWarning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LintResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LegacyConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertManifestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LegacyRouteDraft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LegacyImportResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReapplyFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailedRoutesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -475,8 +8721,8 @@ func file_proto_route_route_proto_init() {
 				return nil
 			}
 		}
-		file_proto_route_route_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoutePath); i {
+		file_proto_route_route_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReapplyFailure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -487,8 +8733,8 @@ func file_proto_route_route_proto_init() {
 				return nil
 			}
 		}
-		file_proto_route_route_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouteId); i {
+		file_proto_route_route_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReapplyJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -499,8 +8745,8 @@ func file_proto_route_route_proto_init() {
 				return nil
 			}
 		}
-		file_proto_route_route_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindAll); i {
+		file_proto_route_route_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReapplyJobId); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -511,8 +8757,8 @@ func file_proto_route_route_proto_init() {
 				return nil
 			}
 		}
-		file_proto_route_route_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
+		file_proto_route_route_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReencryptResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -523,8 +8769,296 @@ func file_proto_route_route_proto_init() {
 				return nil
 			}
 		}
-		file_proto_route_route_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllRoute); i {
+		file_proto_route_route_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnotationTemplateInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnotationTemplateId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllAnnotationTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteRouteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteRouteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareEnvironmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvironmentDifference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvironmentComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleBindingInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBindingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllRoleBinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKeyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKeyId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllAPIKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageReportRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamingViolationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamingViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_route_route_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamingViolationReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -542,7 +9076,7 @@ func file_proto_route_route_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_route_route_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   95,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
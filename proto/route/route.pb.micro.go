@@ -42,6 +42,100 @@ type RouteService interface {
 	UpdateRoute(ctx context.Context, in *RouteInfo, opts ...client.CallOption) (*Response, error)
 	FindRouteByID(ctx context.Context, in *RouteId, opts ...client.CallOption) (*RouteInfo, error)
 	FindAllRoute(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllRoute, error)
+	//按条件查询路由，按游标分页，翻页期间有路由创建或删除时不会跳过或重复
+	SearchRoutes(ctx context.Context, in *SearchRoutesRequest, opts ...client.CallOption) (*AllRoute, error)
+	//逐条返回全部路由，用于导出等超过消息大小限制的场景，支持 fields，不支持分页参数
+	StreamAllRoutes(ctx context.Context, in *FindAll, opts ...client.CallOption) (Route_StreamAllRoutesService, error)
+	//路由数和最后修改时间，网关据此生成列表的 ETag，和 FindAllRoute 需要相同的权限
+	GetRouteCollectionVersion(ctx context.Context, in *FindAll, opts ...client.CallOption) (*RouteCollectionVersion, error)
+	//根据命名空间和名称删除
+	DeleteRouteByName(ctx context.Context, in *RouteName, opts ...client.CallOption) (*Response, error)
+	//命名空间默认配置，供管理员维护
+	AddNamespaceDefault(ctx context.Context, in *NamespaceDefaultInfo, opts ...client.CallOption) (*Response, error)
+	DeleteNamespaceDefault(ctx context.Context, in *NamespaceDefaultId, opts ...client.CallOption) (*Response, error)
+	UpdateNamespaceDefault(ctx context.Context, in *NamespaceDefaultInfo, opts ...client.CallOption) (*Response, error)
+	FindNamespaceDefaultByID(ctx context.Context, in *NamespaceDefaultId, opts ...client.CallOption) (*NamespaceDefaultInfo, error)
+	FindAllNamespaceDefault(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllNamespaceDefault, error)
+	//应用，把多个路由归为一组
+	AddApplication(ctx context.Context, in *ApplicationInfo, opts ...client.CallOption) (*Response, error)
+	DeleteApplication(ctx context.Context, in *ApplicationId, opts ...client.CallOption) (*Response, error)
+	UpdateApplication(ctx context.Context, in *ApplicationInfo, opts ...client.CallOption) (*Response, error)
+	FindApplicationByID(ctx context.Context, in *ApplicationId, opts ...client.CallOption) (*ApplicationInfo, error)
+	FindAllApplication(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllApplication, error)
+	DisableApplication(ctx context.Context, in *ApplicationId, opts ...client.CallOption) (*Response, error)
+	EnableApplication(ctx context.Context, in *ApplicationId, opts ...client.CallOption) (*Response, error)
+	ExportApplication(ctx context.Context, in *ApplicationId, opts ...client.CallOption) (*AllRoute, error)
+	//导出路由清单 CSV
+	ExportInventory(ctx context.Context, in *FindAll, opts ...client.CallOption) (*InventoryFile, error)
+	//导出命名空间中的路由为 Kustomize base 和 overlays 或 Helm chart，打包为 tar.gz，用于迁移到 GitOps 仓库；也可以导出为 Terraform JSON
+	ExportManifests(ctx context.Context, in *ExportManifestsRequest, opts ...client.CallOption) (*InventoryFile, error)
+	//按命名空间或团队统计每天的路由数、域名数和证书数，用于成本分摊，支持 JSON 和 CSV
+	GetUsageReport(ctx context.Context, in *UsageReportRequest, opts ...client.CallOption) (*UsageReport, error)
+	//路由事件记录
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...client.CallOption) (*AllEvent, error)
+	//集群能力探测，供界面按集群开关功能
+	GetClusterCapabilities(ctx context.Context, in *ClusterRequest, opts ...client.CallOption) (*ClusterCapabilities, error)
+	//Ingress 控制器的全局配置（如 ingress-nginx-controller ConfigMap），仅 global-admin 可以查看和修改，修改记录事件
+	GetControllerConfig(ctx context.Context, in *ControllerConfigRequest, opts ...client.CallOption) (*ControllerConfig, error)
+	PatchControllerConfig(ctx context.Context, in *ControllerConfigPatch, opts ...client.CallOption) (*ControllerConfig, error)
+	//Ingress 控制器的 Deployment/DaemonSet 副本状态、镜像版本和重启次数，用于排查写入失败
+	GetControllerInfo(ctx context.Context, in *ControllerInfoRequest, opts ...client.CallOption) (*ControllerInfo, error)
+	//变更冻结窗口，供管理员维护，窗口内的紧急变更需要携带 X-Emergency-Override: true，且操作人有 EmergencyOverride 的角色，未开启 RBAC 时不能跳过
+	AddFreezeWindow(ctx context.Context, in *FreezeWindowInfo, opts ...client.CallOption) (*Response, error)
+	DeleteFreezeWindow(ctx context.Context, in *FreezeWindowId, opts ...client.CallOption) (*Response, error)
+	UpdateFreezeWindow(ctx context.Context, in *FreezeWindowInfo, opts ...client.CallOption) (*Response, error)
+	FindAllFreezeWindow(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllFreezeWindow, error)
+	//保留域名，供管理员维护，其他命名空间使用需要设置 route_reserved_host_override 且有 global-admin 角色
+	AddReservedHost(ctx context.Context, in *ReservedHostInfo, opts ...client.CallOption) (*Response, error)
+	DeleteReservedHost(ctx context.Context, in *ReservedHostId, opts ...client.CallOption) (*Response, error)
+	UpdateReservedHost(ctx context.Context, in *ReservedHostInfo, opts ...client.CallOption) (*Response, error)
+	FindAllReservedHost(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllReservedHost, error)
+	//批量接管命名空间下匹配标签的 Ingress，用于从 kubectl 逐步迁移
+	AdoptIngresses(ctx context.Context, in *AdoptIngressesRequest, opts ...client.CallOption) (*AdoptIngressesResponse, error)
+	//交还手动管理，移除管理标签并归档记录，k8s中的资源保留
+	ReleaseRoute(ctx context.Context, in *RouteId, opts ...client.CallOption) (*Response, error)
+	//自检报告：数据库、注册中心、集群连通性和权限、生效的配置，排查启动问题
+	Diagnose(ctx context.Context, in *DiagnoseRequest, opts ...client.CallOption) (*DiagnoseReport, error)
+	//解析旧的 nginx 或 HAProxy 配置，转换为路由草稿供审核，不写入
+	ImportLegacyConfig(ctx context.Context, in *LegacyConfigRequest, opts ...client.CallOption) (*LegacyImportResult, error)
+	//转换 Ingress YAML 为路由规格，标出无法对应的字段，不写入
+	ConvertManifest(ctx context.Context, in *ConvertManifestRequest, opts ...client.CallOption) (*LegacyImportResult, error)
+	//检查路由规格，只返回警告不写入，供 CI 在合并前检查
+	LintRoute(ctx context.Context, in *RouteInfo, opts ...client.CallOption) (*LintResult, error)
+	//按当前的命名规则检查已有的路由，新增规则后用于找出需要改名的路由，创建时才校验命名规则
+	ListNamingViolations(ctx context.Context, in *NamingViolationsRequest, opts ...client.CallOption) (*NamingViolationReport, error)
+	//对比数据库中的期望规格和k8s中的线上资源，包括其他控制器设置的字段
+	DiffRoute(ctx context.Context, in *RouteId, opts ...client.CallOption) (*RouteDiff, error)
+	//按条件重新渲染并写入所有路由，如修改默认注解模板、升级控制器后，后台执行，通过 GetReapplyJob 查询进度
+	ReapplyAll(ctx context.Context, in *ReapplyFilter, opts ...client.CallOption) (*ReapplyJob, error)
+	GetReapplyJob(ctx context.Context, in *ReapplyJobId, opts ...client.CallOption) (*ReapplyJob, error)
+	//写入失败的路由按指数退避自动重试，超过次数后进入死信列表，排除问题后通过 RetryRoute 手动重试
+	ListFailedRoutes(ctx context.Context, in *FailedRoutesRequest, opts ...client.CallOption) (*AllRoute, error)
+	RetryRoute(ctx context.Context, in *RouteId, opts ...client.CallOption) (*Response, error)
+	//加密密钥轮换后用新的主密钥重新加密敏感字段，完成后才能删除旧密钥
+	ReencryptRoutes(ctx context.Context, in *FindAll, opts ...client.CallOption) (*ReencryptResult, error)
+	//注解模板，由管理员维护，渲染 Ingress 时替换变量后加入注解，修改后通过 ReapplyAll 生效
+	AddAnnotationTemplate(ctx context.Context, in *AnnotationTemplateInfo, opts ...client.CallOption) (*Response, error)
+	DeleteAnnotationTemplate(ctx context.Context, in *AnnotationTemplateId, opts ...client.CallOption) (*Response, error)
+	UpdateAnnotationTemplate(ctx context.Context, in *AnnotationTemplateInfo, opts ...client.CallOption) (*Response, error)
+	FindAllAnnotationTemplate(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllAnnotationTemplate, error)
+	//把路由晋级到目标环境（如 dev → staging → prod），按环境配置替换命名空间、域名、class 和证书签发者
+	PromoteRoute(ctx context.Context, in *PromoteRouteRequest, opts ...client.CallOption) (*PromoteRouteResponse, error)
+	//对比两个环境中的路由，列出缺少的路由、域名不一致和后端不一致
+	CompareEnvironments(ctx context.Context, in *CompareEnvironmentsRequest, opts ...client.CallOption) (*EnvironmentComparison, error)
+	//角色：viewer、editor、namespace-admin、global-admin
+	GrantRole(ctx context.Context, in *RoleBindingInfo, opts ...client.CallOption) (*Response, error)
+	RevokeRole(ctx context.Context, in *RoleBindingInfo, opts ...client.CallOption) (*Response, error)
+	ListBindings(ctx context.Context, in *ListBindingsRequest, opts ...client.CallOption) (*AllRoleBinding, error)
+	//API 密钥，通过 Authorization: Bearer <key> 或 X-Api-Key 传入
+	CreateAPIKey(ctx context.Context, in *APIKeyInfo, opts ...client.CallOption) (*CreateAPIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, in *APIKeyId, opts ...client.CallOption) (*Response, error)
+	ListAPIKeys(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllAPIKey, error)
+	//命名空间配额：路由总数、域名数、每天创建数，由管理员设置，创建路由时检查
+	SetQuota(ctx context.Context, in *QuotaInfo, opts ...client.CallOption) (*Response, error)
+	DeleteQuota(ctx context.Context, in *QuotaInfo, opts ...client.CallOption) (*Response, error)
+	ListQuotas(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllQuota, error)
+	GetQuotaUsage(ctx context.Context, in *QuotaUsageRequest, opts ...client.CallOption) (*QuotaUsage, error)
 }
 
 type routeService struct {
@@ -106,6 +200,665 @@ func (c *routeService) FindAllRoute(ctx context.Context, in *FindAll, opts ...cl
 	return out, nil
 }
 
+func (c *routeService) SearchRoutes(ctx context.Context, in *SearchRoutesRequest, opts ...client.CallOption) (*AllRoute, error) {
+	req := c.c.NewRequest(c.name, "Route.SearchRoutes", in)
+	out := new(AllRoute)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) StreamAllRoutes(ctx context.Context, in *FindAll, opts ...client.CallOption) (Route_StreamAllRoutesService, error) {
+	req := c.c.NewRequest(c.name, "Route.StreamAllRoutes", &FindAll{})
+	stream, err := c.c.Stream(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(in); err != nil {
+		return nil, err
+	}
+	return &routeServiceStreamAllRoutes{stream}, nil
+}
+
+type Route_StreamAllRoutesService interface {
+	Context() context.Context
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Recv() (*RouteInfo, error)
+}
+
+type routeServiceStreamAllRoutes struct {
+	stream client.Stream
+}
+
+func (x *routeServiceStreamAllRoutes) Close() error {
+	return x.stream.Close()
+}
+
+func (x *routeServiceStreamAllRoutes) Context() context.Context {
+	return x.stream.Context()
+}
+
+func (x *routeServiceStreamAllRoutes) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *routeServiceStreamAllRoutes) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *routeServiceStreamAllRoutes) Recv() (*RouteInfo, error) {
+	m := new(RouteInfo)
+	err := x.stream.Recv(m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routeService) GetRouteCollectionVersion(ctx context.Context, in *FindAll, opts ...client.CallOption) (*RouteCollectionVersion, error) {
+	req := c.c.NewRequest(c.name, "Route.GetRouteCollectionVersion", in)
+	out := new(RouteCollectionVersion)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) DeleteRouteByName(ctx context.Context, in *RouteName, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.DeleteRouteByName", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) AddNamespaceDefault(ctx context.Context, in *NamespaceDefaultInfo, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.AddNamespaceDefault", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) DeleteNamespaceDefault(ctx context.Context, in *NamespaceDefaultId, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.DeleteNamespaceDefault", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) UpdateNamespaceDefault(ctx context.Context, in *NamespaceDefaultInfo, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.UpdateNamespaceDefault", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) FindNamespaceDefaultByID(ctx context.Context, in *NamespaceDefaultId, opts ...client.CallOption) (*NamespaceDefaultInfo, error) {
+	req := c.c.NewRequest(c.name, "Route.FindNamespaceDefaultByID", in)
+	out := new(NamespaceDefaultInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) FindAllNamespaceDefault(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllNamespaceDefault, error) {
+	req := c.c.NewRequest(c.name, "Route.FindAllNamespaceDefault", in)
+	out := new(AllNamespaceDefault)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) AddApplication(ctx context.Context, in *ApplicationInfo, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.AddApplication", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) DeleteApplication(ctx context.Context, in *ApplicationId, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.DeleteApplication", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) UpdateApplication(ctx context.Context, in *ApplicationInfo, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.UpdateApplication", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) FindApplicationByID(ctx context.Context, in *ApplicationId, opts ...client.CallOption) (*ApplicationInfo, error) {
+	req := c.c.NewRequest(c.name, "Route.FindApplicationByID", in)
+	out := new(ApplicationInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) FindAllApplication(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllApplication, error) {
+	req := c.c.NewRequest(c.name, "Route.FindAllApplication", in)
+	out := new(AllApplication)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) DisableApplication(ctx context.Context, in *ApplicationId, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.DisableApplication", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) EnableApplication(ctx context.Context, in *ApplicationId, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.EnableApplication", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ExportApplication(ctx context.Context, in *ApplicationId, opts ...client.CallOption) (*AllRoute, error) {
+	req := c.c.NewRequest(c.name, "Route.ExportApplication", in)
+	out := new(AllRoute)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ExportInventory(ctx context.Context, in *FindAll, opts ...client.CallOption) (*InventoryFile, error) {
+	req := c.c.NewRequest(c.name, "Route.ExportInventory", in)
+	out := new(InventoryFile)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ExportManifests(ctx context.Context, in *ExportManifestsRequest, opts ...client.CallOption) (*InventoryFile, error) {
+	req := c.c.NewRequest(c.name, "Route.ExportManifests", in)
+	out := new(InventoryFile)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) GetUsageReport(ctx context.Context, in *UsageReportRequest, opts ...client.CallOption) (*UsageReport, error) {
+	req := c.c.NewRequest(c.name, "Route.GetUsageReport", in)
+	out := new(UsageReport)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...client.CallOption) (*AllEvent, error) {
+	req := c.c.NewRequest(c.name, "Route.ListEvents", in)
+	out := new(AllEvent)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) GetClusterCapabilities(ctx context.Context, in *ClusterRequest, opts ...client.CallOption) (*ClusterCapabilities, error) {
+	req := c.c.NewRequest(c.name, "Route.GetClusterCapabilities", in)
+	out := new(ClusterCapabilities)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) GetControllerConfig(ctx context.Context, in *ControllerConfigRequest, opts ...client.CallOption) (*ControllerConfig, error) {
+	req := c.c.NewRequest(c.name, "Route.GetControllerConfig", in)
+	out := new(ControllerConfig)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) PatchControllerConfig(ctx context.Context, in *ControllerConfigPatch, opts ...client.CallOption) (*ControllerConfig, error) {
+	req := c.c.NewRequest(c.name, "Route.PatchControllerConfig", in)
+	out := new(ControllerConfig)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) GetControllerInfo(ctx context.Context, in *ControllerInfoRequest, opts ...client.CallOption) (*ControllerInfo, error) {
+	req := c.c.NewRequest(c.name, "Route.GetControllerInfo", in)
+	out := new(ControllerInfo)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) AddFreezeWindow(ctx context.Context, in *FreezeWindowInfo, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.AddFreezeWindow", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) DeleteFreezeWindow(ctx context.Context, in *FreezeWindowId, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.DeleteFreezeWindow", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) UpdateFreezeWindow(ctx context.Context, in *FreezeWindowInfo, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.UpdateFreezeWindow", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) FindAllFreezeWindow(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllFreezeWindow, error) {
+	req := c.c.NewRequest(c.name, "Route.FindAllFreezeWindow", in)
+	out := new(AllFreezeWindow)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) AddReservedHost(ctx context.Context, in *ReservedHostInfo, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.AddReservedHost", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) DeleteReservedHost(ctx context.Context, in *ReservedHostId, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.DeleteReservedHost", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) UpdateReservedHost(ctx context.Context, in *ReservedHostInfo, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.UpdateReservedHost", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) FindAllReservedHost(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllReservedHost, error) {
+	req := c.c.NewRequest(c.name, "Route.FindAllReservedHost", in)
+	out := new(AllReservedHost)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) AdoptIngresses(ctx context.Context, in *AdoptIngressesRequest, opts ...client.CallOption) (*AdoptIngressesResponse, error) {
+	req := c.c.NewRequest(c.name, "Route.AdoptIngresses", in)
+	out := new(AdoptIngressesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ReleaseRoute(ctx context.Context, in *RouteId, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.ReleaseRoute", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) Diagnose(ctx context.Context, in *DiagnoseRequest, opts ...client.CallOption) (*DiagnoseReport, error) {
+	req := c.c.NewRequest(c.name, "Route.Diagnose", in)
+	out := new(DiagnoseReport)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ImportLegacyConfig(ctx context.Context, in *LegacyConfigRequest, opts ...client.CallOption) (*LegacyImportResult, error) {
+	req := c.c.NewRequest(c.name, "Route.ImportLegacyConfig", in)
+	out := new(LegacyImportResult)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ConvertManifest(ctx context.Context, in *ConvertManifestRequest, opts ...client.CallOption) (*LegacyImportResult, error) {
+	req := c.c.NewRequest(c.name, "Route.ConvertManifest", in)
+	out := new(LegacyImportResult)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) LintRoute(ctx context.Context, in *RouteInfo, opts ...client.CallOption) (*LintResult, error) {
+	req := c.c.NewRequest(c.name, "Route.LintRoute", in)
+	out := new(LintResult)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ListNamingViolations(ctx context.Context, in *NamingViolationsRequest, opts ...client.CallOption) (*NamingViolationReport, error) {
+	req := c.c.NewRequest(c.name, "Route.ListNamingViolations", in)
+	out := new(NamingViolationReport)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) DiffRoute(ctx context.Context, in *RouteId, opts ...client.CallOption) (*RouteDiff, error) {
+	req := c.c.NewRequest(c.name, "Route.DiffRoute", in)
+	out := new(RouteDiff)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ReapplyAll(ctx context.Context, in *ReapplyFilter, opts ...client.CallOption) (*ReapplyJob, error) {
+	req := c.c.NewRequest(c.name, "Route.ReapplyAll", in)
+	out := new(ReapplyJob)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) GetReapplyJob(ctx context.Context, in *ReapplyJobId, opts ...client.CallOption) (*ReapplyJob, error) {
+	req := c.c.NewRequest(c.name, "Route.GetReapplyJob", in)
+	out := new(ReapplyJob)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ListFailedRoutes(ctx context.Context, in *FailedRoutesRequest, opts ...client.CallOption) (*AllRoute, error) {
+	req := c.c.NewRequest(c.name, "Route.ListFailedRoutes", in)
+	out := new(AllRoute)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) RetryRoute(ctx context.Context, in *RouteId, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.RetryRoute", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ReencryptRoutes(ctx context.Context, in *FindAll, opts ...client.CallOption) (*ReencryptResult, error) {
+	req := c.c.NewRequest(c.name, "Route.ReencryptRoutes", in)
+	out := new(ReencryptResult)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) AddAnnotationTemplate(ctx context.Context, in *AnnotationTemplateInfo, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.AddAnnotationTemplate", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) DeleteAnnotationTemplate(ctx context.Context, in *AnnotationTemplateId, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.DeleteAnnotationTemplate", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) UpdateAnnotationTemplate(ctx context.Context, in *AnnotationTemplateInfo, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.UpdateAnnotationTemplate", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) FindAllAnnotationTemplate(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllAnnotationTemplate, error) {
+	req := c.c.NewRequest(c.name, "Route.FindAllAnnotationTemplate", in)
+	out := new(AllAnnotationTemplate)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) PromoteRoute(ctx context.Context, in *PromoteRouteRequest, opts ...client.CallOption) (*PromoteRouteResponse, error) {
+	req := c.c.NewRequest(c.name, "Route.PromoteRoute", in)
+	out := new(PromoteRouteResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) CompareEnvironments(ctx context.Context, in *CompareEnvironmentsRequest, opts ...client.CallOption) (*EnvironmentComparison, error) {
+	req := c.c.NewRequest(c.name, "Route.CompareEnvironments", in)
+	out := new(EnvironmentComparison)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) GrantRole(ctx context.Context, in *RoleBindingInfo, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.GrantRole", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) RevokeRole(ctx context.Context, in *RoleBindingInfo, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.RevokeRole", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ListBindings(ctx context.Context, in *ListBindingsRequest, opts ...client.CallOption) (*AllRoleBinding, error) {
+	req := c.c.NewRequest(c.name, "Route.ListBindings", in)
+	out := new(AllRoleBinding)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) CreateAPIKey(ctx context.Context, in *APIKeyInfo, opts ...client.CallOption) (*CreateAPIKeyResponse, error) {
+	req := c.c.NewRequest(c.name, "Route.CreateAPIKey", in)
+	out := new(CreateAPIKeyResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) RevokeAPIKey(ctx context.Context, in *APIKeyId, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.RevokeAPIKey", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ListAPIKeys(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllAPIKey, error) {
+	req := c.c.NewRequest(c.name, "Route.ListAPIKeys", in)
+	out := new(AllAPIKey)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) SetQuota(ctx context.Context, in *QuotaInfo, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.SetQuota", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) DeleteQuota(ctx context.Context, in *QuotaInfo, opts ...client.CallOption) (*Response, error) {
+	req := c.c.NewRequest(c.name, "Route.DeleteQuota", in)
+	out := new(Response)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) ListQuotas(ctx context.Context, in *FindAll, opts ...client.CallOption) (*AllQuota, error) {
+	req := c.c.NewRequest(c.name, "Route.ListQuotas", in)
+	out := new(AllQuota)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeService) GetQuotaUsage(ctx context.Context, in *QuotaUsageRequest, opts ...client.CallOption) (*QuotaUsage, error) {
+	req := c.c.NewRequest(c.name, "Route.GetQuotaUsage", in)
+	out := new(QuotaUsage)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Route service
 
 type RouteHandler interface {
@@ -115,6 +868,100 @@ type RouteHandler interface {
 	UpdateRoute(context.Context, *RouteInfo, *Response) error
 	FindRouteByID(context.Context, *RouteId, *RouteInfo) error
 	FindAllRoute(context.Context, *FindAll, *AllRoute) error
+	//按条件查询路由，按游标分页，翻页期间有路由创建或删除时不会跳过或重复
+	SearchRoutes(context.Context, *SearchRoutesRequest, *AllRoute) error
+	//逐条返回全部路由，用于导出等超过消息大小限制的场景，支持 fields，不支持分页参数
+	StreamAllRoutes(context.Context, *FindAll, Route_StreamAllRoutesStream) error
+	//路由数和最后修改时间，网关据此生成列表的 ETag，和 FindAllRoute 需要相同的权限
+	GetRouteCollectionVersion(context.Context, *FindAll, *RouteCollectionVersion) error
+	//根据命名空间和名称删除
+	DeleteRouteByName(context.Context, *RouteName, *Response) error
+	//命名空间默认配置，供管理员维护
+	AddNamespaceDefault(context.Context, *NamespaceDefaultInfo, *Response) error
+	DeleteNamespaceDefault(context.Context, *NamespaceDefaultId, *Response) error
+	UpdateNamespaceDefault(context.Context, *NamespaceDefaultInfo, *Response) error
+	FindNamespaceDefaultByID(context.Context, *NamespaceDefaultId, *NamespaceDefaultInfo) error
+	FindAllNamespaceDefault(context.Context, *FindAll, *AllNamespaceDefault) error
+	//应用，把多个路由归为一组
+	AddApplication(context.Context, *ApplicationInfo, *Response) error
+	DeleteApplication(context.Context, *ApplicationId, *Response) error
+	UpdateApplication(context.Context, *ApplicationInfo, *Response) error
+	FindApplicationByID(context.Context, *ApplicationId, *ApplicationInfo) error
+	FindAllApplication(context.Context, *FindAll, *AllApplication) error
+	DisableApplication(context.Context, *ApplicationId, *Response) error
+	EnableApplication(context.Context, *ApplicationId, *Response) error
+	ExportApplication(context.Context, *ApplicationId, *AllRoute) error
+	//导出路由清单 CSV
+	ExportInventory(context.Context, *FindAll, *InventoryFile) error
+	//导出命名空间中的路由为 Kustomize base 和 overlays 或 Helm chart，打包为 tar.gz，用于迁移到 GitOps 仓库；也可以导出为 Terraform JSON
+	ExportManifests(context.Context, *ExportManifestsRequest, *InventoryFile) error
+	//按命名空间或团队统计每天的路由数、域名数和证书数，用于成本分摊，支持 JSON 和 CSV
+	GetUsageReport(context.Context, *UsageReportRequest, *UsageReport) error
+	//路由事件记录
+	ListEvents(context.Context, *ListEventsRequest, *AllEvent) error
+	//集群能力探测，供界面按集群开关功能
+	GetClusterCapabilities(context.Context, *ClusterRequest, *ClusterCapabilities) error
+	//Ingress 控制器的全局配置（如 ingress-nginx-controller ConfigMap），仅 global-admin 可以查看和修改，修改记录事件
+	GetControllerConfig(context.Context, *ControllerConfigRequest, *ControllerConfig) error
+	PatchControllerConfig(context.Context, *ControllerConfigPatch, *ControllerConfig) error
+	//Ingress 控制器的 Deployment/DaemonSet 副本状态、镜像版本和重启次数，用于排查写入失败
+	GetControllerInfo(context.Context, *ControllerInfoRequest, *ControllerInfo) error
+	//变更冻结窗口，供管理员维护，窗口内的紧急变更需要携带 X-Emergency-Override: true，且操作人有 EmergencyOverride 的角色，未开启 RBAC 时不能跳过
+	AddFreezeWindow(context.Context, *FreezeWindowInfo, *Response) error
+	DeleteFreezeWindow(context.Context, *FreezeWindowId, *Response) error
+	UpdateFreezeWindow(context.Context, *FreezeWindowInfo, *Response) error
+	FindAllFreezeWindow(context.Context, *FindAll, *AllFreezeWindow) error
+	//保留域名，供管理员维护，其他命名空间使用需要设置 route_reserved_host_override 且有 global-admin 角色
+	AddReservedHost(context.Context, *ReservedHostInfo, *Response) error
+	DeleteReservedHost(context.Context, *ReservedHostId, *Response) error
+	UpdateReservedHost(context.Context, *ReservedHostInfo, *Response) error
+	FindAllReservedHost(context.Context, *FindAll, *AllReservedHost) error
+	//批量接管命名空间下匹配标签的 Ingress，用于从 kubectl 逐步迁移
+	AdoptIngresses(context.Context, *AdoptIngressesRequest, *AdoptIngressesResponse) error
+	//交还手动管理，移除管理标签并归档记录，k8s中的资源保留
+	ReleaseRoute(context.Context, *RouteId, *Response) error
+	//自检报告：数据库、注册中心、集群连通性和权限、生效的配置，排查启动问题
+	Diagnose(context.Context, *DiagnoseRequest, *DiagnoseReport) error
+	//解析旧的 nginx 或 HAProxy 配置，转换为路由草稿供审核，不写入
+	ImportLegacyConfig(context.Context, *LegacyConfigRequest, *LegacyImportResult) error
+	//转换 Ingress YAML 为路由规格，标出无法对应的字段，不写入
+	ConvertManifest(context.Context, *ConvertManifestRequest, *LegacyImportResult) error
+	//检查路由规格，只返回警告不写入，供 CI 在合并前检查
+	LintRoute(context.Context, *RouteInfo, *LintResult) error
+	//按当前的命名规则检查已有的路由，新增规则后用于找出需要改名的路由，创建时才校验命名规则
+	ListNamingViolations(context.Context, *NamingViolationsRequest, *NamingViolationReport) error
+	//对比数据库中的期望规格和k8s中的线上资源，包括其他控制器设置的字段
+	DiffRoute(context.Context, *RouteId, *RouteDiff) error
+	//按条件重新渲染并写入所有路由，如修改默认注解模板、升级控制器后，后台执行，通过 GetReapplyJob 查询进度
+	ReapplyAll(context.Context, *ReapplyFilter, *ReapplyJob) error
+	GetReapplyJob(context.Context, *ReapplyJobId, *ReapplyJob) error
+	//写入失败的路由按指数退避自动重试，超过次数后进入死信列表，排除问题后通过 RetryRoute 手动重试
+	ListFailedRoutes(context.Context, *FailedRoutesRequest, *AllRoute) error
+	RetryRoute(context.Context, *RouteId, *Response) error
+	//加密密钥轮换后用新的主密钥重新加密敏感字段，完成后才能删除旧密钥
+	ReencryptRoutes(context.Context, *FindAll, *ReencryptResult) error
+	//注解模板，由管理员维护，渲染 Ingress 时替换变量后加入注解，修改后通过 ReapplyAll 生效
+	AddAnnotationTemplate(context.Context, *AnnotationTemplateInfo, *Response) error
+	DeleteAnnotationTemplate(context.Context, *AnnotationTemplateId, *Response) error
+	UpdateAnnotationTemplate(context.Context, *AnnotationTemplateInfo, *Response) error
+	FindAllAnnotationTemplate(context.Context, *FindAll, *AllAnnotationTemplate) error
+	//把路由晋级到目标环境（如 dev → staging → prod），按环境配置替换命名空间、域名、class 和证书签发者
+	PromoteRoute(context.Context, *PromoteRouteRequest, *PromoteRouteResponse) error
+	//对比两个环境中的路由，列出缺少的路由、域名不一致和后端不一致
+	CompareEnvironments(context.Context, *CompareEnvironmentsRequest, *EnvironmentComparison) error
+	//角色：viewer、editor、namespace-admin、global-admin
+	GrantRole(context.Context, *RoleBindingInfo, *Response) error
+	RevokeRole(context.Context, *RoleBindingInfo, *Response) error
+	ListBindings(context.Context, *ListBindingsRequest, *AllRoleBinding) error
+	//API 密钥，通过 Authorization: Bearer <key> 或 X-Api-Key 传入
+	CreateAPIKey(context.Context, *APIKeyInfo, *CreateAPIKeyResponse) error
+	RevokeAPIKey(context.Context, *APIKeyId, *Response) error
+	ListAPIKeys(context.Context, *FindAll, *AllAPIKey) error
+	//命名空间配额：路由总数、域名数、每天创建数，由管理员设置，创建路由时检查
+	SetQuota(context.Context, *QuotaInfo, *Response) error
+	DeleteQuota(context.Context, *QuotaInfo, *Response) error
+	ListQuotas(context.Context, *FindAll, *AllQuota) error
+	GetQuotaUsage(context.Context, *QuotaUsageRequest, *QuotaUsage) error
 }
 
 func RegisterRouteHandler(s server.Server, hdlr RouteHandler, opts ...server.HandlerOption) error {
@@ -124,6 +971,68 @@ func RegisterRouteHandler(s server.Server, hdlr RouteHandler, opts ...server.Han
 		UpdateRoute(ctx context.Context, in *RouteInfo, out *Response) error
 		FindRouteByID(ctx context.Context, in *RouteId, out *RouteInfo) error
 		FindAllRoute(ctx context.Context, in *FindAll, out *AllRoute) error
+		SearchRoutes(ctx context.Context, in *SearchRoutesRequest, out *AllRoute) error
+		StreamAllRoutes(ctx context.Context, stream server.Stream) error
+		GetRouteCollectionVersion(ctx context.Context, in *FindAll, out *RouteCollectionVersion) error
+		DeleteRouteByName(ctx context.Context, in *RouteName, out *Response) error
+		AddNamespaceDefault(ctx context.Context, in *NamespaceDefaultInfo, out *Response) error
+		DeleteNamespaceDefault(ctx context.Context, in *NamespaceDefaultId, out *Response) error
+		UpdateNamespaceDefault(ctx context.Context, in *NamespaceDefaultInfo, out *Response) error
+		FindNamespaceDefaultByID(ctx context.Context, in *NamespaceDefaultId, out *NamespaceDefaultInfo) error
+		FindAllNamespaceDefault(ctx context.Context, in *FindAll, out *AllNamespaceDefault) error
+		AddApplication(ctx context.Context, in *ApplicationInfo, out *Response) error
+		DeleteApplication(ctx context.Context, in *ApplicationId, out *Response) error
+		UpdateApplication(ctx context.Context, in *ApplicationInfo, out *Response) error
+		FindApplicationByID(ctx context.Context, in *ApplicationId, out *ApplicationInfo) error
+		FindAllApplication(ctx context.Context, in *FindAll, out *AllApplication) error
+		DisableApplication(ctx context.Context, in *ApplicationId, out *Response) error
+		EnableApplication(ctx context.Context, in *ApplicationId, out *Response) error
+		ExportApplication(ctx context.Context, in *ApplicationId, out *AllRoute) error
+		ExportInventory(ctx context.Context, in *FindAll, out *InventoryFile) error
+		ExportManifests(ctx context.Context, in *ExportManifestsRequest, out *InventoryFile) error
+		GetUsageReport(ctx context.Context, in *UsageReportRequest, out *UsageReport) error
+		ListEvents(ctx context.Context, in *ListEventsRequest, out *AllEvent) error
+		GetClusterCapabilities(ctx context.Context, in *ClusterRequest, out *ClusterCapabilities) error
+		GetControllerConfig(ctx context.Context, in *ControllerConfigRequest, out *ControllerConfig) error
+		PatchControllerConfig(ctx context.Context, in *ControllerConfigPatch, out *ControllerConfig) error
+		GetControllerInfo(ctx context.Context, in *ControllerInfoRequest, out *ControllerInfo) error
+		AddFreezeWindow(ctx context.Context, in *FreezeWindowInfo, out *Response) error
+		DeleteFreezeWindow(ctx context.Context, in *FreezeWindowId, out *Response) error
+		UpdateFreezeWindow(ctx context.Context, in *FreezeWindowInfo, out *Response) error
+		FindAllFreezeWindow(ctx context.Context, in *FindAll, out *AllFreezeWindow) error
+		AddReservedHost(ctx context.Context, in *ReservedHostInfo, out *Response) error
+		DeleteReservedHost(ctx context.Context, in *ReservedHostId, out *Response) error
+		UpdateReservedHost(ctx context.Context, in *ReservedHostInfo, out *Response) error
+		FindAllReservedHost(ctx context.Context, in *FindAll, out *AllReservedHost) error
+		AdoptIngresses(ctx context.Context, in *AdoptIngressesRequest, out *AdoptIngressesResponse) error
+		ReleaseRoute(ctx context.Context, in *RouteId, out *Response) error
+		Diagnose(ctx context.Context, in *DiagnoseRequest, out *DiagnoseReport) error
+		ImportLegacyConfig(ctx context.Context, in *LegacyConfigRequest, out *LegacyImportResult) error
+		ConvertManifest(ctx context.Context, in *ConvertManifestRequest, out *LegacyImportResult) error
+		LintRoute(ctx context.Context, in *RouteInfo, out *LintResult) error
+		ListNamingViolations(ctx context.Context, in *NamingViolationsRequest, out *NamingViolationReport) error
+		DiffRoute(ctx context.Context, in *RouteId, out *RouteDiff) error
+		ReapplyAll(ctx context.Context, in *ReapplyFilter, out *ReapplyJob) error
+		GetReapplyJob(ctx context.Context, in *ReapplyJobId, out *ReapplyJob) error
+		ListFailedRoutes(ctx context.Context, in *FailedRoutesRequest, out *AllRoute) error
+		RetryRoute(ctx context.Context, in *RouteId, out *Response) error
+		ReencryptRoutes(ctx context.Context, in *FindAll, out *ReencryptResult) error
+		AddAnnotationTemplate(ctx context.Context, in *AnnotationTemplateInfo, out *Response) error
+		DeleteAnnotationTemplate(ctx context.Context, in *AnnotationTemplateId, out *Response) error
+		UpdateAnnotationTemplate(ctx context.Context, in *AnnotationTemplateInfo, out *Response) error
+		FindAllAnnotationTemplate(ctx context.Context, in *FindAll, out *AllAnnotationTemplate) error
+		PromoteRoute(ctx context.Context, in *PromoteRouteRequest, out *PromoteRouteResponse) error
+		CompareEnvironments(ctx context.Context, in *CompareEnvironmentsRequest, out *EnvironmentComparison) error
+		GrantRole(ctx context.Context, in *RoleBindingInfo, out *Response) error
+		RevokeRole(ctx context.Context, in *RoleBindingInfo, out *Response) error
+		ListBindings(ctx context.Context, in *ListBindingsRequest, out *AllRoleBinding) error
+		CreateAPIKey(ctx context.Context, in *APIKeyInfo, out *CreateAPIKeyResponse) error
+		RevokeAPIKey(ctx context.Context, in *APIKeyId, out *Response) error
+		ListAPIKeys(ctx context.Context, in *FindAll, out *AllAPIKey) error
+		SetQuota(ctx context.Context, in *QuotaInfo, out *Response) error
+		DeleteQuota(ctx context.Context, in *QuotaInfo, out *Response) error
+		ListQuotas(ctx context.Context, in *FindAll, out *AllQuota) error
+		GetQuotaUsage(ctx context.Context, in *QuotaUsageRequest, out *QuotaUsage) error
 	}
 	type Route struct {
 		route
@@ -155,3 +1064,287 @@ func (h *routeHandler) FindRouteByID(ctx context.Context, in *RouteId, out *Rout
 func (h *routeHandler) FindAllRoute(ctx context.Context, in *FindAll, out *AllRoute) error {
 	return h.RouteHandler.FindAllRoute(ctx, in, out)
 }
+
+func (h *routeHandler) SearchRoutes(ctx context.Context, in *SearchRoutesRequest, out *AllRoute) error {
+	return h.RouteHandler.SearchRoutes(ctx, in, out)
+}
+
+func (h *routeHandler) StreamAllRoutes(ctx context.Context, stream server.Stream) error {
+	m := new(FindAll)
+	if err := stream.Recv(m); err != nil {
+		return err
+	}
+	return h.RouteHandler.StreamAllRoutes(ctx, m, &routeStreamAllRoutesStream{stream})
+}
+
+type Route_StreamAllRoutesStream interface {
+	Context() context.Context
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Send(*RouteInfo) error
+}
+
+type routeStreamAllRoutesStream struct {
+	stream server.Stream
+}
+
+func (x *routeStreamAllRoutesStream) Close() error {
+	return x.stream.Close()
+}
+
+func (x *routeStreamAllRoutesStream) Context() context.Context {
+	return x.stream.Context()
+}
+
+func (x *routeStreamAllRoutesStream) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *routeStreamAllRoutesStream) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *routeStreamAllRoutesStream) Send(m *RouteInfo) error {
+	return x.stream.Send(m)
+}
+
+func (h *routeHandler) GetRouteCollectionVersion(ctx context.Context, in *FindAll, out *RouteCollectionVersion) error {
+	return h.RouteHandler.GetRouteCollectionVersion(ctx, in, out)
+}
+
+func (h *routeHandler) DeleteRouteByName(ctx context.Context, in *RouteName, out *Response) error {
+	return h.RouteHandler.DeleteRouteByName(ctx, in, out)
+}
+
+func (h *routeHandler) AddNamespaceDefault(ctx context.Context, in *NamespaceDefaultInfo, out *Response) error {
+	return h.RouteHandler.AddNamespaceDefault(ctx, in, out)
+}
+
+func (h *routeHandler) DeleteNamespaceDefault(ctx context.Context, in *NamespaceDefaultId, out *Response) error {
+	return h.RouteHandler.DeleteNamespaceDefault(ctx, in, out)
+}
+
+func (h *routeHandler) UpdateNamespaceDefault(ctx context.Context, in *NamespaceDefaultInfo, out *Response) error {
+	return h.RouteHandler.UpdateNamespaceDefault(ctx, in, out)
+}
+
+func (h *routeHandler) FindNamespaceDefaultByID(ctx context.Context, in *NamespaceDefaultId, out *NamespaceDefaultInfo) error {
+	return h.RouteHandler.FindNamespaceDefaultByID(ctx, in, out)
+}
+
+func (h *routeHandler) FindAllNamespaceDefault(ctx context.Context, in *FindAll, out *AllNamespaceDefault) error {
+	return h.RouteHandler.FindAllNamespaceDefault(ctx, in, out)
+}
+
+func (h *routeHandler) AddApplication(ctx context.Context, in *ApplicationInfo, out *Response) error {
+	return h.RouteHandler.AddApplication(ctx, in, out)
+}
+
+func (h *routeHandler) DeleteApplication(ctx context.Context, in *ApplicationId, out *Response) error {
+	return h.RouteHandler.DeleteApplication(ctx, in, out)
+}
+
+func (h *routeHandler) UpdateApplication(ctx context.Context, in *ApplicationInfo, out *Response) error {
+	return h.RouteHandler.UpdateApplication(ctx, in, out)
+}
+
+func (h *routeHandler) FindApplicationByID(ctx context.Context, in *ApplicationId, out *ApplicationInfo) error {
+	return h.RouteHandler.FindApplicationByID(ctx, in, out)
+}
+
+func (h *routeHandler) FindAllApplication(ctx context.Context, in *FindAll, out *AllApplication) error {
+	return h.RouteHandler.FindAllApplication(ctx, in, out)
+}
+
+func (h *routeHandler) DisableApplication(ctx context.Context, in *ApplicationId, out *Response) error {
+	return h.RouteHandler.DisableApplication(ctx, in, out)
+}
+
+func (h *routeHandler) EnableApplication(ctx context.Context, in *ApplicationId, out *Response) error {
+	return h.RouteHandler.EnableApplication(ctx, in, out)
+}
+
+func (h *routeHandler) ExportApplication(ctx context.Context, in *ApplicationId, out *AllRoute) error {
+	return h.RouteHandler.ExportApplication(ctx, in, out)
+}
+
+func (h *routeHandler) ExportInventory(ctx context.Context, in *FindAll, out *InventoryFile) error {
+	return h.RouteHandler.ExportInventory(ctx, in, out)
+}
+
+func (h *routeHandler) ExportManifests(ctx context.Context, in *ExportManifestsRequest, out *InventoryFile) error {
+	return h.RouteHandler.ExportManifests(ctx, in, out)
+}
+
+func (h *routeHandler) GetUsageReport(ctx context.Context, in *UsageReportRequest, out *UsageReport) error {
+	return h.RouteHandler.GetUsageReport(ctx, in, out)
+}
+
+func (h *routeHandler) ListEvents(ctx context.Context, in *ListEventsRequest, out *AllEvent) error {
+	return h.RouteHandler.ListEvents(ctx, in, out)
+}
+
+func (h *routeHandler) GetClusterCapabilities(ctx context.Context, in *ClusterRequest, out *ClusterCapabilities) error {
+	return h.RouteHandler.GetClusterCapabilities(ctx, in, out)
+}
+
+func (h *routeHandler) GetControllerConfig(ctx context.Context, in *ControllerConfigRequest, out *ControllerConfig) error {
+	return h.RouteHandler.GetControllerConfig(ctx, in, out)
+}
+
+func (h *routeHandler) PatchControllerConfig(ctx context.Context, in *ControllerConfigPatch, out *ControllerConfig) error {
+	return h.RouteHandler.PatchControllerConfig(ctx, in, out)
+}
+
+func (h *routeHandler) GetControllerInfo(ctx context.Context, in *ControllerInfoRequest, out *ControllerInfo) error {
+	return h.RouteHandler.GetControllerInfo(ctx, in, out)
+}
+
+func (h *routeHandler) AddFreezeWindow(ctx context.Context, in *FreezeWindowInfo, out *Response) error {
+	return h.RouteHandler.AddFreezeWindow(ctx, in, out)
+}
+
+func (h *routeHandler) DeleteFreezeWindow(ctx context.Context, in *FreezeWindowId, out *Response) error {
+	return h.RouteHandler.DeleteFreezeWindow(ctx, in, out)
+}
+
+func (h *routeHandler) UpdateFreezeWindow(ctx context.Context, in *FreezeWindowInfo, out *Response) error {
+	return h.RouteHandler.UpdateFreezeWindow(ctx, in, out)
+}
+
+func (h *routeHandler) FindAllFreezeWindow(ctx context.Context, in *FindAll, out *AllFreezeWindow) error {
+	return h.RouteHandler.FindAllFreezeWindow(ctx, in, out)
+}
+
+func (h *routeHandler) AddReservedHost(ctx context.Context, in *ReservedHostInfo, out *Response) error {
+	return h.RouteHandler.AddReservedHost(ctx, in, out)
+}
+
+func (h *routeHandler) DeleteReservedHost(ctx context.Context, in *ReservedHostId, out *Response) error {
+	return h.RouteHandler.DeleteReservedHost(ctx, in, out)
+}
+
+func (h *routeHandler) UpdateReservedHost(ctx context.Context, in *ReservedHostInfo, out *Response) error {
+	return h.RouteHandler.UpdateReservedHost(ctx, in, out)
+}
+
+func (h *routeHandler) FindAllReservedHost(ctx context.Context, in *FindAll, out *AllReservedHost) error {
+	return h.RouteHandler.FindAllReservedHost(ctx, in, out)
+}
+
+func (h *routeHandler) AdoptIngresses(ctx context.Context, in *AdoptIngressesRequest, out *AdoptIngressesResponse) error {
+	return h.RouteHandler.AdoptIngresses(ctx, in, out)
+}
+
+func (h *routeHandler) ReleaseRoute(ctx context.Context, in *RouteId, out *Response) error {
+	return h.RouteHandler.ReleaseRoute(ctx, in, out)
+}
+
+func (h *routeHandler) Diagnose(ctx context.Context, in *DiagnoseRequest, out *DiagnoseReport) error {
+	return h.RouteHandler.Diagnose(ctx, in, out)
+}
+
+func (h *routeHandler) ImportLegacyConfig(ctx context.Context, in *LegacyConfigRequest, out *LegacyImportResult) error {
+	return h.RouteHandler.ImportLegacyConfig(ctx, in, out)
+}
+
+func (h *routeHandler) ConvertManifest(ctx context.Context, in *ConvertManifestRequest, out *LegacyImportResult) error {
+	return h.RouteHandler.ConvertManifest(ctx, in, out)
+}
+
+func (h *routeHandler) LintRoute(ctx context.Context, in *RouteInfo, out *LintResult) error {
+	return h.RouteHandler.LintRoute(ctx, in, out)
+}
+
+func (h *routeHandler) ListNamingViolations(ctx context.Context, in *NamingViolationsRequest, out *NamingViolationReport) error {
+	return h.RouteHandler.ListNamingViolations(ctx, in, out)
+}
+
+func (h *routeHandler) DiffRoute(ctx context.Context, in *RouteId, out *RouteDiff) error {
+	return h.RouteHandler.DiffRoute(ctx, in, out)
+}
+
+func (h *routeHandler) ReapplyAll(ctx context.Context, in *ReapplyFilter, out *ReapplyJob) error {
+	return h.RouteHandler.ReapplyAll(ctx, in, out)
+}
+
+func (h *routeHandler) GetReapplyJob(ctx context.Context, in *ReapplyJobId, out *ReapplyJob) error {
+	return h.RouteHandler.GetReapplyJob(ctx, in, out)
+}
+
+func (h *routeHandler) ListFailedRoutes(ctx context.Context, in *FailedRoutesRequest, out *AllRoute) error {
+	return h.RouteHandler.ListFailedRoutes(ctx, in, out)
+}
+
+func (h *routeHandler) RetryRoute(ctx context.Context, in *RouteId, out *Response) error {
+	return h.RouteHandler.RetryRoute(ctx, in, out)
+}
+
+func (h *routeHandler) ReencryptRoutes(ctx context.Context, in *FindAll, out *ReencryptResult) error {
+	return h.RouteHandler.ReencryptRoutes(ctx, in, out)
+}
+
+func (h *routeHandler) AddAnnotationTemplate(ctx context.Context, in *AnnotationTemplateInfo, out *Response) error {
+	return h.RouteHandler.AddAnnotationTemplate(ctx, in, out)
+}
+
+func (h *routeHandler) DeleteAnnotationTemplate(ctx context.Context, in *AnnotationTemplateId, out *Response) error {
+	return h.RouteHandler.DeleteAnnotationTemplate(ctx, in, out)
+}
+
+func (h *routeHandler) UpdateAnnotationTemplate(ctx context.Context, in *AnnotationTemplateInfo, out *Response) error {
+	return h.RouteHandler.UpdateAnnotationTemplate(ctx, in, out)
+}
+
+func (h *routeHandler) FindAllAnnotationTemplate(ctx context.Context, in *FindAll, out *AllAnnotationTemplate) error {
+	return h.RouteHandler.FindAllAnnotationTemplate(ctx, in, out)
+}
+
+func (h *routeHandler) PromoteRoute(ctx context.Context, in *PromoteRouteRequest, out *PromoteRouteResponse) error {
+	return h.RouteHandler.PromoteRoute(ctx, in, out)
+}
+
+func (h *routeHandler) CompareEnvironments(ctx context.Context, in *CompareEnvironmentsRequest, out *EnvironmentComparison) error {
+	return h.RouteHandler.CompareEnvironments(ctx, in, out)
+}
+
+func (h *routeHandler) GrantRole(ctx context.Context, in *RoleBindingInfo, out *Response) error {
+	return h.RouteHandler.GrantRole(ctx, in, out)
+}
+
+func (h *routeHandler) RevokeRole(ctx context.Context, in *RoleBindingInfo, out *Response) error {
+	return h.RouteHandler.RevokeRole(ctx, in, out)
+}
+
+func (h *routeHandler) ListBindings(ctx context.Context, in *ListBindingsRequest, out *AllRoleBinding) error {
+	return h.RouteHandler.ListBindings(ctx, in, out)
+}
+
+func (h *routeHandler) CreateAPIKey(ctx context.Context, in *APIKeyInfo, out *CreateAPIKeyResponse) error {
+	return h.RouteHandler.CreateAPIKey(ctx, in, out)
+}
+
+func (h *routeHandler) RevokeAPIKey(ctx context.Context, in *APIKeyId, out *Response) error {
+	return h.RouteHandler.RevokeAPIKey(ctx, in, out)
+}
+
+func (h *routeHandler) ListAPIKeys(ctx context.Context, in *FindAll, out *AllAPIKey) error {
+	return h.RouteHandler.ListAPIKeys(ctx, in, out)
+}
+
+func (h *routeHandler) SetQuota(ctx context.Context, in *QuotaInfo, out *Response) error {
+	return h.RouteHandler.SetQuota(ctx, in, out)
+}
+
+func (h *routeHandler) DeleteQuota(ctx context.Context, in *QuotaInfo, out *Response) error {
+	return h.RouteHandler.DeleteQuota(ctx, in, out)
+}
+
+func (h *routeHandler) ListQuotas(ctx context.Context, in *FindAll, out *AllQuota) error {
+	return h.RouteHandler.ListQuotas(ctx, in, out)
+}
+
+func (h *routeHandler) GetQuotaUsage(ctx context.Context, in *QuotaUsageRequest, out *QuotaUsage) error {
+	return h.RouteHandler.GetQuotaUsage(ctx, in, out)
+}
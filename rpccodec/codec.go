@@ -0,0 +1,154 @@
+package rpccodec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"github.com/asim/go-micro/v3/client"
+	"github.com/asim/go-micro/v3/codec"
+	"github.com/asim/go-micro/v3/server"
+	"google.golang.org/protobuf/proto"
+	"io"
+)
+
+// 请求和响应的内容类型，ContentType 为 go-micro 默认的 protobuf，GzipContentType 为压缩后的 protobuf
+const (
+	ContentType     = "application/protobuf"
+	GzipContentType = "application/protobuf+gzip"
+)
+
+// DefaultMaxMessageBytes 单条消息默认的最大字节数
+const DefaultMaxMessageBytes = 16 << 20
+
+// Config gRPC 端口的消息大小限制，从配置中心的 route.server 节点读取
+type Config struct {
+	// MaxMessageBytes 单条请求或响应的最大字节数（压缩前），默认 16MB
+	MaxMessageBytes int `json:"max_message_bytes"`
+}
+
+// Limit 配置的最大字节数，未配置时使用默认值
+func (c Config) Limit() int {
+	if c.MaxMessageBytes <= 0 {
+		return DefaultMaxMessageBytes
+	}
+	return c.MaxMessageBytes
+}
+
+// ErrMessageTooLarge 消息超过大小限制，使用 errors.Is 判断
+var ErrMessageTooLarge = errors.New("消息超过大小限制")
+
+// ServerOptions 替换默认的 protobuf 编解码，限制消息大小，并接受 gzip 压缩的请求，响应使用和请求相同的压缩方式
+func ServerOptions(config Config) []server.Option {
+	return []server.Option{
+		server.Codec(ContentType, NewCodec(false, config.Limit())),
+		server.Codec(GzipContentType, NewCodec(true, config.Limit())),
+	}
+}
+
+// ClientOptions 客户端使用 gzip 压缩请求和响应，服务端需要使用 ServerOptions
+func ClientOptions(maxMessageBytes int) []client.Option {
+	if maxMessageBytes <= 0 {
+		maxMessageBytes = DefaultMaxMessageBytes
+	}
+	return []client.Option{
+		client.Codec(ContentType, NewCodec(false, maxMessageBytes)),
+		client.Codec(GzipContentType, NewCodec(true, maxMessageBytes)),
+		client.ContentType(GzipContentType),
+	}
+}
+
+// NewCodec 创建 protobuf 编解码，compress 为 true 时消息体使用 gzip 压缩
+func NewCodec(compress bool, maxMessageBytes int) codec.NewCodec {
+	return func(conn io.ReadWriteCloser) codec.Codec {
+		return &protoCodec{conn: conn, compress: compress, max: maxMessageBytes}
+	}
+}
+
+type protoCodec struct {
+	conn     io.ReadWriteCloser
+	compress bool
+	max      int
+}
+
+func (c *protoCodec) ReadHeader(m *codec.Message, t codec.MessageType) error {
+	return nil
+}
+
+// b 为 nil 时读取并丢弃消息体
+func (c *protoCodec) ReadBody(b interface{}) error {
+	var r io.Reader = c.conn
+	if c.compress {
+		zr, err := gzip.NewReader(c.conn)
+		//空消息体没有 gzip 头
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		r = zr
+	}
+	//多读一个字节判断是否超出限制，解压后再判断，避免压缩炸弹
+	data, err := io.ReadAll(io.LimitReader(r, int64(c.max)+1))
+	if err != nil {
+		return err
+	}
+	if len(data) > c.max {
+		return tooLarge(c.max)
+	}
+	if b == nil {
+		return nil
+	}
+	m, ok := b.(proto.Message)
+	if !ok {
+		return codec.ErrInvalidMessage
+	}
+	return proto.Unmarshal(data, m)
+}
+
+func (c *protoCodec) Write(m *codec.Message, b interface{}) error {
+	if b == nil {
+		return nil
+	}
+	p, ok := b.(proto.Message)
+	if !ok {
+		return codec.ErrInvalidMessage
+	}
+	data, err := proto.Marshal(p)
+	if err != nil {
+		return err
+	}
+	if len(data) > c.max {
+		return tooLarge(c.max)
+	}
+	if c.compress {
+		buf := &bytes.Buffer{}
+		zw := gzip.NewWriter(buf)
+		if _, err := zw.Write(data); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+		data = buf.Bytes()
+	}
+	_, err = c.conn.Write(data)
+	return err
+}
+
+func (c *protoCodec) Close() error {
+	return c.conn.Close()
+}
+
+func (c *protoCodec) String() string {
+	if c.compress {
+		return "proto+gzip"
+	}
+	return "proto"
+}
+
+func tooLarge(max int) error {
+	return fmt.Errorf("%w（%d 字节）", ErrMessageTooLarge, max)
+}
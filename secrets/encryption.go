@@ -0,0 +1,103 @@
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// 加密后的格式：enc:v1:<密钥 ID>:<base64(nonce + 密文)>
+const encryptedPrefix = "enc:v1:"
+
+// Encrypter 字段加密，AES-256-GCM，密钥从密钥后端读取，缓存过期后自动使用轮换后的密钥
+type Encrypter struct {
+	provider Provider
+	path     string
+	primary  string
+}
+
+// NewEncrypter 未配置 encryption_keys 时返回 nil，表示明文保存
+func NewEncrypter(provider Provider, config Config) (*Encrypter, error) {
+	if config.EncryptionKeys == "" {
+		return nil, nil
+	}
+	if provider == nil {
+		return nil, errors.New("字段加密需要配置密钥后端")
+	}
+	if config.EncryptionPrimaryKey == "" {
+		return nil, errors.New("字段加密需要配置 encryption_primary_key")
+	}
+	e := &Encrypter{provider: provider, path: config.EncryptionKeys, primary: config.EncryptionPrimaryKey}
+	//启动时检查一次主密钥
+	if _, err := e.aead(context.Background(), e.primary); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// IsEncrypted 是否是加密后的值
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix)
+}
+
+// Encrypt 使用主密钥加密
+func (e *Encrypter) Encrypt(ctx context.Context, plaintext []byte) (string, error) {
+	aead, err := e.aead(ctx, e.primary)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, plaintext, nil)
+	return encryptedPrefix + e.primary + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt 按值中记录的密钥 ID 解密，轮换后旧密钥需要保留到重新加密完成
+func (e *Encrypter) Decrypt(ctx context.Context, value string) ([]byte, error) {
+	parts := strings.SplitN(strings.TrimPrefix(value, encryptedPrefix), ":", 2)
+	if !IsEncrypted(value) || len(parts) != 2 {
+		return nil, errors.New("加密数据格式错误")
+	}
+	aead, err := e.aead(ctx, parts[0])
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("加密数据格式错误")
+	}
+	return aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+}
+
+// 密钥后端中每个字段是一个密钥，字段名为密钥 ID，值为 base64 编码的 32 字节密钥
+func (e *Encrypter) aead(ctx context.Context, id string) (cipher.AEAD, error) {
+	keys, err := e.provider.Get(ctx, e.path)
+	if err != nil {
+		return nil, err
+	}
+	encoded, ok := keys[id]
+	if !ok {
+		return nil, errors.New("密钥 " + e.path + " 中没有加密密钥 " + id)
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != 32 {
+		return nil, errors.New("加密密钥 " + id + " 长度应为 32 字节")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
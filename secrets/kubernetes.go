@@ -0,0 +1,30 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"strings"
+)
+
+// KubernetesProvider 从 Secret 读取，路径格式为 namespace/name
+type KubernetesProvider struct {
+	clientSet kubernetes.Interface
+}
+
+func (p *KubernetesProvider) Get(ctx context.Context, path string) (map[string]string, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, errors.New("Secret 路径 " + path + " 格式应为 namespace/name")
+	}
+	secret, err := p.clientSet.CoreV1().Secrets(parts[0]).Get(ctx, parts[1], metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string]string, len(secret.Data))
+	for k, v := range secret.Data {
+		data[k] = string(v)
+	}
+	return data, nil
+}
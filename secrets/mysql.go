@@ -0,0 +1,86 @@
+package secrets
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"net"
+	"time"
+)
+
+// mysqlConnector 每次建立新连接时读取账号，密钥轮换后新连接使用新的账号
+type mysqlConnector struct {
+	provider Provider
+	path     string
+	config   *mysqldriver.Config
+}
+
+// MysqlDSN 从密钥中的 host、port、database 字段生成不含账号的 dsn，配置了密钥路径时不再读取配置中心的 mysql 节点
+func MysqlDSN(provider Provider, config Config) (string, error) {
+	if provider == nil || config.Mysql == "" {
+		return "", errors.New("未配置 MySQL 账号的密钥路径")
+	}
+	data, err := provider.Get(context.Background(), config.Mysql)
+	if err != nil {
+		return "", err
+	}
+	if data["host"] == "" || data["database"] == "" {
+		return "", errors.New("密钥 " + config.Mysql + " 中没有 host 或 database")
+	}
+	port := data["port"]
+	if port == "" {
+		port = "3306"
+	}
+	dsn := mysqldriver.NewConfig()
+	dsn.Net = "tcp"
+	dsn.Addr = net.JoinHostPort(data["host"], port)
+	dsn.DBName = data["database"]
+	dsn.Params = map[string]string{"charset": "utf8mb4"}
+	dsn.ParseTime = true
+	dsn.Loc = time.Local
+	return dsn.FormatDSN(), nil
+}
+
+// OpenMysql 打开连接池，dsn 中的账号被密钥后端中的 username、password 替换
+// 连接的最长存活时间与缓存时间一致，轮换后旧连接会逐步关闭
+func OpenMysql(provider Provider, config Config, dsn string) (*sql.DB, error) {
+	if provider == nil || config.Mysql == "" {
+		return nil, errors.New("未配置 MySQL 账号的密钥路径")
+	}
+	parsed, err := mysqldriver.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	connector := &mysqlConnector{provider: provider, path: config.Mysql, config: parsed}
+	db := sql.OpenDB(connector)
+	db.SetConnMaxLifetime(config.RefreshInterval())
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+func (c *mysqlConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	data, err := c.provider.Get(ctx, c.path)
+	if err != nil {
+		return nil, err
+	}
+	if data["username"] == "" {
+		return nil, errors.New("密钥 " + c.path + " 中没有 username")
+	}
+	config := c.config.Clone()
+	config.User = data["username"]
+	config.Passwd = data["password"]
+	connector, err := mysqldriver.NewConnector(config)
+	if err != nil {
+		return nil, err
+	}
+	return connector.Connect(ctx)
+}
+
+func (c *mysqlConnector) Driver() driver.Driver {
+	return mysqldriver.MySQLDriver{}
+}
@@ -0,0 +1,124 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"github.com/zxnlx/common"
+	"k8s.io/client-go/kubernetes"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 密钥后端
+const (
+	BackendNone       = ""
+	BackendVault      = "vault"
+	BackendKubernetes = "kubernetes"
+)
+
+const defaultRefreshSeconds = 300
+
+// Provider 密钥后端，按路径读取一组字段
+type Provider interface {
+	Get(ctx context.Context, path string) (map[string]string, error)
+}
+
+// Config 密钥配置，从配置中心的 route.secrets 节点读取，配置中心只保存密钥的路径
+type Config struct {
+	Backend string      `json:"backend"`
+	Vault   VaultConfig `json:"vault"`
+	// RefreshSeconds 密钥的缓存时间，轮换后最多经过这么久生效，默认 300
+	RefreshSeconds int64 `json:"refresh_seconds"`
+	// Mysql MySQL 账号所在路径，读取 username、password、host、port、database 字段，为空时使用配置中心中的 mysql 节点
+	Mysql string `json:"mysql"`
+	// EncryptionKeys 字段加密密钥所在路径，每个字段是一个密钥，为空时不加密
+	EncryptionKeys string `json:"encryption_keys"`
+	// EncryptionPrimaryKey 加密使用的密钥 ID，其余密钥只用于解密
+	// 轮换时先新增密钥并切换主密钥，调用 ReencryptRoutes 后再删除旧密钥
+	EncryptionPrimaryKey string `json:"encryption_primary_key"`
+}
+
+// RefreshInterval 缓存时间
+func (c Config) RefreshInterval() time.Duration {
+	if c.RefreshSeconds <= 0 {
+		return defaultRefreshSeconds * time.Second
+	}
+	return time.Duration(c.RefreshSeconds) * time.Second
+}
+
+// New 根据配置创建带缓存的后端，未配置时返回 nil
+// kubernetes 后端的路径格式为 namespace/name，vault 后端为 KV 的完整路径，如 secret/data/route/mysql
+func New(config Config, clientSet kubernetes.Interface) (Provider, error) {
+	var provider Provider
+	switch config.Backend {
+	case BackendNone:
+		return nil, nil
+	case BackendVault:
+		vault, err := NewVaultProvider(config.Vault)
+		if err != nil {
+			return nil, err
+		}
+		provider = vault
+	case BackendKubernetes:
+		provider = &KubernetesProvider{clientSet: clientSet}
+	default:
+		return nil, errors.New("不支持的密钥后端：" + config.Backend)
+	}
+	return &cachedProvider{provider: provider, ttl: config.RefreshInterval(), entries: map[string]cacheEntry{}}, nil
+}
+
+// Resolve 读取引用指向的字段，引用格式为 path#field
+func Resolve(ctx context.Context, provider Provider, ref string) (string, error) {
+	i := strings.LastIndex(ref, "#")
+	if i <= 0 || i == len(ref)-1 {
+		return "", errors.New("密钥引用 " + ref + " 格式应为 path#field")
+	}
+	if provider == nil {
+		return "", errors.New("未配置密钥后端，无法读取 " + ref)
+	}
+	data, err := provider.Get(ctx, ref[:i])
+	if err != nil {
+		return "", err
+	}
+	value, ok := data[ref[i+1:]]
+	if !ok {
+		return "", errors.New("密钥 " + ref[:i] + " 中没有字段 " + ref[i+1:])
+	}
+	return value, nil
+}
+
+type cacheEntry struct {
+	data      map[string]string
+	fetchedAt time.Time
+}
+
+// cachedProvider 缓存读取结果，过期后重新读取，以此获得轮换后的密钥
+// 重新读取失败时继续使用旧值，避免密钥后端短暂不可用影响服务
+type cachedProvider struct {
+	provider Provider
+	ttl      time.Duration
+	mu       sync.Mutex
+	entries  map[string]cacheEntry
+}
+
+func (p *cachedProvider) Get(ctx context.Context, path string) (map[string]string, error) {
+	p.mu.Lock()
+	entry, ok := p.entries[path]
+	p.mu.Unlock()
+	if ok && time.Since(entry.fetchedAt) < p.ttl {
+		return entry.data, nil
+	}
+	data, err := p.provider.Get(ctx, path)
+	if err != nil {
+		if ok {
+			common.Error(err)
+			return entry.data, nil
+		}
+		return nil, err
+	}
+	p.mu.Lock()
+	p.entries[path] = cacheEntry{data: data, fetchedAt: time.Now()}
+	p.mu.Unlock()
+	return data, nil
+}
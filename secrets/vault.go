@@ -0,0 +1,162 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const serviceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// VaultConfig Vault 配置，配置了 token 时直接使用，否则使用 Kubernetes 认证登录
+type VaultConfig struct {
+	Address string `json:"address"`
+	Token   string `json:"token"`
+	// KubernetesRole Kubernetes 认证的角色，使用 Pod 的 ServiceAccount token 登录
+	KubernetesRole string `json:"kubernetes_role"`
+	// AuthMount Kubernetes 认证的挂载路径，默认 kubernetes
+	AuthMount string `json:"auth_mount"`
+}
+
+// VaultProvider 读取 KV 引擎，同时支持 v1 和 v2
+type VaultProvider struct {
+	config VaultConfig
+	client *http.Client
+	mu     sync.Mutex
+	token  string
+}
+
+// NewVaultProvider 创建
+func NewVaultProvider(config VaultConfig) (*VaultProvider, error) {
+	if config.Address == "" {
+		return nil, errors.New("未配置 Vault 地址")
+	}
+	if config.Token == "" && config.KubernetesRole == "" {
+		return nil, errors.New("Vault 需要配置 token 或 kubernetes_role")
+	}
+	if config.AuthMount == "" {
+		config.AuthMount = "kubernetes"
+	}
+	return &VaultProvider{config: config, client: &http.Client{Timeout: 10 * time.Second}, token: config.Token}, nil
+}
+
+type vaultResponse struct {
+	Auth struct {
+		ClientToken string `json:"client_token"`
+	} `json:"auth"`
+	Errors []string `json:"errors"`
+}
+
+// Get 读取 KV，v2 的路径需要包含 data，如 secret/data/route/mysql
+func (p *VaultProvider) Get(ctx context.Context, path string) (map[string]string, error) {
+	token, err := p.currentToken(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	raw, status, err := p.do(ctx, http.MethodGet, "/v1/"+strings.TrimPrefix(path, "/"), token, nil)
+	//登录得到的 token 过期后重新登录一次
+	if err == nil && status == http.StatusForbidden && p.config.Token == "" {
+		if token, err = p.currentToken(ctx, true); err != nil {
+			return nil, err
+		}
+		raw, status, err = p.do(ctx, http.MethodGet, "/v1/"+strings.TrimPrefix(path, "/"), token, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, vaultError(path, status, raw)
+	}
+	//v1 的字段直接在 data 中，v2 在 data.data 中
+	var envelope struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return nil, err
+	}
+	fields := envelope.Data
+	if nested, ok := fields["data"].(map[string]interface{}); ok {
+		if _, ok := fields["metadata"]; ok {
+			fields = nested
+		}
+	}
+	data := make(map[string]string, len(fields))
+	for k, v := range fields {
+		if s, ok := v.(string); ok {
+			data[k] = s
+			continue
+		}
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		data[k] = string(encoded)
+	}
+	return data, nil
+}
+
+func (p *VaultProvider) currentToken(ctx context.Context, relogin bool) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.token != "" && !relogin {
+		return p.token, nil
+	}
+	jwt, err := ioutil.ReadFile(serviceAccountTokenFile)
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(map[string]string{"role": p.config.KubernetesRole, "jwt": strings.TrimSpace(string(jwt))})
+	if err != nil {
+		return "", err
+	}
+	raw, status, err := p.do(ctx, http.MethodPost, "/v1/auth/"+p.config.AuthMount+"/login", "", body)
+	if err != nil {
+		return "", err
+	}
+	if status != http.StatusOK {
+		return "", vaultError("auth/"+p.config.AuthMount+"/login", status, raw)
+	}
+	rsp := &vaultResponse{}
+	if err := json.Unmarshal(raw, rsp); err != nil {
+		return "", err
+	}
+	if rsp.Auth.ClientToken == "" {
+		return "", errors.New("Vault 登录没有返回 token")
+	}
+	p.token = rsp.Auth.ClientToken
+	return p.token, nil
+}
+
+func (p *VaultProvider) do(ctx context.Context, method string, path string, token string, body []byte) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(p.config.Address, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, err
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	rsp, err := p.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rsp.Body.Close()
+	raw, err := ioutil.ReadAll(rsp.Body)
+	return raw, rsp.StatusCode, err
+}
+
+func vaultError(path string, status int, raw []byte) error {
+	rsp := &vaultResponse{}
+	_ = json.Unmarshal(raw, rsp)
+	message := "读取 Vault " + path + " 失败，状态码：" + strconv.Itoa(status)
+	if len(rsp.Errors) > 0 {
+		message += "，" + strings.Join(rsp.Errors, "；")
+	}
+	return errors.New(message)
+}
@@ -0,0 +1,186 @@
+// Package seed 启动时加载内置的示例路由，方便新用户直接体验接口
+package seed
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/service"
+	"github.com/zxnlx/route/proto/route"
+	"gorm.io/gorm"
+	"io"
+	"io/ioutil"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
+	"net/http"
+	yaml2 "sigs.k8s.io/yaml"
+	"strconv"
+	"time"
+)
+
+// 示例路由，字段名与 proto 一致
+//
+//go:embed routes.yaml
+var routesYAML []byte
+
+// 示例路由的后端服务
+//
+//go:embed backend.yaml
+var backendYAML []byte
+
+// IngressNginxManifest kind 集群安装 ingress-nginx 使用的清单
+const IngressNginxManifest = "https://raw.githubusercontent.com/kubernetes/ingress-nginx/controller-v1.8.1/deploy/static/provider/kind/deploy.yaml"
+
+// Actor 示例路由的创建人
+const Actor = "seed"
+
+// Seeder 加载示例路由
+type Seeder struct {
+	RouteDataService service.IRouteDataService
+	K8sClientSet     kubernetes.Interface
+	K8sDynamicClient dynamic.Interface
+	// HTTPClient 下载 ingress-nginx 清单，为空时使用默认客户端
+	HTTPClient *http.Client
+}
+
+// NewSeeder 创建
+func NewSeeder(routeDataService service.IRouteDataService, clientSet kubernetes.Interface, dynamicClient dynamic.Interface) *Seeder {
+	return &Seeder{RouteDataService: routeDataService, K8sClientSet: clientSet, K8sDynamicClient: dynamicClient}
+}
+
+// Routes 解析内置的示例路由
+func Routes() ([]*route.RouteInfo, error) {
+	data, err := yaml2.YAMLToJSON(routesYAML)
+	if err != nil {
+		return nil, err
+	}
+	var routes []*route.RouteInfo
+	if err := json.Unmarshal(data, &routes); err != nil {
+		return nil, err
+	}
+	return routes, nil
+}
+
+// Run 依次安装 ingress-nginx（可选）、后端服务和示例路由
+func (s *Seeder) Run(ctx context.Context, ingressNginx bool) error {
+	if ingressNginx {
+		if err := s.InstallIngressNginx(ctx); err != nil {
+			return err
+		}
+	}
+	if err := s.ApplyManifest(ctx, backendYAML); err != nil {
+		return err
+	}
+	created, err := s.LoadRoutes()
+	if err != nil {
+		return err
+	}
+	common.Info("示例路由加载完成，新建 " + strconv.Itoa(created) + " 条")
+	return nil
+}
+
+// LoadRoutes 创建示例路由，已存在的跳过，返回新建的数量
+func (s *Seeder) LoadRoutes() (int, error) {
+	routes, err := Routes()
+	if err != nil {
+		return 0, err
+	}
+	created := 0
+	for _, info := range routes {
+		_, err := s.RouteDataService.FindRouteByName(info.RouteNamespace, info.RouteName)
+		if err == nil {
+			common.Info("示例路由 " + info.RouteNamespace + "/" + info.RouteName + " 已存在，跳过")
+			continue
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return created, err
+		}
+		info.RouteCreatedBy = Actor
+		info.RouteUpdatedBy = Actor
+		if _, err := s.RouteDataService.CreateRoute(info); err != nil {
+			return created, fmt.Errorf("创建示例路由 %s/%s 失败：%w", info.RouteNamespace, info.RouteName, err)
+		}
+		created++
+	}
+	return created, nil
+}
+
+// InstallIngressNginx 下载并安装 kind 使用的 ingress-nginx
+func (s *Seeder) InstallIngressNginx(ctx context.Context) error {
+	httpClient := s.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, IngressNginxManifest, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("下载 ingress-nginx 清单失败：" + resp.Status)
+	}
+	manifest, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return s.ApplyManifest(ctx, manifest)
+}
+
+// ApplyManifest 通过 dynamic client 创建多文档 YAML 中的资源，已存在的跳过
+func (s *Seeder) ApplyManifest(ctx context.Context, manifest []byte) error {
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(s.K8sClientSet.Discovery()))
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		//空文档
+		if len(obj.Object) == 0 {
+			continue
+		}
+		gvk := obj.GroupVersionKind()
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if meta.IsNoMatchError(err) {
+			//前面的文档可能刚创建了 CRD，刷新后重试
+			mapper.Reset()
+			mapping, err = mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		}
+		if err != nil {
+			return err
+		}
+		var resource dynamic.ResourceInterface = s.K8sDynamicClient.Resource(mapping.Resource)
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			namespace := obj.GetNamespace()
+			if namespace == "" {
+				namespace = metav1.NamespaceDefault
+			}
+			resource = s.K8sDynamicClient.Resource(mapping.Resource).Namespace(namespace)
+		}
+		if _, err := resource.Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+			if k8serrors.IsAlreadyExists(err) {
+				continue
+			}
+			return fmt.Errorf("创建 %s %s 失败：%w", gvk.Kind, obj.GetName(), err)
+		}
+		common.Info("已创建 " + gvk.Kind + " " + obj.GetName())
+	}
+}
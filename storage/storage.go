@@ -0,0 +1,152 @@
+// Package storage 按存储后端创建仓库，MySQL 使用 gorm 仓库，kubernetes、redis 和测试使用 kvstore
+package storage
+
+import (
+	"errors"
+	"github.com/zxnlx/route/domain/repository"
+	"github.com/zxnlx/route/domain/repository/kvstore"
+	"gorm.io/gorm"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Storage 按存储后端创建仓库，DB 和 Store 只有一个不为 nil
+type Storage struct {
+	DB    *gorm.DB
+	Store *kvstore.Store
+	// NewRouteRepository MySQL 后端的路由仓库构造函数，为空时使用 repository.NewRouteRepository
+	NewRouteRepository func(db *gorm.DB) repository.IRouteRepository
+}
+
+// NewKubernetesStorage kubernetes 后端启动时把所有数据加载到内存，只支持单副本部署
+func NewKubernetesStorage(clientSet kubernetes.Interface, dynamicClient dynamic.Interface, config kvstore.Config) (*Storage, error) {
+	namespace := config.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	persister, err := kvstore.NewKubernetesPersister(clientSet, dynamicClient, namespace)
+	if err != nil {
+		return nil, err
+	}
+	store, err := kvstore.NewStore(persister)
+	if err != nil {
+		return nil, err
+	}
+	return &Storage{Store: store}, nil
+}
+
+// NewRedisStorage redis 后端同样启动时全部加载到内存，只支持单副本部署
+func NewRedisStorage(config kvstore.Config) (*Storage, error) {
+	persister, err := kvstore.NewRedisPersister(config.Redis)
+	if err != nil {
+		return nil, err
+	}
+	store, err := kvstore.NewStore(persister)
+	if err != nil {
+		return nil, err
+	}
+	return &Storage{Store: store}, nil
+}
+
+// CheckBackend 检查配置的存储后端
+func CheckBackend(backend string) error {
+	switch backend {
+	case "", kvstore.BackendMysql, kvstore.BackendKubernetes, kvstore.BackendRedis:
+		return nil
+	}
+	return errors.New("不支持的存储后端：" + backend)
+}
+
+func (s *Storage) RouteRepository() repository.IRouteRepository {
+	if s.Store != nil {
+		return kvstore.NewRouteRepository(s.Store)
+	}
+	if s.NewRouteRepository != nil {
+		return s.NewRouteRepository(s.DB)
+	}
+	return repository.NewRouteRepository(s.DB)
+}
+
+func (s *Storage) NamespaceDefaultRepository() repository.INamespaceDefaultRepository {
+	if s.Store != nil {
+		return kvstore.NewNamespaceDefaultRepository(s.Store)
+	}
+	return repository.NewNamespaceDefaultRepository(s.DB)
+}
+
+func (s *Storage) ApplicationRepository() repository.IApplicationRepository {
+	if s.Store != nil {
+		return kvstore.NewApplicationRepository(s.Store)
+	}
+	return repository.NewApplicationRepository(s.DB)
+}
+
+func (s *Storage) EventRepository() repository.IEventRepository {
+	if s.Store != nil {
+		return kvstore.NewEventRepository(s.Store)
+	}
+	return repository.NewEventRepository(s.DB)
+}
+
+func (s *Storage) FreezeWindowRepository() repository.IFreezeWindowRepository {
+	if s.Store != nil {
+		return kvstore.NewFreezeWindowRepository(s.Store)
+	}
+	return repository.NewFreezeWindowRepository(s.DB)
+}
+
+func (s *Storage) ReservedHostRepository() repository.IReservedHostRepository {
+	if s.Store != nil {
+		return kvstore.NewReservedHostRepository(s.Store)
+	}
+	return repository.NewReservedHostRepository(s.DB)
+}
+
+func (s *Storage) AnnotationTemplateRepository() repository.IAnnotationTemplateRepository {
+	if s.Store != nil {
+		return kvstore.NewAnnotationTemplateRepository(s.Store)
+	}
+	return repository.NewAnnotationTemplateRepository(s.DB)
+}
+
+func (s *Storage) RoleBindingRepository() repository.IRoleBindingRepository {
+	if s.Store != nil {
+		return kvstore.NewRoleBindingRepository(s.Store)
+	}
+	return repository.NewRoleBindingRepository(s.DB)
+}
+
+func (s *Storage) APIKeyRepository() repository.IAPIKeyRepository {
+	if s.Store != nil {
+		return kvstore.NewAPIKeyRepository(s.Store)
+	}
+	return repository.NewAPIKeyRepository(s.DB)
+}
+
+func (s *Storage) OutboxRepository() repository.IOutboxRepository {
+	if s.Store != nil {
+		return kvstore.NewOutboxRepository(s.Store)
+	}
+	return repository.NewOutboxRepository(s.DB)
+}
+
+func (s *Storage) QuotaRepository() repository.IQuotaRepository {
+	if s.Store != nil {
+		return kvstore.NewQuotaRepository(s.Store)
+	}
+	return repository.NewQuotaRepository(s.DB)
+}
+
+func (s *Storage) UsageSnapshotRepository() repository.IUsageSnapshotRepository {
+	if s.Store != nil {
+		return kvstore.NewUsageSnapshotRepository(s.Store)
+	}
+	return repository.NewUsageSnapshotRepository(s.DB)
+}
+
+func (s *Storage) RetentionRepository() repository.IRetentionRepository {
+	if s.Store != nil {
+		return kvstore.NewRetentionRepository(s.Store)
+	}
+	return repository.NewRetentionRepository(s.DB)
+}
@@ -0,0 +1,100 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"sync"
+	"time"
+)
+
+// 连接长时间没有请求时清理记录，之后的请求需要重新握手
+const peerIdleTimeout = time.Hour
+
+// Peers 按连接的远端地址记录客户端证书对应的身份，供鉴权作为调用方身份
+// go-micro 的传输层不暴露连接的证书，只能在握手时按远端地址记录，请求的 Remote 头即该地址
+type Peers struct {
+	mu    sync.Mutex
+	peers map[string]*peer
+}
+
+type peer struct {
+	identity string
+	lastUsed time.Time
+}
+
+// NewPeers 创建
+func NewPeers() *Peers {
+	return &Peers{peers: map[string]*peer{}}
+}
+
+// Track 在 tlsConfig 的每次握手时记录客户端证书的身份，没有提供证书的连接删除该地址之前的记录
+func (p *Peers) Track(tlsConfig *tls.Config) {
+	base := tlsConfig.Clone()
+	tlsConfig.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+		remote := hello.Conn.RemoteAddr().String()
+		config := base.Clone()
+		config.VerifyConnection = func(state tls.ConnectionState) error {
+			//证书链已经由 ClientCAs 校验过
+			if len(state.PeerCertificates) == 0 {
+				p.forget(remote)
+				return nil
+			}
+			p.remember(remote, CertificateIdentity(state.PeerCertificates[0]))
+			return nil
+		}
+		return config, nil
+	}
+}
+
+// CertificateIdentity 证书对应的身份 cert:<标识>，标识优先使用 URI SAN（如 SPIFFE ID），否则使用 CN
+func CertificateIdentity(cert *x509.Certificate) string {
+	if len(cert.URIs) > 0 {
+		return "cert:" + cert.URIs[0].String()
+	}
+	if cert.Subject.CommonName != "" {
+		return "cert:" + cert.Subject.CommonName
+	}
+	return ""
+}
+
+// Identity 远端地址对应的身份，没有客户端证书时为空
+func (p *Peers) Identity(remote string) string {
+	if p == nil || remote == "" {
+		return ""
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	v, ok := p.peers[remote]
+	if !ok {
+		return ""
+	}
+	v.lastUsed = time.Now()
+	return v.identity
+}
+
+func (p *Peers) remember(remote string, identity string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.prune()
+	if identity == "" {
+		delete(p.peers, remote)
+		return
+	}
+	p.peers[remote] = &peer{identity: identity, lastUsed: time.Now()}
+}
+
+func (p *Peers) forget(remote string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.peers, remote)
+}
+
+// 连接关闭时传输层不会通知，握手时顺便清理长时间没有请求的记录
+func (p *Peers) prune() {
+	now := time.Now()
+	for k, v := range p.peers {
+		if now.Sub(v.lastUsed) > peerIdleTimeout {
+			delete(p.peers, k)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"strings"
+)
+
+// 客户端证书校验方式
+const (
+	// ClientAuthNone 不要求客户端证书
+	ClientAuthNone = ""
+	// ClientAuthRequest 客户端提供证书时校验，不提供也允许连接
+	ClientAuthRequest = "request"
+	// ClientAuthRequire 必须提供可信的客户端证书
+	ClientAuthRequire = "require"
+)
+
+// Config gRPC 端口的 TLS 配置，从配置中心的 route.tls 节点读取
+// 服务端证书从文件或 kubernetes.io/tls 类型的 Secret 读取，客户端 CA 从配置中心或 Secret 读取
+type Config struct {
+	Enabled  bool   `json:"enabled"`
+	CertFile string `json:"cert_file"`
+	KeyFile  string `json:"key_file"`
+	// CertSecret 服务端证书所在的 Secret，格式 namespace/name，设置后忽略 CertFile、KeyFile
+	CertSecret string `json:"cert_secret"`
+	ClientAuth string `json:"client_auth"`
+	// ClientCA PEM 格式的客户端 CA 证书
+	ClientCA string `json:"client_ca"`
+	// ClientCASecret 客户端 CA 所在的 Secret，格式 namespace/name，读取其中的 ca.crt
+	ClientCASecret string `json:"client_ca_secret"`
+	// ServerCA PEM 格式的服务端 CA 证书，本服务作为客户端时用于校验对端，未配置时使用系统的根证书
+	ServerCA string `json:"server_ca"`
+	// ServerCASecret 服务端 CA 所在的 Secret，格式 namespace/name，读取其中的 ca.crt
+	ServerCASecret string `json:"server_ca_secret"`
+}
+
+// Load 生成 tls.Config，未开启时返回 nil
+// 同一份配置也用于本服务作为客户端调用其他服务：服务端证书作为客户端证书，服务端 CA 用于校验对端
+func Load(config Config, clientSet kubernetes.Interface) (*tls.Config, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+	cert, err := loadCertificate(config, clientSet)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	//客户端 CA 只用于校验调用方，不能用来信任对端服务
+	if tlsConfig.RootCAs, err = loadServerCA(config, clientSet); err != nil {
+		return nil, err
+	}
+	switch config.ClientAuth {
+	case ClientAuthNone:
+		return tlsConfig, nil
+	case ClientAuthRequest:
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	case ClientAuthRequire:
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	default:
+		return nil, errors.New("不支持的客户端证书校验方式：" + config.ClientAuth)
+	}
+	pool, err := loadClientCA(config, clientSet)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.ClientCAs = pool
+	return tlsConfig, nil
+}
+
+func loadCertificate(config Config, clientSet kubernetes.Interface) (tls.Certificate, error) {
+	if config.CertSecret != "" {
+		data, err := secretData(clientSet, config.CertSecret)
+		if err != nil {
+			return tls.Certificate{}, err
+		}
+		return tls.X509KeyPair(data["tls.crt"], data["tls.key"])
+	}
+	if config.CertFile == "" || config.KeyFile == "" {
+		return tls.Certificate{}, errors.New("开启 TLS 需要配置 cert_file 和 key_file 或 cert_secret")
+	}
+	return tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+}
+
+func loadClientCA(config Config, clientSet kubernetes.Interface) (*x509.CertPool, error) {
+	if config.ClientCA == "" && config.ClientCASecret == "" {
+		return nil, errors.New("校验客户端证书需要配置 client_ca 或 client_ca_secret")
+	}
+	return loadCA("客户端 CA", config.ClientCA, config.ClientCASecret, clientSet)
+}
+
+// 未配置时返回 nil，使用系统的根证书
+func loadServerCA(config Config, clientSet kubernetes.Interface) (*x509.CertPool, error) {
+	if config.ServerCA == "" && config.ServerCASecret == "" {
+		return nil, nil
+	}
+	return loadCA("服务端 CA", config.ServerCA, config.ServerCASecret, clientSet)
+}
+
+// 优先使用配置中心的 PEM，否则读取 Secret 中的 ca.crt
+func loadCA(name string, pem string, secret string, clientSet kubernetes.Interface) (*x509.CertPool, error) {
+	data := []byte(pem)
+	if pem == "" {
+		values, err := secretData(clientSet, secret)
+		if err != nil {
+			return nil, err
+		}
+		data = values["ca.crt"]
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.New(name + " 中没有有效的证书")
+	}
+	return pool, nil
+}
+
+func secretData(clientSet kubernetes.Interface, ref string) (map[string][]byte, error) {
+	i := strings.Index(ref, "/")
+	if i <= 0 || i == len(ref)-1 {
+		return nil, errors.New("Secret " + ref + " 格式应为 namespace/name")
+	}
+	secret, err := clientSet.CoreV1().Secrets(ref[:i]).Get(context.TODO(), ref[i+1:], metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return secret.Data, nil
+}
@@ -0,0 +1,176 @@
+// Package webhook 校验 Ingress 的准入 webhook，拒绝绕过路由服务直接修改受管 Ingress 的操作
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/domain/service"
+	"github.com/zxnlx/route/tlsconfig"
+	"io"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"net/http"
+)
+
+// 绕过路由服务的修改的处理方式
+const (
+	// ModeReject 拒绝
+	ModeReject = "reject"
+	// ModeRecord 放行，记录漂移事件
+	ModeRecord = "record"
+)
+
+// ValidatePath ValidatingWebhookConfiguration 中配置的路径
+const ValidatePath = "/validate-ingress"
+
+// 请求体的最大长度
+const maxReviewBody = 4 << 20
+
+// Config 准入 webhook 配置，从配置中心的 route.ingress_webhook 节点读取
+// ValidatingWebhookConfiguration 需要单独创建，建议按命名空间选择受管的命名空间，failurePolicy 为 Ignore
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// Port 监听端口，默认 8443
+	Port string `json:"port"`
+	// Mode 为 reject（默认）或 record
+	Mode string `json:"mode"`
+	// AllowedUsers 可以直接修改受管 Ingress 的用户，必须包含本服务的 service account，如 system:serviceaccount:route:route
+	AllowedUsers []string `json:"allowed_users"`
+	// AllowedGroups 可以直接修改受管 Ingress 的用户组，如 system:masters
+	AllowedGroups []string `json:"allowed_groups"`
+	// TLS 服务端证书，API server 只通过 HTTPS 调用 webhook，enabled 可以不填
+	TLS tlsconfig.Config `json:"tls"`
+}
+
+func (c Config) port() string {
+	if c.Port == "" {
+		return "8443"
+	}
+	return c.Port
+}
+
+func (c Config) check() error {
+	switch c.Mode {
+	case "", ModeReject, ModeRecord:
+	default:
+		return errors.New("不支持的准入 webhook 模式：" + c.Mode)
+	}
+	//没有放行本服务时所有写入都会被拒绝
+	if len(c.AllowedUsers) == 0 {
+		return errors.New("准入 webhook 需要在 allowed_users 中配置本服务的 service account")
+	}
+	return nil
+}
+
+// Server 准入 webhook
+type Server struct {
+	RouteDataService service.IRouteDataService
+	Config           Config
+}
+
+// NewServer 创建
+func NewServer(routeDataService service.IRouteDataService, config Config) *Server {
+	return &Server{RouteDataService: routeDataService, Config: config}
+}
+
+// Run 启动 HTTPS 服务，阻塞直到出错
+func (s *Server) Run(clientSet kubernetes.Interface) error {
+	if err := s.Config.check(); err != nil {
+		return err
+	}
+	tlsConfig := s.Config.TLS
+	tlsConfig.Enabled = true
+	serverTLS, err := tlsconfig.Load(tlsConfig, clientSet)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(ValidatePath, s.validate)
+	server := &http.Server{Addr: ":" + s.Config.port(), Handler: mux, TLSConfig: serverTLS}
+	common.Info("Ingress 准入 webhook 监听 " + server.Addr)
+	return server.ListenAndServeTLS("", "")
+}
+
+// POST /validate-ingress 接收 AdmissionReview，返回同一 UID 的结果
+func (s *Server) validate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxReviewBody))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	review := &admissionv1.AdmissionReview{}
+	if err := json.Unmarshal(body, review); err != nil || review.Request == nil {
+		http.Error(w, "AdmissionReview 格式不正确", http.StatusBadRequest)
+		return
+	}
+	review.Response = s.review(review.Request)
+	review.Response.UID = review.Request.UID
+	review.Request = nil
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		common.Error(err)
+	}
+}
+
+func (s *Server) review(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	allowed := &admissionv1.AdmissionResponse{Allowed: true}
+	//控制器更新 status、试运行和放行的用户不检查
+	if req.SubResource != "" || req.DryRun != nil && *req.DryRun || s.allowedUser(req.UserInfo.Username, req.UserInfo.Groups) {
+		return allowed
+	}
+	if req.Kind.Kind != "Ingress" {
+		return allowed
+	}
+	labels := []map[string]string{}
+	for _, v := range []runtime.RawExtension{req.OldObject, req.Object} {
+		if len(v.Raw) == 0 {
+			continue
+		}
+		object := &metav1.PartialObjectMetadata{}
+		if err := json.Unmarshal(v.Raw, object); err != nil {
+			common.Error(err)
+			continue
+		}
+		labels = append(labels, object.Labels)
+	}
+	ok, message := s.RouteDataService.ReviewIngressChange(&service.IngressChange{
+		Operation: string(req.Operation),
+		Namespace: req.Namespace,
+		Name:      req.Name,
+		User:      req.UserInfo.Username,
+		Labels:    labels,
+	}, s.Config.Mode == ModeRecord)
+	if ok {
+		if message != "" {
+			allowed.Warnings = []string{message}
+		}
+		return allowed
+	}
+	return &admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result:  &metav1.Status{Status: metav1.StatusFailure, Code: http.StatusForbidden, Reason: metav1.StatusReasonForbidden, Message: message},
+	}
+}
+
+func (s *Server) allowedUser(user string, groups []string) bool {
+	for _, v := range s.Config.AllowedUsers {
+		if v == user {
+			return true
+		}
+	}
+	for _, v := range s.Config.AllowedGroups {
+		for _, g := range groups {
+			if v == g {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package wrapper
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/metadata"
+	"github.com/asim/go-micro/v3/server"
+	"strings"
+)
+
+// Authorizer 检查调用方能否执行操作，method 为不带服务名的方法名
+type Authorizer interface {
+	Authorize(subject string, method string, req interface{}) error
+}
+
+// APIKeyAuthorizer 校验 API 密钥及其范围，返回密钥对应的调用方身份，密钥有效但范围不够时同时返回身份和错误
+type APIKeyAuthorizer interface {
+	AuthorizeAPIKey(key string, method string, req interface{}) (string, error)
+}
+
+// PeerIdentity 按连接的远端地址返回客户端证书对应的身份，未开启双向 TLS 时为空
+type PeerIdentity interface {
+	Identity(remote string) string
+}
+
+// NewAuthorizationWrapper 检查 Route 服务的每个操作，无效的 API 密钥或身份声明返回 401，没有权限返回 403
+// 调用方身份只取校验过的凭据，依次为 API 密钥对应的身份、网关签名的登录用户、客户端证书，请求自带的 X-Actor、调用方服务名不作为身份
+// 校验后把 X-Actor 改为该身份，没有身份时删除，处理器记录的操作人和鉴权使用的身份一致
+func NewAuthorizationWrapper(authorizer Authorizer, apiKeys APIKeyAuthorizer, assertion *Assertion, peers PeerIdentity) server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			method := strings.TrimPrefix(req.Endpoint(), "Route.")
+			if method == req.Endpoint() {
+				//健康检查等其他服务不鉴权，同样不接受请求自带的身份
+				return fn(withActor(ctx, ""), req, rsp)
+			}
+			if key := apiKeyFromContext(ctx); key != "" {
+				subject, err := apiKeys.AuthorizeAPIKey(key, method, req.Body())
+				if err != nil {
+					//密钥有效但范围不够时返回了身份
+					code := int32(403)
+					if subject == "" {
+						code = 401
+					}
+					return newError(req.Service(), err, code)
+				}
+				return fn(withActor(ctx, subject), req, rsp)
+			}
+			subject, err := assertion.Verify(ctx)
+			if err != nil {
+				return newError(req.Service(), err, 401)
+			}
+			if subject == "" && peers != nil {
+				//Remote 由服务端按连接设置，调用方无法伪造
+				remote, _ := metadata.Get(ctx, "Remote")
+				subject = peers.Identity(remote)
+			}
+			if err := authorizer.Authorize(subject, method, req.Body()); err != nil {
+				return newError(req.Service(), err, 403)
+			}
+			return fn(withActor(ctx, subject), req, rsp)
+		}
+	}
+}
+
+func apiKeyFromContext(ctx context.Context) string {
+	if key, ok := metadata.Get(ctx, "X-Api-Key"); ok && key != "" {
+		return key
+	}
+	if auth, ok := metadata.Get(ctx, "Authorization"); ok && strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimPrefix(auth, "Bearer ")
+	}
+	return ""
+}
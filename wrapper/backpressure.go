@@ -0,0 +1,36 @@
+package wrapper
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/asim/go-micro/v3/server"
+	"strconv"
+	"time"
+)
+
+// retryAfterError 服务暂时无法处理请求，如写入队列已满
+type retryAfterError interface {
+	error
+	RetryAfter() time.Duration
+}
+
+// RetryAfterPrefix 429 错误详情中重试间隔的前缀，网关据此设置 Retry-After 头
+const RetryAfterPrefix = "retry_after="
+
+// NewBackpressureWrapper 把带重试间隔的错误转换为 429（gRPC 的 ResourceExhausted），详情末尾带上 retry_after=秒数
+func NewBackpressureWrapper() server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			err := fn(ctx, req, rsp)
+			var e retryAfterError
+			if err == nil || !goerrors.As(err, &e) {
+				return err
+			}
+			seconds := int(e.RetryAfter().Seconds())
+			if seconds < 1 {
+				seconds = 1
+			}
+			return newCodedError(req.Service(), e.Error()+" "+RetryAfterPrefix+strconv.Itoa(seconds), 429, errorCode(err))
+		}
+	}
+}
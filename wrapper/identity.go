@@ -0,0 +1,127 @@
+package wrapper
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/asim/go-micro/v3/metadata"
+	"github.com/zxnlx/route/i18n"
+	"github.com/zxnlx/route/secrets"
+	"strconv"
+	"time"
+)
+
+// 网关签发的身份声明
+const (
+	ActorHeader          = "X-Actor"
+	ActorExpiresHeader   = "X-Actor-Expires"
+	ActorSignatureHeader = "X-Actor-Signature"
+)
+
+// ErrInvalidAssertion 身份声明的签名不正确或已过期
+var ErrInvalidAssertion = i18n.NewError(i18n.CodeInvalidAssertion, "身份声明无效")
+
+// AssertionConfig 网关为登录用户签发身份声明的密钥，网关和 Route 服务读取同一份配置（route.gateway.assertion）
+// 未配置时网关不传入身份，Route 服务也不接受 X-Actor，通过网关的请求只能使用 API 密钥
+type AssertionConfig struct {
+	Key string `json:"key"`
+	// KeyRef 密钥在密钥后端中的引用，格式 path#field，优先于 key，轮换后自动使用新密钥
+	KeyRef string `json:"key_ref"`
+	// TTLSeconds 声明的有效期，默认 60 秒
+	TTLSeconds int `json:"ttl_seconds"`
+}
+
+func (c AssertionConfig) ttl() time.Duration {
+	if c.TTLSeconds <= 0 {
+		return time.Minute
+	}
+	return time.Duration(c.TTLSeconds) * time.Second
+}
+
+// Assertion 签发和校验身份声明
+// X-Actor-Expires 为过期时间的 unix 秒，X-Actor-Signature 为 sha256=hex(HmacSHA256(expires + "." + actor))
+type Assertion struct {
+	config  AssertionConfig
+	secrets secrets.Provider
+}
+
+// NewAssertion 创建，provider 用于读取 KeyRef，未配置时为空
+func NewAssertion(config AssertionConfig, provider secrets.Provider) *Assertion {
+	return &Assertion{config: config, secrets: provider}
+}
+
+func (a *Assertion) key(ctx context.Context) (string, error) {
+	if a == nil {
+		return "", nil
+	}
+	if a.config.KeyRef != "" {
+		return secrets.Resolve(ctx, a.secrets, a.config.KeyRef)
+	}
+	return a.config.Key, nil
+}
+
+func sign(key string, actor string, expires string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(expires + "."))
+	mac.Write([]byte(actor))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sign 把签名后的身份写入 md，未配置密钥时返回错误，不传入身份
+func (a *Assertion) Sign(ctx context.Context, md metadata.Metadata, actor string) error {
+	key, err := a.key(ctx)
+	if err != nil {
+		return err
+	}
+	if key == "" {
+		return errors.New("未配置身份声明的签名密钥 route.gateway.assertion")
+	}
+	expires := strconv.FormatInt(time.Now().Add(a.config.ttl()).Unix(), 10)
+	md[ActorHeader] = actor
+	md[ActorExpiresHeader] = expires
+	md[ActorSignatureHeader] = sign(key, actor, expires)
+	return nil
+}
+
+// Verify 校验请求中的身份声明，没有声明时返回空，签名不正确、已过期或未配置密钥时返回 ErrInvalidAssertion
+func (a *Assertion) Verify(ctx context.Context) (string, error) {
+	actor, _ := metadata.Get(ctx, ActorHeader)
+	if actor == "" {
+		return "", nil
+	}
+	key, err := a.key(ctx)
+	if err != nil {
+		return "", err
+	}
+	if key == "" {
+		return "", ErrInvalidAssertion
+	}
+	expires, _ := metadata.Get(ctx, ActorExpiresHeader)
+	signature, _ := metadata.Get(ctx, ActorSignatureHeader)
+	if !hmac.Equal([]byte(signature), []byte(sign(key, actor, expires))) {
+		return "", ErrInvalidAssertion
+	}
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > unix {
+		return "", ErrInvalidAssertion
+	}
+	return actor, nil
+}
+
+// 用校验后的身份替换请求中的 X-Actor，身份为空时删除，处理器只能读到校验过的身份
+func withActor(ctx context.Context, actor string) context.Context {
+	//FromContext 返回的是副本，键统一为首字母大写
+	md, ok := metadata.FromContext(ctx)
+	if !ok {
+		md = metadata.Metadata{}
+	}
+	md.Delete(ActorHeader)
+	md.Delete(ActorExpiresHeader)
+	md.Delete(ActorSignatureHeader)
+	if actor != "" {
+		md[ActorHeader] = actor
+	}
+	return metadata.NewContext(ctx, md)
+}
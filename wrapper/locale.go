@@ -0,0 +1,94 @@
+package wrapper
+
+import (
+	"context"
+	goerrors "errors"
+	"github.com/asim/go-micro/v3/errors"
+	"github.com/asim/go-micro/v3/metadata"
+	"github.com/asim/go-micro/v3/server"
+	"github.com/zxnlx/route/i18n"
+	"gorm.io/gorm"
+	"net/http"
+	"strings"
+)
+
+// ErrorCodeHeader 网关返回错误码的响应头，错误码不随语言变化
+const ErrorCodeHeader = "X-Error-Code"
+
+// NewLocaleWrapper 按请求的 Accept-Language 翻译错误消息，没有时使用配置的默认语言
+// 错误码取自创建错误时带上的 i18n.Error，写入 go-micro 错误的 Status，Id 仍为服务名，HTTP 状态码保持不变
+// 目录只用于翻译消息，目录中没有的消息原样返回，不影响错误码
+func NewLocaleWrapper(catalog *i18n.Catalog) server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			err := fn(ctx, req, rsp)
+			if err == nil {
+				return nil
+			}
+			acceptLanguage, _ := metadata.Get(ctx, "Accept-Language")
+			lang := catalog.Negotiate(acceptLanguage)
+			e := &errors.Error{Id: req.Service(), Detail: err.Error(), Status: errorCode(err)}
+			var microErr *errors.Error
+			if goerrors.As(err, &microErr) {
+				e = &errors.Error{Id: microErr.Id, Code: microErr.Code, Detail: microErr.Detail, Status: microErr.Status}
+			}
+			//重试间隔由网关解析，不参与翻译
+			detail, suffix := e.Detail, ""
+			if i := strings.LastIndex(detail, " "+RetryAfterPrefix); i >= 0 {
+				detail, suffix = detail[:i], detail[i:]
+			}
+			_, translated := catalog.Translate(detail, lang)
+			if !isErrorCode(e.Status) && translated == detail {
+				return err
+			}
+			e.Detail = translated + suffix
+			return e
+		}
+	}
+}
+
+// 错误链中的错误码，gorm 的记录不存在由 gorm 创建，按 NOT_FOUND 返回
+func errorCode(err error) string {
+	if code := i18n.Code(err); code != "" {
+		return code
+	}
+	if goerrors.Is(err, gorm.ErrRecordNotFound) {
+		return i18n.CodeNotFound
+	}
+	return ""
+}
+
+// 其他包装器创建 go-micro 错误时使用，Id 为服务名，错误中带有错误码时写入 Status
+func newError(service string, err error, code int32) error {
+	return newCodedError(service, err.Error(), code, errorCode(err))
+}
+
+func newCodedError(service string, detail string, code int32, errorCode string) error {
+	status := errorCode
+	if status == "" {
+		status = http.StatusText(int(code))
+	}
+	return &errors.Error{Id: service, Code: code, Detail: detail, Status: status}
+}
+
+// ErrorCode 调用返回的错误码，从 go-micro 错误的 Status 中读取，没有错误码时为空
+func ErrorCode(err error) string {
+	status := errors.Parse(err.Error()).Status
+	if !isErrorCode(status) {
+		return ""
+	}
+	return status
+}
+
+// 错误码由大写字母、数字和下划线组成，和 HTTP 状态的描述区分
+func isErrorCode(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,164 @@
+package wrapper
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/asim/go-micro/v3/config"
+	"github.com/asim/go-micro/v3/errors"
+	"github.com/asim/go-micro/v3/server"
+	"github.com/zxnlx/common"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// LoggingConfig 请求日志配置，从配置中心的 route.logging 节点读取，修改后无需重启
+type LoggingConfig struct {
+	Enabled bool `json:"enabled"`
+	// Payload 是否记录脱敏后的请求和响应内容
+	Payload bool `json:"payload"`
+	// RedactFields 额外需要脱敏的字段名，不区分大小写，包含即匹配
+	RedactFields []string `json:"redact_fields"`
+}
+
+// 字段名包含以下内容时脱敏
+var defaultRedactFields = []string{"password", "passwd", "secret", "token", "authorization", "api_key", "apikey", "credential", "private_key", "route_auth_url"}
+
+// 字段名等于以下内容时脱敏，如 CreateAPIKeyResponse 中的明文密钥，按包含匹配会误伤 key_name 等字段
+var exactRedactFields = []string{"key"}
+
+const redacted = "******"
+
+// RequestLogger 记录每个 RPC 的操作、路由标识、结果和耗时
+type RequestLogger struct {
+	config atomic.Value
+}
+
+func NewRequestLogger(config LoggingConfig) *RequestLogger {
+	l := &RequestLogger{}
+	l.SetConfig(config)
+	return l
+}
+
+// SetConfig 运行时替换配置
+func (l *RequestLogger) SetConfig(config LoggingConfig) {
+	l.config.Store(config)
+}
+
+// Config 当前生效的配置
+func (l *RequestLogger) Config() LoggingConfig {
+	return l.config.Load().(LoggingConfig)
+}
+
+// Watch 监听配置中心的变更，path 一般为 route, logging
+func (l *RequestLogger) Watch(conf config.Config, path ...string) {
+	watcher, err := conf.Watch(path...)
+	if err != nil {
+		common.Error(err)
+		return
+	}
+	for {
+		value, err := watcher.Next()
+		if err != nil {
+			common.Error(err)
+			return
+		}
+		loggingConfig := LoggingConfig{}
+		if err := value.Scan(&loggingConfig); err != nil {
+			common.Error(err)
+			continue
+		}
+		l.SetConfig(loggingConfig)
+		common.Infof("请求日志配置已更新 enabled=%v payload=%v", loggingConfig.Enabled, loggingConfig.Payload)
+	}
+}
+
+// NewLoggingWrapper 按配置输出结构化请求日志，未开启时直接调用
+func (l *RequestLogger) NewLoggingWrapper() server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			conf := l.config.Load().(LoggingConfig)
+			if !conf.Enabled {
+				return fn(ctx, req, rsp)
+			}
+			start := time.Now()
+			err := fn(ctx, req, rsp)
+			entry := map[string]interface{}{
+				"operation":  req.Endpoint(),
+				"caller":     callerFromContext(ctx),
+				"latency_ms": time.Since(start).Milliseconds(),
+				"result":     "ok",
+			}
+			request := toMap(req.Body())
+			for _, key := range []string{"id", "route_id", "route_namespace", "route_name", "route_application_id"} {
+				if v, ok := request[key]; ok {
+					entry[key] = v
+				}
+			}
+			if err != nil {
+				entry["result"] = "error"
+				entry["error"] = err.Error()
+				if e := errors.FromError(err); e != nil && e.Code != 0 {
+					entry["code"] = e.Code
+				}
+			}
+			if conf.Payload {
+				fields := append(append([]string{}, defaultRedactFields...), conf.RedactFields...)
+				entry["request"] = redact(request, fields)
+				entry["response"] = redact(toMap(rsp), fields)
+			}
+			b, _ := json.Marshal(entry)
+			common.Info(string(b))
+			return err
+		}
+	}
+}
+
+// proto 消息转为 map，字段名与 json tag 一致
+func toMap(v interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return result
+	}
+	_ = json.Unmarshal(b, &result)
+	return result
+}
+
+// 递归替换敏感字段的值，map 类型字段(如注解)同样按 key 判断
+func redact(v interface{}, fields []string) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for k, item := range value {
+			if sensitive(k, fields) {
+				result[k] = redacted
+				continue
+			}
+			result[k] = redact(item, fields)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, item := range value {
+			result[i] = redact(item, fields)
+		}
+		return result
+	}
+	return v
+}
+
+func sensitive(key string, fields []string) bool {
+	key = strings.ToLower(key)
+	for _, f := range exactRedactFields {
+		if key == f {
+			return true
+		}
+	}
+	for _, f := range fields {
+		if f != "" && strings.Contains(key, strings.ToLower(f)) {
+			return true
+		}
+	}
+	return false
+}
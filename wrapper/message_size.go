@@ -0,0 +1,29 @@
+package wrapper
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/server"
+	"github.com/zxnlx/route/i18n"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+)
+
+// NewMessageSizeWrapper 响应超过大小限制时返回 413，提示分页或使用流式接口，避免编码时才失败
+// 流式接口的每条消息由编解码单独限制
+func NewMessageSizeWrapper(maxMessageBytes int) server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			if err := fn(ctx, req, rsp); err != nil {
+				return err
+			}
+			m, ok := rsp.(proto.Message)
+			if !ok {
+				return nil
+			}
+			if size := proto.Size(m); size > maxMessageBytes {
+				return newCodedError(req.Service(), "响应大小 "+strconv.Itoa(size)+" 字节超过限制 "+strconv.Itoa(maxMessageBytes)+" 字节，请使用分页（page_size）、fields 或 StreamAllRoutes", 413, i18n.CodeMessageTooLarge)
+			}
+			return nil
+		}
+	}
+}
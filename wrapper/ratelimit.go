@@ -0,0 +1,180 @@
+package wrapper
+
+import (
+	"context"
+	"github.com/asim/go-micro/v3/config"
+	"github.com/asim/go-micro/v3/metadata"
+	"github.com/asim/go-micro/v3/server"
+	"github.com/zxnlx/common"
+	"github.com/zxnlx/route/i18n"
+	"golang.org/x/time/rate"
+	"net"
+	"sync"
+	"time"
+)
+
+// RateLimitConfig 限流配置，从配置中心的 route.rate_limit 节点读取，值为 0 时不限流，修改后无需重启
+type RateLimitConfig struct {
+	// Global 整个服务每秒允许的请求数
+	Global      float64 `json:"global"`
+	GlobalBurst int     `json:"global_burst"`
+	// PerCaller 单个调用方每秒允许的请求数，调用方为鉴权后的身份，没有身份时为来源 IP
+	PerCaller      float64 `json:"per_caller"`
+	PerCallerBurst int     `json:"per_caller_burst"`
+}
+
+// 调用方长时间没有请求时回收对应的限流器
+const callerLimiterTTL = 10 * time.Minute
+
+type callerLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter 全局和按调用方限流
+type RateLimiter struct {
+	mu        sync.Mutex
+	config    RateLimitConfig
+	global    *rate.Limiter
+	callers   map[string]*callerLimiter
+	lastClean time.Time
+}
+
+// NewRateLimiter 创建
+func NewRateLimiter(config RateLimitConfig) *RateLimiter {
+	r := &RateLimiter{}
+	r.SetConfig(config)
+	return r
+}
+
+// SetConfig 运行时替换配置，按调用方的限流器重新创建
+func (r *RateLimiter) SetConfig(config RateLimitConfig) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.config = config
+	r.global = nil
+	if config.Global > 0 {
+		r.global = rate.NewLimiter(rate.Limit(config.Global), burst(config.Global, config.GlobalBurst))
+	}
+	r.callers = map[string]*callerLimiter{}
+	r.lastClean = time.Now()
+}
+
+// Config 当前生效的配置
+func (r *RateLimiter) Config() RateLimitConfig {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.config
+}
+
+// Watch 监听配置中心的变更，path 一般为 route, rate_limit
+func (r *RateLimiter) Watch(conf config.Config, path ...string) {
+	watcher, err := conf.Watch(path...)
+	if err != nil {
+		common.Error(err)
+		return
+	}
+	for {
+		value, err := watcher.Next()
+		if err != nil {
+			common.Error(err)
+			return
+		}
+		rateLimitConfig := RateLimitConfig{}
+		if err := value.Scan(&rateLimitConfig); err != nil {
+			common.Error(err)
+			continue
+		}
+		r.SetConfig(rateLimitConfig)
+		common.Infof("限流配置已更新 global=%v per_caller=%v", rateLimitConfig.Global, rateLimitConfig.PerCaller)
+	}
+}
+
+// NewGlobalRateLimitWrapper 全局限流，放在鉴权之前，超出限制返回 429
+func (r *RateLimiter) NewGlobalRateLimitWrapper() server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			if !r.allowGlobal() {
+				return newCodedError(req.Service(), "请求过于频繁，请稍后重试", 429, i18n.CodeRateLimited)
+			}
+			return fn(ctx, req, rsp)
+		}
+	}
+}
+
+// NewCallerRateLimitWrapper 按调用方限流，放在鉴权之后，使用校验过的身份，调用方无法通过修改请求头绕过
+func (r *RateLimiter) NewCallerRateLimitWrapper() server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			if !r.allowCaller(subjectFromContext(ctx)) {
+				return newCodedError(req.Service(), "请求过于频繁，请稍后重试", 429, i18n.CodeRateLimited)
+			}
+			return fn(ctx, req, rsp)
+		}
+	}
+}
+
+func (r *RateLimiter) allowGlobal() bool {
+	r.mu.Lock()
+	global := r.global
+	r.mu.Unlock()
+	return global == nil || global.Allow()
+}
+
+func (r *RateLimiter) allowCaller(name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.config.PerCaller <= 0 {
+		return true
+	}
+	now := time.Now()
+	if now.Sub(r.lastClean) > callerLimiterTTL {
+		for k, v := range r.callers {
+			if now.Sub(v.lastSeen) > callerLimiterTTL {
+				delete(r.callers, k)
+			}
+		}
+		r.lastClean = now
+	}
+	c, ok := r.callers[name]
+	if !ok {
+		c = &callerLimiter{limiter: rate.NewLimiter(rate.Limit(r.config.PerCaller), burst(r.config.PerCaller, r.config.PerCallerBurst))}
+		r.callers[name] = c
+	}
+	c.lastSeen = now
+	return c.limiter.Allow()
+}
+
+// 没有配置突发数时允许一秒的请求量
+func burst(limit float64, b int) int {
+	if b > 0 {
+		return b
+	}
+	if limit < 1 {
+		return 1
+	}
+	return int(limit)
+}
+
+// 优先使用调用方服务名，其次使用来源地址，仅用于日志
+func callerFromContext(ctx context.Context) string {
+	if from, ok := metadata.Get(ctx, "Micro-From-Service"); ok && from != "" {
+		return from
+	}
+	if remote, ok := metadata.Get(ctx, "Remote"); ok {
+		return remote
+	}
+	return ""
+}
+
+// 鉴权后的 X-Actor 为校验过的身份，没有身份时使用来源 IP，不含端口，新建连接不能换到新的限流器
+func subjectFromContext(ctx context.Context) string {
+	if actor, ok := metadata.Get(ctx, ActorHeader); ok && actor != "" {
+		return actor
+	}
+	remote, _ := metadata.Get(ctx, "Remote")
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		return "ip:" + host
+	}
+	return "ip:" + remote
+}